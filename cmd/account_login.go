@@ -69,22 +69,22 @@ requires a prior 'dtctl auth login' so an environment token is available.`,
 		oauthConfig := auth.AccountOAuthConfig(env, ctx.SafetyLevel, accountUUID)
 
 		// Ensure token storage is available (mirrors auth login).
-		if keyringErr := authCheckKeyringFunc(); keyringErr != nil {
+		if keyringErr := authCheckKeyringFunc(cfg.KeyringMode()); keyringErr != nil {
 			recovered := false
 			if strings.Contains(keyringErr.Error(), config.ErrMsgCollectionUnlock) {
 				output.PrintInfo("No keyring collection — creating one (you may be prompted for a password)...")
 				if initErr := authEnsureKeyringFunc(cmd.Context()); initErr == nil {
-					if authCheckKeyringFunc() == nil {
+					if authCheckKeyringFunc(cfg.KeyringMode()) == nil {
 						output.PrintSuccess("Keyring collection created")
 						recovered = true
 					}
 				}
 			}
 			if !recovered {
-				if !config.IsFileTokenStorage() {
+				if !cfg.KeyringMode().FileTokenStorage() {
 					return fmt.Errorf("token storage unavailable: %v\n\nSet DTCTL_TOKEN_STORAGE=file to use file-based fallback", keyringErr)
 				}
-				output.PrintWarning("Keyring unavailable; using file-based token storage (%s)", config.OAuthStorageBackend())
+				output.PrintWarning("Keyring unavailable; using file-based token storage (%s)", cfg.KeyringMode().OAuthStorageBackend())
 			}
 		}
 
@@ -107,7 +107,7 @@ requires a prior 'dtctl auth login' so an environment token is available.`,
 
 		output.PrintSuccess("Authentication successful!")
 
-		tokenManager, err := auth.NewTokenManager(oauthConfig)
+		tokenManager, err := newTokenManager(cfg, oauthConfig)
 		if err != nil {
 			return fmt.Errorf("failed to create token manager: %w", err)
 		}
@@ -121,7 +121,7 @@ requires a prior 'dtctl auth login' so an environment token is available.`,
 		if ctx.AccountUUID == "" {
 			if nc, err := cfg.GetContext(cfg.CurrentContext); err == nil {
 				nc.Context.AccountUUID = accountUUID
-				if saveErr := cfg.Save(); saveErr != nil {
+				if saveErr := saveConfig(cfg); saveErr != nil {
 					output.PrintWarning("Could not persist account-uuid to context: %v", saveErr)
 				}
 			}
@@ -69,7 +69,7 @@ is overriding the stored token.`,
 
 		env := auth.DetectEnvironment(ctx.Environment)
 		oauthCfg := auth.AccountOAuthConfig(env, ctx.SafetyLevel, accountUUID)
-		tm, err := auth.NewTokenManager(oauthCfg)
+		tm, err := newTokenManager(cfg, oauthCfg)
 		if err != nil {
 			return fmt.Errorf("token manager: %w", err)
 		}
@@ -46,6 +46,16 @@ func extractApplyBase(result apply.ApplyResult) *apply.ApplyResultBase {
 		return &r.ApplyResultBase
 	case apply.ExtensionConfigApplyResult:
 		return &r.ApplyResultBase
+	case *apply.SegmentApplyResult:
+		return &r.ApplyResultBase
+	case apply.SegmentApplyResult:
+		return &r.ApplyResultBase
+	case *apply.AnomalyDetectorApplyResult:
+		return &r.ApplyResultBase
+	case apply.AnomalyDetectorApplyResult:
+		return &r.ApplyResultBase
+	case *apply.EdgeConnectApplyResult:
+		return &r.ApplyResultBase
 	default:
 		return nil
 	}
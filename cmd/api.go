@@ -0,0 +1,231 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dynatrace-oss/dtctl/pkg/client"
+	"github.com/dynatrace-oss/dtctl/pkg/exec"
+	"github.com/dynatrace-oss/dtctl/pkg/safety"
+	"github.com/dynatrace-oss/dtctl/pkg/util/format"
+	"github.com/dynatrace-oss/dtctl/sdk/httpclient"
+)
+
+// apiMethodOperations maps the HTTP methods `dtctl api` accepts to the safety
+// operation they must pass. GET has no entry: it is read-only.
+var apiMethodOperations = map[string]safety.Operation{
+	http.MethodPost:   safety.OperationCreate,
+	http.MethodPut:    safety.OperationUpdate,
+	http.MethodPatch:  safety.OperationUpdate,
+	http.MethodDelete: safety.OperationDelete,
+}
+
+// apiCmd calls an arbitrary platform API path with the authenticated client
+var apiCmd = &cobra.Command{
+	Use:   "api <method> <path>",
+	Short: "Call a Dynatrace API path directly",
+	Long: `Send an authenticated request to an arbitrary Dynatrace API path.
+
+This is an escape hatch for endpoints dtctl does not wrap yet. The request uses
+the current context's environment URL and credentials. Supported methods are
+GET, POST, PUT, PATCH, and DELETE (case-insensitive).
+
+The request body is given with -d/--data, either inline or as @<file> (use @-
+for stdin). YAML bodies are converted to JSON unless a Content-Type header is
+set explicitly.
+
+Write methods pass through the context's safety level: POST is checked as a
+create, PUT/PATCH as an update, and DELETE as a delete.
+
+JSON responses are pretty-printed by default; -o yaml, -o toon and --jq work
+on them too. Non-JSON responses are written as-is.`,
+	Example: `  # List SLOs through the raw API
+  dtctl api GET /platform/slo/v1/slos
+
+  # Pass query parameters
+  dtctl api get /platform/document/v1/documents --query filter="type=='dashboard'" --query page-size=10
+
+  # POST a body from a file
+  dtctl api POST /platform/automation/v1/workflows -d @workflow.json
+
+  # Send a custom header
+  dtctl api GET /platform/metadata/v1/user -H "Accept-Language: en"`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		method := strings.ToUpper(args[0])
+		if method != http.MethodGet {
+			if _, ok := apiMethodOperations[method]; !ok {
+				return fmt.Errorf("unsupported method %q (use GET, POST, PUT, PATCH, or DELETE)", args[0])
+			}
+		}
+
+		path := args[1]
+		if !strings.HasPrefix(path, "/") {
+			return fmt.Errorf("path must start with '/' (got %q)", path)
+		}
+
+		data, _ := cmd.Flags().GetString("data")
+		queryFlags, _ := cmd.Flags().GetStringArray("query")
+		headerFlags, _ := cmd.Flags().GetStringArray("header")
+
+		query, err := parseAPIQuery(queryFlags)
+		if err != nil {
+			return err
+		}
+		headers, err := parseAPIHeaders(headerFlags)
+		if err != nil {
+			return err
+		}
+
+		body, err := readAPIBody(data, headers)
+		if err != nil {
+			return err
+		}
+
+		if dryRun {
+			fmt.Printf("Dry run: would send %s %s\n", method, path)
+			for _, q := range queryFlags {
+				fmt.Printf("Query:  %s\n", q)
+			}
+			for _, h := range headerFlags {
+				fmt.Printf("Header: %s\n", h)
+			}
+			if len(body) > 0 {
+				fmt.Println("---")
+				fmt.Println(string(body))
+				fmt.Println("---")
+			}
+			return nil
+		}
+
+		var c *client.Client
+		if op, ok := apiMethodOperations[method]; ok {
+			_, c, err = SetupWithSafety(op)
+		} else {
+			_, c, err = SetupClient()
+		}
+		if err != nil {
+			return err
+		}
+
+		req := c.HTTP().R().SetQueryParamsFromValues(query)
+		for k, v := range headers {
+			req.SetHeader(k, v)
+		}
+		if len(body) > 0 {
+			if _, ok := headers["Content-Type"]; !ok {
+				req.SetHeader("Content-Type", "application/json")
+			}
+			req.SetBody(body)
+		}
+
+		resp, err := req.Execute(method, path)
+		if err != nil {
+			return fmt.Errorf("%s %s: %w", method, path, err)
+		}
+		if err := httpclient.CheckResponse(resp); err != nil {
+			return fmt.Errorf("%s %s: %w", method, path, err)
+		}
+
+		return printAPIResponse(resp.Body())
+	},
+}
+
+// parseAPIQuery parses repeated --query key=value flags. Repeating a key sends
+// it multiple times.
+func parseAPIQuery(flags []string) (url.Values, error) {
+	values := url.Values{}
+	for _, f := range flags {
+		k, v, ok := strings.Cut(f, "=")
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid --query %q: expected key=value", f)
+		}
+		values.Add(k, v)
+	}
+	return values, nil
+}
+
+// parseAPIHeaders parses repeated -H "Name: value" flags.
+func parseAPIHeaders(flags []string) (map[string]string, error) {
+	headers := make(map[string]string, len(flags))
+	for _, f := range flags {
+		k, v, ok := strings.Cut(f, ":")
+		k = strings.TrimSpace(k)
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid --header %q: expected \"Name: value\"", f)
+		}
+		if strings.EqualFold(k, "Authorization") {
+			return nil, fmt.Errorf("the Authorization header is set from the current context and cannot be overridden")
+		}
+		headers[http.CanonicalHeaderKey(k)] = strings.TrimSpace(v)
+	}
+	return headers, nil
+}
+
+// readAPIBody resolves the --data value: inline text, or @file / @- for a file
+// or stdin. Without an explicit Content-Type, YAML is converted to JSON.
+func readAPIBody(data string, headers map[string]string) ([]byte, error) {
+	if data == "" {
+		return nil, nil
+	}
+
+	body := []byte(data)
+	if name, ok := strings.CutPrefix(data, "@"); ok {
+		content, err := exec.ReadFileOrStdin(name)
+		if err != nil {
+			return nil, err
+		}
+		body = []byte(content)
+	}
+
+	if _, ok := headers["Content-Type"]; ok {
+		return body, nil
+	}
+	jsonData, err := format.ValidateAndConvert(body)
+	if err != nil {
+		return nil, fmt.Errorf("invalid request body (set -H \"Content-Type: ...\" to send non-JSON data): %w", err)
+	}
+	return jsonData, nil
+}
+
+// printAPIResponse writes a response body. JSON goes through the printer for
+// structured formats (json/yaml/toon, --jq, agent mode) and is pretty-printed
+// otherwise; anything else is written verbatim.
+func printAPIResponse(body []byte) error {
+	if len(body) == 0 {
+		return nil
+	}
+
+	var parsed interface{}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		_, err := os.Stdout.Write(body)
+		return err
+	}
+
+	if !agentMode && jqFilter == "" && (outputFormat == "table" || outputFormat == "wide") {
+		pretty, err := format.PrettyJSON(body)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(pretty))
+		return nil
+	}
+
+	printer := NewPrinter()
+	enrichAgent(printer, "api", "")
+	return printer.Print(parsed)
+}
+
+func init() {
+	rootCmd.AddCommand(apiCmd)
+
+	apiCmd.Flags().StringP("data", "d", "", "request body, inline or @file (@- for stdin)")
+	apiCmd.Flags().StringArray("query", []string{}, "query parameter (key=value, repeatable)")
+	apiCmd.Flags().StringArrayP("header", "H", []string{}, "request header (\"Name: value\", repeatable)")
+}
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+)
+
+func TestParseAPIQuery(t *testing.T) {
+	values, err := parseAPIQuery([]string{"page-size=10", "filter=name=='x'", "tag=a", "tag=b"})
+	if err != nil {
+		t.Fatalf("parseAPIQuery() error = %v", err)
+	}
+	if got := values.Get("page-size"); got != "10" {
+		t.Errorf("page-size = %q, want 10", got)
+	}
+	if got := values.Get("filter"); got != "name=='x'" {
+		t.Errorf("filter = %q, want value split on the first '='", got)
+	}
+	if got := values["tag"]; len(got) != 2 {
+		t.Errorf("tag = %v, want repeated values kept", got)
+	}
+
+	if _, err := parseAPIQuery([]string{"novalue"}); err == nil {
+		t.Error("expected error for query without '='")
+	}
+}
+
+func TestParseAPIHeaders(t *testing.T) {
+	headers, err := parseAPIHeaders([]string{"accept-language: en", "X-Custom:value"})
+	if err != nil {
+		t.Fatalf("parseAPIHeaders() error = %v", err)
+	}
+	if headers["Accept-Language"] != "en" {
+		t.Errorf("Accept-Language = %q, want en", headers["Accept-Language"])
+	}
+	if headers["X-Custom"] != "value" {
+		t.Errorf("X-Custom = %q, want value", headers["X-Custom"])
+	}
+
+	if _, err := parseAPIHeaders([]string{"Authorization: Bearer x"}); err == nil {
+		t.Error("expected error when overriding Authorization")
+	}
+	if _, err := parseAPIHeaders([]string{"no-colon"}); err == nil {
+		t.Error("expected error for header without ':'")
+	}
+}
+
+func TestReadAPIBody(t *testing.T) {
+	body, err := readAPIBody("name: test\n", map[string]string{})
+	if err != nil {
+		t.Fatalf("readAPIBody() error = %v", err)
+	}
+	if !strings.Contains(string(body), `"name":"test"`) {
+		t.Errorf("YAML body not converted to JSON: %s", body)
+	}
+
+	file := testutil.CreateTempFile(t, `{"a": 1}`, "body-*.json")
+	body, err = readAPIBody("@"+file, map[string]string{})
+	if err != nil {
+		t.Fatalf("readAPIBody(@file) error = %v", err)
+	}
+	if !strings.Contains(string(body), `"a"`) {
+		t.Errorf("file body not read: %s", body)
+	}
+
+	body, err = readAPIBody("plain text", map[string]string{"Content-Type": "text/plain"})
+	if err != nil {
+		t.Fatalf("readAPIBody() with Content-Type error = %v", err)
+	}
+	if string(body) != "plain text" {
+		t.Errorf("body = %q, want verbatim with explicit Content-Type", body)
+	}
+}
+
+func TestAPICmd_PostSendsBody(t *testing.T) {
+	var gotBody, gotQuery, gotHeader string
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/example/v1/things": func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("expected POST, got %s", r.Method)
+			}
+			data, _ := io.ReadAll(r.Body)
+			gotBody = string(data)
+			gotQuery = r.URL.Query().Get("mode")
+			gotHeader = r.Header.Get("X-Custom")
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"thing-1"}`))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile := cfgFile
+	defer func() { cfgFile = origCfgFile }()
+	cfgFile = configPath
+
+	testutil.ResetCommandFlags(apiCmd)
+	_ = apiCmd.Flags().Set("data", `{"name":"x"}`)
+	_ = apiCmd.Flags().Set("query", "mode=fast")
+	_ = apiCmd.Flags().Set("header", "X-Custom: yes")
+
+	if err := apiCmd.RunE(apiCmd, []string{"post", "/platform/example/v1/things"}); err != nil {
+		t.Fatalf("RunE() error = %v", err)
+	}
+	if !strings.Contains(gotBody, `"name":"x"`) {
+		t.Errorf("body = %q, want JSON payload", gotBody)
+	}
+	if gotQuery != "fast" {
+		t.Errorf("query mode = %q, want fast", gotQuery)
+	}
+	if gotHeader != "yes" {
+		t.Errorf("X-Custom header = %q, want yes", gotHeader)
+	}
+}
+
+func TestAPICmd_RejectsUnknownMethod(t *testing.T) {
+	testutil.ResetCommandFlags(apiCmd)
+	err := apiCmd.RunE(apiCmd, []string{"TRACE", "/platform/x"})
+	if err == nil || !strings.Contains(err.Error(), "unsupported method") {
+		t.Errorf("expected unsupported method error, got %v", err)
+	}
+}
+
+func TestAPICmd_ErrorStatus(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/missing": func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write(testutil.ErrorResponse(404, "nothing here"))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile := cfgFile
+	defer func() { cfgFile = origCfgFile }()
+	cfgFile = configPath
+
+	testutil.ResetCommandFlags(apiCmd)
+	err := apiCmd.RunE(apiCmd, []string{"GET", "/platform/missing"})
+	if err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("expected 404 error, got %v", err)
+	}
+}
@@ -3,20 +3,22 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/dynatrace-oss/dtctl/pkg/apply"
 	"github.com/dynatrace-oss/dtctl/pkg/client"
+	"github.com/dynatrace-oss/dtctl/pkg/labels"
 	"github.com/dynatrace-oss/dtctl/pkg/output"
 	"github.com/dynatrace-oss/dtctl/pkg/resources/document"
-	"github.com/dynatrace-oss/dtctl/pkg/util/template"
+	"github.com/dynatrace-oss/dtctl/pkg/safety"
 )
 
 // applyCmd represents the apply command
 var applyCmd = &cobra.Command{
-	Use:   "apply -f <file>",
+	Use:   "apply -f <file|directory>",
 	Short: "Apply a configuration to create or update resources",
 	Long: `Apply a configuration to create or update resources from YAML or JSON files.
 
@@ -53,6 +55,8 @@ Template-driven deployments (--id):
 
 Template variables can be used with the --set flag for reusable configurations,
 making it easy to deploy the same resource across multiple environments.
+--set-file sets a variable to a file's contents and --set-json to a typed JSON
+value; a placeholder that is a whole value ("{{ .hosts }}") keeps that type.
 
 Supported resource types:
   - Workflows (automation)
@@ -63,6 +67,47 @@ Supported resource types:
   - Settings objects
   - Extension monitoring configurations
 
+Resource type (--type):
+  The resource type is detected from the file's fields. When detection picks
+  the wrong type or fails, --type names it explicitly and skips detection
+  (e.g. --type slo, --type settings, --type anomaly-detector). A detection
+  failure lists the shapes that were tried.
+
+Input format (--input-format):
+  JSON and YAML are told apart by the file's content. --input-format json or
+  --input-format yaml forces one of them, e.g. for generated files without a
+  .json or .yaml extension; a file that does not parse as that format fails.
+
+Labels (--label):
+  Workflows, dashboards, and notebooks have no native key/value labels, so
+  --label key=value stores labels in a sidecar line of the description,
+  together with dtctl.io/managed-by=dtctl. Select them later with
+  'dtctl get <resource> --selector key=value' or delete them the same way.
+
+Directories (-f <dir>, --recursive):
+  When -f names a directory, every .yaml, .yml, and .json file in it is applied,
+  ordered by resource type so dependencies exist first: connections, then
+  buckets, settings and other configuration, then monitoring configs, then
+  workflows and documents. Files of the same type are applied in lexical path
+  order. --order lists types to apply first (e.g. --order settings,workflow);
+  unlisted types follow in the default order. --recursive (-R) also
+  descends into subdirectories. Hidden files and directories are skipped. Each
+  file's result is reported on stderr, followed by a summary; a failing file
+  does not stop the others.
+
+Pruning (--prune):
+  With a directory, --prune deletes the objects this directory used to own but
+  no longer contains: workflows, dashboards, and notebooks that carry
+  dtctl.io/managed-by=dtctl and every --label pair, whose ID was not part of
+  this apply. It is guarded on purpose:
+    - --prune-allow must list the types that may be deleted; others are kept
+    - at least one --label is required to scope the objects
+    - the context must be dangerously-unrestricted, or --i-know-what-im-doing
+      acknowledges the deletion
+    - nothing is pruned when any file failed to apply
+  The full plan, with counts per type, is printed before deleting; with
+  --dry-run only the plan is printed.
+
 Array input (bulk apply):
   Files containing an array of resources (e.g., from 'dtctl get settings --schema ...
   -o yaml') are applied element-by-element. Partial failures do not abort the batch;
@@ -93,15 +138,60 @@ Examples:
   # Edit rum-settings.yaml (modify values for specific applications)...
   dtctl apply -f rum-settings.yaml  # Updates all settings in the file
 
+  # Skip type detection for an ambiguous file
+  dtctl apply -f objective.yaml --type slo
+
+  # Parse a generated file as JSON regardless of its name
+  dtctl apply -f /tmp/render.out --input-format json
+
+  # Apply every manifest under a config repository (connections first)
+  dtctl apply -f environments/prod/ --recursive
+
+  # Apply settings before everything else in a directory
+  dtctl apply -f environments/prod/ --order settings
+
+  # Preview which labelled workflows and dashboards a prune would delete
+  dtctl apply -f team-checkout/ --label team=checkout --prune --prune-allow workflow,dashboard --dry-run
+
+  # Sync the directory and delete what was removed from it
+  dtctl apply -f team-checkout/ --label team=checkout --prune --prune-allow workflow,dashboard --i-know-what-im-doing
+
   # Apply with template variables
   dtctl apply -f dashboard.yaml --set environment=prod --set owner=team-a
 
+  # Pass a list and a file's contents as template variables
+  dtctl apply -f workflow.yaml --set-json hosts='["h-1","h-2"]' --set-file script=./task.js
+
   # Preview changes before applying
   dtctl apply -f notebook.yaml --dry-run
 
+  # Have the API validate a settings change without persisting it
+  dtctl apply -f openpipeline-settings.yaml --dry-run=server
+
   # See what changed when updating
   dtctl apply -f dashboard.yaml --show-diff
 
+  # Reject dashboards whose tiles reference deleted variables
+  dtctl apply -f dashboard.yaml --strict
+
+  # Merge a partial settings value into the existing object (JSON merge patch)
+  dtctl apply -f partial-setting.yaml --merge
+
+  # Retry a dashboard/SLO update that lost a race with a concurrent edit
+  dtctl apply -f dashboard.yaml --force-conflicts
+
+  # Check Azure/GCP connection credentials right after saving them
+  dtctl apply -f azure-connection.yaml --verify
+
+  # Label a dashboard so it can be selected later
+  dtctl apply -f dashboard.yaml --label team=checkout --label env=prod
+
+  # Block until a new bucket is active before continuing
+  dtctl apply -f bucket.yaml --wait --wait-timeout 3m
+
+  # Capture the resulting ID in a script
+  WF_ID=$(dtctl apply -f workflow.yaml -q)
+
   # Apply and get JSON output (for scripting/CI)
   dtctl apply -f dashboard.yaml -o json
 
@@ -120,31 +210,81 @@ resources in sync with their file definitions.
 			return fmt.Errorf("--file is required")
 		}
 
-		setFlags, _ := cmd.Flags().GetStringArray("set")
-		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		strategy := dryRunStrategy(cmd.Flags().Lookup("dry-run").Value.String())
+		dryRun := strategy != dryRunNone
 		showDiff, _ := cmd.Flags().GetBool("show-diff")
 		noHooks, _ := cmd.Flags().GetBool("no-hooks")
 		overrideID, _ := cmd.Flags().GetString("id")
 		writeID, _ := cmd.Flags().GetBool("write-id")
 		shareEnvironment, _ := cmd.Flags().GetString("share-environment")
+		strict, _ := cmd.Flags().GetBool("strict")
+		merge, _ := cmd.Flags().GetBool("merge")
+		verifyConnections, _ := cmd.Flags().GetBool("verify")
+		forceConflicts, _ := cmd.Flags().GetBool("force-conflicts")
+		wait, _ := cmd.Flags().GetBool("wait")
+		waitTimeout, _ := cmd.Flags().GetDuration("wait-timeout")
+		labelPairs, _ := cmd.Flags().GetStringArray("label")
+		recursive, _ := cmd.Flags().GetBool("recursive")
+		typeName, _ := cmd.Flags().GetString("type")
+		orderNames, _ := cmd.Flags().GetStringSlice("order")
+		prune, _ := cmd.Flags().GetBool("prune")
+		pruneAllow, _ := cmd.Flags().GetStringSlice("prune-allow")
+		pruneAck, _ := cmd.Flags().GetBool("i-know-what-im-doing")
 
 		if err := validateShareEnvironmentValue(shareEnvironment); err != nil {
 			return err
 		}
+		var forcedType apply.ResourceType
+		if typeName != "" {
+			var err error
+			if forcedType, err = apply.ParseResourceType(typeName); err != nil {
+				return err
+			}
+		}
+		inputFormat, err := inputFormatFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+		order, err := apply.ParseOrder(orderNames)
+		if err != nil {
+			return fmt.Errorf("invalid --order: %w", err)
+		}
+		applyLabels, err := labels.ParsePairs(labelPairs)
+		if err != nil {
+			return err
+		}
 
-		// Read the file
-		fileData, err := os.ReadFile(file)
+		info, err := os.Stat(file)
 		if err != nil {
 			return fmt.Errorf("failed to read file: %w", err)
 		}
+		var files []string
+		if info.IsDir() {
+			if overrideID != "" {
+				return fmt.Errorf("--id cannot be used when applying a directory")
+			}
+			if forcedType != "" {
+				return fmt.Errorf("--type cannot be used when applying a directory")
+			}
+			if inputFormat != "" {
+				return fmt.Errorf("--input-format cannot be used when applying a directory")
+			}
+			if files, err = collectManifestFiles(file, recursive); err != nil {
+				return err
+			}
+			files = orderManifestFiles(files, order)
+		} else if len(orderNames) > 0 {
+			return fmt.Errorf("--order only applies when -f names a directory")
+		}
+		pruneOpts, err := parsePruneFlags(prune, pruneAllow, pruneAck, info.IsDir(), applyLabels)
+		if err != nil {
+			return err
+		}
 
 		// Parse template variables
-		var templateVars map[string]interface{}
-		if len(setFlags) > 0 {
-			templateVars, err = template.ParseSetFlags(setFlags)
-			if err != nil {
-				return fmt.Errorf("invalid --set flag: %w", err)
-			}
+		templateVars, err := templateVarsFromFlags(cmd)
+		if err != nil {
+			return fmt.Errorf("invalid template variable: %w", err)
 		}
 
 		// Load configuration
@@ -161,21 +301,26 @@ resources in sync with their file definitions.
 		// Create applier with safety checker (safety checks happen inside applier
 		// with proper ownership determination for updates)
 		applier := apply.NewApplier(c)
+		var checker *safety.Checker
 		if !dryRun {
-			checker, err := NewSafetyChecker(cfg)
-			if err != nil {
+			if checker, err = NewSafetyChecker(cfg); err != nil {
 				return err
 			}
 			applier = applier.WithSafetyChecker(checker)
+			if pruneOpts != nil {
+				if err := checkPruneAllowed(checker.SafetyLevel(), pruneOpts.acknowledged); err != nil {
+					return err
+				}
+			}
 		}
 
 		// Configure pre-apply and post-apply hooks
 		if !noHooks {
 			if hookCmd := cfg.GetPreApplyHook(); hookCmd != "" {
-				applier = applier.WithPreApplyHook(hookCmd).WithSourceFile(file)
+				applier = applier.WithPreApplyHook(hookCmd)
 			}
 			if hookCmd := cfg.GetPostApplyHook(); hookCmd != "" {
-				applier = applier.WithPostApplyHook(hookCmd).WithSourceFile(file)
+				applier = applier.WithPostApplyHook(hookCmd)
 			}
 			// Hook output (stdout and stderr) always goes to stderr so that
 			// stdout carries only the structured result — JSON, YAML, or table
@@ -185,14 +330,35 @@ resources in sync with their file definitions.
 
 		// Apply the resource
 		opts := apply.ApplyOptions{
-			TemplateVars: templateVars,
-			DryRun:       dryRun,
-			ShowDiff:     showDiff,
-			OverrideID:   overrideID,
-			WriteID:      writeID,
+			TemplateVars:   templateVars,
+			DryRun:         dryRun,
+			ShowDiff:       showDiff,
+			OverrideID:     overrideID,
+			WriteID:        writeID,
+			Strict:         strict,
+			ServerDryRun:   strategy == dryRunServer,
+			Merge:          merge,
+			Verify:         verifyConnections,
+			ForceConflicts: forceConflicts,
+			Labels:         applyLabels,
+			BaseDir:        filepath.Dir(file),
+			Wait:           wait,
+			WaitTimeout:    waitTimeout,
+			ResourceType:   forcedType,
+			InputFormat:    inputFormat,
 		}
 
-		results, applyErr := applier.Apply(fileData, opts)
+		var results []apply.ApplyResult
+		var applyErr error
+		if files != nil {
+			results, applyErr = applyFiles(applier, files, opts)
+		} else {
+			fileData, err := os.ReadFile(file)
+			if err != nil {
+				return fmt.Errorf("failed to read file: %w", err)
+			}
+			results, applyErr = applier.WithSourceFile(file).Apply(fileData, opts)
+		}
 
 		// For ListApplyError (partial batch failure), we still want to print
 		// the successful results before returning the error.
@@ -208,6 +374,27 @@ resources in sync with their file definitions.
 			shareErr = ensureEnvironmentShareForResults(c, results, shareEnvironment)
 		}
 
+		// Prune once the applied IDs are known; the plan is printed on stderr
+		// before anything is deleted.
+		if pruneOpts != nil {
+			if err := runPrune(c, checker, pruneOpts, results, applyErr, dryRun); err != nil && applyErr == nil {
+				applyErr = err
+			}
+		}
+
+		// With --quiet only the resulting IDs are printed, one per line.
+		if quiet, _ := cmd.Flags().GetBool("quiet"); quiet {
+			for _, r := range results {
+				if base := extractApplyBase(r); base != nil && base.ID != "" {
+					fmt.Println(base.ID)
+				}
+			}
+			if shareErr != nil {
+				return fmt.Errorf("apply succeeded but environment share failed: %w", shareErr)
+			}
+			return applyErr
+		}
+
 		// Print structured output using the global -o flag.
 		// The concrete type (DashboardApplyResult, WorkflowApplyResult, DryRunResult, etc.)
 		// determines which columns/fields appear in the output.
@@ -248,6 +435,14 @@ resources in sync with their file definitions.
 				return err
 			}
 		}
+		// Table output hides the one-time EdgeConnect OAuth secret column;
+		// structured formats already carry it.
+		if outputFormat == "table" || outputFormat == "wide" {
+			printEdgeConnectCredentials(results)
+			if strategy == dryRunServer && applyErr == nil {
+				output.PrintInfo("Validated by the server; nothing was persisted.")
+			}
+		}
 		if shareErr != nil {
 			return fmt.Errorf("apply succeeded but environment share failed: %w", shareErr)
 		}
@@ -258,19 +453,77 @@ resources in sync with their file definitions.
 func init() {
 	rootCmd.AddCommand(applyCmd)
 
-	applyCmd.Flags().StringP("file", "f", "", "file containing resource definition (required)")
-	applyCmd.Flags().StringArray("set", []string{}, "set template variable (key=value)")
-	applyCmd.Flags().Bool("dry-run", false, "preview changes without applying")
+	applyCmd.Flags().StringP("file", "f", "", "file or directory containing resource definitions (required)")
+	applyCmd.Flags().BoolP("recursive", "R", false, "with a directory for -f, also apply manifests in its subdirectories")
+	applyCmd.Flags().Bool("prune", false, "with a directory for -f, delete dtctl-managed objects that carry the --label pairs but are not in the directory (requires --prune-allow)")
+	applyCmd.Flags().StringSlice("prune-allow", nil, "resource types --prune may delete (workflow, dashboard, notebook)")
+	applyCmd.Flags().Bool("i-know-what-im-doing", false, "acknowledge that --prune deletes resources when the context is not dangerously-unrestricted")
+	applyCmd.Flags().StringSlice("order", nil, "with a directory for -f, resource types to apply first, in order (e.g. azure-connection,settings); others follow the default dependency order")
+	addTemplateVarFlags(applyCmd)
+	addInputFormatFlag(applyCmd)
+	dryRunFlag := dryRunNone
+	applyCmd.Flags().Var(&dryRunFlag, "dry-run", `preview changes without applying: "client" (local preview, the default for a bare --dry-run) or "server" (API validation; settings only, as the SLO and bucket APIs have no validate-only mode)`)
+	applyCmd.Flags().Lookup("dry-run").NoOptDefVal = string(dryRunClient)
 	applyCmd.Flags().Bool("show-diff", false, "show diff of changes when updating existing resources")
 	applyCmd.Flags().Bool("no-hooks", false, "skip pre-apply and post-apply hooks")
 	applyCmd.Flags().String("id", "", "override or inject resource ID (use with --write-id to stamp ID into file)")
 	applyCmd.Flags().Bool("write-id", false, "write the created resource ID back into the source file for idempotent future applies")
 	applyCmd.Flags().String("share-environment", "", "share the applied notebook/dashboard with everyone in the environment (values: 'read' or 'read-write'; bare --share-environment defaults to 'read')")
 	applyCmd.Flags().Lookup("share-environment").NoOptDefVal = "read"
+	applyCmd.Flags().Bool("strict", false, "fail instead of warning when a dashboard tile references an undefined variable")
+	applyCmd.Flags().Bool("verify", false, "after applying Azure or GCP connections, validate their credentials server-side and fail if they do not work")
+	applyCmd.Flags().Bool("merge", false, "merge the file into the existing settings value or document content as a JSON merge patch instead of replacing it")
+	applyCmd.Flags().Bool("force-conflicts", false, fmt.Sprintf("on a version conflict (HTTP 409), re-fetch the current document/SLO version and retry the update (up to %d times); overwrites concurrent edits", apply.MaxConflictRetries))
+	applyCmd.Flags().BoolP("quiet", "q", false, "print only the resulting resource IDs, one per line")
+	applyCmd.Flags().String("type", "", "resource type of the file (e.g. workflow, dashboard, slo, settings); skips auto-detection")
+	applyCmd.Flags().StringArray("label", []string{}, "label workflows, dashboards, and notebooks (key=value, repeatable); also marks them dtctl.io/managed-by=dtctl")
+	addWaitFlags(applyCmd)
 
 	_ = applyCmd.MarkFlagRequired("file")
 }
 
+// printEdgeConnectCredentials prints the OAuth credentials of EdgeConnects
+// created by apply. The secret is only returned on create.
+func printEdgeConnectCredentials(results []apply.ApplyResult) {
+	for _, r := range results {
+		ec, ok := r.(*apply.EdgeConnectApplyResult)
+		if !ok || ec.OAuthClientSecret == "" {
+			continue
+		}
+		output.PrintInfo("\nOAuth Client Credentials for %q (save these, the secret won't be shown again):", ec.Name)
+		output.PrintInfo("  Client ID:     %s", ec.OAuthClientID)
+		output.PrintInfo("  Client Secret: %s", ec.OAuthClientSecret)
+	}
+}
+
+// dryRunStrategy is the value of apply's --dry-run flag. A bare --dry-run
+// means client; "server" sends the change with the API's validate-only mode.
+type dryRunStrategy string
+
+const (
+	dryRunNone   dryRunStrategy = "none"
+	dryRunClient dryRunStrategy = "client"
+	dryRunServer dryRunStrategy = "server"
+)
+
+func (s *dryRunStrategy) String() string { return string(*s) }
+
+func (s *dryRunStrategy) Type() string { return "string" }
+
+func (s *dryRunStrategy) Set(v string) error {
+	switch strings.ToLower(v) {
+	case "client", "true":
+		*s = dryRunClient
+	case "server":
+		*s = dryRunServer
+	case "none", "false":
+		*s = dryRunNone
+	default:
+		return fmt.Errorf(`must be "client", "server", or "none"`)
+	}
+	return nil
+}
+
 // validateShareEnvironmentValue rejects any --share-environment value outside
 // the empty string, "read", or "read-write".
 func validateShareEnvironmentValue(v string) error {
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/dynatrace-oss/dtctl/pkg/apply"
+	"github.com/dynatrace-oss/dtctl/pkg/output"
+)
+
+// manifestExtensions are the file extensions apply picks up from a directory.
+var manifestExtensions = map[string]bool{
+	".yaml": true,
+	".yml":  true,
+	".json": true,
+}
+
+// collectManifestFiles returns the manifest files in dir in lexical path
+// order, which orderManifestFiles keeps within each resource type. Hidden
+// files and directories are skipped; subdirectories are only entered when
+// recursive is set.
+func collectManifestFiles(dir string, recursive bool) ([]string, error) {
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == dir {
+			return nil
+		}
+		if strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			if !recursive {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if manifestExtensions[strings.ToLower(filepath.Ext(path))] {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory: %w", err)
+	}
+	if len(files) == 0 {
+		hint := ""
+		if !recursive {
+			hint = " (use --recursive to include subdirectories)"
+		}
+		return nil, fmt.Errorf("no manifest files (.yaml, .yml, .json) found in %s%s", dir, hint)
+	}
+	return files, nil
+}
+
+// orderManifestFiles sorts files by the rank of their resource type in order,
+// so dependencies (connections) are applied before the resources that
+// reference them. Files of the same type keep their path order; files whose
+// type cannot be detected go last and report their error when applied.
+func orderManifestFiles(files []string, order []apply.ResourceType) []string {
+	rank := make(map[apply.ResourceType]int, len(order))
+	for i, t := range order {
+		rank[t] = i
+	}
+	ranks := make(map[string]int, len(files))
+	for _, file := range files {
+		ranks[file] = len(order)
+		data, err := os.ReadFile(file)
+		if err != nil {
+			continue
+		}
+		if t, err := apply.DetectType(data); err == nil {
+			if r, ok := rank[t]; ok {
+				ranks[file] = r
+			}
+		}
+	}
+
+	sorted := append([]string(nil), files...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return ranks[sorted[i]] < ranks[sorted[j]]
+	})
+	return sorted
+}
+
+// applyFiles applies each file in order and reports a per-file result on
+// stderr followed by a summary. A failing file does not stop the remaining
+// ones; the results of all successful files are returned together with an
+// error counting the failures.
+func applyFiles(applier *apply.Applier, files []string, opts apply.ApplyOptions) ([]apply.ApplyResult, error) {
+	var results []apply.ApplyResult
+	failed := 0
+	for _, file := range files {
+		fileData, err := os.ReadFile(file)
+		if err != nil {
+			output.PrintWarning("%s: failed to read file: %v", file, err)
+			failed++
+			continue
+		}
+
+		opts.BaseDir = filepath.Dir(file)
+		fileResults, err := applier.WithSourceFile(file).Apply(fileData, opts)
+		results = append(results, fileResults...)
+		if err != nil {
+			output.PrintWarning("%s: %v", file, err)
+			failed++
+			continue
+		}
+		output.PrintSuccess("%s: %d resource(s) %s", file, len(fileResults), appliedVerb(opts.DryRun))
+	}
+
+	output.PrintInfo("%d of %d file(s) %s, %d resource(s) in total", len(files)-failed, len(files), appliedVerb(opts.DryRun), len(results))
+	if failed > 0 {
+		return results, fmt.Errorf("%d of %d files failed to apply", failed, len(files))
+	}
+	return results, nil
+}
+
+func appliedVerb(dryRun bool) string {
+	if dryRun {
+		return "checked"
+	}
+	return "applied"
+}
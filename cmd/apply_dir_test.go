@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+	"github.com/dynatrace-oss/dtctl/pkg/apply"
+)
+
+func writeManifestTree(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestCollectManifestFiles(t *testing.T) {
+	dir := writeManifestTree(t, map[string]string{
+		"20-configs/b.yaml":    "",
+		"20-configs/a.json":    "",
+		"10-connection.yml":    "",
+		"README.md":            "",
+		".hidden.yaml":         "",
+		".git/config.yaml":     "",
+		"30-nested/x/y.YAML":   "",
+		"05-first.yaml":        "",
+		"20-configs/notes.txt": "",
+	})
+
+	rel := func(files []string) []string {
+		out := make([]string, len(files))
+		for i, f := range files {
+			r, _ := filepath.Rel(dir, f)
+			out[i] = filepath.ToSlash(r)
+		}
+		return out
+	}
+
+	files, err := collectManifestFiles(dir, false)
+	if err != nil {
+		t.Fatalf("collectManifestFiles() error = %v", err)
+	}
+	if got, want := strings.Join(rel(files), ","), "05-first.yaml,10-connection.yml"; got != want {
+		t.Errorf("non-recursive = %s, want %s", got, want)
+	}
+
+	files, err = collectManifestFiles(dir, true)
+	if err != nil {
+		t.Fatalf("collectManifestFiles() error = %v", err)
+	}
+	want := "05-first.yaml,10-connection.yml,20-configs/a.json,20-configs/b.yaml,30-nested/x/y.YAML"
+	if got := strings.Join(rel(files), ","); got != want {
+		t.Errorf("recursive = %s, want %s", got, want)
+	}
+}
+
+func TestCollectManifestFiles_Empty(t *testing.T) {
+	dir := writeManifestTree(t, map[string]string{"sub/a.yaml": ""})
+	_, err := collectManifestFiles(dir, false)
+	if err == nil || !strings.Contains(err.Error(), "--recursive") {
+		t.Errorf("expected no-manifests error with --recursive hint, got %v", err)
+	}
+}
+
+func TestOrderManifestFiles(t *testing.T) {
+	dir := writeManifestTree(t, map[string]string{
+		"01-workflow.yaml":   "title: wf\ntasks: {}\n",
+		"02-broken.yaml":     "{",
+		"03-bucket.yaml":     "bucketName: b\ntable: logs\nretentionDays: 35\n",
+		"04-connection.yaml": "schemaId: builtin:hyperscaler-authentication.connections.azure\nvalue:\n  name: conn\n",
+		"05-bucket.yaml":     "bucketName: c\ntable: logs\nretentionDays: 35\n",
+	})
+	files, err := collectManifestFiles(dir, false)
+	if err != nil {
+		t.Fatalf("collectManifestFiles() error = %v", err)
+	}
+
+	names := func(files []string) string {
+		out := make([]string, len(files))
+		for i, f := range files {
+			out[i] = filepath.Base(f)
+		}
+		return strings.Join(out, ",")
+	}
+
+	order, _ := apply.ParseOrder(nil)
+	want := "04-connection.yaml,03-bucket.yaml,05-bucket.yaml,01-workflow.yaml,02-broken.yaml"
+	if got := names(orderManifestFiles(files, order)); got != want {
+		t.Errorf("default order = %s, want %s", got, want)
+	}
+
+	order, _ = apply.ParseOrder([]string{"workflow"})
+	want = "01-workflow.yaml,04-connection.yaml,03-bucket.yaml,05-bucket.yaml,02-broken.yaml"
+	if got := names(orderManifestFiles(files, order)); got != want {
+		t.Errorf("--order workflow = %s, want %s", got, want)
+	}
+}
+
+func TestApplyCmd_Directory(t *testing.T) {
+	var created []string
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/storage/management/v1/bucket-definitions/": func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		},
+		"/platform/storage/management/v1/bucket-definitions": func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			name, _ := body["bucketName"].(string)
+			if name == "broken" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			created = append(created, name)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]any{"bucketName": name, "table": "logs", "status": "creating"})
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	dir := writeManifestTree(t, map[string]string{
+		"10-first.yaml":      "bucketName: first\ntable: logs\nretentionDays: 35\n",
+		"sub/20-second.yaml": "bucketName: second\ntable: logs\nretentionDays: 35\n",
+		"sub/30-broken.yaml": "bucketName: broken\ntable: logs\nretentionDays: 35\n",
+	})
+
+	origCfgFile, origOutputFormat, origAgentMode := cfgFile, outputFormat, agentMode
+	defer func() {
+		testutil.ResetCommandFlags(applyCmd)
+		cfgFile, outputFormat, agentMode = origCfgFile, origOutputFormat, origAgentMode
+	}()
+	testutil.ResetCommandFlags(applyCmd)
+	cfgFile = configPath
+	outputFormat = "json"
+	agentMode = false
+	_ = applyCmd.Flags().Set("file", dir)
+	_ = applyCmd.Flags().Set("recursive", "true")
+	_ = applyCmd.Flags().Set("no-hooks", "true")
+
+	var err error
+	out := captureStdout(t, func() {
+		err = applyCmd.RunE(applyCmd, nil)
+	})
+	if err == nil || !strings.Contains(err.Error(), "1 of 3 files failed") {
+		t.Errorf("expected summary error for the broken file, got %v", err)
+	}
+	if strings.Join(created, ",") != "first,second" {
+		t.Errorf("created buckets = %v, want first,second in path order", created)
+	}
+	if !strings.Contains(out, `"first"`) || !strings.Contains(out, `"second"`) {
+		t.Errorf("expected results of the successful files on stdout, got:\n%s", out)
+	}
+}
+
+func TestApplyCmd_DirectoryRejectsID(t *testing.T) {
+	dir := writeManifestTree(t, map[string]string{"a.yaml": "bucketName: a\n"})
+	defer testutil.ResetCommandFlags(applyCmd)
+	testutil.ResetCommandFlags(applyCmd)
+	_ = applyCmd.Flags().Set("file", dir)
+	_ = applyCmd.Flags().Set("id", "some-id")
+
+	err := applyCmd.RunE(applyCmd, nil)
+	if err == nil || !strings.Contains(err.Error(), "--id cannot be used") {
+		t.Errorf("expected --id rejection, got %v", err)
+	}
+}
@@ -10,7 +10,6 @@ import (
 	"github.com/dynatrace-oss/dtctl/pkg/apply"
 	"github.com/dynatrace-oss/dtctl/pkg/resources/extension"
 	"github.com/dynatrace-oss/dtctl/pkg/safety"
-	"github.com/dynatrace-oss/dtctl/pkg/util/format"
 	"github.com/dynatrace-oss/dtctl/pkg/util/template"
 )
 
@@ -49,7 +48,6 @@ Examples:
 		extensionName := args[0]
 		file, _ := cmd.Flags().GetString("file")
 		scope, _ := cmd.Flags().GetString("scope")
-		setFlags, _ := cmd.Flags().GetStringArray("set")
 
 		if file == "" {
 			return fmt.Errorf("--file is required")
@@ -62,18 +60,18 @@ Examples:
 		}
 
 		// Convert to JSON if needed
-		jsonData, err := format.ValidateAndConvert(fileData)
+		jsonData, err := convertInputFile(cmd, fileData)
 		if err != nil {
-			return fmt.Errorf("invalid file format: %w", err)
+			return err
 		}
 
 		// Apply template rendering if variables provided
-		if len(setFlags) > 0 {
-			templateVars, err := template.ParseSetFlags(setFlags)
-			if err != nil {
-				return fmt.Errorf("invalid --set flag: %w", err)
-			}
-			rendered, err := template.RenderTemplate(string(jsonData), templateVars)
+		templateVars, err := templateVarsFromFlags(cmd)
+		if err != nil {
+			return fmt.Errorf("invalid template variable: %w", err)
+		}
+		if len(templateVars) > 0 {
+			rendered, err := template.RenderJSONTemplate(string(jsonData), templateVars)
 			if err != nil {
 				return fmt.Errorf("template rendering failed: %w", err)
 			}
@@ -170,6 +168,7 @@ func init() {
 
 	applyExtensionConfigCmd.Flags().StringP("file", "f", "", "file containing the monitoring configuration (scope + value) (required)")
 	applyExtensionConfigCmd.Flags().String("scope", "", "scope for the monitoring configuration (e.g. HOST-1234, only for create)")
-	applyExtensionConfigCmd.Flags().StringArray("set", []string{}, "set template variable (key=value)")
+	addTemplateVarFlags(applyExtensionConfigCmd)
+	addInputFormatFlag(applyExtensionConfigCmd)
 	_ = applyExtensionConfigCmd.MarkFlagRequired("file")
 }
@@ -0,0 +1,242 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dynatrace-oss/dtctl/pkg/apply"
+	"github.com/dynatrace-oss/dtctl/pkg/client"
+	"github.com/dynatrace-oss/dtctl/pkg/config"
+	"github.com/dynatrace-oss/dtctl/pkg/labels"
+	"github.com/dynatrace-oss/dtctl/pkg/output"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/document"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/workflow"
+	"github.com/dynatrace-oss/dtctl/pkg/safety"
+)
+
+// prunableTypes are the resource types apply --prune can delete. Only they
+// carry the dtctl label sidecar that tells which objects a directory owns.
+var prunableTypes = map[apply.ResourceType]bool{
+	apply.ResourceWorkflow:  true,
+	apply.ResourceDashboard: true,
+	apply.ResourceNotebook:  true,
+}
+
+// pruneOptions are the validated --prune flags of apply.
+type pruneOptions struct {
+	// allow lists the resource types that may be pruned (--prune-allow).
+	allow []apply.ResourceType
+	// scope are the labels an object must carry to be pruned: the dtctl
+	// managed-by marker and every --label pair.
+	scope labels.Labels
+	// acknowledged is set by --i-know-what-im-doing.
+	acknowledged bool
+}
+
+// pruneCandidate is an object apply --prune would delete.
+type pruneCandidate struct {
+	Type    apply.ResourceType
+	ID      string
+	Name    string
+	Owner   string
+	Version int
+}
+
+// parsePruneFlags validates the --prune flags before anything is applied.
+// It returns nil options when --prune is not set.
+func parsePruneFlags(prune bool, allowNames []string, acknowledged, isDir bool, applyLabels labels.Labels) (*pruneOptions, error) {
+	if !prune {
+		if len(allowNames) > 0 {
+			return nil, fmt.Errorf("--prune-allow requires --prune")
+		}
+		if acknowledged {
+			return nil, fmt.Errorf("--i-know-what-im-doing requires --prune")
+		}
+		return nil, nil
+	}
+	if !isDir {
+		return nil, fmt.Errorf("--prune only applies when -f names a directory")
+	}
+	if len(allowNames) == 0 {
+		return nil, fmt.Errorf("--prune requires --prune-allow listing the resource types that may be deleted (workflow, dashboard, notebook)")
+	}
+	if len(applyLabels) == 0 {
+		return nil, fmt.Errorf("--prune requires at least one --label to scope which dtctl-managed objects belong to this directory")
+	}
+
+	opts := &pruneOptions{
+		scope:        labels.Labels{labels.ManagedByKey: labels.ManagedByValue}.Merge(applyLabels),
+		acknowledged: acknowledged,
+	}
+	seen := make(map[apply.ResourceType]bool)
+	for _, name := range allowNames {
+		t, err := apply.ParseResourceType(strings.TrimSpace(name))
+		if err != nil {
+			return nil, fmt.Errorf("invalid --prune-allow: %w", err)
+		}
+		if !prunableTypes[t] {
+			return nil, fmt.Errorf("invalid --prune-allow: %s cannot be pruned; only workflows, dashboards, and notebooks carry the labels prune relies on", t)
+		}
+		if !seen[t] {
+			seen[t] = true
+			opts.allow = append(opts.allow, t)
+		}
+	}
+	return opts, nil
+}
+
+// checkPruneAllowed requires the dangerously-unrestricted safety level or the
+// explicit --i-know-what-im-doing acknowledgement.
+func checkPruneAllowed(level config.SafetyLevel, acknowledged bool) error {
+	if acknowledged || level == config.SafetyLevelDangerouslyUnrestricted {
+		return nil
+	}
+	return fmt.Errorf("--prune deletes resources; it requires the dangerously-unrestricted safety level (e.g. --safety-level dangerously-unrestricted) or --i-know-what-im-doing")
+}
+
+// appliedIDs returns the IDs of the applied resources, keyed by resource type.
+func appliedIDs(results []apply.ApplyResult) map[apply.ResourceType]map[string]bool {
+	ids := make(map[apply.ResourceType]map[string]bool)
+	for _, r := range results {
+		base := extractApplyBase(r)
+		switch dr := r.(type) {
+		case *apply.DryRunResult:
+			base = &dr.ApplyResultBase
+		case apply.DryRunResult:
+			base = &dr.ApplyResultBase
+		}
+		if base == nil || base.ID == "" {
+			continue
+		}
+		t := apply.ResourceType(base.ResourceType)
+		if ids[t] == nil {
+			ids[t] = make(map[string]bool)
+		}
+		ids[t][base.ID] = true
+	}
+	return ids
+}
+
+// hasLabels reports whether the labels in description include every scope label.
+func hasLabels(description string, scope labels.Labels) bool {
+	_, l := labels.FromDescription(description)
+	for k, v := range scope {
+		if got, ok := l[k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
+
+// findPruneCandidates lists the allowed types and returns the objects that
+// carry every scope label but were not part of this apply.
+func findPruneCandidates(c *client.Client, opts *pruneOptions, applied map[apply.ResourceType]map[string]bool) ([]pruneCandidate, error) {
+	var candidates []pruneCandidate
+	for _, t := range opts.allow {
+		switch t {
+		case apply.ResourceWorkflow:
+			list, err := workflow.NewHandler(c).List(workflow.WorkflowFilters{}, allPagesChunkSize(), 0)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list workflows: %w", err)
+			}
+			for _, wf := range list.Results {
+				if hasLabels(wf.Description, opts.scope) && !applied[t][wf.ID] {
+					candidates = append(candidates, pruneCandidate{Type: t, ID: wf.ID, Name: wf.Title, Owner: wf.Owner})
+				}
+			}
+		case apply.ResourceDashboard, apply.ResourceNotebook:
+			list, err := document.NewHandler(c).List(documentListFilters(string(t)))
+			if err != nil {
+				return nil, fmt.Errorf("failed to list %ss: %w", t, err)
+			}
+			for _, d := range document.ConvertToDocuments(list) {
+				if hasLabels(d.Description, opts.scope) && !applied[t][d.ID] {
+					candidates = append(candidates, pruneCandidate{Type: t, ID: d.ID, Name: d.Name, Owner: d.Owner, Version: d.Version})
+				}
+			}
+		}
+	}
+	return candidates, nil
+}
+
+// printPrunePlan prints every object prune will delete, with counts per type,
+// on stderr.
+func printPrunePlan(candidates []pruneCandidate, opts *pruneOptions, dryRun bool) {
+	if len(candidates) == 0 {
+		output.PrintInfo("Prune: nothing to delete (scope %s)", opts.scope)
+		return
+	}
+
+	counts := make(map[apply.ResourceType]int)
+	for _, cand := range candidates {
+		counts[cand.Type]++
+	}
+	types := make([]string, 0, len(counts))
+	for t, n := range counts {
+		types = append(types, fmt.Sprintf("%s: %d", t, n))
+	}
+	sort.Strings(types)
+
+	verb := "will delete"
+	if dryRun {
+		verb = "would delete"
+	}
+	output.PrintInfo("Prune plan: %s %d resource(s) not in this apply (%s), scope %s", verb, len(candidates), strings.Join(types, ", "), opts.scope)
+	for _, cand := range candidates {
+		output.PrintInfo("  %-10s %s  %s", cand.Type, cand.ID, cand.Name)
+	}
+}
+
+// pruneResources deletes the candidates after checking the safety level for
+// each of them. Individual failures do not stop the remaining deletions; they
+// are reported in the returned error.
+func pruneResources(c *client.Client, checker *safety.Checker, candidates []pruneCandidate) error {
+	currentUserID, _ := c.CurrentUserID()
+	for _, cand := range candidates {
+		ownership := safety.DetermineOwnership(cand.Owner, currentUserID)
+		if err := checker.CheckError(safety.OperationDelete, ownership); err != nil {
+			return fmt.Errorf("%s %q: %w", cand.Type, cand.Name, err)
+		}
+	}
+
+	failed := 0
+	for _, cand := range candidates {
+		var err error
+		switch cand.Type {
+		case apply.ResourceWorkflow:
+			err = workflow.NewHandler(c).Delete(cand.ID)
+		default:
+			err = document.NewHandler(c).Delete(cand.ID, cand.Version)
+		}
+		if err != nil {
+			output.PrintWarning("Failed to prune %s %q: %v", cand.Type, cand.Name, err)
+			failed++
+			continue
+		}
+		output.PrintSuccess("%s %q pruned", cand.Type, cand.Name)
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to prune %d of %d resources", failed, len(candidates))
+	}
+	return nil
+}
+
+// runPrune deletes the objects in scope that this apply did not write. It
+// refuses to run after a failed apply, because a manifest that failed to apply
+// would otherwise look removed and its object would be deleted.
+func runPrune(c *client.Client, checker *safety.Checker, opts *pruneOptions, results []apply.ApplyResult, applyErr error, dryRun bool) error {
+	if applyErr != nil {
+		output.PrintWarning("Skipping prune because the apply did not fully succeed")
+		return nil
+	}
+	candidates, err := findPruneCandidates(c, opts, appliedIDs(results))
+	if err != nil {
+		return fmt.Errorf("prune: %w", err)
+	}
+	printPrunePlan(candidates, opts, dryRun)
+	if dryRun || len(candidates) == 0 {
+		return nil
+	}
+	return pruneResources(c, checker, candidates)
+}
@@ -0,0 +1,179 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+	"github.com/dynatrace-oss/dtctl/pkg/apply"
+	"github.com/dynatrace-oss/dtctl/pkg/client"
+	"github.com/dynatrace-oss/dtctl/pkg/config"
+	"github.com/dynatrace-oss/dtctl/pkg/labels"
+	"github.com/dynatrace-oss/dtctl/pkg/safety"
+)
+
+func TestParsePruneFlags(t *testing.T) {
+	team := labels.Labels{"team": "checkout"}
+	tests := []struct {
+		name      string
+		prune     bool
+		allow     []string
+		ack       bool
+		isDir     bool
+		labels    labels.Labels
+		wantErr   string
+		wantAllow []apply.ResourceType
+	}{
+		{name: "off"},
+		{name: "allow without prune", allow: []string{"workflow"}, wantErr: "--prune-allow requires --prune"},
+		{name: "ack without prune", ack: true, wantErr: "--i-know-what-im-doing requires --prune"},
+		{name: "single file", prune: true, allow: []string{"workflow"}, labels: team, wantErr: "directory"},
+		{name: "missing allow", prune: true, isDir: true, labels: team, wantErr: "--prune-allow"},
+		{name: "missing label", prune: true, isDir: true, allow: []string{"workflow"}, wantErr: "--label"},
+		{name: "unlabelled type", prune: true, isDir: true, allow: []string{"settings"}, labels: team, wantErr: "cannot be pruned"},
+		{name: "unknown type", prune: true, isDir: true, allow: []string{"widget"}, labels: team, wantErr: "invalid --prune-allow"},
+		{
+			name: "valid", prune: true, isDir: true, labels: team,
+			allow:     []string{"workflow", "dashboard", "workflow"},
+			wantAllow: []apply.ResourceType{apply.ResourceWorkflow, apply.ResourceDashboard},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := parsePruneFlags(tt.prune, tt.allow, tt.ack, tt.isDir, tt.labels)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.prune {
+				if opts != nil {
+					t.Fatalf("expected nil options without --prune, got %+v", opts)
+				}
+				return
+			}
+			if len(opts.allow) != len(tt.wantAllow) {
+				t.Fatalf("allow = %v, want %v", opts.allow, tt.wantAllow)
+			}
+			for i := range tt.wantAllow {
+				if opts.allow[i] != tt.wantAllow[i] {
+					t.Errorf("allow[%d] = %s, want %s", i, opts.allow[i], tt.wantAllow[i])
+				}
+			}
+			if opts.scope[labels.ManagedByKey] != labels.ManagedByValue || opts.scope["team"] != "checkout" {
+				t.Errorf("scope = %v, want managed-by marker and team label", opts.scope)
+			}
+		})
+	}
+}
+
+func TestCheckPruneAllowed(t *testing.T) {
+	if err := checkPruneAllowed(config.SafetyLevelReadWriteAll, false); err == nil {
+		t.Error("expected an error for readwrite-all without acknowledgement")
+	}
+	if err := checkPruneAllowed(config.SafetyLevelReadWriteAll, true); err != nil {
+		t.Errorf("acknowledged prune should be allowed: %v", err)
+	}
+	if err := checkPruneAllowed(config.SafetyLevelDangerouslyUnrestricted, false); err != nil {
+		t.Errorf("dangerously-unrestricted should allow prune: %v", err)
+	}
+}
+
+func TestRunPrune(t *testing.T) {
+	scope := labels.Labels{labels.ManagedByKey: labels.ManagedByValue, "team": "checkout"}
+	var deleted []string
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/automation/v1/workflows": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"count": 4, "results": []any{
+				map[string]any{"id": "wf-applied", "title": "Applied", "description": labels.InDescription("", scope)},
+				map[string]any{"id": "wf-stale", "title": "Stale", "description": labels.InDescription("Removed from the repo", scope)},
+				map[string]any{"id": "wf-other-team", "title": "Other", "description": labels.InDescription("", labels.Labels{labels.ManagedByKey: labels.ManagedByValue, "team": "payments"})},
+				map[string]any{"id": "wf-manual", "title": "Manual", "description": labels.InDescription("", labels.Labels{"team": "checkout"})},
+			}})
+		},
+		"/platform/automation/v1/workflows/wf-stale": func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodDelete {
+				deleted = append(deleted, "wf-stale")
+			}
+			w.WriteHeader(http.StatusNoContent)
+		},
+	})
+	defer ms.Close()
+
+	c, err := client.NewForTesting(ms.URL, "test-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	checker := safety.NewCheckerWithLevel("test", config.SafetyLevelDangerouslyUnrestricted)
+	opts := &pruneOptions{allow: []apply.ResourceType{apply.ResourceWorkflow}, scope: scope}
+	results := []apply.ApplyResult{&apply.WorkflowApplyResult{ApplyResultBase: apply.ApplyResultBase{
+		Action: apply.ActionUpdated, ResourceType: "workflow", ID: "wf-applied",
+	}}}
+
+	// A dry run prints the plan only.
+	if err := runPrune(c, checker, opts, results, nil, true); err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if len(deleted) != 0 {
+		t.Fatalf("dry run deleted %v", deleted)
+	}
+
+	// A failed apply never prunes.
+	if err := runPrune(c, checker, opts, results, errors.New("1 of 2 files failed to apply"), false); err != nil {
+		t.Fatalf("failed apply: %v", err)
+	}
+	if len(deleted) != 0 {
+		t.Fatalf("prune ran after a failed apply: %v", deleted)
+	}
+
+	if err := runPrune(c, checker, opts, results, nil, false); err != nil {
+		t.Fatalf("runPrune: %v", err)
+	}
+	if len(deleted) != 1 || deleted[0] != "wf-stale" {
+		t.Errorf("deleted = %v, want [wf-stale]", deleted)
+	}
+}
+
+func TestFindPruneCandidates_AllPages(t *testing.T) {
+	scope := labels.Labels{labels.ManagedByKey: labels.ManagedByValue, "team": "checkout"}
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/automation/v1/workflows": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			id := "wf-page1"
+			if r.URL.Query().Get("offset") != "" {
+				id = "wf-page2"
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"count": 2, "results": []any{
+				map[string]any{"id": id, "title": id, "description": labels.InDescription("", scope)},
+			}})
+		},
+	})
+	defer ms.Close()
+
+	c, err := client.NewForTesting(ms.URL, "test-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// --no-paginate limits what get displays; the prune plan must still be complete.
+	origNoPaginate, origChunk := noPaginate, chunkSize
+	defer func() { noPaginate, chunkSize = origNoPaginate, origChunk }()
+	noPaginate, chunkSize = true, 1
+
+	opts := &pruneOptions{allow: []apply.ResourceType{apply.ResourceWorkflow}, scope: scope}
+	got, err := findPruneCandidates(c, opts, nil)
+	if err != nil {
+		t.Fatalf("findPruneCandidates() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Errorf("candidates = %+v, want the workflows on both pages", got)
+	}
+}
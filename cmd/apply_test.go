@@ -4,10 +4,13 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync/atomic"
 	"testing"
 
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
 	"github.com/dynatrace-oss/dtctl/pkg/apply"
 	"github.com/dynatrace-oss/dtctl/pkg/client"
 	"github.com/dynatrace-oss/dtctl/pkg/resources/document"
@@ -200,3 +203,184 @@ func TestApplyCmd_ShareEnvironmentFlagValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyCmd_DryRunFlag(t *testing.T) {
+	f := applyCmd.Flags().Lookup("dry-run")
+	if f == nil {
+		t.Fatal("--dry-run flag not registered")
+	}
+	if f.NoOptDefVal != "client" {
+		t.Errorf("bare --dry-run should mean client, got %q", f.NoOptDefVal)
+	}
+	defer func() { _ = f.Value.Set(f.DefValue) }()
+
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"client", "client", false},
+		{"true", "client", false},
+		{"server", "server", false},
+		{"none", "none", false},
+		{"false", "none", false},
+		{"bogus", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			err := f.Value.Set(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Set(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if !tt.wantErr && f.Value.String() != tt.want {
+				t.Errorf("Set(%q) = %q, want %q", tt.value, f.Value.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyCmd_TypeFlagValidation(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name    string
+		file    string
+		typ     string
+		wantErr string
+	}{
+		{name: "unknown type", file: filepath.Join(dir, "x.yaml"), typ: "pipeline", wantErr: "unknown resource type"},
+		{name: "directory", file: dir, typ: "slo", wantErr: "--type cannot be used when applying a directory"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.ResetCommandFlags(applyCmd)
+			_ = applyCmd.Flags().Set("file", tt.file)
+			_ = applyCmd.Flags().Set("type", tt.typ)
+
+			err := applyCmd.RunE(applyCmd, nil)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestApplyCmd_InputFormat(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{})
+	defer ms.Close()
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile := cfgFile
+	defer func() {
+		testutil.ResetCommandFlags(applyCmd)
+		cfgFile = origCfgFile
+	}()
+	cfgFile = configPath
+
+	dir := t.TempDir()
+	generated := filepath.Join(dir, "render.out")
+	if err := os.WriteFile(generated, []byte("title: Nightly\ntasks: {}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		file    string
+		format  string
+		wantErr string
+	}{
+		{name: "unknown format", file: generated, format: "toml", wantErr: `invalid --input-format: unsupported input format "toml"`},
+		{name: "directory", file: dir, format: "json", wantErr: "--input-format cannot be used when applying a directory"},
+		{name: "forced format does not match", file: generated, format: "json", wantErr: "failed to parse input as JSON (input format forced to json)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.ResetCommandFlags(applyCmd)
+			_ = applyCmd.Flags().Set("file", tt.file)
+			_ = applyCmd.Flags().Set("input-format", tt.format)
+
+			err := applyCmd.RunE(applyCmd, nil)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestApplyCmd_QuietPrintsID(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/storage/filter-segments/v1/filter-segments": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"uid":"seg-123","name":"prod","isPublic":false,"version":1}`))
+		},
+		"/platform/classic/environment-api/v2/settings/objects": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.Method == http.MethodPost {
+				_, _ = w.Write([]byte(`[{"objectId":"ad-456","code":200}]`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"items":[],"totalCount":0}`))
+		},
+		"/platform/classic/environment-api/v2/settings/objects/ad-456": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"objectId":"ad-456","schemaId":"builtin:davis.anomaly-detectors","value":{"title":"High CPU"}}`))
+		},
+	})
+	defer ms.Close()
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile := cfgFile
+	defer func() {
+		testutil.ResetCommandFlags(applyCmd)
+		cfgFile = origCfgFile
+	}()
+	cfgFile = configPath
+
+	tests := []struct {
+		name     string
+		manifest string
+		wantID   string
+	}{
+		{
+			name:     "segment",
+			manifest: "name: prod\nisPublic: false\nincludes: []\n",
+			wantID:   "seg-123",
+		},
+		{
+			name: "anomaly detector",
+			manifest: `title: High CPU
+analyzer:
+  name: dt.statistics.ui.anomaly_detection.StaticThresholdAnomalyDetectionAnalyzer
+  input:
+    query: timeseries avg(dt.host.cpu.usage)
+    threshold: 90
+eventTemplate:
+  event.type: PERFORMANCE_EVENT
+  event.name: High CPU
+`,
+			wantID: "ad-456",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := testutil.CreateTempFile(t, tt.manifest, "manifest-*.yaml")
+			testutil.ResetCommandFlags(applyCmd)
+			_ = applyCmd.Flags().Set("file", file)
+			_ = applyCmd.Flags().Set("quiet", "true")
+
+			var runErr error
+			out := captureStdout(t, func() {
+				runErr = applyCmd.RunE(applyCmd, nil)
+			})
+			if runErr != nil {
+				t.Fatalf("RunE() error = %v", runErr)
+			}
+			if out != tt.wantID+"\n" {
+				t.Errorf("stdout = %q, want %q", out, tt.wantID+"\n")
+			}
+		})
+	}
+}
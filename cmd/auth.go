@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -25,7 +26,7 @@ var (
 // probe and recover the keyring in auth login. They default to the real
 // implementations and can be overridden in tests.
 var (
-	authCheckKeyringFunc  = config.CheckKeyring
+	authCheckKeyringFunc  = config.KeyringMode.CheckKeyring
 	authEnsureKeyringFunc = config.EnsureKeyringCollection
 )
 
@@ -62,7 +63,7 @@ type SessionStatus struct {
 // Overridable in tests.
 var buildSessionStatusFunc = buildSessionStatus
 
-func buildSessionStatus(contextName string, ctx *config.Context, tokenName string) (*SessionStatus, error) {
+func buildSessionStatus(cfg *config.Config, contextName string, ctx *config.Context, tokenName string) (*SessionStatus, error) {
 	status := &SessionStatus{
 		Context:     contextName,
 		Environment: ctx.Environment,
@@ -73,7 +74,7 @@ func buildSessionStatus(contextName string, ctx *config.Context, tokenName strin
 	}
 
 	oauthConfig := auth.OAuthConfigFromEnvironmentURLWithSafety(ctx.Environment, ctx.SafetyLevel)
-	tokenManager, err := auth.NewTokenManager(oauthConfig)
+	tokenManager, err := newTokenManager(cfg, oauthConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +86,7 @@ func buildSessionStatus(contextName string, ctx *config.Context, tokenName strin
 	}
 
 	status.IsOAuth = true
-	status.Storage = config.OAuthStorageBackend()
+	status.Storage = cfg.KeyringMode().OAuthStorageBackend()
 	status.AccessTokenPresent = stored.AccessToken != ""
 	if !stored.ExpiresAt.IsZero() {
 		t := stored.ExpiresAt
@@ -238,7 +239,7 @@ OAuth-specific fields.`,
 			return fmt.Errorf("failed to get current context: %w", err)
 		}
 
-		status, err := buildSessionStatusFunc(cfg.CurrentContext, ctx, ctx.TokenRef)
+		status, err := buildSessionStatusFunc(cfg, cfg.CurrentContext, ctx, ctx.TokenRef)
 		if err != nil {
 			return fmt.Errorf("failed to build session status: %w", err)
 		}
@@ -371,6 +372,81 @@ func resolveLoginContext(cfg *config.Config, contextName, environment, tokenName
 	return contextName, environment, tokenName, nil
 }
 
+// runTokenLogin configures contextName with a static token instead of running
+// the OAuth flow and marks the context as token-auth. The token is stored
+// under --token-name or <context>-token rather than the context's existing
+// (possibly OAuth) token reference.
+func runTokenLogin(cmd *cobra.Command, contextName, environment, tokenName string, safetyLevel config.SafetyLevel, global bool) error {
+	token, err := readTokenFlag(cmd)
+	if err != nil {
+		return err
+	}
+
+	path := loginConfigPath(global)
+	cfg, err := loadLoginConfig(path)
+	if err != nil {
+		return err
+	}
+
+	finalizeLoginConfig(cfg, contextName, environment, "", safetyLevel, loginPlaceholderContexts(cfg))
+	tokenName, err = storeStaticToken(cfg, contextName, environment, tokenName, token)
+	if err != nil {
+		return fmt.Errorf("failed to store token: %w", err)
+	}
+
+	if err := cfg.SaveTo(path); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	output.PrintSuccess("Token stored as '%s'", tokenName)
+	output.PrintSuccess("Context '%s' configured with static token authentication and activated", contextName)
+	output.PrintInfo("Config written to %s", path)
+	return nil
+}
+
+// loginConfigPath returns the config file auth login updates: the one
+// effectiveConfigPath resolves, so a login from inside a project updates the
+// file that project's commands read. --global skips straight to the global
+// config.
+func loginConfigPath(global bool) string {
+	if global {
+		return config.DefaultConfigPath()
+	}
+	return effectiveConfigPath(cfgFile)
+}
+
+// loadLoginConfig loads the config at path without env-var expansion, so
+// ${VAR} references survive the rewrite. A missing file yields an empty
+// config; any other error is returned rather than overwriting a file that
+// could not be read.
+func loadLoginConfig(path string) (*config.Config, error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		cfg := config.NewConfig()
+		applyKeyringMode(cfg)
+		return cfg, nil
+	}
+	cfg, err := config.LoadFromWithoutExpansion(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config %s: %w", path, err)
+	}
+	applyKeyringMode(cfg)
+	return cfg, nil
+}
+
+// loginPlaceholderContexts identifies placeholder contexts in the raw
+// (unexpanded) config. A context is a placeholder if its environment expands
+// to the empty string (either literally empty or an unset env-var reference
+// like ${DT_ENVIRONMENT_URL}).
+func loginPlaceholderContexts(rawCfg *config.Config) map[string]bool {
+	placeholderNames := make(map[string]bool)
+	for _, nc := range rawCfg.Contexts {
+		if os.ExpandEnv(nc.Context.Environment) == "" {
+			placeholderNames[nc.Name] = true
+		}
+	}
+	return placeholderNames
+}
+
 // finalizeLoginConfig updates cfg after a successful OAuth login: sets the
 // context, activates it, and prunes placeholder contexts whose names are in
 // placeholderNames (computed from the raw config file before env-var expansion,
@@ -379,6 +455,8 @@ func finalizeLoginConfig(cfg *config.Config, contextName, environment, tokenName
 	cfg.SetContextWithOptions(contextName, environment, tokenName, &config.ContextOptions{
 		SafetyLevel: safetyLevel,
 	})
+	// An OAuth login replaces any static token the context used before.
+	_ = cfg.SetContextAuthType(contextName, "")
 	cfg.CurrentContext = contextName
 	cfg.PruneEmptyEnvironments(contextName, placeholderNames)
 }
@@ -407,7 +485,37 @@ Token storage:
   tokens in a local file (~/.local/share/dtctl/oauth-tokens/) with 0600 permissions.
 
 If neither keyring nor file storage is available, use API token authentication
-instead (dtctl config set-credentials).`,
+instead (dtctl config set-credentials).
+
+Static tokens:
+  With --token, no OAuth exchange happens. The platform or API token is stored
+  as-is and the context is marked as token-auth, so OAuth refresh is skipped.
+  Use this for service accounts that cannot log in through a browser.
+
+Device login:
+  With --device, no browser is opened and no localhost callback is started.
+  dtctl prints a verification URL and a one-time code; open the URL on any
+  device (e.g. your laptop), enter the code, and dtctl finishes the login once
+  you approve it. Use this over SSH and on headless servers.
+
+No browser:
+  With --no-browser, dtctl does not try to open a browser. It prints the login
+  URL for you to open yourself and still waits for the localhost callback. Use
+  this when the default browser is the wrong one or cannot be launched.
+
+Callback port:
+  The browser login receives the authorization code on http://localhost:3232.
+  If another process holds that port, pass --callback-port to use a different
+  one (0 picks a free port). Only port 3232 is pre-registered as a redirect URI
+  for dtctl's OAuth client, so the login page may reject other ports; --device
+  avoids the callback entirely.
+
+Config file:
+  Login updates the same config file other commands read: --config, then
+  DTCTL_CONFIG, then the nearest .dtctl.yaml in the current directory or a
+  parent, then the global config. Inside a project with a local .dtctl.yaml,
+  the context, safety level and token reference are written there. Pass
+  --global to update the global config instead.`,
 	Example: `  # Re-authenticate the current context (e.g. after token expiry)
   dtctl auth login
 
@@ -418,7 +526,19 @@ instead (dtctl config set-credentials).`,
   dtctl auth login --context my-env --environment https://abc12345.apps.dynatrace.com --token-name my-oauth-token
 
   # Login with custom timeout
-  dtctl auth login --context my-env --environment https://abc12345.apps.dynatrace.com --timeout 5m`,
+  dtctl auth login --context my-env --environment https://abc12345.apps.dynatrace.com --timeout 5m
+
+  # Print the login URL instead of opening a browser
+  dtctl auth login --no-browser
+
+  # Login from an SSH session or headless server
+  dtctl auth login --context my-env --environment https://abc12345.apps.dynatrace.com --device
+
+  # Use a static platform token instead of OAuth (e.g. for a service account)
+  dtctl auth login --context ci --environment https://abc12345.apps.dynatrace.com --token dt0s16.XXXX
+
+  # Update the global config even inside a project with a .dtctl.yaml
+  dtctl auth login --context my-env --environment https://abc12345.apps.dynatrace.com --global`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Get flags
 		contextName, _ := cmd.Flags().GetString("context")
@@ -426,12 +546,18 @@ instead (dtctl config set-credentials).`,
 		tokenName, _ := cmd.Flags().GetString("token-name")
 		timeoutStr, _ := cmd.Flags().GetString("timeout")
 		safetyLevelStr, _ := cmd.Flags().GetString("safety-level")
+		staticToken := cmd.Flags().Changed("token")
+		deviceFlow, _ := cmd.Flags().GetBool("device")
+		noBrowser, _ := cmd.Flags().GetBool("no-browser")
+		callbackPort, _ := cmd.Flags().GetInt("callback-port")
+		global, _ := cmd.Flags().GetBool("global")
+		explicitTokenName := tokenName
 
 		// Resolve contextName, environment and tokenName from the config when not
 		// supplied as explicit flags.
 		if contextName == "" || environment == "" {
 			contextHint := "Use 'dtctl ctx' to list available context names, then pass --context <name> --environment <url>"
-			cfg, err := LoadConfig()
+			cfg, err := loadFileConfig()
 			if err != nil {
 				if contextName == "" {
 					return &diagnostic.Error{
@@ -482,23 +608,36 @@ instead (dtctl config set-credentials).`,
 			return fmt.Errorf("invalid safety level: %s (valid values: %v)", safetyLevelStr, config.ValidSafetyLevels())
 		}
 
-		// Load config
-		cfg, err := LoadConfig()
+		if callbackPort < 0 || callbackPort > 65535 {
+			return fmt.Errorf("invalid --callback-port %d: must be between 0 and 65535", callbackPort)
+		}
+		if staticToken && deviceFlow {
+			return fmt.Errorf("--token and --device cannot be used together")
+		}
+		if noBrowser && (staticToken || deviceFlow) {
+			return fmt.Errorf("--no-browser only applies to the browser login; it cannot be used with --token or --device")
+		}
+		if staticToken {
+			return runTokenLogin(cmd, contextName, environment, explicitTokenName, safetyLevel, global)
+		}
+
+		// Load the config file the login will update
+		configPath := loginConfigPath(global)
+		cfg, err := loadLoginConfig(configPath)
 		if err != nil {
-			// If config doesn't exist, create a new one
-			cfg = config.NewConfig()
+			return err
 		}
 
 		// Ensure a token storage backend is available before starting OAuth flow.
 		// Keyring is preferred; file-based storage is the fallback for headless/WSL/CI environments.
-		if keyringErr := authCheckKeyringFunc(); keyringErr != nil {
+		if keyringErr := authCheckKeyringFunc(cfg.KeyringMode()); keyringErr != nil {
 			recovered := false
 			// On Linux/WSL the persistent keyring collection may not exist yet.
 			// Attempt to create it — this may trigger an OS password prompt.
 			if strings.Contains(keyringErr.Error(), config.ErrMsgCollectionUnlock) {
 				output.PrintInfo("No keyring collection found — creating one (you may be prompted for a password)...")
 				if initErr := authEnsureKeyringFunc(cmd.Context()); initErr == nil {
-					if authCheckKeyringFunc() == nil {
+					if authCheckKeyringFunc(cfg.KeyringMode()) == nil {
 						output.PrintSuccess("Keyring collection created successfully")
 						recovered = true
 					}
@@ -506,8 +645,8 @@ instead (dtctl config set-credentials).`,
 			}
 			if !recovered {
 				// Keyring is unavailable — check if file-based storage can be used instead
-				if config.IsFileTokenStorage() {
-					output.PrintWarning("Keyring unavailable; using file-based token storage (%s)", config.OAuthStorageBackend())
+				if cfg.KeyringMode().FileTokenStorage() {
+					output.PrintWarning("Keyring unavailable; using file-based token storage (%s)", cfg.KeyringMode().OAuthStorageBackend())
 					output.PrintWarning("Tokens will be stored in plaintext. Ensure only you can read the file.")
 				} else {
 					return &diagnostic.Error{
@@ -541,6 +680,8 @@ instead (dtctl config set-credentials).`,
 
 		// Detect environment and create appropriate OAuth config with safety level
 		oauthConfig := auth.OAuthConfigFromEnvironmentURLWithSafety(environment, safetyLevel)
+		oauthConfig.Port = callbackPort
+		oauthConfig.NoBrowser = noBrowser
 
 		// Log which environment we detected
 		output.PrintInfo("Detected environment: %s", oauthConfig.Environment)
@@ -558,7 +699,24 @@ instead (dtctl config set-credentials).`,
 		defer cancel()
 
 		output.PrintInfo("Starting OAuth authentication flow...")
-		tokens, err := flow.Start(ctx)
+		var tokens *auth.TokenSet
+		if deviceFlow {
+			tokens, err = flow.StartDevice(ctx)
+		} else {
+			tokens, err = flow.Start(ctx)
+		}
+		if errors.Is(err, auth.ErrCallbackPortInUse) {
+			return &diagnostic.Error{
+				Operation: "auth login",
+				Message:   fmt.Sprintf("cannot start the login callback: port %d is already in use by another process", callbackPort),
+				Suggestions: []string{
+					fmt.Sprintf("Stop the process listening on port %d (e.g. 'lsof -i :%d') and retry", callbackPort, callbackPort),
+					"Or pass --callback-port <port> (0 picks a free port); only port 3232 is pre-registered, so other ports may be rejected as a redirect_uri mismatch",
+					"Or use --device to log in without a localhost callback",
+				},
+				Err: err,
+			}
+		}
 		if err != nil {
 			return fmt.Errorf("authentication failed: %w", err)
 		}
@@ -574,7 +732,7 @@ instead (dtctl config set-credentials).`,
 		}
 
 		// Store tokens
-		tokenManager, err := auth.NewTokenManager(oauthConfig)
+		tokenManager, err := newTokenManager(cfg, oauthConfig)
 		if err != nil {
 			return fmt.Errorf("failed to create token manager: %w", err)
 		}
@@ -583,28 +741,16 @@ instead (dtctl config set-credentials).`,
 			return fmt.Errorf("failed to store tokens: %w", err)
 		}
 
-		output.PrintSuccess("Tokens stored in %s as '%s'", config.OAuthStorageBackend(), tokenName)
+		output.PrintSuccess("Tokens stored in %s as '%s'", cfg.KeyringMode().OAuthStorageBackend(), tokenName)
 
-		// Identify placeholder contexts from the raw (unexpanded) config.
-		// A context is a placeholder if its environment expands to the empty string
-		// (either literally empty or an unset env-var reference like ${DT_ENVIRONMENT_URL}).
-		placeholderNames := make(map[string]bool)
-		if rawCfg, err := loadRawConfig(); err == nil {
-			for _, nc := range rawCfg.Contexts {
-				if os.ExpandEnv(nc.Context.Environment) == "" {
-					placeholderNames[nc.Name] = true
-				}
-			}
-		}
+		finalizeLoginConfig(cfg, contextName, environment, tokenName, safetyLevel, loginPlaceholderContexts(cfg))
 
-		finalizeLoginConfig(cfg, contextName, environment, tokenName, safetyLevel, placeholderNames)
-
-		// Save config (respects local .dtctl.yaml if present)
-		if err := saveConfig(cfg); err != nil {
+		if err := cfg.SaveTo(configPath); err != nil {
 			return fmt.Errorf("failed to save config: %w", err)
 		}
 
 		output.PrintSuccess("Context '%s' configured and activated", contextName)
+		output.PrintInfo("Config written to %s", configPath)
 		output.PrintInfo("\nYou can now use dtctl commands with this context.")
 
 		return nil
@@ -632,7 +778,7 @@ If no context name is provided, the current context will be used.`,
   dtctl auth logout my-env --remove-context`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Load config
-		cfg, err := LoadConfig()
+		cfg, err := loadFileConfig()
 		if err != nil {
 			return fmt.Errorf("failed to load config: %w", err)
 		}
@@ -665,7 +811,7 @@ If no context name is provided, the current context will be used.`,
 		oauthConfig := auth.OAuthConfigFromEnvironmentURLWithSafety(ctx.Context.Environment, ctx.Context.SafetyLevel)
 
 		// Delete OAuth token
-		tokenManager, err := auth.NewTokenManager(oauthConfig)
+		tokenManager, err := newTokenManager(cfg, oauthConfig)
 		if err != nil {
 			return fmt.Errorf("failed to create token manager: %w", err)
 		}
@@ -714,15 +860,24 @@ var authRefreshCmd = &cobra.Command{
 
 This command manually triggers a token refresh. Normally, dtctl will
 automatically refresh tokens when needed, but this command can be used
-to force a refresh.`,
+to force a refresh, e.g. right before a long batch job.
+
+The new token set is stored like one from 'dtctl auth login', and the new
+expiry is reported. Contexts using a static platform or API token have no
+refresh token and fail with an error.`,
 	Example: `  # Refresh tokens for current context
   dtctl auth refresh
 
   # Refresh tokens for specific context
-  dtctl auth refresh my-env`,
+  dtctl auth refresh my-env
+  dtctl auth refresh --context my-env
+
+  # Print the new expiry for a script
+  dtctl auth refresh -o json | jq -r .accessTokenExpiresAt`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Load config
-		cfg, err := LoadConfig()
+		cfg, err := loadFileConfig()
 		if err != nil {
 			return fmt.Errorf("failed to load config: %w", err)
 		}
@@ -750,29 +905,65 @@ to force a refresh.`,
 		if tokenName == "" {
 			return fmt.Errorf("context has no token reference")
 		}
+		if ctx.Context.AuthType == config.AuthTypeToken || cfg.IsStaticToken(tokenName) {
+			return staticTokenRefreshError(contextName)
+		}
 
 		// Detect environment from context URL
 		oauthConfig := auth.OAuthConfigFromEnvironmentURLWithSafety(ctx.Context.Environment, ctx.Context.SafetyLevel)
 
 		// Refresh token
-		tokenManager, err := auth.NewTokenManager(oauthConfig)
+		tokenManager, err := newTokenManager(cfg, oauthConfig)
 		if err != nil {
 			return fmt.Errorf("failed to create token manager: %w", err)
 		}
 
+		stored, err := tokenManager.GetTokenInfo(tokenName)
+		if err != nil && !isTokenNotFound(err) {
+			return fmt.Errorf("failed to load stored tokens for context %q: %w", contextName, err)
+		}
+		if stored == nil {
+			// No OAuth token set: a static token stored before auth types
+			// were recorded.
+			return staticTokenRefreshError(contextName)
+		}
+		if stored.RefreshToken == "" {
+			return fmt.Errorf("no refresh token is stored for context %q; run 'dtctl auth login --context %s' to sign in again", contextName, contextName)
+		}
+
 		output.PrintInfo("Refreshing OAuth tokens...")
 		tokens, err := tokenManager.RefreshToken(tokenName)
 		if err != nil {
 			return fmt.Errorf("failed to refresh tokens: %w", err)
 		}
 
+		if outputFormat != "table" && outputFormat != "" {
+			status, err := buildSessionStatusFunc(cfg, contextName, &ctx.Context, tokenName)
+			if err != nil {
+				return fmt.Errorf("failed to build session status: %w", err)
+			}
+			return NewPrinter().Print(status)
+		}
+
 		output.PrintSuccess("Tokens refreshed")
-		output.PrintInfo("New token expires at: %s", tokens.ExpiresAt.Format(time.RFC3339))
+		output.PrintInfo("New token expires at: %s (in %s)", tokens.ExpiresAt.Format(time.RFC3339), time.Until(tokens.ExpiresAt).Round(time.Second))
 
 		return nil
 	},
 }
 
+// isTokenNotFound reports whether a token store error means the token does
+// not exist, as opposed to a locked keyring or an unreadable token.
+func isTokenNotFound(err error) bool {
+	return strings.Contains(err.Error(), "not found")
+}
+
+// staticTokenRefreshError explains that a context without OAuth tokens cannot
+// be refreshed.
+func staticTokenRefreshError(contextName string) error {
+	return fmt.Errorf("context %q uses a static token, which has no refresh token; run 'dtctl auth login --context %s' to sign in with OAuth instead", contextName, contextName)
+}
+
 func init() {
 	rootCmd.AddCommand(authCmd)
 
@@ -791,7 +982,12 @@ func init() {
 	authLoginCmd.Flags().String("environment", "", "Dynatrace environment URL (defaults to current context's environment)")
 	authLoginCmd.Flags().String("token-name", "", "name for storing the OAuth token (defaults to existing token name or <context>-oauth)")
 	authLoginCmd.Flags().String("timeout", "5m", "timeout for the authentication flow")
+	authLoginCmd.Flags().String("token", "", "store this static platform or API token instead of running OAuth (\"-\" reads it from stdin)")
+	authLoginCmd.Flags().Int("callback-port", auth.DefaultCallbackPort, "localhost port for the OAuth login callback (0 picks a free port; only 3232 is pre-registered)")
+	authLoginCmd.Flags().Bool("no-browser", false, "print the login URL instead of opening a browser (the localhost callback still runs)")
+	authLoginCmd.Flags().Bool("device", false, "use the device-code flow: print a URL and code to authorize from another device (for SSH/headless hosts)")
 	authLoginCmd.Flags().String("safety-level", string(config.DefaultSafetyLevel), "safety level for the context (readonly, readwrite-mine, readwrite-all, dangerously-unrestricted)")
+	authLoginCmd.Flags().Bool("global", false, "write the context to the global config even when a local .dtctl.yaml is found")
 
 	// Flags for logout
 	authLogoutCmd.Flags().Bool("remove-context", false, "also remove the context configuration")
@@ -16,7 +16,7 @@ import (
 func withStubbedSessionStatus(t *testing.T, status *SessionStatus) {
 	t.Helper()
 	original := buildSessionStatusFunc
-	buildSessionStatusFunc = func(contextName string, ctx *config.Context, tokenName string) (*SessionStatus, error) {
+	buildSessionStatusFunc = func(_ *config.Config, contextName string, ctx *config.Context, tokenName string) (*SessionStatus, error) {
 		return status, nil
 	}
 	t.Cleanup(func() { buildSessionStatusFunc = original })
@@ -175,7 +175,7 @@ func TestDoctor_OAuthSessionRow_SkippedForPlatformToken(t *testing.T) {
 func TestDoctor_OAuthSessionRow_FailWhenSessionError(t *testing.T) {
 	// Stub buildSessionStatusFunc to return an error (e.g. keyring unavailable)
 	original := buildSessionStatusFunc
-	buildSessionStatusFunc = func(contextName string, ctx *config.Context, tokenName string) (*SessionStatus, error) {
+	buildSessionStatusFunc = func(_ *config.Config, contextName string, ctx *config.Context, tokenName string) (*SessionStatus, error) {
 		return nil, errors.New("keyring unavailable")
 	}
 	t.Cleanup(func() { buildSessionStatusFunc = original })
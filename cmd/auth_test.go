@@ -3,10 +3,12 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/adrg/xdg"
 	"github.com/spf13/viper"
 
 	"github.com/dynatrace-oss/dtctl/pkg/config"
@@ -35,7 +37,7 @@ func setupAuthTestConfig(t *testing.T, contextName, environment, tokenRef string
 // Execute() call keeps the value set by the previous call.
 func resetAuthLoginFlags(t *testing.T) {
 	t.Helper()
-	for _, name := range []string{"context", "environment", "token-name", "timeout", "safety-level"} {
+	for _, name := range []string{"context", "environment", "token-name", "token", "timeout", "safety-level", "device", "no-browser", "callback-port", "global"} {
 		if f := authLoginCmd.Flags().Lookup(name); f != nil {
 			if err := f.Value.Set(f.DefValue); err != nil {
 				t.Logf("warning: could not reset flag %q: %v", name, err)
@@ -110,6 +112,170 @@ func TestAuthLogin_FlagValidation(t *testing.T) {
 	}
 }
 
+// TestAuthLogin_StaticToken verifies that --token stores the token without an
+// OAuth flow and marks the context as token-auth.
+func TestAuthLogin_StaticToken(t *testing.T) {
+	viper.Reset()
+	configPath := setupAuthTestConfig(t, "ci", "https://abc12345.apps.dynatrace.com", "ci-oauth")
+	cfgFile = configPath
+	defer func() { cfgFile = "" }()
+	resetAuthLoginFlags(t)
+	defer resetAuthLoginFlags(t)
+
+	rootCmd.SetArgs([]string{"auth", "login", "--context", "ci", "--token", "dt0s16.TEST"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("auth login --token: %v", err)
+	}
+
+	cfg, err := config.LoadFrom(configPath)
+	if err != nil {
+		t.Fatalf("LoadFrom: %v", err)
+	}
+	nc, err := cfg.GetContext("ci")
+	if err != nil {
+		t.Fatalf("GetContext: %v", err)
+	}
+	if nc.Context.AuthType != config.AuthTypeToken {
+		t.Errorf("AuthType = %q, want %q", nc.Context.AuthType, config.AuthTypeToken)
+	}
+	if nc.Context.TokenRef != "ci-token" {
+		t.Errorf("TokenRef = %q, want ci-token", nc.Context.TokenRef)
+	}
+	if !cfg.IsStaticToken("ci-token") {
+		t.Error("expected ci-token to be a static token")
+	}
+	if token, err := cfg.GetToken("ci-token"); err != nil || token != "dt0s16.TEST" {
+		t.Errorf("GetToken = %q, %v", token, err)
+	}
+}
+
+// TestAuthLogin_LocalConfig verifies that login updates the discovered local
+// .dtctl.yaml in place, and that --global targets the global config instead.
+func TestAuthLogin_LocalConfig(t *testing.T) {
+	for _, global := range []bool{false, true} {
+		t.Run(fmt.Sprintf("global=%v", global), func(t *testing.T) {
+			viper.Reset()
+			origCfgFile := cfgFile
+			cfgFile = ""
+			defer func() { cfgFile = origCfgFile }()
+			t.Setenv("DTCTL_DISABLE_KEYRING", "1")
+			t.Setenv(config.EnvConfig, "")
+			t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+			xdg.Reload()
+			defer xdg.Reload()
+			globalPath := config.DefaultConfigPath()
+
+			projectDir := t.TempDir()
+			localPath := filepath.Join(projectDir, config.LocalConfigName)
+			local := config.NewConfig()
+			local.SetContext("proj", "https://old.apps.dynatrace.com", "proj-oauth")
+			local.CurrentContext = "proj"
+			if err := local.SaveTo(localPath); err != nil {
+				t.Fatalf("SaveTo: %v", err)
+			}
+			t.Chdir(projectDir)
+
+			resetAuthLoginFlags(t)
+			defer resetAuthLoginFlags(t)
+			args := []string{"auth", "login", "--context", "proj", "--environment", "https://abc12345.apps.dynatrace.com",
+				"--safety-level", "readonly", "--token", "dt0s16.TEST"}
+			if global {
+				args = append(args, "--global")
+			}
+			rootCmd.SetArgs(args)
+			if err := rootCmd.Execute(); err != nil {
+				t.Fatalf("auth login: %v", err)
+			}
+
+			written, untouched := localPath, globalPath
+			if global {
+				written, untouched = globalPath, localPath
+			}
+			cfg, err := config.LoadFrom(written)
+			if err != nil {
+				t.Fatalf("LoadFrom(%s): %v", written, err)
+			}
+			nc, err := cfg.GetContext("proj")
+			if err != nil {
+				t.Fatalf("GetContext: %v", err)
+			}
+			if nc.Context.Environment != "https://abc12345.apps.dynatrace.com" {
+				t.Errorf("Environment = %q", nc.Context.Environment)
+			}
+			if nc.Context.SafetyLevel != config.SafetyLevelReadOnly {
+				t.Errorf("SafetyLevel = %q, want readonly", nc.Context.SafetyLevel)
+			}
+			if nc.Context.TokenRef != "proj-token" {
+				t.Errorf("TokenRef = %q, want proj-token", nc.Context.TokenRef)
+			}
+
+			if global {
+				// The local file keeps its original context.
+				cfg, err := config.LoadFrom(untouched)
+				if err != nil {
+					t.Fatalf("LoadFrom(%s): %v", untouched, err)
+				}
+				if nc, err := cfg.GetContext("proj"); err != nil || nc.Context.Environment != "https://old.apps.dynatrace.com" {
+					t.Errorf("local config was modified: %+v, %v", nc, err)
+				}
+			} else if _, err := os.Stat(untouched); !os.IsNotExist(err) {
+				t.Errorf("global config %s was written (stat err: %v)", untouched, err)
+			}
+		})
+	}
+}
+
+// TestAuthLogin_DeviceWithTokenRejected verifies that --device and --token are
+// mutually exclusive.
+func TestAuthLogin_DeviceWithTokenRejected(t *testing.T) {
+	viper.Reset()
+	configPath := setupAuthTestConfig(t, "ci", "https://abc12345.apps.dynatrace.com", "ci-oauth")
+	cfgFile = configPath
+	defer func() { cfgFile = "" }()
+	resetAuthLoginFlags(t)
+	defer resetAuthLoginFlags(t)
+
+	rootCmd.SetArgs([]string{"auth", "login", "--context", "ci", "--token", "dt0s16.TEST", "--device"})
+	err := rootCmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "--token and --device") {
+		t.Fatalf("expected --token/--device conflict error, got %v", err)
+	}
+}
+
+// TestAuthLogin_InvalidCallbackPort verifies that out-of-range callback ports
+// are rejected before any OAuth flow starts.
+func TestAuthLogin_InvalidCallbackPort(t *testing.T) {
+	viper.Reset()
+	configPath := setupAuthTestConfig(t, "ci", "https://abc12345.apps.dynatrace.com", "ci-oauth")
+	cfgFile = configPath
+	defer func() { cfgFile = "" }()
+	resetAuthLoginFlags(t)
+	defer resetAuthLoginFlags(t)
+
+	rootCmd.SetArgs([]string{"auth", "login", "--context", "ci", "--callback-port", "70000"})
+	err := rootCmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "invalid --callback-port") {
+		t.Fatalf("expected invalid callback port error, got %v", err)
+	}
+}
+
+// TestAuthLogin_NoBrowserWithDevice verifies that --no-browser is rejected
+// with --device, which never opens a browser.
+func TestAuthLogin_NoBrowserWithDevice(t *testing.T) {
+	viper.Reset()
+	configPath := setupAuthTestConfig(t, "ci", "https://abc12345.apps.dynatrace.com", "ci-oauth")
+	cfgFile = configPath
+	defer func() { cfgFile = "" }()
+	resetAuthLoginFlags(t)
+	defer resetAuthLoginFlags(t)
+
+	rootCmd.SetArgs([]string{"auth", "login", "--context", "ci", "--device", "--no-browser"})
+	err := rootCmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "--no-browser") {
+		t.Fatalf("expected --no-browser/--device conflict error, got %v", err)
+	}
+}
+
 // TestAuthLogin_CurrentContextFallback verifies that the login command derives
 // context name, environment URL and token name from the active context when no
 // flags are provided.  The test stops before the actual OAuth flow (keyring
@@ -208,7 +374,7 @@ func TestAuthLogin_KeyringRecovery(t *testing.T) {
 		authEnsureKeyringFunc = origEnsure
 	}()
 
-	authCheckKeyringFunc = func() error {
+	authCheckKeyringFunc = func(config.KeyringMode) error {
 		calls++
 		if calls == 1 {
 			return fmt.Errorf("keyring probe failed: %s", config.ErrMsgCollectionUnlock)
@@ -580,7 +746,7 @@ func TestAuthLogin_KeyringRecoveryFailure(t *testing.T) {
 	}()
 
 	// CheckKeyring always fails with the unlock error.
-	authCheckKeyringFunc = func() error {
+	authCheckKeyringFunc = func(config.KeyringMode) error {
 		return fmt.Errorf("keyring probe failed: %s", config.ErrMsgCollectionUnlock)
 	}
 	// EnsureKeyringCollection fails too.
@@ -625,7 +791,7 @@ func TestAuthLogin_FileStorage_PassesKeyringGate(t *testing.T) {
 	// Override keyring check to always fail (deterministic across OSes)
 	origCheck := authCheckKeyringFunc
 	defer func() { authCheckKeyringFunc = origCheck }()
-	authCheckKeyringFunc = func() error {
+	authCheckKeyringFunc = func(config.KeyringMode) error {
 		return fmt.Errorf("keyring disabled via %s environment variable", config.EnvDisableKeyring)
 	}
 
@@ -668,7 +834,7 @@ func TestAuthLogin_KeyringRecovery_WithFileStorage(t *testing.T) {
 	// Override keyring check to always fail (simulates headless Linux)
 	origCheck := authCheckKeyringFunc
 	defer func() { authCheckKeyringFunc = origCheck }()
-	authCheckKeyringFunc = func() error {
+	authCheckKeyringFunc = func(config.KeyringMode) error {
 		return fmt.Errorf("keyring disabled via %s environment variable", config.EnvDisableKeyring)
 	}
 
@@ -724,3 +890,69 @@ func TestAuthLogout_RemoveContext_DoesNotPersistEnvOverride(t *testing.T) {
 		t.Error("context 'doomed' should have been removed")
 	}
 }
+
+// TestAuthRefresh_StaticToken verifies that refreshing a context signed in
+// with a static token fails with a hint to use OAuth instead of a keyring error.
+func TestAuthRefresh_StaticToken(t *testing.T) {
+	viper.Reset()
+	configPath := setupAuthTestConfig(t, "ci", "https://abc12345.apps.dynatrace.com", "ci-oauth")
+	cfgFile = configPath
+	defer func() { cfgFile = "" }()
+	resetAuthLoginFlags(t)
+	defer resetAuthLoginFlags(t)
+
+	rootCmd.SetArgs([]string{"auth", "login", "--context", "ci", "--token", "dt0s16.TEST"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("auth login --token: %v", err)
+	}
+
+	err := authRefreshCmd.RunE(authRefreshCmd, []string{"ci"})
+	if err == nil {
+		t.Fatal("expected an error for a static token context")
+	}
+	if !strings.Contains(err.Error(), "uses a static token") || !strings.Contains(err.Error(), "dtctl auth login --context ci") {
+		t.Errorf("error = %q, want the static token hint", err)
+	}
+}
+
+// TestAuthRefresh_StoredTokenErrors verifies that only a missing token set is
+// reported as a static token context; other storage errors are returned as is.
+func TestAuthRefresh_StoredTokenErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		stored  string
+		want    string
+		notWant string
+	}{
+		{name: "no stored tokens", want: `context "prod" uses a static token`},
+		{name: "unreadable token", stored: "{not json", want: "failed to parse stored token", notWant: "static token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			viper.Reset()
+			cfgFile = setupAuthTestConfig(t, "prod", "https://abc12345.apps.dynatrace.com", "prod-oauth")
+			defer func() { cfgFile = "" }()
+			t.Setenv(config.EnvTokenStorage, "file")
+			dataHome := t.TempDir()
+			t.Setenv("XDG_DATA_HOME", dataHome)
+			xdg.Reload()
+			defer xdg.Reload()
+
+			if tt.stored != "" {
+				store := config.NewOAuthFileStoreWithDir(filepath.Join(dataHome, "dtctl", "oauth-tokens"))
+				if err := store.SetToken("oauth:prod:prod-oauth", tt.stored); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := authRefreshCmd.RunE(authRefreshCmd, nil)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("error = %v, want %q", err, tt.want)
+			}
+			if tt.notWant != "" && strings.Contains(err.Error(), tt.notWant) {
+				t.Errorf("error = %v, should not mention %q", err, tt.notWant)
+			}
+		})
+	}
+}
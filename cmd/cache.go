@@ -0,0 +1,233 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dynatrace-oss/dtctl/pkg/client"
+	"github.com/dynatrace-oss/dtctl/pkg/completion"
+	"github.com/dynatrace-oss/dtctl/pkg/config"
+	"github.com/dynatrace-oss/dtctl/pkg/output"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/bucket"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/document"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/workflow"
+)
+
+// completionSources lists the resources whose names are offered by shell
+// completion. Each fetcher returns "id\tdescription" candidates.
+var completionSources = map[string]func(c *client.Client) ([]string, error){
+	"workflows": func(c *client.Client) ([]string, error) {
+		list, err := workflow.NewHandler(c).List(workflow.WorkflowFilters{}, allPagesChunkSize(), 0)
+		if err != nil {
+			return nil, err
+		}
+		candidates := make([]string, 0, len(list.Results))
+		for _, wf := range list.Results {
+			candidates = append(candidates, wf.ID+"\t"+wf.Title)
+		}
+		return candidates, nil
+	},
+	"dashboards": func(c *client.Client) ([]string, error) {
+		return documentCandidates(c, "dashboard")
+	},
+	"notebooks": func(c *client.Client) ([]string, error) {
+		return documentCandidates(c, "notebook")
+	},
+	"buckets": func(c *client.Client) ([]string, error) {
+		list, err := bucket.NewHandler(c).List()
+		if err != nil {
+			return nil, err
+		}
+		candidates := make([]string, 0, len(list.Buckets))
+		for _, b := range list.Buckets {
+			candidates = append(candidates, b.BucketName+"\t"+b.DisplayName)
+		}
+		return candidates, nil
+	},
+}
+
+func documentCandidates(c *client.Client, docType string) ([]string, error) {
+	list, err := document.NewHandler(c).List(documentListFilters(docType))
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]string, 0, len(list.Documents))
+	for _, d := range list.Documents {
+		candidates = append(candidates, d.ID+"\t"+d.Name)
+	}
+	return candidates, nil
+}
+
+// completionCacheFor returns the completion cache with the TTL from
+// --cache-ttl, then preferences.cache-ttl, then the default.
+func completionCacheFor(cfg *config.Config) *completion.Cache {
+	ttl := completion.DefaultTTL
+	if rootCmd.PersistentFlags().Changed("cache-ttl") {
+		ttl = cacheTTL
+	} else if cfg != nil && cfg.Preferences.CacheTTL != "" {
+		if d, err := time.ParseDuration(cfg.Preferences.CacheTTL); err == nil {
+			ttl = d
+		}
+	}
+	return completion.New(filepath.Join(config.CacheDir(), "completion"), ttl)
+}
+
+// completeResourceNames returns a ValidArgsFunction offering cached names of
+// resource for the first positional argument.
+func completeResourceNames(resource string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		cfg, err := LoadConfig()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		candidates, err := completionCacheFor(cfg).Candidates(cfg.CurrentContext, resource, func() ([]string, error) {
+			c, err := NewClientFromConfig(cfg)
+			if err != nil {
+				return nil, err
+			}
+			return completionSources[resource](c)
+		})
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return candidates, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// cacheCmd manages the shell-completion cache
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage the shell-completion cache",
+	Long: `Manage the cache of resource names used by shell completion.
+
+Completing a workflow, dashboard, notebook, or bucket name lists it from the
+API once and reuses the result for the cache TTL (default 5m). Entries are kept
+per context, so switching contexts never offers another environment's names.
+
+Set the TTL with --cache-ttl or 'dtctl config set preferences.cache-ttl 10m'.
+Set DTCTL_NO_CACHE=1 to disable the cache entirely.`,
+	RunE: requireSubcommand,
+}
+
+var cacheClearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Remove cached completion candidates",
+	Long: `Remove cached completion candidates for the current context.
+
+Examples:
+  # Clear the current context's cache
+  dtctl cache clear
+
+  # Clear the cache of every context
+  dtctl cache clear --all-contexts
+`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		all, _ := cmd.Flags().GetBool("all-contexts")
+		cfg, err := LoadConfig()
+		if err != nil && !all {
+			return err
+		}
+
+		ctxName := ""
+		if !all {
+			ctxName = cfg.CurrentContext
+		}
+		if err := completionCacheFor(cfg).Clear(ctxName); err != nil {
+			return err
+		}
+		if all {
+			output.PrintSuccess("Completion cache cleared for all contexts")
+		} else {
+			output.PrintSuccess("Completion cache cleared for context %q", ctxName)
+		}
+		return nil
+	},
+}
+
+var cacheRefreshCmd = &cobra.Command{
+	Use:   "refresh [resource...]",
+	Short: "Re-fetch completion candidates now",
+	Long: `Re-fetch completion candidates for the current context, ignoring the TTL.
+
+Without arguments every cached resource type is refreshed.
+
+Examples:
+  # Refresh all resource names
+  dtctl cache refresh
+
+  # Refresh only workflow names
+  dtctl cache refresh workflows
+`,
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return completionResources(), cobra.ShellCompDirectiveNoFileComp
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		resources := args
+		if len(resources) == 0 {
+			resources = completionResources()
+		}
+		for _, r := range resources {
+			if _, ok := completionSources[r]; !ok {
+				return fmt.Errorf("unknown resource %q (supported: %s)", r, strings.Join(completionResources(), ", "))
+			}
+		}
+
+		_, c, err := SetupClient()
+		if err != nil {
+			return err
+		}
+		cfg, err := LoadConfig()
+		if err != nil {
+			return err
+		}
+
+		cache := completionCacheFor(cfg)
+		if !cache.Enabled() {
+			return fmt.Errorf("completion cache is disabled (%s is set or the TTL is 0)", completion.EnvNoCache)
+		}
+		for _, r := range resources {
+			candidates, err := completionSources[r](c)
+			if err != nil {
+				return fmt.Errorf("failed to list %s: %w", r, err)
+			}
+			if err := cache.Put(cfg.CurrentContext, r, candidates); err != nil {
+				return err
+			}
+			output.PrintInfo("%s: %d cached", r, len(candidates))
+		}
+		output.PrintSuccess("Completion cache refreshed for context %q", cfg.CurrentContext)
+		return nil
+	},
+}
+
+// completionResources returns the cacheable resource types, sorted.
+func completionResources() []string {
+	names := make([]string, 0, len(completionSources))
+	for name := range completionSources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func init() {
+	rootCmd.AddCommand(cacheCmd)
+	cacheCmd.AddCommand(cacheClearCmd)
+	cacheCmd.AddCommand(cacheRefreshCmd)
+
+	cacheClearCmd.Flags().Bool("all-contexts", false, "clear the cache of every context")
+
+	getWorkflowsCmd.ValidArgsFunction = completeResourceNames("workflows")
+	getDashboardsCmd.ValidArgsFunction = completeResourceNames("dashboards")
+	getNotebooksCmd.ValidArgsFunction = completeResourceNames("notebooks")
+	getBucketsCmd.ValidArgsFunction = completeResourceNames("buckets")
+}
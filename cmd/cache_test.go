@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+	"github.com/dynatrace-oss/dtctl/pkg/client"
+)
+
+func TestCompletionSources_WorkflowsAllPages(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/automation/v1/workflows": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			id := "wf-page1"
+			if r.URL.Query().Get("offset") != "" {
+				id = "wf-page2"
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"count": 2, "results": []any{
+				map[string]any{"id": id, "title": id},
+			}})
+		},
+	})
+	defer ms.Close()
+
+	c, err := client.NewForTesting(ms.URL, "test-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The cache is shared by every later completion, so it must not depend
+	// on the pagination flags of the command that happened to populate it.
+	origNoPaginate, origChunk := noPaginate, chunkSize
+	defer func() { noPaginate, chunkSize = origNoPaginate, origChunk }()
+	noPaginate, chunkSize = true, 1
+
+	got, err := completionSources["workflows"](c)
+	if err != nil {
+		t.Fatalf("workflows source error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Errorf("candidates = %v, want the workflows on both pages", got)
+	}
+}
@@ -2,17 +2,23 @@ package cmd
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"regexp"
 	"sort"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
 
+	"github.com/dynatrace-oss/dtctl/pkg/auth"
 	"github.com/dynatrace-oss/dtctl/pkg/client"
 	"github.com/dynatrace-oss/dtctl/pkg/commands"
+	"github.com/dynatrace-oss/dtctl/pkg/config"
+	"github.com/dynatrace-oss/dtctl/pkg/diagnostic"
 	"github.com/dynatrace-oss/dtctl/pkg/output"
+	"github.com/dynatrace-oss/dtctl/sdk/httpclient"
 )
 
 // checkScopes is the --check-scopes persistent flag: resolve the command's
@@ -47,6 +53,84 @@ func (e *ScopeError) Error() string {
 		len(e.Missing), target, strings.Join(e.Missing, ", "))
 }
 
+// InsufficientScopeError is a 403 response annotated with the scopes the
+// failed command needs (from the command catalog) and the lowest safety level
+// whose OAuth login requests them. execute wraps forbidden errors in it so an
+// opaque "403" comes with a concrete fix; the message itself is unchanged.
+type InsufficientScopeError struct {
+	Verb     string
+	Resource string
+	Required []string
+	// MinSafetyLevel is empty when no safety level grants Required.
+	MinSafetyLevel config.SafetyLevel
+	Err            error
+}
+
+func (e *InsufficientScopeError) Error() string { return e.Err.Error() }
+
+func (e *InsufficientScopeError) Unwrap() error { return e.Err }
+
+// Suggestions returns the remediation hints for the 403.
+func (e *InsufficientScopeError) Suggestions() []string {
+	if len(e.Required) == 0 {
+		return []string{"the token lacks a scope this operation needs; see 'dtctl commands howto' for token scope guidance"}
+	}
+	target := e.Verb
+	if e.Resource != "" {
+		target += " " + e.Resource
+	}
+	suggestions := []string{fmt.Sprintf("'%s' needs scope(s): %s", target, strings.Join(e.Required, ", "))}
+	if e.MinSafetyLevel != "" {
+		suggestions = append(suggestions, fmt.Sprintf("OAuth logins request these from safety level %s: dtctl auth login --safety-level %s", e.MinSafetyLevel, e.MinSafetyLevel))
+	}
+	suggestions = append(suggestions, "API/platform tokens: re-create the token with the scope(s) above")
+	return suggestions
+}
+
+// forbiddenPattern matches the 403 renderings of handlers that format status
+// codes into plain errors ("status 403", "API error (403)", "HTTP 403").
+var forbiddenPattern = regexp.MustCompile(`status 403\b|\(403\)|HTTP 403\b`)
+
+// isForbiddenError reports whether err is a 403 response from the platform.
+func isForbiddenError(err error) bool {
+	if errors.Is(err, httpclient.ErrForbidden) {
+		return true
+	}
+	var apiErr *client.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == 403
+	}
+	var diagErr *diagnostic.Error
+	if errors.As(err, &diagErr) && diagErr.StatusCode != 0 {
+		return diagErr.StatusCode == 403
+	}
+	return forbiddenPattern.MatchString(err.Error())
+}
+
+// wrapForbiddenError turns a 403 from command c into an InsufficientScopeError.
+// Other errors, and 403s already explained by the scope preflight, are
+// returned unchanged.
+func wrapForbiddenError(c *cobra.Command, err error) error {
+	var scopeErr *ScopeError
+	if c == nil || errors.As(err, &scopeErr) || !isForbiddenError(err) {
+		return err
+	}
+	var already *InsufficientScopeError
+	if errors.As(err, &already) {
+		return err
+	}
+	verb, resource := verbResource(c)
+	required, _ := requiredScopesFor(verb, resource)
+	level, _ := auth.MinimumSafetyLevel(required)
+	return &InsufficientScopeError{
+		Verb:           verb,
+		Resource:       resource,
+		Required:       required,
+		MinSafetyLevel: level,
+		Err:            err,
+	}
+}
+
 // silentExitError carries a process exit code without producing any output.
 // Used by the explicit --check-scopes path, which prints its own verdict and
 // then needs to set a non-zero exit code without root.go printing a second error.
@@ -208,7 +292,7 @@ func grantedScopes() (scopes []string, known bool) {
 	if err != nil {
 		return nil, false
 	}
-	status, err := buildSessionStatusFunc(cfg.CurrentContext, ctx, ctx.TokenRef)
+	status, err := buildSessionStatusFunc(cfg, cfg.CurrentContext, ctx, ctx.TokenRef)
 	if err != nil || status == nil || !status.IsOAuth || len(status.GrantedScopes) == 0 {
 		return nil, false
 	}
@@ -3,8 +3,11 @@ package cmd
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/spf13/cobra"
@@ -12,6 +15,8 @@ import (
 
 	"github.com/dynatrace-oss/dtctl/pkg/client"
 	"github.com/dynatrace-oss/dtctl/pkg/commands"
+	"github.com/dynatrace-oss/dtctl/pkg/config"
+	"github.com/dynatrace-oss/dtctl/sdk/httpclient"
 )
 
 // withScopeState overrides grantedScopesFunc and the relevant global flags for a
@@ -293,3 +298,35 @@ func TestScopePreflight_DisabledIsNoOp(t *testing.T) {
 	require.False(t, skip)
 	require.NoError(t, preErr)
 }
+
+func TestWrapForbiddenError(t *testing.T) {
+	createBucket, _, err := rootCmd.Find([]string{"create", "bucket"})
+	require.NoError(t, err)
+
+	apiErr := fmt.Errorf("create bucket: %w", httpclient.NewAPIError(403, "403 Forbidden", ""))
+	wrapped := wrapForbiddenError(createBucket, apiErr)
+
+	var scopeErr *InsufficientScopeError
+	require.True(t, errors.As(wrapped, &scopeErr))
+	require.Equal(t, apiErr.Error(), wrapped.Error(), "message is unchanged")
+	require.True(t, errors.Is(wrapped, httpclient.ErrForbidden))
+	require.Equal(t, []string{"storage:buckets:write"}, scopeErr.Required)
+	require.Equal(t, config.SafetyLevelReadWriteAll, scopeErr.MinSafetyLevel)
+	require.Contains(t, strings.Join(scopeErr.Suggestions(), "\n"), "dtctl auth login --safety-level readwrite-all")
+	require.Equal(t, client.ExitPermissionError, exitCodeForError(wrapped))
+
+	detail := errorToDetail(wrapped)
+	require.Equal(t, "insufficient_scope", detail.Code)
+	require.Equal(t, 403, detail.StatusCode)
+	require.Equal(t, []string{"storage:buckets:write"}, detail.RequiredScopes)
+
+	// Plain-text 403s from handlers that format the status code are recognised.
+	legacy := wrapForbiddenError(createBucket, errors.New("failed to create bucket: status 403: forbidden"))
+	require.True(t, errors.As(legacy, &scopeErr))
+
+	// Other errors pass through untouched.
+	notFound := fmt.Errorf("get bucket: %w", httpclient.NewAPIError(404, "404 Not Found", ""))
+	require.Same(t, notFound, wrapForbiddenError(createBucket, notFound))
+	preflight := &ScopeError{Verb: "create", Resource: "bucket", Missing: []string{"storage:buckets:write"}}
+	require.Same(t, error(preflight), wrapForbiddenError(createBucket, preflight))
+}
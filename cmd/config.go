@@ -2,20 +2,42 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
 
 	"github.com/dynatrace-oss/dtctl/pkg/config"
+	"github.com/dynatrace-oss/dtctl/pkg/diagnostic"
 	"github.com/dynatrace-oss/dtctl/pkg/output"
 )
 
 // loadConfigRaw loads configuration respecting the --config flag but WITHOUT applying
 // runtime overrides like --context. This is used for configuration management commands.
 func loadConfigRaw() (*config.Config, error) {
+	var cfg *config.Config
+	var err error
 	if cfgFile != "" {
-		return config.LoadFrom(cfgFile)
+		cfg, err = config.LoadFrom(cfgFile)
+	} else {
+		cfg, err = config.Load()
+	}
+	if err != nil {
+		return nil, err
+	}
+	applyKeyringMode(cfg)
+	return cfg, nil
+}
+
+// loadConfigForArgs loads configuration before Cobra has parsed the flags,
+// honoring a --config value found in the raw args. Used by the pre-parse
+// alias and profile resolution.
+func loadConfigForArgs(args []string) (*config.Config, error) {
+	if cfgPath := extractFlagValue(args, "config"); cfgPath != "" {
+		return config.LoadFrom(cfgPath)
 	}
 	return config.Load()
 }
@@ -36,20 +58,7 @@ func loadRawConfig() (*config.Config, error) {
 // DTCTL_CONFIG would be written to a different file (global or a local
 // .dtctl.yaml), silently clobbering it.
 func saveConfig(cfg *config.Config) error {
-	if cfgFile != "" {
-		return cfg.SaveTo(cfgFile)
-	}
-	// An explicit config named in the environment is trusted like --config and
-	// short-circuits local discovery, so writes must target it too.
-	if envPath := os.Getenv(config.EnvConfig); envPath != "" {
-		return cfg.SaveTo(envPath)
-	}
-	// If a local config exists, save to it
-	if local := config.FindLocalConfig(); local != "" {
-		return cfg.SaveTo(local)
-	}
-	// Fall back to default global location
-	return cfg.Save()
+	return cfg.SaveTo(effectiveConfigPath(cfgFile))
 }
 
 // configCmd represents the config command
@@ -63,17 +72,81 @@ var configCmd = &cobra.Command{
 var configViewCmd = &cobra.Command{
 	Use:   "view",
 	Short: "Display the current configuration",
+	Long: `Display the merged configuration with token values redacted.
+
+Token values stored in the config file (when no keyring is available) are
+shown as REDACTED, so the output is safe to share when debugging. Table
+output is shown as YAML; use -o json for JSON.
+
+Examples:
+  # Show the configuration
+  dtctl config view
+
+  # Show only the current context and its token entry
+  dtctl config view --minify
+
+  # Include token values (do not share the output)
+  dtctl config view --show-tokens
+`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		cfg, err := LoadConfig()
+		cfg, err := loadFileConfig()
+		if err != nil {
+			return err
+		}
+
+		showTokens, _ := cmd.Flags().GetBool("show-tokens")
+		minify, _ := cmd.Flags().GetBool("minify")
+		view, err := configView(cfg, showTokens, minify)
 		if err != nil {
 			return err
 		}
 
+		if !agentMode && jqFilter == "" && (outputFormat == "table" || outputFormat == "wide") {
+			return output.NewPrinterWithOpts(output.PrinterOptions{Format: "yaml", Writer: os.Stdout, PlainMode: plainMode}).Print(view)
+		}
 		printer := NewPrinter()
-		return printer.Print(cfg)
+		return printer.Print(view)
 	},
 }
 
+// redactedToken replaces token values in config view output.
+const redactedToken = "REDACTED"
+
+// configView returns a copy of cfg for display: token values are redacted
+// unless showTokens is set, and with minify only the current context and the
+// token it references are kept.
+func configView(cfg *config.Config, showTokens, minify bool) (*config.Config, error) {
+	view := *cfg
+	view.Contexts = append([]config.NamedContext(nil), cfg.Contexts...)
+	view.Tokens = append([]config.NamedToken(nil), cfg.Tokens...)
+
+	if minify {
+		if cfg.CurrentContext == "" {
+			return nil, fmt.Errorf("--minify requires a current context")
+		}
+		ctx, err := cfg.CurrentContextObj()
+		if err != nil {
+			return nil, err
+		}
+		view.Contexts = []config.NamedContext{{Name: cfg.CurrentContext, Context: *ctx}}
+		view.Tokens = nil
+		for _, t := range cfg.Tokens {
+			if t.Name == ctx.TokenRef {
+				view.Tokens = append(view.Tokens, t)
+			}
+		}
+	}
+
+	if !showTokens {
+		for i := range view.Tokens {
+			if view.Tokens[i].Token != "" {
+				view.Tokens[i].Token = redactedToken
+			}
+		}
+	}
+	return &view, nil
+}
+
 // configInitCmd creates a .dtctl.yaml template in the current directory
 var configInitCmd = &cobra.Command{
 	Use:   "init",
@@ -91,9 +164,19 @@ Examples:
   # Create .dtctl.yaml with a specific context pre-set
   dtctl config init --context production
 
+  # CI bootstrap: fill in DT_ENVIRONMENT_URL and DT_TOKEN, read-only
+  dtctl config init --from-env --safety-level readonly
+
 Environment variables can be used in the config file using ${VAR_NAME} syntax.
+With --from-env their current values are written instead.
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		fromEnv, _ := cmd.Flags().GetBool("from-env")
+		safetyLevel, _ := cmd.Flags().GetString("safety-level")
+		if safetyLevel != "" && !config.SafetyLevel(safetyLevel).IsValid() {
+			return fmt.Errorf("invalid safety level %q (valid values: %v)", safetyLevel, config.ValidSafetyLevels())
+		}
+
 		// Check if .dtctl.yaml already exists
 		configPath := config.LocalConfigName
 		if _, err := os.Stat(configPath); err == nil {
@@ -108,6 +191,14 @@ Environment variables can be used in the config file using ${VAR_NAME} syntax.
 
 		// Create template config
 		template := createLocalConfigTemplate(contextName)
+		if safetyLevel != "" {
+			template.Contexts[0].Context.SafetyLevel = config.SafetyLevel(safetyLevel)
+		}
+		if fromEnv {
+			if err := fillLocalConfigFromEnv(template); err != nil {
+				return err
+			}
+		}
 
 		// Write to file
 		data, err := yaml.Marshal(template)
@@ -120,12 +211,45 @@ Environment variables can be used in the config file using ${VAR_NAME} syntax.
 		}
 
 		output.PrintSuccess("Created %s", configPath)
+		if fromEnv {
+			output.PrintWarning("%s contains the API token in plain text; do not commit it", configPath)
+			return nil
+		}
 		output.PrintInfo("\nEdit this file to configure your project-local settings.")
 		output.PrintInfo("Environment variables can be used with ${VAR_NAME} syntax.")
 		return nil
 	},
 }
 
+// fillLocalConfigFromEnv replaces the template's ${...} placeholders with the
+// values of DT_ENVIRONMENT_URL and DT_TOKEN (or DT_API_TOKEN).
+func fillLocalConfigFromEnv(cfg *config.Config) error {
+	environment := os.Getenv("DT_ENVIRONMENT_URL")
+	token := os.Getenv("DT_TOKEN")
+	if token == "" {
+		token = os.Getenv("DT_API_TOKEN")
+	}
+
+	var missing []string
+	if environment == "" {
+		missing = append(missing, "DT_ENVIRONMENT_URL")
+	}
+	if token == "" {
+		missing = append(missing, "DT_TOKEN")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("--from-env requires %s to be set", strings.Join(missing, " and "))
+	}
+
+	for _, p := range diagnostic.CheckEnvironmentURL(environment) {
+		output.PrintWarning("%s", p.Message)
+	}
+
+	cfg.Contexts[0].Context.Environment = environment
+	cfg.Tokens[0].Token = token
+	return nil
+}
+
 // createLocalConfigTemplate generates a template config for local projects
 func createLocalConfigTemplate(contextName string) *config.Config {
 	if contextName == "" {
@@ -192,7 +316,7 @@ var configCurrentContextCmd = &cobra.Command{
 	Use:   "current-context",
 	Short: "Display the current context",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		cfg, err := LoadConfig()
+		cfg, err := loadFileConfig()
 		if err != nil {
 			return err
 		}
@@ -247,16 +371,16 @@ Examples:
     --token-ref prod-token \
     --profile query \
     --safety-level readonly
+
+  # Make destructive prompts in production stand out
+  dtctl config set-context prod --prompt PROD --color red
 `,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		environment, _ := cmd.Flags().GetString("environment")
 		tokenRef, _ := cmd.Flags().GetString("token-ref")
-		safetyLevel, _ := cmd.Flags().GetString("safety-level")
-		description, _ := cmd.Flags().GetString("description")
-		profile, _ := cmd.Flags().GetString("profile")
 
-		return setContext(args[0], environment, tokenRef, safetyLevel, description, profile)
+		return setContext(args[0], environment, tokenRef, contextOptionsFromFlags(cmd))
 	},
 }
 
@@ -276,6 +400,7 @@ var configSetCredentialsCmd = &cobra.Command{
 		cfg, err := loadConfigRaw()
 		if err != nil {
 			cfg = config.NewConfig()
+			applyKeyringMode(cfg)
 		}
 
 		if err := cfg.SetToken(name, token); err != nil {
@@ -286,15 +411,118 @@ var configSetCredentialsCmd = &cobra.Command{
 			return err
 		}
 
-		if config.IsKeyringAvailable() {
+		if cfg.KeyringMode().KeyringAvailable() {
 			output.PrintSuccess("Credentials %q stored securely in %s", name, config.KeyringBackend())
 		} else {
-			output.PrintWarning("Credentials %q set (stored in plaintext, keyring not available)", name)
+			warnPlaintextToken(cfg, name)
+		}
+		return nil
+	},
+}
+
+// warnPlaintextToken warns that a token was written to the config file, and
+// loudly so when the file can be read by other users.
+func warnPlaintextToken(cfg *config.Config, name string) {
+	reason := "keyring not available"
+	if cfg.KeyringMode() == config.KeyringModeFile {
+		reason = "--no-keyring"
+	}
+	path := effectiveConfigPath(cfgFile)
+	output.PrintWarning("Credentials %q stored in plaintext in %s (%s)", name, path, reason)
+	if info, err := os.Stat(path); err == nil && info.Mode().Perm()&0o077 != 0 {
+		output.PrintWarning("%s is readable by other users (mode %04o); restrict it with: chmod 600 %s", path, info.Mode().Perm(), path)
+	}
+}
+
+// configSetTokenCmd stores a static token for a context
+var configSetTokenCmd = &cobra.Command{
+	Use:   "set-token",
+	Short: "Use a static platform or API token for a context",
+	Long: `Store a static platform or API token for a context and mark the context
+as token-auth. Token-auth contexts skip OAuth session lookup and refresh,
+which suits service accounts that cannot log in through a browser.
+
+Examples:
+  # Use a platform token for the current context
+  dtctl config set-token --token dt0s16.XXXX
+
+  # Read the token from stdin (keeps it out of shell history)
+  printenv PROD_TOKEN | dtctl config set-token --context prod --token -
+`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctxName, _ := cmd.Flags().GetString("context")
+		tokenRef, _ := cmd.Flags().GetString("token-ref")
+		token, err := readTokenFlag(cmd)
+		if err != nil {
+			return err
+		}
+
+		cfg, err := loadConfigRaw()
+		if err != nil {
+			return err
+		}
+		if ctxName == "" {
+			ctxName = cfg.CurrentContext
+		}
+		if ctxName == "" {
+			return fmt.Errorf("no current context set: pass --context")
+		}
+		nc, err := cfg.GetContext(ctxName)
+		if err != nil {
+			return err
 		}
+		if tokenRef == "" {
+			tokenRef = nc.Context.TokenRef
+		}
+
+		tokenRef, err = storeStaticToken(cfg, ctxName, nc.Context.Environment, tokenRef, token)
+		if err != nil {
+			return err
+		}
+		if err := saveConfig(cfg); err != nil {
+			return err
+		}
+
+		output.PrintSuccess("Context %q now uses static token %q", ctxName, tokenRef)
 		return nil
 	},
 }
 
+// readTokenFlag returns the --token value, reading it from stdin when it is "-".
+func readTokenFlag(cmd *cobra.Command) (string, error) {
+	token, _ := cmd.Flags().GetString("token")
+	if token == "-" {
+		data, err := io.ReadAll(cmd.InOrStdin())
+		if err != nil {
+			return "", fmt.Errorf("failed to read token from stdin: %w", err)
+		}
+		token = string(data)
+	}
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", fmt.Errorf("--token is required")
+	}
+	return token, nil
+}
+
+// storeStaticToken saves token under tokenRef (default <context>-token), points
+// the context at it, and marks the context as token-auth. It returns the token
+// reference used.
+func storeStaticToken(cfg *config.Config, ctxName, environment, tokenRef, token string) (string, error) {
+	if tokenRef == "" {
+		tokenRef = ctxName + "-token"
+	}
+	if err := cfg.SetToken(tokenRef, token); err != nil {
+		return "", err
+	}
+	cfg.SetContext(ctxName, environment, tokenRef)
+	if err := cfg.SetContextAuthType(ctxName, config.AuthTypeToken); err != nil {
+		return "", err
+	}
+	return tokenRef, nil
+}
+
 // configSetCmd sets a configuration value
 var configSetCmd = &cobra.Command{
 	Use:   "set <key> <value>",
@@ -302,7 +530,10 @@ var configSetCmd = &cobra.Command{
 	Long: `Set a configuration value such as preferences.
 
 Supported keys:
-  - preferences.editor: Set the default editor for edit commands`,
+  - preferences.editor: Set the default editor for edit commands
+  - preferences.cache-ttl: How long completion candidates are cached (e.g. 10m, 0 to disable)
+  - preferences.sdk-version: Function executor SDK version for ad-hoc 'exec function' runs ("" to unpin)
+  - preferences.keyring-backend: Token storage: auto, os, or file (overridden by --keyring-backend and --no-keyring)`,
 	Args: cobra.ExactArgs(2),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		key := args[0]
@@ -317,6 +548,18 @@ Supported keys:
 		switch key {
 		case "preferences.editor":
 			cfg.Preferences.Editor = value
+		case "preferences.cache-ttl":
+			if _, err := time.ParseDuration(value); err != nil {
+				return fmt.Errorf("invalid cache-ttl %q: %w", value, err)
+			}
+			cfg.Preferences.CacheTTL = value
+		case "preferences.sdk-version":
+			cfg.Preferences.SDKVersion = value
+		case "preferences.keyring-backend":
+			if _, err := config.ParseKeyringMode(value); err != nil {
+				return err
+			}
+			cfg.Preferences.KeyringBackend = value
 		default:
 			return fmt.Errorf("unknown configuration key %q", key)
 		}
@@ -343,15 +586,15 @@ This command moves tokens stored in ~/.config/dtctl/config to:
 
 After migration, tokens are removed from the config file and stored securely.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if !config.IsKeyringAvailable() {
-			return fmt.Errorf("keyring not available on this system. Tokens will remain in config file")
-		}
-
 		cfg, err := loadConfigRaw()
 		if err != nil {
 			return err
 		}
 
+		if !cfg.KeyringMode().KeyringAvailable() {
+			return fmt.Errorf("keyring not available on this system. Tokens will remain in config file")
+		}
+
 		migrated, err := config.MigrateTokensToKeyring(cfg)
 		if err != nil {
 			return err
@@ -417,10 +660,121 @@ Examples:
 	},
 }
 
+// configSetViewCmd saves a named 'dtctl get' view
+var configSetViewCmd = &cobra.Command{
+	Use:   "set-view <view-name> --resource <type>",
+	Short: "Save a named view for 'dtctl get --view'",
+	Long: `Save a reusable combination of resource type, filter, label selector, sort
+order, columns and output format under a name. 'dtctl get --view <name>' expands
+to the saved flags; flags given on the command line are added after them and
+take precedence.
+
+Only flags supported by the resource's get command can be saved. --columns
+selects the columns of markdown output.
+
+Examples:
+  # Failing production workflows
+  dtctl config set-view prod-workflows --resource workflows --selector env=prod --filter deploy
+
+  # Recently modified dashboards as a Markdown table
+  dtctl config set-view recent-dashboards --resource dashboards \
+    --sort=-modificationInfo.lastModifiedTime --columns name,owner --default-output markdown
+
+  # Use a view
+  dtctl get --view prod-workflows
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var view config.View
+		view.Resource, _ = cmd.Flags().GetString("resource")
+		view.Filter, _ = cmd.Flags().GetString("filter")
+		view.Selector, _ = cmd.Flags().GetString("selector")
+		view.Sort, _ = cmd.Flags().GetString("sort")
+		view.Columns = columns
+		view.Output, _ = cmd.Flags().GetString("default-output")
+
+		if view.Resource == "" {
+			return fmt.Errorf("--resource is required (e.g. workflows, dashboards)")
+		}
+		if err := validateView(view); err != nil {
+			return err
+		}
+
+		cfg, err := loadConfigRaw()
+		if err != nil {
+			return err
+		}
+		if err := cfg.SetView(args[0], view); err != nil {
+			return err
+		}
+		if err := saveConfig(cfg); err != nil {
+			return err
+		}
+
+		output.PrintSuccess("View %q set", args[0])
+		output.PrintHint("Run: dtctl get --view %s", args[0])
+		return nil
+	},
+}
+
+// configDeleteViewCmd removes a named view
+var configDeleteViewCmd = &cobra.Command{
+	Use:   "delete-view <view-name>",
+	Short: "Delete a saved view",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := loadConfigRaw()
+		if err != nil {
+			return err
+		}
+		if err := cfg.DeleteView(args[0]); err != nil {
+			return err
+		}
+		if err := saveConfig(cfg); err != nil {
+			return err
+		}
+
+		output.PrintSuccess("View %q deleted", args[0])
+		return nil
+	},
+}
+
+// validateView checks that the view's resource has a get command and that the
+// command accepts the flags the view sets.
+func validateView(view config.View) error {
+	var resourceCmd *cobra.Command
+	for _, sub := range getCmd.Commands() {
+		if sub.Name() == view.Resource || sub.HasAlias(view.Resource) {
+			resourceCmd = sub
+			break
+		}
+	}
+	if resourceCmd == nil {
+		return fmt.Errorf("unknown resource type %q (see 'dtctl get --help')", view.Resource)
+	}
+
+	checks := []struct {
+		flag string
+		set  bool
+	}{
+		{"filter", view.Filter != ""},
+		{"selector", view.Selector != ""},
+		{"sort", view.Sort != ""},
+	}
+	for _, c := range checks {
+		if c.set && resourceCmd.Flags().Lookup(c.flag) == nil {
+			return fmt.Errorf("'dtctl get %s' does not support --%s", resourceCmd.Name(), c.flag)
+		}
+	}
+	return nil
+}
+
 func init() {
 	rootCmd.AddCommand(configCmd)
 
 	configCmd.AddCommand(configViewCmd)
+	configViewCmd.Flags().Bool("show-tokens", false, "show token values instead of REDACTED")
+	configViewCmd.Flags().Bool("minify", false, "show only the current context and its token")
 	configCmd.AddCommand(configInitCmd)
 	configCmd.AddCommand(configGetContextsCmd)
 	configCmd.AddCommand(configCurrentContextCmd)
@@ -428,22 +782,37 @@ func init() {
 	configCmd.AddCommand(configSetCmd)
 	configCmd.AddCommand(configSetContextCmd)
 	configCmd.AddCommand(configSetCredentialsCmd)
+	configCmd.AddCommand(configSetTokenCmd)
 	configCmd.AddCommand(configMigrateTokensCmd)
 	configCmd.AddCommand(configDescribeContextCmd)
 	configCmd.AddCommand(configDeleteContextCmd)
+	configCmd.AddCommand(configSetViewCmd)
+	configCmd.AddCommand(configDeleteViewCmd)
 
 	// Flags for init
 	configInitCmd.Flags().String("context", "", "context name to use in template (default: my-environment)")
 	configInitCmd.Flags().Bool("force", false, "overwrite existing .dtctl.yaml")
+	configInitCmd.Flags().Bool("from-env", false, "fill in the environment URL and token from DT_ENVIRONMENT_URL and DT_TOKEN instead of ${...} placeholders")
+	configInitCmd.Flags().String("safety-level", "", "safety level for the generated context (default: readwrite-all)")
 
 	// Flags for set-context
 	configSetContextCmd.Flags().String("environment", "", "environment URL")
 	configSetContextCmd.Flags().String("token-ref", "", "token reference name")
-	configSetContextCmd.Flags().String("safety-level", "", "safety level (readonly, readwrite-mine, readwrite-all, dangerously-unrestricted)")
-	configSetContextCmd.Flags().String("description", "", "human-readable description for this context")
-	configSetContextCmd.Flags().String("profile", "", "command profile to bind (restricts the visible command surface; e.g. query, investigate, full)")
+	addContextOptionFlags(configSetContextCmd)
 	_ = configSetContextCmd.RegisterFlagCompletionFunc("profile", completeProfileNames)
 
+	// Flags for set-view
+	configSetViewCmd.Flags().String("resource", "", "resource type to list (e.g. workflows, dashboards)")
+	configSetViewCmd.Flags().String("filter", "", "value for the resource's --filter flag")
+	configSetViewCmd.Flags().StringP("selector", "l", "", "label selector (e.g. team=checkout,env!=dev)")
+	configSetViewCmd.Flags().String("sort", "", "value for the resource's --sort flag")
+	configSetViewCmd.Flags().String("default-output", "", "output format for the view (json|yaml|csv|toon|markdown|table|wide)")
+
 	// Flags for set-credentials
 	configSetCredentialsCmd.Flags().String("token", "", "API token")
+
+	// Flags for set-token
+	configSetTokenCmd.Flags().String("context", "", "context to update (default: current context)")
+	configSetTokenCmd.Flags().String("token", "", "platform or API token (\"-\" reads it from stdin)")
+	configSetTokenCmd.Flags().String("token-ref", "", "token reference name (default: the context's token-ref or <context>-token)")
 }
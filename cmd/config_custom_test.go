@@ -391,3 +391,33 @@ func TestConfigSetCredentialsWithCustomPath(t *testing.T) {
 		t.Error("token reference not found in custom config")
 	}
 }
+
+// TestLoadConfigForArgs verifies that pre-parse config loading (alias and
+// profile resolution) reads the file named by --config in the raw args.
+func TestLoadConfigForArgs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ci.yaml")
+	content := `apiVersion: v1
+kind: Config
+current-context: ci
+contexts:
+  - name: ci
+    context:
+      environment: https://ci.example.com
+`
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	for _, args := range [][]string{
+		{"--config", path, "get", "workflows"},
+		{"get", "workflows", "--config=" + path},
+	} {
+		cfg, err := loadConfigForArgs(args)
+		if err != nil {
+			t.Fatalf("loadConfigForArgs(%v) error = %v", args, err)
+		}
+		if cfg.CurrentContext != "ci" {
+			t.Errorf("loadConfigForArgs(%v) loaded context %q, want ci", args, cfg.CurrentContext)
+		}
+	}
+}
@@ -3,10 +3,12 @@ package cmd
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"gopkg.in/yaml.v3"
 
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
 	"github.com/dynatrace-oss/dtctl/pkg/config"
 )
 
@@ -286,3 +288,101 @@ func TestConfigInitCmd_Integration(t *testing.T) {
 		t.Errorf("Token = %q, want dt0s16.TEST_TOKEN", cfg.Tokens[0].Token)
 	}
 }
+
+func TestConfigInitCmd_FromEnv(t *testing.T) {
+	tests := []struct {
+		name      string
+		env       map[string]string
+		flags     map[string]string
+		existing  bool
+		wantErr   string
+		wantEnv   string
+		wantToken string
+		wantLevel config.SafetyLevel
+	}{
+		{
+			name:      "from env with safety level",
+			env:       map[string]string{"DT_ENVIRONMENT_URL": "https://abc12345.apps.dynatrace.com", "DT_TOKEN": "dt0s16.FROM_ENV"},
+			flags:     map[string]string{"from-env": "true", "safety-level": "readonly"},
+			wantEnv:   "https://abc12345.apps.dynatrace.com",
+			wantToken: "dt0s16.FROM_ENV",
+			wantLevel: config.SafetyLevelReadOnly,
+		},
+		{
+			name:      "falls back to DT_API_TOKEN",
+			env:       map[string]string{"DT_ENVIRONMENT_URL": "https://abc12345.apps.dynatrace.com", "DT_API_TOKEN": "dt0s16.API"},
+			flags:     map[string]string{"from-env": "true"},
+			wantEnv:   "https://abc12345.apps.dynatrace.com",
+			wantToken: "dt0s16.API",
+			wantLevel: config.SafetyLevelReadWriteAll,
+		},
+		{
+			name:      "placeholders without from-env",
+			flags:     map[string]string{"safety-level": "readwrite-mine"},
+			wantEnv:   "${DT_ENVIRONMENT_URL}",
+			wantToken: "${DT_API_TOKEN}",
+			wantLevel: config.SafetyLevelReadWriteMine,
+		},
+		{
+			name:    "missing env vars",
+			flags:   map[string]string{"from-env": "true"},
+			wantErr: "--from-env requires DT_ENVIRONMENT_URL and DT_TOKEN to be set",
+		},
+		{
+			name:    "invalid safety level",
+			flags:   map[string]string{"safety-level": "yolo"},
+			wantErr: `invalid safety level "yolo"`,
+		},
+		{
+			name:     "existing file without force",
+			env:      map[string]string{"DT_ENVIRONMENT_URL": "https://abc12345.apps.dynatrace.com", "DT_TOKEN": "dt0s16.FROM_ENV"},
+			flags:    map[string]string{"from-env": "true"},
+			existing: true,
+			wantErr:  "already exists",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, k := range []string{"DT_ENVIRONMENT_URL", "DT_TOKEN", "DT_API_TOKEN"} {
+				t.Setenv(k, tt.env[k])
+			}
+			t.Chdir(t.TempDir())
+			if tt.existing {
+				if err := os.WriteFile(config.LocalConfigName, []byte("existing"), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			testutil.ResetCommandFlags(configInitCmd)
+			for k, v := range tt.flags {
+				_ = configInitCmd.Flags().Set(k, v)
+			}
+
+			err := configInitCmd.RunE(configInitCmd, nil)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RunE() error = %v", err)
+			}
+
+			data, err := os.ReadFile(config.LocalConfigName)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var cfg config.Config
+			if err := yaml.Unmarshal(data, &cfg); err != nil {
+				t.Fatal(err)
+			}
+			ctx := cfg.Contexts[0].Context
+			if ctx.Environment != tt.wantEnv || cfg.Tokens[0].Token != tt.wantToken || ctx.SafetyLevel != tt.wantLevel {
+				t.Errorf("got environment %q, token %q, safety level %q; want %q, %q, %q",
+					ctx.Environment, cfg.Tokens[0].Token, ctx.SafetyLevel, tt.wantEnv, tt.wantToken, tt.wantLevel)
+			}
+		})
+	}
+}
@@ -50,6 +50,35 @@ func TestConfigSetCmd(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:      "pin sdk version",
+			key:       "preferences.sdk-version",
+			value:     "1.4.0",
+			wantError: false,
+			validate: func(t *testing.T, cfg *config.Config) {
+				if cfg.Preferences.SDKVersion != "1.4.0" {
+					t.Errorf("expected sdk-version to be '1.4.0', got %q", cfg.Preferences.SDKVersion)
+				}
+			},
+		},
+		{
+			name:      "set keyring backend",
+			key:       "preferences.keyring-backend",
+			value:     "file",
+			wantError: false,
+			validate: func(t *testing.T, cfg *config.Config) {
+				if cfg.Preferences.KeyringBackend != "file" {
+					t.Errorf("expected keyring-backend to be 'file', got %q", cfg.Preferences.KeyringBackend)
+				}
+			},
+		},
+		{
+			name:      "invalid keyring backend",
+			key:       "preferences.keyring-backend",
+			value:     "pass",
+			wantError: true,
+			validate:  nil,
+		},
 		{
 			name:      "unknown key",
 			key:       "unknown.key",
@@ -226,3 +255,48 @@ func TestConfigDeleteContextCmd(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigView_RedactsTokens(t *testing.T) {
+	cfg := &config.Config{
+		CurrentContext: "prod",
+		Contexts: []config.NamedContext{
+			{Name: "prod", Context: config.Context{Environment: "https://prod.example.com", TokenRef: "prod-token"}},
+			{Name: "dev", Context: config.Context{Environment: "https://dev.example.com", TokenRef: "dev-token"}},
+		},
+		Tokens: []config.NamedToken{
+			{Name: "prod-token", Token: "dt0s16.SECRET1"},
+			{Name: "dev-token", Token: "dt0s16.SECRET2"},
+			{Name: "keyring-token"},
+		},
+	}
+
+	view, err := configView(cfg, false, false)
+	if err != nil {
+		t.Fatalf("configView() error = %v", err)
+	}
+	if len(view.Tokens) != 3 || view.Tokens[0].Token != redactedToken || view.Tokens[1].Token != redactedToken {
+		t.Errorf("tokens not redacted: %+v", view.Tokens)
+	}
+	if view.Tokens[2].Token != "" {
+		t.Errorf("empty token should stay empty, got %q", view.Tokens[2].Token)
+	}
+	if cfg.Tokens[0].Token != "dt0s16.SECRET1" {
+		t.Error("configView() modified the loaded config")
+	}
+
+	view, err = configView(cfg, true, true)
+	if err != nil {
+		t.Fatalf("configView(minify) error = %v", err)
+	}
+	if len(view.Contexts) != 1 || view.Contexts[0].Name != "prod" {
+		t.Errorf("minify contexts = %+v, want only prod", view.Contexts)
+	}
+	if len(view.Tokens) != 1 || view.Tokens[0].Token != "dt0s16.SECRET1" {
+		t.Errorf("minify tokens = %+v, want prod-token unredacted", view.Tokens)
+	}
+
+	cfg.CurrentContext = ""
+	if _, err := configView(cfg, false, true); err == nil {
+		t.Error("expected error for --minify without a current context")
+	}
+}
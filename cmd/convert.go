@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dynatrace-oss/dtctl/pkg/apply"
+	"github.com/dynatrace-oss/dtctl/pkg/exec"
+	"github.com/dynatrace-oss/dtctl/pkg/output"
+	"github.com/dynatrace-oss/dtctl/pkg/util/format"
+)
+
+var convertCmd = &cobra.Command{
+	Use:   "convert -f FILE [-o json|yaml] [--to-apply-format]",
+	Short: "Convert resource files between YAML and JSON and into apply format",
+	Long: `Convert a resource definition between YAML and JSON, offline.
+
+The output format is chosen with -o (json or yaml); without it the input format
+is kept. The result is written to stdout.
+
+With --to-apply-format, files exported with 'dtctl get -o yaml|json' are
+normalized into the form 'dtctl apply' is written against:
+  - Dashboards and notebooks: the content (including double-nested
+    .content.content) is lifted to the root next to id, name and description,
+    and server-managed fields (owner, version, shareInfo, ...) are dropped.
+  - Anomaly detectors: the raw Settings format is converted to the flattened
+    authoring format.
+Other resources are only re-encoded. No API calls are made.`,
+	Example: `  # Convert YAML to JSON
+  dtctl convert -f workflow.yaml -o json
+
+  # Clean up an exported dashboard before committing it
+  dtctl get dashboard abc-123 -o yaml > exported-dashboard.yaml
+  dtctl convert -f exported-dashboard.yaml --to-apply-format > dashboard.yaml
+
+  # Read from stdin
+  dtctl get notebook abc-123 -o json | dtctl convert -f - --to-apply-format -o yaml`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		file, _ := cmd.Flags().GetString("file")
+		toApplyFormat, _ := cmd.Flags().GetBool("to-apply-format")
+		if file == "" {
+			return fmt.Errorf("--file is required")
+		}
+
+		target := outputFormat
+		switch target {
+		case "json", "yaml":
+		case "yml":
+			target = "yaml"
+		case "", "table":
+			target = ""
+		default:
+			return fmt.Errorf("unsupported output format %q for convert (use json or yaml)", outputFormat)
+		}
+
+		content, err := exec.ReadFileOrStdin(file)
+		if err != nil {
+			return err
+		}
+		data := []byte(content)
+		if target == "" {
+			inputFormat, err := format.DetectFormat(data)
+			if err != nil {
+				return err
+			}
+			target = string(inputFormat)
+		}
+
+		jsonData, err := format.ValidateAndConvert(data)
+		if err != nil {
+			return err
+		}
+		if toApplyFormat {
+			var warnings []string
+			jsonData, warnings, err = apply.ToApplyFormat(jsonData)
+			if err != nil {
+				return err
+			}
+			for _, w := range warnings {
+				output.PrintWarning("%s", w)
+			}
+		}
+
+		var result []byte
+		if target == string(format.FormatJSON) {
+			result, err = format.PrettyJSON(jsonData)
+			if err == nil {
+				result = append(result, '\n')
+			}
+		} else {
+			result, err = format.JSONToYAML(jsonData)
+		}
+		if err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(result)
+		return err
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(convertCmd)
+
+	convertCmd.Flags().StringP("file", "f", "", "File to convert (use - for stdin)")
+	convertCmd.Flags().Bool("to-apply-format", false, "Normalize a 'get' export into the form apply expects")
+}
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+)
+
+func TestConvertCmd(t *testing.T) {
+	exported := `id: abc
+name: Prod
+type: dashboard
+owner: u1
+version: 3
+content:
+  content:
+    version: 21
+    tiles: {}
+`
+	path := filepath.Join(t.TempDir(), "dashboard.yaml")
+	if err := os.WriteFile(path, []byte(exported), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		output        string
+		toApplyFormat bool
+		want          []string
+		notWant       []string
+	}{
+		{
+			name:   "yaml to json",
+			output: "json",
+			want:   []string{`"owner": "u1"`, `"content": {`},
+		},
+		{
+			name:    "keeps input format",
+			output:  "table",
+			want:    []string{"owner: u1"},
+			notWant: []string{`"owner"`},
+		},
+		{
+			name:          "to apply format",
+			output:        "yaml",
+			toApplyFormat: true,
+			want:          []string{"name: Prod", "tiles: {}", "version: 21"},
+			notWant:       []string{"owner", "content", "type"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			origOutput := outputFormat
+			defer func() { outputFormat = origOutput }()
+
+			testutil.ResetCommandFlags(convertCmd)
+			outputFormat = tt.output
+			_ = convertCmd.Flags().Set("file", path)
+			if tt.toApplyFormat {
+				_ = convertCmd.Flags().Set("to-apply-format", "true")
+			}
+
+			var runErr error
+			got := captureStdout(t, func() { runErr = convertCmd.RunE(convertCmd, nil) })
+			if runErr != nil {
+				t.Fatalf("convert error = %v", runErr)
+			}
+			for _, w := range tt.want {
+				if !strings.Contains(got, w) {
+					t.Errorf("output missing %q:\n%s", w, got)
+				}
+			}
+			for _, w := range tt.notWant {
+				if strings.Contains(got, w) {
+					t.Errorf("output unexpectedly contains %q:\n%s", w, got)
+				}
+			}
+		})
+	}
+}
+
+func TestConvertCmd_Errors(t *testing.T) {
+	origOutput := outputFormat
+	defer func() { outputFormat = origOutput }()
+
+	testutil.ResetCommandFlags(convertCmd)
+	outputFormat = "json"
+	if err := convertCmd.RunE(convertCmd, nil); err == nil || !strings.Contains(err.Error(), "--file is required") {
+		t.Errorf("error = %v, want --file is required", err)
+	}
+
+	_ = convertCmd.Flags().Set("file", "x.yaml")
+	outputFormat = "csv"
+	if err := convertCmd.RunE(convertCmd, nil); err == nil || !strings.Contains(err.Error(), "unsupported output format") {
+		t.Errorf("error = %v, want unsupported output format", err)
+	}
+}
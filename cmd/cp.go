@@ -0,0 +1,284 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dynatrace-oss/dtctl/pkg/apply"
+	"github.com/dynatrace-oss/dtctl/pkg/client"
+	"github.com/dynatrace-oss/dtctl/pkg/config"
+	"github.com/dynatrace-oss/dtctl/pkg/output"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/document"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/resolver"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/slo"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/workflow"
+	"github.com/dynatrace-oss/dtctl/pkg/safety"
+)
+
+// copyServerFields are fields the source environment manages. They are
+// dropped before creating the copy so the target assigns its own ID and
+// owner instead of inheriting (or colliding with) the source's.
+var copyServerFields = map[string][]string{
+	"workflow": {"id", "owner", "ownerType", "actor", "triggerType", "lastExecution", "modificationInfo"},
+	"slo":      {"id", "version", "externalId"},
+}
+
+// cpCmd copies resources between contexts
+var cpCmd = &cobra.Command{
+	Use:   "cp",
+	Short: "Copy a resource from one context to another",
+	Long: `Copy a dashboard, notebook, workflow, or SLO from one context to another.
+
+The resource is fetched from the --from context (default: the current context),
+server-managed fields such as the ID and owner are removed, and it is created
+as a new resource in the --to context. The target context's safety level
+applies to the create.`,
+	RunE: requireSubcommand,
+}
+
+var cpDashboardCmd = &cobra.Command{
+	Use:     "dashboard <dashboard-id-or-name>",
+	Aliases: []string{"dashboards", "dash", "db"},
+	Short:   "Copy a dashboard to another context",
+	Long: `Copy a dashboard to another context.
+
+Examples:
+  # Promote a dashboard from staging to prod
+  dtctl cp dashboard "Service Health" --from staging --to prod
+
+  # Copy under a new name
+  dtctl cp dashboard <id> --from staging --to prod --new-name "Service Health (prod)"
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runCopy(cmd, "dashboard", args[0])
+	},
+}
+
+var cpNotebookCmd = &cobra.Command{
+	Use:     "notebook <notebook-id-or-name>",
+	Aliases: []string{"notebooks", "nb"},
+	Short:   "Copy a notebook to another context",
+	Long: `Copy a notebook to another context.
+
+Examples:
+  # Copy a notebook from dev to staging
+  dtctl cp notebook "Incident Runbook" --from dev --to staging
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runCopy(cmd, "notebook", args[0])
+	},
+}
+
+var cpWorkflowCmd = &cobra.Command{
+	Use:     "workflow <workflow-id-or-name>",
+	Aliases: []string{"workflows", "wf"},
+	Short:   "Copy a workflow to another context",
+	Long: `Copy a workflow to another context.
+
+The copy is created with the target context's credentials, so the workflow
+actor and owner are those of the target user.
+
+Examples:
+  # Promote a workflow from staging to prod
+  dtctl cp workflow "Nightly Cleanup" --from staging --to prod
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runCopy(cmd, "workflow", args[0])
+	},
+}
+
+var cpSLOCmd = &cobra.Command{
+	Use:     "slo <slo-id>",
+	Aliases: []string{"slos"},
+	Short:   "Copy an SLO to another context",
+	Long: `Copy an SLO to another context.
+
+Examples:
+  # Copy an SLO from staging to prod under a new name
+  dtctl cp slo <slo-id> --from staging --to prod --new-name "Checkout availability"
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runCopy(cmd, "slo", args[0])
+	},
+}
+
+// runCopy fetches kind/identifier from the source context and creates it in
+// the target context.
+func runCopy(cmd *cobra.Command, kind, identifier string) error {
+	from, _ := cmd.Flags().GetString("from")
+	to, _ := cmd.Flags().GetString("to")
+	newName, _ := cmd.Flags().GetString("new-name")
+
+	srcCfg, err := LoadConfig()
+	if err != nil {
+		return err
+	}
+	if from == "" {
+		from = srcCfg.CurrentContext
+	}
+	if from == to {
+		return fmt.Errorf("--from and --to are the same context (%q)", to)
+	}
+
+	_, src, err := clientForContext(srcCfg, from)
+	if err != nil {
+		return fmt.Errorf("source context: %w", err)
+	}
+
+	payload, name, err := exportForCopy(src, kind, identifier, newName)
+	if err != nil {
+		return err
+	}
+
+	if dryRun {
+		fmt.Printf("Dry run: would copy %s %q from context %q to %q\n", kind, name, from, to)
+		return nil
+	}
+
+	dstCfg, err := LoadConfig()
+	if err != nil {
+		return err
+	}
+	dstCfg, dst, err := clientForContext(dstCfg, to)
+	if err != nil {
+		return fmt.Errorf("target context: %w", err)
+	}
+	checker, err := NewSafetyChecker(dstCfg)
+	if err != nil {
+		return err
+	}
+	if err := checker.CheckError(safety.OperationCreate, safety.OwnershipUnknown); err != nil {
+		return err
+	}
+
+	results, err := apply.NewApplier(dst).WithSafetyChecker(checker).Apply(payload, apply.ApplyOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create %s in context %q: %w", kind, to, err)
+	}
+
+	base := extractApplyBase(results[0])
+	if base == nil {
+		return fmt.Errorf("unexpected apply result for %s", kind)
+	}
+	if printQuietID(cmd, base.ID) {
+		return nil
+	}
+	output.PrintSuccess("Copied %s %q from %q to %q", kind, name, from, to)
+	output.PrintInfo("  ID: %s", base.ID)
+	return nil
+}
+
+// clientForContext builds a client for the named context. The config is
+// modified in place, so callers needing two contexts load it twice.
+func clientForContext(cfg *config.Config, name string) (*config.Config, *client.Client, error) {
+	if _, err := cfg.GetContext(name); err != nil {
+		return nil, nil, err
+	}
+	cfg.CurrentContext = name
+	c, err := NewClientFromConfig(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cfg, c, nil
+}
+
+// exportForCopy fetches a resource and returns it as an apply payload without
+// server-managed fields, along with the name the copy will carry.
+func exportForCopy(c *client.Client, kind, identifier, newName string) ([]byte, string, error) {
+	switch kind {
+	case "dashboard", "notebook":
+		resType := resolver.TypeDashboard
+		if kind == "notebook" {
+			resType = resolver.TypeNotebook
+		}
+		id, err := resolver.NewResolver(c).ResolveID(resType, identifier)
+		if err != nil {
+			return nil, "", err
+		}
+		doc, err := document.NewHandler(c).Get(id)
+		if err != nil {
+			return nil, "", err
+		}
+		if doc.Type != kind {
+			return nil, "", fmt.Errorf("document %s is a %s, not a %s", id, doc.Type, kind)
+		}
+		var content map[string]interface{}
+		if err := json.Unmarshal(doc.Content, &content); err != nil {
+			return nil, "", fmt.Errorf("failed to parse %s content: %w", kind, err)
+		}
+		name := doc.Name
+		if newName != "" {
+			name = newName
+		}
+		payload := map[string]interface{}{
+			"type":    kind,
+			"name":    name,
+			"content": content,
+		}
+		if doc.Description != "" {
+			payload["description"] = doc.Description
+		}
+		data, err := json.Marshal(payload)
+		return data, name, err
+
+	case "workflow":
+		id, err := resolver.NewResolver(c).ResolveID(resolver.TypeWorkflow, identifier)
+		if err != nil {
+			return nil, "", err
+		}
+		raw, err := workflow.NewHandler(c).GetRaw(id)
+		if err != nil {
+			return nil, "", err
+		}
+		return stripForCopy(raw, kind, "title", newName)
+
+	case "slo":
+		raw, err := slo.NewHandler(c).GetRaw(identifier)
+		if err != nil {
+			return nil, "", err
+		}
+		return stripForCopy(raw, kind, "name", newName)
+	}
+	return nil, "", fmt.Errorf("unsupported resource type %q", kind)
+}
+
+// stripForCopy removes the server-managed fields of kind from raw and applies
+// newName to nameField when set.
+func stripForCopy(raw []byte, kind, nameField, newName string) ([]byte, string, error) {
+	var obj map[string]interface{}
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return nil, "", fmt.Errorf("failed to parse %s: %w", kind, err)
+	}
+	for _, f := range copyServerFields[kind] {
+		delete(obj, f)
+	}
+	if newName != "" {
+		obj[nameField] = newName
+	}
+	name, _ := obj[nameField].(string)
+	data, err := json.Marshal(obj)
+	return data, name, err
+}
+
+func init() {
+	rootCmd.AddCommand(cpCmd)
+
+	cpCmd.AddCommand(cpDashboardCmd)
+	cpCmd.AddCommand(cpNotebookCmd)
+	cpCmd.AddCommand(cpWorkflowCmd)
+	cpCmd.AddCommand(cpSLOCmd)
+
+	for _, c := range []*cobra.Command{cpDashboardCmd, cpNotebookCmd, cpWorkflowCmd, cpSLOCmd} {
+		c.Flags().String("from", "", "source context (default: current context)")
+		c.Flags().String("to", "", "target context (required)")
+		c.Flags().String("new-name", "", "name for the copy (default: the source name)")
+		c.Flags().BoolP("quiet", "q", false, "print only the new resource ID")
+		_ = c.MarkFlagRequired("to")
+	}
+}
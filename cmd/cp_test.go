@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+	"github.com/dynatrace-oss/dtctl/pkg/config"
+)
+
+func TestStripForCopy(t *testing.T) {
+	raw := []byte(`{"id":"wf-1","title":"Cleanup","owner":"u-1","actor":"u-1","tasks":{}}`)
+	data, name, err := stripForCopy(raw, "workflow", "title", "Cleanup (prod)")
+	if err != nil {
+		t.Fatalf("stripForCopy() error = %v", err)
+	}
+	if name != "Cleanup (prod)" {
+		t.Errorf("name = %q, want new name", name)
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	for _, f := range []string{"id", "owner", "actor"} {
+		if _, ok := obj[f]; ok {
+			t.Errorf("field %q was not stripped", f)
+		}
+	}
+	if _, ok := obj["tasks"]; !ok {
+		t.Error("tasks were dropped")
+	}
+}
+
+func TestCpWorkflow(t *testing.T) {
+	const srcID = "a1b2c3d4-e5f6-7890-abcd-ef1234567890"
+
+	src := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/automation/v1/workflows/" + srcID: func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"` + srcID + `","title":"Nightly Cleanup","owner":"src-user","tasks":{"t1":{"action":"x"}}}`))
+		},
+	})
+	defer src.Close()
+
+	var created map[string]interface{}
+	dst := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/automation/v1/workflows": func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("expected POST on target, got %s", r.Method)
+			}
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, &created)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"new-wf-id","title":"Nightly Cleanup"}`))
+		},
+	})
+	defer dst.Close()
+
+	configPath := filepath.Join(t.TempDir(), "config")
+	cfg := config.NewConfig()
+	cfg.SetContext("staging", src.URL, "test-token")
+	cfg.SetContext("prod", dst.URL, "test-token")
+	if err := cfg.SetToken("test-token", "dt0c01.ST.test-token-value.test-secret"); err != nil {
+		t.Fatalf("failed to set token: %v", err)
+	}
+	cfg.CurrentContext = "staging"
+	if err := cfg.SaveTo(configPath); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+
+	origCfgFile := cfgFile
+	defer func() { cfgFile = origCfgFile }()
+	cfgFile = configPath
+
+	testutil.ResetCommandFlags(cpWorkflowCmd)
+	_ = cpWorkflowCmd.Flags().Set("from", "staging")
+	_ = cpWorkflowCmd.Flags().Set("to", "prod")
+
+	if err := cpWorkflowCmd.RunE(cpWorkflowCmd, []string{srcID}); err != nil {
+		t.Fatalf("RunE() error = %v", err)
+	}
+	if created == nil {
+		t.Fatal("workflow was not created in the target context")
+	}
+	if _, ok := created["id"]; ok {
+		t.Error("source ID was sent to the target")
+	}
+	if _, ok := created["owner"]; ok {
+		t.Error("source owner was sent to the target")
+	}
+	if created["title"] != "Nightly Cleanup" {
+		t.Errorf("title = %v, want Nightly Cleanup", created["title"])
+	}
+}
@@ -11,7 +11,8 @@ var createCmd = &cobra.Command{
 	Long: `Create a new resource on the Dynatrace platform from a YAML or JSON file.
 
 Reads a resource definition from a file and creates it. If the resource already
-exists, the command fails — use 'dtctl apply' for create-or-update semantics.
+exists, the command fails — use 'dtctl apply' for create-or-update semantics,
+or pass --if-not-exists to exit successfully without changes instead.
 
 For most workflows, 'dtctl apply -f <file>' is preferred over 'create' because
 apply is idempotent (creates if new, updates if existing).
@@ -29,6 +30,12 @@ Supported resources:
   # Create a settings object
   dtctl create settings -f settings.yaml
 
+  # Safe to re-run in provisioning scripts
+  dtctl create bucket --name custom_logs --table logs --retention 35 --if-not-exists
+
+  # Parse a generated file as JSON regardless of its name
+  dtctl create workflow -f /tmp/render.out --input-format json
+
   # Preview what would be created
   dtctl create workflow -f workflow.yaml --dry-run`,
 	RunE: requireSubcommand,
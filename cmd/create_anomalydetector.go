@@ -9,7 +9,6 @@ import (
 	"github.com/dynatrace-oss/dtctl/pkg/output"
 	"github.com/dynatrace-oss/dtctl/pkg/resources/anomalydetector"
 	"github.com/dynatrace-oss/dtctl/pkg/safety"
-	"github.com/dynatrace-oss/dtctl/pkg/util/format"
 	"github.com/dynatrace-oss/dtctl/pkg/util/template"
 )
 
@@ -42,8 +41,6 @@ Examples:
 			return fmt.Errorf("--file is required")
 		}
 
-		setFlags, _ := cmd.Flags().GetStringArray("set")
-
 		// Read the file
 		fileData, err := os.ReadFile(file)
 		if err != nil {
@@ -51,18 +48,18 @@ Examples:
 		}
 
 		// Convert to JSON if needed
-		jsonData, err := format.ValidateAndConvert(fileData)
+		jsonData, err := convertInputFile(cmd, fileData)
 		if err != nil {
-			return fmt.Errorf("invalid file format: %w", err)
+			return err
 		}
 
 		// Apply template rendering if variables provided
-		if len(setFlags) > 0 {
-			templateVars, err := template.ParseSetFlags(setFlags)
-			if err != nil {
-				return fmt.Errorf("invalid --set flag: %w", err)
-			}
-			rendered, err := template.RenderTemplate(string(jsonData), templateVars)
+		templateVars, err := templateVarsFromFlags(cmd)
+		if err != nil {
+			return fmt.Errorf("invalid template variable: %w", err)
+		}
+		if len(templateVars) > 0 {
+			rendered, err := template.RenderJSONTemplate(string(jsonData), templateVars)
 			if err != nil {
 				return fmt.Errorf("template rendering failed: %w", err)
 			}
@@ -85,11 +82,32 @@ Examples:
 
 		handler := anomalydetector.NewHandler(c)
 
+		if ifNotExists, _ := cmd.Flags().GetBool("if-not-exists"); ifNotExists {
+			if title := anomalydetector.ExtractTitle(jsonData); title != "" {
+				existing, err := handler.FindByExactTitle(title)
+				if err != nil {
+					return fmt.Errorf("failed to check for existing anomaly detector: %w", err)
+				}
+				if existing != nil {
+					reportAlreadyExists(cmd, "anomaly detector", &existingResource{ID: existing.ObjectID, Name: existing.Title})
+					return nil
+				}
+			}
+		}
+
 		result, err := handler.Create(jsonData)
 		if err != nil {
 			return fmt.Errorf("failed to create anomaly detector: %w", err)
 		}
 
+		if printQuietID(cmd, result.ObjectID) {
+			return nil
+		}
+
+		if handled, err := printCreateResult(CreateResult{ResourceType: "anomaly_detector", ID: result.ObjectID, Name: result.Title}); handled {
+			return err
+		}
+
 		output.PrintSuccess("Anomaly detector %q created", result.Title)
 		output.PrintInfo("  Object ID: %s", result.ObjectID)
 		output.PrintInfo("  Title:     %s", result.Title)
@@ -103,6 +121,9 @@ Examples:
 
 func init() {
 	createAnomalyDetectorCmd.Flags().StringP("file", "f", "", "file containing anomaly detector definition (required)")
-	createAnomalyDetectorCmd.Flags().StringArray("set", []string{}, "set template variable (key=value)")
+	addTemplateVarFlags(createAnomalyDetectorCmd)
+	addInputFormatFlag(createAnomalyDetectorCmd)
+	addIfNotExistsFlag(createAnomalyDetectorCmd)
+	addQuietFlag(createAnomalyDetectorCmd)
 	_ = createAnomalyDetectorCmd.MarkFlagRequired("file")
 }
@@ -53,6 +53,17 @@ Examples:
 
 		handler := awsconnection.NewHandler(c)
 
+		if ifNotExists, _ := cmd.Flags().GetBool("if-not-exists"); ifNotExists {
+			existing, err := findExistingAWSConnection(c, createAWSConnectionName)
+			if err != nil {
+				return fmt.Errorf("failed to check for existing AWS connection: %w", err)
+			}
+			if existing != nil {
+				reportAlreadyExists(cmd, "AWS connection", existing)
+				return nil
+			}
+		}
+
 		value := awsconnection.Value{
 			Name: createAWSConnectionName,
 			Type: awsconnection.TypeRoleBased,
@@ -67,6 +78,14 @@ Examples:
 			return err
 		}
 
+		if printQuietID(cmd, created.ObjectID) {
+			return nil
+		}
+
+		if handled, err := printCreateResult(CreateResult{ResourceType: "aws_connection", ID: created.ObjectID, Name: createAWSConnectionName}); handled {
+			return err
+		}
+
 		output.PrintSuccess("AWS connection created: %s", created.ObjectID)
 		printAWSConnectionInstructions(c.BaseURL(), created.ObjectID, createAWSConnectionName)
 		return nil
@@ -98,6 +117,17 @@ Examples:
 			return err
 		}
 
+		if ifNotExists, _ := cmd.Flags().GetBool("if-not-exists"); ifNotExists {
+			existing, err := findExistingAWSMonitoringConfig(c, createAWSMonitoringConfigName)
+			if err != nil {
+				return fmt.Errorf("failed to check for existing AWS monitoring config: %w", err)
+			}
+			if existing != nil {
+				reportAlreadyExists(cmd, "AWS monitoring config", existing)
+				return nil
+			}
+		}
+
 		connectionHandler := awsconnection.NewHandler(c)
 		monitoringHandler := awsmonitoringconfig.NewHandler(c)
 
@@ -158,6 +188,14 @@ Examples:
 			return err
 		}
 
+		if printQuietID(cmd, created.ObjectID) {
+			return nil
+		}
+
+		if handled, err := printCreateResult(CreateResult{ResourceType: "aws_monitoring_config", ID: created.ObjectID, Name: createAWSMonitoringConfigName}); handled {
+			return err
+		}
+
 		output.PrintSuccess("AWS monitoring config created (disabled): %s", created.ObjectID)
 		output.PrintInfo("Run 'dtctl enable aws monitoring --name %q' to enable it", createAWSMonitoringConfigName)
 		return nil
@@ -233,4 +271,9 @@ func init() {
 	createAWSMonitoringConfigCmd.Flags().StringVar(&createAWSMonitoringConfigCredentials, "credentials", "", "AWS connection name or ID (required)")
 	createAWSMonitoringConfigCmd.Flags().StringVar(&createAWSMonitoringConfigRegions, "regions", "", "Comma-separated AWS regions (required, first is the deployment region)")
 	createAWSMonitoringConfigCmd.Flags().StringVar(&createAWSMonitoringConfigFeatureSets, "featureSets", "", "Comma-separated feature sets (default: all *_essential)")
+
+	addIfNotExistsFlag(createAWSConnectionCmd)
+	addIfNotExistsFlag(createAWSMonitoringConfigCmd)
+	addQuietFlag(createAWSConnectionCmd)
+	addQuietFlag(createAWSMonitoringConfigCmd)
 }
@@ -83,6 +83,17 @@ Examples:
 
 		handler := azureconnection.NewHandler(c)
 
+		if ifNotExists, _ := cmd.Flags().GetBool("if-not-exists"); ifNotExists {
+			existing, err := findExistingAzureConnection(c, createAzureConnectionName)
+			if err != nil {
+				return fmt.Errorf("failed to check for existing Azure connection: %w", err)
+			}
+			if existing != nil {
+				reportAlreadyExists(cmd, "Azure connection", existing)
+				return nil
+			}
+		}
+
 		value := azureconnection.Value{
 			Name: createAzureConnectionName,
 			Type: createAzureConnectionType,
@@ -105,6 +116,14 @@ Examples:
 			return err
 		}
 
+		if printQuietID(cmd, created.ObjectID) {
+			return nil
+		}
+
+		if handled, err := printCreateResult(CreateResult{ResourceType: "azure_connection", ID: created.ObjectID, Name: createAzureConnectionName}); handled {
+			return err
+		}
+
 		output.PrintSuccess("Azure connection created: %s", created.ObjectID)
 		if createAzureConnectionType == "federatedIdentityCredential" {
 			printFederatedCreateInstructions(c.BaseURL(), created.ObjectID, createAzureConnectionName, createAzureConnectionIssuer)
@@ -135,6 +154,17 @@ Examples:
 			return err
 		}
 
+		if ifNotExists, _ := cmd.Flags().GetBool("if-not-exists"); ifNotExists {
+			existing, err := findExistingAzureMonitoringConfig(c, createAzureMonitoringConfigName)
+			if err != nil {
+				return fmt.Errorf("failed to check for existing Azure monitoring config: %w", err)
+			}
+			if existing != nil {
+				reportAlreadyExists(cmd, "Azure monitoring config", existing)
+				return nil
+			}
+		}
+
 		connectionHandler := azureconnection.NewHandler(c)
 		monitoringHandler := azuremonitoringconfig.NewHandler(c)
 
@@ -187,6 +217,14 @@ Examples:
 			return err
 		}
 
+		if printQuietID(cmd, created.ObjectID) {
+			return nil
+		}
+
+		if handled, err := printCreateResult(CreateResult{ResourceType: "azure_monitoring_config", ID: created.ObjectID, Name: createAzureMonitoringConfigName}); handled {
+			return err
+		}
+
 		output.PrintSuccess("Azure monitoring config created (disabled): %s", created.ObjectID)
 		output.PrintInfo("Run 'dtctl enable azure monitoring --name %q' to enable it", createAzureMonitoringConfigName)
 		return nil
@@ -270,4 +308,9 @@ func init() {
 	createAzureMonitoringConfigCmd.Flags().StringVar(&createAzureMonitoringConfigFeatureSets, "featuresets", "", "Alias for --featureSets")
 	_ = createAzureMonitoringConfigCmd.MarkFlagRequired("name")
 	_ = createAzureMonitoringConfigCmd.MarkFlagRequired("credentials")
+
+	addIfNotExistsFlag(createAzureConnectionCmd)
+	addIfNotExistsFlag(createAzureMonitoringConfigCmd)
+	addQuietFlag(createAzureConnectionCmd)
+	addQuietFlag(createAzureMonitoringConfigCmd)
 }
@@ -39,6 +39,9 @@ Examples:
   # Multiple filters
   dtctl create breakpoint OrderController.java:306 --filters k8s.namespace.name:prod,dt.entity.host:HOST-123
 
+  # Skip the create when a breakpoint already exists at that location
+  dtctl create breakpoint OrderController.java:306 --if-not-exists
+
   # Dry run to preview
   dtctl create breakpoint OrderController.java:306 --dry-run
 `,
@@ -111,6 +114,25 @@ Examples:
 			}
 		}
 
+		// Look the location up before any filter change so an existing
+		// breakpoint leaves the workspace untouched.
+		if ifNotExists, _ := cmd.Flags().GetBool("if-not-exists"); ifNotExists {
+			rulesResp, err := handler.GetWorkspaceRules(workspaceID)
+			if err != nil {
+				return fmt.Errorf("failed to check for existing breakpoint: %w", err)
+			}
+			rows, err := extractBreakpointRows(rulesResp)
+			if err != nil {
+				return fmt.Errorf("failed to check for existing breakpoint: %w", err)
+			}
+			if matches := findBreakpointRowsByLocation(rows, fileName, lineNumber); len(matches) > 0 {
+				if printQuietID(cmd, matches[0].ID) {
+					return nil
+				}
+				return printBreakpointMessage("create", fmt.Sprintf("Breakpoint at %s:%d already exists (ID: %s)", fileName, lineNumber, matches[0].ID))
+			}
+		}
+
 		if filtersChanged {
 			// Changing workspace filters re-scopes every existing active
 			// breakpoint in the workspace, not just the one being created.
@@ -121,7 +143,7 @@ Examples:
 				if err != nil {
 					return err
 				}
-				if count > 0 && !prompt.Confirm(filterChangeConfirmMessage(count, true)) {
+				if count > 0 && !prompt.Confirm(promptTarget(cfg), filterChangeConfirmMessage(count, true)) {
 					return printBreakpointMessage("create", "Cancelled")
 				}
 			}
@@ -161,6 +183,15 @@ Examples:
 			}
 		}
 
+		if quiet, _ := cmd.Flags().GetBool("quiet"); quiet {
+			ruleID, err := livedebugger.ExtractCreatedRuleID(createResp)
+			if err != nil {
+				return err
+			}
+			printQuietID(cmd, ruleID)
+			return nil
+		}
+
 		return printBreakpointMessage("create", fmt.Sprintf("Created breakpoint at %s:%d", fileName, lineNumber))
 	},
 }
@@ -168,4 +199,6 @@ Examples:
 func init() {
 	createBreakpointCmd.Flags().String("filters", "", "workspace filters to set before creating the breakpoint (comma-separated key:value pairs)")
 	createBreakpointCmd.Flags().BoolP("yes", "y", false, "skip the confirmation prompt when changing workspace filters affects existing breakpoints")
+	addIfNotExistsFlag(createBreakpointCmd)
+	addQuietFlag(createBreakpointCmd)
 }
@@ -42,6 +42,16 @@ func TestCreateBreakpointYesFlagRegistered(t *testing.T) {
 	}
 }
 
+func TestCreateBreakpointQuietFlagRegistered(t *testing.T) {
+	flag := createBreakpointCmd.Flags().Lookup("quiet")
+	if flag == nil {
+		t.Fatalf("expected --quiet flag to be registered on create breakpoint")
+	}
+	if flag.Shorthand != "q" {
+		t.Fatalf("expected --quiet shorthand 'q', got %q", flag.Shorthand)
+	}
+}
+
 // TestCreateBreakpointFiltersValidation exercises the early --filters validation
 // that runs before any config or network call, so no client/config setup is
 // required. The flag state is saved and restored to avoid leaking into other tests.
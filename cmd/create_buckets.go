@@ -10,7 +10,6 @@ import (
 	"github.com/dynatrace-oss/dtctl/pkg/output"
 	"github.com/dynatrace-oss/dtctl/pkg/resources/bucket"
 	"github.com/dynatrace-oss/dtctl/pkg/safety"
-	"github.com/dynatrace-oss/dtctl/pkg/util/format"
 )
 
 // createBucketCmd creates a Grail bucket
@@ -29,6 +28,12 @@ Examples:
   # Create from a file
   dtctl create bucket -f bucket.yaml
 
+  # Skip creation if the bucket already exists (safe to re-run)
+  dtctl create bucket --name custom_logs --table logs --retention 35 --if-not-exists
+
+  # Wait until the bucket is active
+  dtctl create bucket --name custom_logs --table logs --retention 35 --wait
+
   # Dry run to preview
   dtctl create bucket --name custom_logs --table logs --retention 35 --dry-run
 `,
@@ -49,9 +54,9 @@ Examples:
 				return fmt.Errorf("failed to read file: %w", err)
 			}
 
-			jsonData, err := format.ValidateAndConvert(fileData)
+			jsonData, err := convertInputFile(cmd, fileData)
 			if err != nil {
-				return fmt.Errorf("invalid file format: %w", err)
+				return err
 			}
 
 			if err := json.Unmarshal(jsonData, &req); err != nil {
@@ -96,13 +101,42 @@ Examples:
 
 		handler := bucket.NewHandler(c)
 
+		if ifNotExists, _ := cmd.Flags().GetBool("if-not-exists"); ifNotExists {
+			existing, err := handler.Get(req.BucketName)
+			if err == nil {
+				reportAlreadyExists(cmd, "bucket", &existingResource{ID: existing.BucketName, Name: existing.BucketName})
+				return nil
+			}
+			if !isNotFoundError(err) {
+				return fmt.Errorf("failed to check for existing bucket: %w", err)
+			}
+		}
+
 		result, err := handler.Create(req)
 		if err != nil {
 			return fmt.Errorf("failed to create bucket: %w", err)
 		}
 
+		wait, _ := cmd.Flags().GetBool("wait")
+		if wait {
+			waitTimeout, _ := cmd.Flags().GetDuration("wait-timeout")
+			if result, err = handler.WaitActive(result.BucketName, waitTimeout); err != nil {
+				return fmt.Errorf("bucket created but not ready: %w", err)
+			}
+		}
+
+		if printQuietID(cmd, result.BucketName) {
+			return nil
+		}
+
+		if handled, err := printCreateResult(CreateResult{ResourceType: "bucket", ID: result.BucketName, Name: result.BucketName}); handled {
+			return err
+		}
+
 		output.PrintSuccess("Bucket %q created (status: %s)", result.BucketName, result.Status)
-		output.PrintInfo("Note: Bucket creation can take up to 1 minute to complete")
+		if !wait {
+			output.PrintInfo("Note: Bucket creation can take up to 1 minute to complete")
+		}
 		return nil
 	},
 }
@@ -110,8 +144,12 @@ Examples:
 func init() {
 	// Bucket flags
 	createBucketCmd.Flags().StringP("file", "f", "", "file containing bucket definition")
+	addInputFormatFlag(createBucketCmd)
 	createBucketCmd.Flags().String("name", "", "bucket name (3-100 chars, lowercase alphanumeric, underscores, hyphens)")
 	createBucketCmd.Flags().String("table", "", "table type (logs, events, or bizevents)")
 	createBucketCmd.Flags().Int("retention", 0, "retention period in days (1-3657)")
 	createBucketCmd.Flags().String("display-name", "", "display name for the bucket")
+	addIfNotExistsFlag(createBucketCmd)
+	addQuietFlag(createBucketCmd)
+	addWaitFlags(createBucketCmd)
 }
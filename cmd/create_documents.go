@@ -8,10 +8,10 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/dynatrace-oss/dtctl/pkg/apply"
 	"github.com/dynatrace-oss/dtctl/pkg/output"
 	"github.com/dynatrace-oss/dtctl/pkg/resources/document"
 	"github.com/dynatrace-oss/dtctl/pkg/safety"
-	"github.com/dynatrace-oss/dtctl/pkg/util/format"
 	"github.com/dynatrace-oss/dtctl/pkg/util/template"
 )
 
@@ -49,7 +49,7 @@ Examples:
 			if file != "" {
 				fileData, err := os.ReadFile(file)
 				if err == nil {
-					jsonData, err := format.ValidateAndConvert(fileData)
+					jsonData, err := convertInputFile(cmd, fileData)
 					if err == nil {
 						var doc map[string]interface{}
 						if err := json.Unmarshal(jsonData, &doc); err == nil {
@@ -129,6 +129,9 @@ Examples:
   # Provide a custom ID (useful for predictable IDs)
   dtctl create dashboard -f dashboard.yaml --id my.custom.dashboard-id
 
+  # Skip creation if a dashboard with the same ID or name exists
+  dtctl create dashboard -f dashboard.yaml --if-not-exists
+
 See also:
   dtctl apply --help    # For updating existing dashboards
   dtctl get dashboard --help    # For exporting dashboards
@@ -148,7 +151,6 @@ func createDocumentRunE(docType string) func(cmd *cobra.Command, args []string)
 		name, _ := cmd.Flags().GetString("name")
 		description, _ := cmd.Flags().GetString("description")
 		id, _ := cmd.Flags().GetString("id")
-		setFlags, _ := cmd.Flags().GetStringArray("set")
 
 		// Read the file
 		fileData, err := os.ReadFile(file)
@@ -157,18 +159,18 @@ func createDocumentRunE(docType string) func(cmd *cobra.Command, args []string)
 		}
 
 		// Convert to JSON if needed
-		jsonData, err := format.ValidateAndConvert(fileData)
+		jsonData, err := convertInputFile(cmd, fileData)
 		if err != nil {
-			return fmt.Errorf("invalid file format: %w", err)
+			return err
 		}
 
 		// Apply template rendering if variables provided
-		if len(setFlags) > 0 {
-			templateVars, err := template.ParseSetFlags(setFlags)
-			if err != nil {
-				return fmt.Errorf("invalid --set flag: %w", err)
-			}
-			rendered, err := template.RenderTemplate(string(jsonData), templateVars)
+		templateVars, err := templateVarsFromFlags(cmd)
+		if err != nil {
+			return fmt.Errorf("invalid template variable: %w", err)
+		}
+		if len(templateVars) > 0 {
+			rendered, err := template.RenderJSONTemplate(string(jsonData), templateVars)
 			if err != nil {
 				return fmt.Errorf("template rendering failed: %w", err)
 			}
@@ -209,6 +211,15 @@ func createDocumentRunE(docType string) func(cmd *cobra.Command, args []string)
 			name = fmt.Sprintf("Untitled %s", docType)
 		}
 
+		// The Documents API rejects UUID-formatted IDs during creation. As in
+		// apply, an exported UUID is dropped and the API generates a new ID;
+		// --if-not-exists still looks the original ID up.
+		createID := id
+		if apply.IsUUID(id) {
+			createID = ""
+			output.PrintInfo("Note: Creating new %s (UUID IDs cannot be reused across tenants)", docType)
+		}
+
 		// Count tiles/sections for feedback
 		tileCount := countDocumentItems(contentData, docType)
 
@@ -216,8 +227,8 @@ func createDocumentRunE(docType string) func(cmd *cobra.Command, args []string)
 		if dryRun {
 			output.PrintInfo("Dry run: would create %s", docType)
 			output.PrintInfo("  Name: %s", name)
-			if id != "" {
-				output.PrintInfo("  ID: %s", id)
+			if createID != "" {
+				output.PrintInfo("  ID: %s", createID)
 			}
 			if description != "" {
 				output.PrintInfo("  Description: %s", description)
@@ -238,8 +249,19 @@ func createDocumentRunE(docType string) func(cmd *cobra.Command, args []string)
 
 		handler := document.NewHandler(c)
 
+		if ifNotExists, _ := cmd.Flags().GetBool("if-not-exists"); ifNotExists {
+			existing, err := findExistingDocument(c, docType, id, name)
+			if err != nil {
+				return fmt.Errorf("failed to check for existing %s: %w", docType, err)
+			}
+			if existing != nil {
+				reportAlreadyExists(cmd, docType, existing)
+				return nil
+			}
+		}
+
 		result, err := handler.Create(document.CreateRequest{
-			ID:          id,
+			ID:          createID,
 			Name:        name,
 			Type:        docType,
 			Description: description,
@@ -256,12 +278,20 @@ func createDocumentRunE(docType string) func(cmd *cobra.Command, args []string)
 		}
 		resultID := result.ID
 		if resultID == "" {
-			resultID = id
+			resultID = createID
 			if resultID == "" {
 				resultID = "(ID not returned)"
 			}
 		}
 
+		if printQuietID(cmd, resultID) {
+			return nil
+		}
+
+		if handled, err := printCreateResult(CreateResult{ResourceType: docType, ID: resultID, Name: resultName, URL: documentURL(c.BaseURL(), docType, result.ID)}); handled {
+			return err
+		}
+
 		// Improved output formatting for better visibility
 		output.PrintSuccess("%s created", capitalize(docType))
 		output.PrintInfo("  Name: %s", resultName)
@@ -269,13 +299,22 @@ func createDocumentRunE(docType string) func(cmd *cobra.Command, args []string)
 		if tileCount > 0 {
 			output.PrintInfo("  %s: %d", capitalize(itemName(docType)), tileCount)
 		}
-		if result.ID != "" {
-			output.PrintInfo("  URL:  %s/ui/apps/dynatrace.%ss/%s/%s", c.BaseURL(), docType, docType, result.ID)
+		if url := documentURL(c.BaseURL(), docType, result.ID); url != "" {
+			output.PrintInfo("  URL:  %s", url)
 		}
 		return nil
 	}
 }
 
+// documentURL returns the UI link of a created document, or "" when the API
+// did not return an ID.
+func documentURL(baseURL, docType, id string) string {
+	if id == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/ui/apps/dynatrace.%ss/%s/%s", baseURL, docType, docType, id)
+}
+
 // extractDocumentContent extracts the content from a document, handling various input formats
 // Returns: contentData, name, description, warnings
 func extractDocumentContent(doc map[string]interface{}, docType string) ([]byte, string, string, []string) {
@@ -387,7 +426,10 @@ func init() {
 	createDocumentCmd.Flags().String("name", "", "name for the document (extracted from content if not provided)")
 	createDocumentCmd.Flags().String("description", "", "description for the document")
 	createDocumentCmd.Flags().String("id", "", "custom ID for the document (auto-generated if not provided)")
-	createDocumentCmd.Flags().StringArray("set", []string{}, "set template variable (key=value)")
+	addTemplateVarFlags(createDocumentCmd)
+	addInputFormatFlag(createDocumentCmd)
+	addIfNotExistsFlag(createDocumentCmd)
+	addQuietFlag(createDocumentCmd)
 	_ = createDocumentCmd.MarkFlagRequired("file")
 
 	// Notebook flags
@@ -395,7 +437,10 @@ func init() {
 	createNotebookCmd.Flags().String("name", "", "name for the notebook (extracted from content if not provided)")
 	createNotebookCmd.Flags().String("description", "", "description for the notebook")
 	createNotebookCmd.Flags().String("id", "", "custom ID for the notebook (auto-generated if not provided)")
-	createNotebookCmd.Flags().StringArray("set", []string{}, "set template variable (key=value)")
+	addTemplateVarFlags(createNotebookCmd)
+	addInputFormatFlag(createNotebookCmd)
+	addIfNotExistsFlag(createNotebookCmd)
+	addQuietFlag(createNotebookCmd)
 	_ = createNotebookCmd.MarkFlagRequired("file")
 
 	// Dashboard flags
@@ -403,6 +448,9 @@ func init() {
 	createDashboardCmd.Flags().String("name", "", "name for the dashboard (extracted from content if not provided)")
 	createDashboardCmd.Flags().String("description", "", "description for the dashboard")
 	createDashboardCmd.Flags().String("id", "", "custom ID for the dashboard (auto-generated if not provided)")
-	createDashboardCmd.Flags().StringArray("set", []string{}, "set template variable (key=value)")
+	addTemplateVarFlags(createDashboardCmd)
+	addInputFormatFlag(createDashboardCmd)
+	addIfNotExistsFlag(createDashboardCmd)
+	addQuietFlag(createDashboardCmd)
 	_ = createDashboardCmd.MarkFlagRequired("file")
 }
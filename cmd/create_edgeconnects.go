@@ -11,7 +11,6 @@ import (
 	"github.com/dynatrace-oss/dtctl/pkg/output"
 	"github.com/dynatrace-oss/dtctl/pkg/resources/edgeconnect"
 	"github.com/dynatrace-oss/dtctl/pkg/safety"
-	"github.com/dynatrace-oss/dtctl/pkg/util/format"
 )
 
 // createEdgeConnectCmd creates an EdgeConnect
@@ -45,9 +44,9 @@ Examples:
 				return fmt.Errorf("failed to read file: %w", err)
 			}
 
-			jsonData, err := format.ValidateAndConvert(fileData)
+			jsonData, err := convertInputFile(cmd, fileData)
 			if err != nil {
-				return fmt.Errorf("invalid file format: %w", err)
+				return err
 			}
 
 			if err := json.Unmarshal(jsonData, &req); err != nil {
@@ -90,12 +89,33 @@ Examples:
 
 		handler := edgeconnect.NewHandler(c)
 
+		if ifNotExists, _ := cmd.Flags().GetBool("if-not-exists"); ifNotExists {
+			existing, err := findExistingEdgeConnect(c, req.Name)
+			if err != nil {
+				return fmt.Errorf("failed to check for existing EdgeConnect: %w", err)
+			}
+			if existing != nil {
+				reportAlreadyExists(cmd, "EdgeConnect", existing)
+				return nil
+			}
+		}
+
 		result, err := handler.Create(req)
 		if err != nil {
 			return fmt.Errorf("failed to create EdgeConnect: %w", err)
 		}
 
-		output.PrintSuccess("EdgeConnect %q created (ID: %s)", result.Name, result.ID)
+		// The OAuth secret is only returned once, so it is still shown (on
+		// stderr) with --quiet and structured output.
+		if !printQuietID(cmd, result.ID) {
+			handled, err := printCreateResult(CreateResult{ResourceType: "edgeconnect", ID: result.ID, Name: result.Name})
+			if err != nil {
+				return err
+			}
+			if !handled {
+				output.PrintSuccess("EdgeConnect %q created (ID: %s)", result.Name, result.ID)
+			}
+		}
 		if result.OAuthClientSecret != "" {
 			output.PrintInfo("\nOAuth Client Credentials (save these, the secret won't be shown again):")
 			output.PrintInfo("  Client ID:     %s", result.OAuthClientID)
@@ -111,6 +131,9 @@ Examples:
 func init() {
 	// EdgeConnect flags
 	createEdgeConnectCmd.Flags().StringP("file", "f", "", "file containing EdgeConnect definition")
+	addInputFormatFlag(createEdgeConnectCmd)
 	createEdgeConnectCmd.Flags().String("name", "", "EdgeConnect name (RFC 1123 compliant, max 50 chars)")
 	createEdgeConnectCmd.Flags().String("host-patterns", "", "comma-separated list of host patterns")
+	addIfNotExistsFlag(createEdgeConnectCmd)
+	addQuietFlag(createEdgeConnectCmd)
 }
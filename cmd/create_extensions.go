@@ -39,6 +39,9 @@ Examples:
   # Install a specific version of a Hub extension
   dtctl create extension --hub-extension com.dynatrace.extension.host-monitoring --version 1.2.3
 
+  # Skip the install when that extension version is already present
+  dtctl create extension -f my-extension.zip --if-not-exists
+
   # Preview what would be installed (dry run)
   dtctl create extension -f my-extension.zip --dry-run
 `,
@@ -59,13 +62,13 @@ Examples:
 		}
 
 		if file != "" {
-			return runUploadExtension(file)
+			return runUploadExtension(cmd, file)
 		}
-		return runInstallHubExtension(hubExtension, version)
+		return runInstallHubExtension(cmd, hubExtension, version)
 	},
 }
 
-func runUploadExtension(file string) error {
+func runUploadExtension(cmd *cobra.Command, file string) error {
 	// Read the zip file
 	zipData, err := os.ReadFile(file)
 	if err != nil {
@@ -82,19 +85,42 @@ func runUploadExtension(file string) error {
 		return err
 	}
 
+	if ifNotExists, _ := cmd.Flags().GetBool("if-not-exists"); ifNotExists {
+		name, version, err := extension.PackageIdentity(zipData)
+		if err != nil {
+			return fmt.Errorf("failed to read extension package %q: %w", file, err)
+		}
+		existing, err := findExistingExtension(c, name, version)
+		if err != nil {
+			return fmt.Errorf("failed to check for existing extension: %w", err)
+		}
+		if existing != nil {
+			reportAlreadyExists(cmd, "extension", existing)
+			return nil
+		}
+	}
+
 	handler := extension.NewHandler(c)
 	result, err := handler.Upload(filepath.Base(file), zipData)
 	if err != nil {
 		return err
 	}
 
+	if printQuietID(cmd, result.ExtensionName) {
+		return nil
+	}
+
+	if handled, err := printCreateResult(CreateResult{ResourceType: "extension", ID: result.ExtensionName, Name: result.ExtensionName}); handled {
+		return err
+	}
+
 	output.PrintSuccess("Extension uploaded")
 	output.PrintInfo("  Name:    %s", result.ExtensionName)
 	output.PrintInfo("  Version: %s", result.Version)
 	return nil
 }
 
-func runInstallHubExtension(extensionID, version string) error {
+func runInstallHubExtension(cmd *cobra.Command, extensionID, version string) error {
 	if dryRun {
 		if version != "" {
 			fmt.Printf("Dry run: would install Hub extension %q version %s\n", extensionID, version)
@@ -109,12 +135,31 @@ func runInstallHubExtension(extensionID, version string) error {
 		return err
 	}
 
+	if ifNotExists, _ := cmd.Flags().GetBool("if-not-exists"); ifNotExists {
+		existing, err := findExistingExtension(c, extensionID, version)
+		if err != nil {
+			return fmt.Errorf("failed to check for existing extension: %w", err)
+		}
+		if existing != nil {
+			reportAlreadyExists(cmd, "extension", existing)
+			return nil
+		}
+	}
+
 	handler := extension.NewHandler(c)
 	result, err := handler.InstallFromHub(extensionID, version)
 	if err != nil {
 		return err
 	}
 
+	if printQuietID(cmd, result.ExtensionName) {
+		return nil
+	}
+
+	if handled, err := printCreateResult(CreateResult{ResourceType: "extension", ID: result.ExtensionName, Name: result.ExtensionName}); handled {
+		return err
+	}
+
 	output.PrintSuccess("Hub extension installed")
 	output.PrintInfo("  Name:    %s", result.ExtensionName)
 	output.PrintInfo("  Version: %s", result.Version)
@@ -125,4 +170,6 @@ func init() {
 	createExtensionCmd.Flags().StringP("file", "f", "", "path to the extension zip file (for custom extension upload)")
 	createExtensionCmd.Flags().String("hub-extension", "", "Hub extension catalog ID to install (e.g. com.dynatrace.extension.host-monitoring)")
 	createExtensionCmd.Flags().String("version", "", "version to install (only for --hub-extension; defaults to latest)")
+	addIfNotExistsFlag(createExtensionCmd)
+	addQuietFlag(createExtensionCmd)
 }
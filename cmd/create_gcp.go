@@ -48,6 +48,18 @@ Examples:
 		}
 
 		handler := gcpconnection.NewHandler(c)
+
+		if ifNotExists, _ := cmd.Flags().GetBool("if-not-exists"); ifNotExists {
+			existing, err := findExistingGCPConnection(c, createGCPConnectionName)
+			if err != nil {
+				return fmt.Errorf("failed to check for existing GCP connection: %w", err)
+			}
+			if existing != nil {
+				reportAlreadyExists(cmd, "GCP connection", existing)
+				return nil
+			}
+		}
+
 		value := gcpconnection.Value{
 			Name: createGCPConnectionName,
 			Type: "serviceAccountImpersonation",
@@ -63,6 +75,14 @@ Examples:
 			return err
 		}
 
+		if printQuietID(cmd, created.ObjectID) {
+			return nil
+		}
+
+		if handled, err := printCreateResult(CreateResult{ResourceType: "gcp_connection", ID: created.ObjectID, Name: createGCPConnectionName}); handled {
+			return err
+		}
+
 		output.PrintSuccess("GCP connection created: %s", created.ObjectID)
 		printGCPPrincipalHint(handler, createGCPConnectionServiceAccountID)
 		return nil
@@ -154,6 +174,17 @@ Examples:
 			return err
 		}
 
+		if ifNotExists, _ := cmd.Flags().GetBool("if-not-exists"); ifNotExists {
+			existing, err := findExistingGCPMonitoringConfig(c, createGCPMonitoringConfigName)
+			if err != nil {
+				return fmt.Errorf("failed to check for existing GCP monitoring config: %w", err)
+			}
+			if existing != nil {
+				reportAlreadyExists(cmd, "GCP monitoring config", existing)
+				return nil
+			}
+		}
+
 		connectionHandler := gcpconnection.NewHandler(c)
 		monitoringHandler := gcpmonitoringconfig.NewHandler(c)
 
@@ -211,6 +242,14 @@ Examples:
 			return err
 		}
 
+		if printQuietID(cmd, created.ObjectID) {
+			return nil
+		}
+
+		if handled, err := printCreateResult(CreateResult{ResourceType: "gcp_monitoring_config", ID: created.ObjectID, Name: createGCPMonitoringConfigName}); handled {
+			return err
+		}
+
 		output.PrintSuccess("GCP monitoring config created (disabled): %s", created.ObjectID)
 		output.PrintInfo("Run 'dtctl enable gcp monitoring --name %q' to enable it", createGCPMonitoringConfigName)
 		return nil
@@ -232,4 +271,9 @@ func init() {
 	createGCPMonitoringConfigCmd.Flags().StringVar(&createGCPMonitoringConfigFeatureSets, "featuresets", "", "Alias for --featureSets")
 	_ = createGCPMonitoringConfigCmd.MarkFlagRequired("name")
 	_ = createGCPMonitoringConfigCmd.MarkFlagRequired("credentials")
+
+	addIfNotExistsFlag(createGCPConnectionCmd)
+	addIfNotExistsFlag(createGCPMonitoringConfigCmd)
+	addQuietFlag(createGCPConnectionCmd)
+	addQuietFlag(createGCPMonitoringConfigCmd)
 }
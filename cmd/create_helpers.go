@@ -0,0 +1,356 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dynatrace-oss/dtctl/pkg/client"
+	"github.com/dynatrace-oss/dtctl/pkg/output"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/awsconnection"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/awsmonitoringconfig"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/azureconnection"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/azuremonitoringconfig"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/document"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/edgeconnect"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/extension"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/gcpconnection"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/gcpmonitoringconfig"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/resolver"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/settings"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/slo"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/workflow"
+	"github.com/dynatrace-oss/dtctl/sdk/httpclient"
+)
+
+// existingResource identifies a resource found by a create --if-not-exists check.
+type existingResource struct {
+	ID   string
+	Name string
+}
+
+// addIfNotExistsFlag registers --if-not-exists on a create command.
+func addIfNotExistsFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("if-not-exists", false, "exit successfully without changes if the resource already exists")
+}
+
+// defaultWaitTimeout bounds --wait when --wait-timeout is not given.
+const defaultWaitTimeout = 5 * time.Minute
+
+// addWaitFlags registers --wait and --wait-timeout for commands that
+// provision resources asynchronously. Buckets are the only such resource
+// apply and create handle; apps are installed outside dtctl, so there is no
+// app install to wait for.
+func addWaitFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("wait", false, "wait until a created or updated bucket is active (buckets are the only asynchronously provisioned resource)")
+	cmd.Flags().Duration("wait-timeout", defaultWaitTimeout, "maximum time to wait with --wait")
+}
+
+// addQuietFlag registers -q/--quiet on a create command.
+func addQuietFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolP("quiet", "q", false, "print only the resulting resource ID")
+}
+
+// printQuietID prints id on stdout when --quiet is set and reports whether it
+// did, so the caller can skip its decorative output. Status messages go to
+// stderr anyway; this keeps stdout down to the bare ID for
+// WF_ID=$(dtctl create workflow -f wf.yaml -q).
+func printQuietID(cmd *cobra.Command, id string) bool {
+	quiet, _ := cmd.Flags().GetBool("quiet")
+	if !quiet {
+		return false
+	}
+	fmt.Println(id)
+	return true
+}
+
+// CreateResult is what create commands print for structured output formats
+// (-o json|yaml|csv|toon), instead of the human-readable summary on stderr.
+type CreateResult struct {
+	ResourceType string `json:"resourceType"  yaml:"resourceType"  table:"TYPE"`
+	ID           string `json:"id"            yaml:"id"            table:"ID"`
+	Name         string `json:"name,omitempty" yaml:"name,omitempty" table:"NAME"`
+	URL          string `json:"url,omitempty"  yaml:"url,omitempty"  table:"URL,wide"`
+}
+
+// printCreateResult prints result with the -o printer when a structured format
+// was requested and reports whether it did. Table output keeps each command's
+// own summary, so callers return early only when handled is true.
+func printCreateResult(result CreateResult) (handled bool, err error) {
+	switch outputFormat {
+	case "", "table", "wide":
+		return false, nil
+	}
+	printer := NewPrinter()
+	enrichAgent(printer, "create", result.ResourceType)
+	return true, printer.Print(result)
+}
+
+// reportAlreadyExists prints the --if-not-exists notice for a resource that
+// was found, so re-running a provisioning script is a visible no-op. With
+// --quiet the existing ID is printed instead, like a fresh create would.
+func reportAlreadyExists(cmd *cobra.Command, kind string, existing *existingResource) {
+	if printQuietID(cmd, existing.ID) {
+		return
+	}
+	switch {
+	case existing.Name != "" && existing.ID != "" && existing.Name != existing.ID:
+		output.PrintInfo("%s %q already exists (ID: %s)", capitalize(kind), existing.Name, existing.ID)
+	case existing.Name != "":
+		output.PrintInfo("%s %q already exists", capitalize(kind), existing.Name)
+	default:
+		output.PrintInfo("%s %s already exists", capitalize(kind), existing.ID)
+	}
+}
+
+// isNotFoundError reports whether err is an HTTP 404 from the API.
+func isNotFoundError(err error) bool {
+	return errors.Is(err, httpclient.ErrNotFound)
+}
+
+// singleExisting narrows exact name matches to one existing resource. More
+// than one match still means "exists", but the create is skipped against the
+// first so the caller can report an ID.
+func singleExisting(matches []resolver.Resource) *existingResource {
+	if len(matches) == 0 {
+		return nil
+	}
+	return &existingResource{ID: matches[0].ID, Name: matches[0].Name}
+}
+
+// findExistingWorkflow looks a workflow up by ID when the definition carries
+// one, otherwise by exact title.
+func findExistingWorkflow(c *client.Client, id, title string) (*existingResource, error) {
+	if id != "" {
+		wf, err := workflow.NewHandler(c).Get(id)
+		if err == nil {
+			return &existingResource{ID: wf.ID, Name: wf.Title}, nil
+		}
+		if !isNotFoundError(err) {
+			return nil, err
+		}
+	}
+	if title == "" {
+		return nil, nil
+	}
+	matches, err := resolver.NewResolver(c).FindExact(resolver.TypeWorkflow, title)
+	if err != nil {
+		return nil, err
+	}
+	return singleExisting(matches), nil
+}
+
+// findExistingSLO looks an SLO up by ID when the definition carries one,
+// otherwise by exact name.
+func findExistingSLO(c *client.Client, id, name string) (*existingResource, error) {
+	handler := slo.NewHandler(c)
+	if id != "" {
+		s, err := handler.Get(id)
+		if err == nil {
+			return &existingResource{ID: s.ID, Name: s.Name}, nil
+		}
+		if !isNotFoundError(err) {
+			return nil, err
+		}
+	}
+	if name == "" {
+		return nil, nil
+	}
+	list, err := handler.ListWithOptions("", allPageOptions())
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range list.SLOs {
+		if strings.EqualFold(s.Name, name) {
+			return &existingResource{ID: s.ID, Name: s.Name}, nil
+		}
+	}
+	return nil, nil
+}
+
+// findExistingDocument looks a document up by ID when one is given, otherwise
+// by exact name within the document type.
+func findExistingDocument(c *client.Client, docType, id, name string) (*existingResource, error) {
+	handler := document.NewHandler(c)
+	if id != "" {
+		meta, err := handler.GetMetadata(id)
+		if err == nil {
+			return &existingResource{ID: meta.ID, Name: meta.Name}, nil
+		}
+		if !isNotFoundError(err) {
+			return nil, err
+		}
+	}
+	if name == "" {
+		return nil, nil
+	}
+	list, err := handler.List(document.DocumentFilters{Type: docType, Name: name, AllPages: true})
+	if err != nil {
+		return nil, err
+	}
+	for _, doc := range list.Documents {
+		if strings.EqualFold(doc.Name, name) {
+			return &existingResource{ID: doc.ID, Name: doc.Name}, nil
+		}
+	}
+	return nil, nil
+}
+
+// findExistingEdgeConnect looks an EdgeConnect up by exact name.
+func findExistingEdgeConnect(c *client.Client, name string) (*existingResource, error) {
+	list, err := edgeconnect.NewHandler(c).List()
+	if err != nil {
+		return nil, err
+	}
+	for _, ec := range list.EdgeConnects {
+		if ec.Name == name {
+			return &existingResource{ID: ec.ID, Name: ec.Name}, nil
+		}
+	}
+	return nil, nil
+}
+
+// findExistingSettings looks for a settings object in schemaID and scope
+// whose value equals value. Settings objects carry no name, so an identical
+// value is the only reliable sign the create already happened.
+func findExistingSettings(c *client.Client, schemaID, scope string, value map[string]any) (*existingResource, error) {
+	list, err := settings.NewHandler(c).ListObjectsWithOptions(schemaID, scope, "", allPageOptions())
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range list.Items {
+		if reflect.DeepEqual(obj.Value, value) {
+			return &existingResource{ID: obj.ObjectID, Name: obj.Summary}, nil
+		}
+	}
+	return nil, nil
+}
+
+// findExistingAWSConnection looks an AWS connection up by exact name.
+func findExistingAWSConnection(c *client.Client, name string) (*existingResource, error) {
+	items, err := awsconnection.NewHandler(c).List()
+	if err != nil {
+		return nil, err
+	}
+	for _, conn := range items {
+		if conn.Name == name {
+			return &existingResource{ID: conn.ObjectID, Name: conn.Name}, nil
+		}
+	}
+	return nil, nil
+}
+
+// findExistingAWSMonitoringConfig looks an AWS monitoring config up by its
+// description, which serves as its name.
+func findExistingAWSMonitoringConfig(c *client.Client, name string) (*existingResource, error) {
+	items, err := awsmonitoringconfig.NewHandler(c).List()
+	if err != nil {
+		return nil, err
+	}
+	for _, mc := range items {
+		if mc.Description == name {
+			return &existingResource{ID: mc.ObjectID, Name: mc.Description}, nil
+		}
+	}
+	return nil, nil
+}
+
+// findExistingAzureConnection looks an Azure connection up by exact name.
+func findExistingAzureConnection(c *client.Client, name string) (*existingResource, error) {
+	items, err := azureconnection.NewHandler(c).List()
+	if err != nil {
+		return nil, err
+	}
+	for _, conn := range items {
+		if conn.Name == name {
+			return &existingResource{ID: conn.ObjectID, Name: conn.Name}, nil
+		}
+	}
+	return nil, nil
+}
+
+// findExistingAzureMonitoringConfig looks an Azure monitoring config up by
+// its description, which serves as its name.
+func findExistingAzureMonitoringConfig(c *client.Client, name string) (*existingResource, error) {
+	items, err := azuremonitoringconfig.NewHandler(c).List()
+	if err != nil {
+		return nil, err
+	}
+	for _, mc := range items {
+		if mc.Description == name {
+			return &existingResource{ID: mc.ObjectID, Name: mc.Description}, nil
+		}
+	}
+	return nil, nil
+}
+
+// findExistingGCPConnection looks a GCP connection up by exact name.
+func findExistingGCPConnection(c *client.Client, name string) (*existingResource, error) {
+	items, err := gcpconnection.NewHandler(c).List()
+	if err != nil {
+		return nil, err
+	}
+	for _, conn := range items {
+		if conn.Name == name {
+			return &existingResource{ID: conn.ObjectID, Name: conn.Name}, nil
+		}
+	}
+	return nil, nil
+}
+
+// findExistingGCPMonitoringConfig looks a GCP monitoring config up by its
+// description, which serves as its name.
+func findExistingGCPMonitoringConfig(c *client.Client, name string) (*existingResource, error) {
+	items, err := gcpmonitoringconfig.NewHandler(c).List()
+	if err != nil {
+		return nil, err
+	}
+	for _, mc := range items {
+		if mc.Description == name {
+			return &existingResource{ID: mc.ObjectID, Name: mc.Description}, nil
+		}
+	}
+	return nil, nil
+}
+
+// findExistingExtension looks an installed extension up by exact name. With a
+// version, that version must also be installed; otherwise any version counts.
+func findExistingExtension(c *client.Client, name, version string) (*existingResource, error) {
+	handler := extension.NewHandler(c)
+	// The extensions list stops after one page at chunk size 0.
+	pageSize := allPageOptions().PageSize
+	if pageSize == 0 {
+		pageSize = defaultChunkSize
+	}
+	list, err := handler.List(name, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	found := false
+	for _, ext := range list.Items {
+		if ext.ExtensionName == name {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, nil
+	}
+	if version == "" {
+		return &existingResource{ID: name, Name: name}, nil
+	}
+	versions, err := handler.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range versions.Items {
+		if v.Version == version {
+			return &existingResource{ID: name, Name: fmt.Sprintf("%s %s", name, version)}, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,415 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+	"github.com/dynatrace-oss/dtctl/pkg/client"
+)
+
+func TestCreateBucketIfNotExists_Existing(t *testing.T) {
+	created := false
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/storage/management/v1/bucket-definitions/custom_logs": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"bucketName":"custom_logs","table":"logs","status":"active","retentionDays":35}`))
+		},
+		"/platform/storage/management/v1/bucket-definitions": func(w http.ResponseWriter, r *http.Request) {
+			created = true
+			w.WriteHeader(http.StatusConflict)
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile := cfgFile
+	defer func() { cfgFile = origCfgFile }()
+	cfgFile = configPath
+
+	testutil.ResetCommandFlags(createBucketCmd)
+	_ = createBucketCmd.Flags().Set("name", "custom_logs")
+	_ = createBucketCmd.Flags().Set("table", "logs")
+	_ = createBucketCmd.Flags().Set("retention", "35")
+	_ = createBucketCmd.Flags().Set("if-not-exists", "true")
+
+	if err := createBucketCmd.RunE(createBucketCmd, nil); err != nil {
+		t.Fatalf("RunE() error = %v, want nil for existing bucket", err)
+	}
+	if created {
+		t.Error("bucket create was called although the bucket exists")
+	}
+}
+
+func TestCreateBucketIfNotExists_Missing(t *testing.T) {
+	created := false
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/storage/management/v1/bucket-definitions/custom_logs": func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write(testutil.ErrorResponse(404, "bucket not found"))
+		},
+		"/platform/storage/management/v1/bucket-definitions": func(w http.ResponseWriter, r *http.Request) {
+			created = true
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"bucketName":"custom_logs","table":"logs","status":"creating","retentionDays":35}`))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile := cfgFile
+	defer func() { cfgFile = origCfgFile }()
+	cfgFile = configPath
+
+	testutil.ResetCommandFlags(createBucketCmd)
+	_ = createBucketCmd.Flags().Set("name", "custom_logs")
+	_ = createBucketCmd.Flags().Set("table", "logs")
+	_ = createBucketCmd.Flags().Set("retention", "35")
+	_ = createBucketCmd.Flags().Set("if-not-exists", "true")
+
+	if err := createBucketCmd.RunE(createBucketCmd, nil); err != nil {
+		t.Fatalf("RunE() error = %v", err)
+	}
+	if !created {
+		t.Error("bucket create was not called for a missing bucket")
+	}
+}
+
+func TestCreateWorkflowIfNotExists_ExistingTitle(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/automation/v1/workflows": func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost {
+				t.Error("workflow create was called although a workflow with the title exists")
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(testutil.WorkflowListResponse())
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile := cfgFile
+	defer func() { cfgFile = origCfgFile }()
+	cfgFile = configPath
+
+	wfFile := testutil.CreateTempFile(t, "title: Test Workflow 2\ntasks: {}\n", "workflow-*.yaml")
+
+	testutil.ResetCommandFlags(createWorkflowCmd)
+	_ = createWorkflowCmd.Flags().Set("file", wfFile)
+	_ = createWorkflowCmd.Flags().Set("if-not-exists", "true")
+
+	if err := createWorkflowCmd.RunE(createWorkflowCmd, nil); err != nil {
+		t.Fatalf("RunE() error = %v", err)
+	}
+}
+
+func TestCreateBucketQuiet_PrintsOnlyName(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/storage/management/v1/bucket-definitions": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"bucketName":"custom_logs","table":"logs","status":"creating","retentionDays":35}`))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile := cfgFile
+	defer func() { cfgFile = origCfgFile }()
+	cfgFile = configPath
+
+	testutil.ResetCommandFlags(createBucketCmd)
+	_ = createBucketCmd.Flags().Set("name", "custom_logs")
+	_ = createBucketCmd.Flags().Set("table", "logs")
+	_ = createBucketCmd.Flags().Set("retention", "35")
+	_ = createBucketCmd.Flags().Set("quiet", "true")
+
+	var runErr error
+	out := captureStdout(t, func() {
+		runErr = createBucketCmd.RunE(createBucketCmd, nil)
+	})
+	if runErr != nil {
+		t.Fatalf("RunE() error = %v", runErr)
+	}
+	if out != "custom_logs\n" {
+		t.Errorf("stdout = %q, want only the bucket name", out)
+	}
+}
+
+func TestCreateWorkflow_JSONOutput(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/automation/v1/workflows": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"wf-123","title":"Nightly"}`))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile := cfgFile
+	origOutput := outputFormat
+	defer func() {
+		cfgFile = origCfgFile
+		outputFormat = origOutput
+	}()
+	wfFile := testutil.CreateTempFile(t, "title: Nightly\ntasks: {}\n", "workflow-*.yaml")
+
+	testutil.ResetCommandFlags(createWorkflowCmd)
+	cfgFile = configPath
+	outputFormat = "json"
+	agentMode = false
+	_ = createWorkflowCmd.Flags().Set("file", wfFile)
+
+	var runErr error
+	out := captureStdout(t, func() {
+		runErr = createWorkflowCmd.RunE(createWorkflowCmd, nil)
+	})
+	if runErr != nil {
+		t.Fatalf("RunE() error = %v", runErr)
+	}
+
+	var got CreateResult
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("stdout is not a JSON create result: %v\n%s", err, out)
+	}
+	if got.ResourceType != "workflow" || got.ID != "wf-123" || got.Name != "Nightly" {
+		t.Errorf("unexpected result: %+v\n%s", got, out)
+	}
+	if !strings.HasSuffix(got.URL, "/ui/apps/dynatrace.automations/workflows/wf-123") {
+		t.Errorf("URL = %q", got.URL)
+	}
+}
+
+func TestCreateDashboard_DropsUUIDID(t *testing.T) {
+	var sentID string
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/document/v1/documents": func(w http.ResponseWriter, r *http.Request) {
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Errorf("ParseMultipartForm() error = %v", err)
+			}
+			sentID = r.FormValue("id")
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"new-dash-id","name":"Exported","type":"dashboard","version":1}`))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	file := filepath.Join(t.TempDir(), "dashboard.yaml")
+	content := "id: 3f2b8c1e-1d2a-4b5c-9e8f-0a1b2c3d4e5f\nname: Exported\ntype: dashboard\ncontent:\n  version: 15\n  tiles: {}\n"
+	if err := os.WriteFile(file, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	origCfgFile := cfgFile
+	defer func() {
+		testutil.ResetCommandFlags(createDashboardCmd)
+		cfgFile = origCfgFile
+	}()
+	testutil.ResetCommandFlags(createDashboardCmd)
+	cfgFile = configPath
+	_ = createDashboardCmd.Flags().Set("file", file)
+
+	if err := createDashboardCmd.RunE(createDashboardCmd, nil); err != nil {
+		t.Fatalf("RunE() error = %v", err)
+	}
+	if sentID != "" {
+		t.Errorf("UUID id %q was sent to the API, want it dropped", sentID)
+	}
+}
+
+func TestCreateSettingsIfNotExists(t *testing.T) {
+	tests := []struct {
+		name        string
+		existing    string
+		wantCreated bool
+	}{
+		{name: "identical value skips the create", existing: `{"key":"value","nested":{"n":1}}`, wantCreated: false},
+		{name: "different value still creates", existing: `{"key":"other","nested":{"n":1}}`, wantCreated: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			created := false
+			ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+				"/platform/classic/environment-api/v2/settings/objects": func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					if r.Method == http.MethodPost {
+						created = true
+						_, _ = w.Write([]byte(`[{"code":200,"objectId":"obj-new"}]`))
+						return
+					}
+					_, _ = w.Write([]byte(`{"items":[{"objectId":"obj-1","summary":"first","value":` + tt.existing + `}],"totalCount":1}`))
+				},
+			})
+			defer ms.Close()
+
+			configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+			defer cleanup()
+
+			origCfgFile := cfgFile
+			defer func() { cfgFile = origCfgFile }()
+			cfgFile = configPath
+
+			settingsFile := testutil.CreateTempFile(t, "key: value\nnested:\n  n: 1\n", "settings-*.yaml")
+
+			testutil.ResetCommandFlags(createSettingsCmd)
+			_ = createSettingsCmd.Flags().Set("file", settingsFile)
+			_ = createSettingsCmd.Flags().Set("schema", "builtin:alerting.profile")
+			_ = createSettingsCmd.Flags().Set("scope", "environment")
+			_ = createSettingsCmd.Flags().Set("if-not-exists", "true")
+
+			if err := createSettingsCmd.RunE(createSettingsCmd, nil); err != nil {
+				t.Fatalf("RunE() error = %v", err)
+			}
+			if created != tt.wantCreated {
+				t.Errorf("created = %v, want %v", created, tt.wantCreated)
+			}
+		})
+	}
+}
+
+func TestCreateAWSConnectionIfNotExists_Existing(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/classic/environment-api/v2/settings/objects": func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost {
+				t.Error("AWS connection create was called although the connection exists")
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"items":[{"objectId":"aws-1","value":{"name":"my-aws","type":"awsRoleBasedAuthentication"}}]}`))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile, origName := cfgFile, createAWSConnectionName
+	defer func() { cfgFile, createAWSConnectionName = origCfgFile, origName }()
+	cfgFile = configPath
+
+	testutil.ResetCommandFlags(createAWSConnectionCmd)
+	_ = createAWSConnectionCmd.Flags().Set("name", "my-aws")
+	_ = createAWSConnectionCmd.Flags().Set("if-not-exists", "true")
+	_ = createAWSConnectionCmd.Flags().Set("quiet", "true")
+
+	out := captureStdout(t, func() {
+		if err := createAWSConnectionCmd.RunE(createAWSConnectionCmd, nil); err != nil {
+			t.Fatalf("RunE() error = %v", err)
+		}
+	})
+	if strings.TrimSpace(out) != "aws-1" {
+		t.Errorf("stdout = %q, want the existing ID", out)
+	}
+}
+
+func TestCreateExtensionIfNotExists_HubVersion(t *testing.T) {
+	tests := []struct {
+		name          string
+		version       string
+		wantInstalled bool
+	}{
+		{name: "installed version skips the install", version: "1.2.3", wantInstalled: false},
+		{name: "any version when none is given", version: "", wantInstalled: false},
+		{name: "other version still installs", version: "2.0.0", wantInstalled: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			installed := false
+			ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+				"/platform/extensions/v2/extensions": func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write([]byte(`{"items":[{"extensionName":"com.example.ext","version":"1.2.3"}],"totalCount":1}`))
+				},
+				"/platform/extensions/v2/extensions/com.example.ext": func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "application/json")
+					if r.Method == http.MethodPost {
+						installed = true
+						_, _ = w.Write([]byte(`{"extensionName":"com.example.ext","version":"2.0.0"}`))
+						return
+					}
+					_, _ = w.Write([]byte(`{"items":[{"extensionName":"com.example.ext","version":"1.2.3"}],"totalCount":1}`))
+				},
+			})
+			defer ms.Close()
+
+			configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+			defer cleanup()
+
+			// Reset first: once the root's persistent flags are merged in,
+			// resetting also clears --config and with it cfgFile.
+			testutil.ResetCommandFlags(createExtensionCmd)
+			origCfgFile := cfgFile
+			defer func() { cfgFile = origCfgFile }()
+			cfgFile = configPath
+
+			_ = createExtensionCmd.Flags().Set("hub-extension", "com.example.ext")
+			if tt.version != "" {
+				_ = createExtensionCmd.Flags().Set("version", tt.version)
+			}
+			_ = createExtensionCmd.Flags().Set("if-not-exists", "true")
+
+			if err := createExtensionCmd.RunE(createExtensionCmd, nil); err != nil {
+				t.Fatalf("RunE() error = %v", err)
+			}
+			if installed != tt.wantInstalled {
+				t.Errorf("install attempted = %v, want %v", installed, tt.wantInstalled)
+			}
+		})
+	}
+}
+
+func TestFindExistingSLO_SecondPage(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/slo/v1/slos": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Query().Get("page-key") == "" {
+				_, _ = w.Write([]byte(`{"slos":[{"id":"slo-1","name":"Availability"}],"totalCount":2,"nextPageKey":"p2"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"slos":[{"id":"slo-2","name":"Latency"}],"totalCount":2}`))
+		},
+	})
+	defer ms.Close()
+
+	c, err := client.NewForTesting(ms.URL, "test-token")
+	if err != nil {
+		t.Fatalf("client.NewForTesting: %v", err)
+	}
+
+	// --no-paginate only limits what get displays; the lookup must still see
+	// every page or --if-not-exists creates a duplicate.
+	origNoPaginate := noPaginate
+	defer func() { noPaginate = origNoPaginate }()
+	noPaginate = true
+
+	got, err := findExistingSLO(c, "", "latency")
+	if err != nil {
+		t.Fatalf("findExistingSLO() error = %v", err)
+	}
+	if got == nil || got.ID != "slo-2" {
+		t.Errorf("findExistingSLO() = %+v, want slo-2 from the second page", got)
+	}
+}
@@ -136,11 +136,30 @@ Examples:
 
 		handler := lookup.NewHandler(c)
 
+		if ifNotExists, _ := cmd.Flags().GetBool("if-not-exists"); ifNotExists {
+			exists, err := handler.Exists(path)
+			if err != nil {
+				return fmt.Errorf("failed to check for existing lookup table: %w", err)
+			}
+			if exists {
+				reportAlreadyExists(cmd, "lookup table", &existingResource{ID: path, Name: path})
+				return nil
+			}
+		}
+
 		result, err := handler.Create(req)
 		if err != nil {
 			return fmt.Errorf("failed to create lookup table: %w", err)
 		}
 
+		if printQuietID(cmd, path) {
+			return nil
+		}
+
+		if handled, err := printCreateResult(CreateResult{ResourceType: "lookup", ID: path, Name: path}); handled {
+			return err
+		}
+
 		output.PrintSuccess("Lookup table %q created", path)
 		output.PrintInfo("  Records: %d", result.Records)
 		output.PrintInfo("  File Size: %d bytes", result.FileSize)
@@ -162,5 +181,7 @@ func init() {
 	createLookupCmd.Flags().Int("skip-records", 0, "number of records to skip (e.g., 1 for CSV headers)")
 	createLookupCmd.Flags().String("timezone", "UTC", "timezone for parsing time/date fields")
 	createLookupCmd.Flags().String("locale", "en_US", "locale for parsing locale-specific data")
+	addIfNotExistsFlag(createLookupCmd)
+	addQuietFlag(createLookupCmd)
 	_ = createLookupCmd.MarkFlagRequired("file")
 }
@@ -8,9 +8,9 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/dynatrace-oss/dtctl/pkg/output"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/resolver"
 	"github.com/dynatrace-oss/dtctl/pkg/resources/segment"
 	"github.com/dynatrace-oss/dtctl/pkg/safety"
-	"github.com/dynatrace-oss/dtctl/pkg/util/format"
 )
 
 // createSegmentCmd creates a Grail filter segment
@@ -43,9 +43,9 @@ Examples:
 			return fmt.Errorf("failed to read file: %w", err)
 		}
 
-		jsonData, err := format.ValidateAndConvert(fileData)
+		jsonData, err := convertInputFile(cmd, fileData)
 		if err != nil {
-			return fmt.Errorf("invalid file format: %w", err)
+			return err
 		}
 
 		// Handle dry-run
@@ -76,11 +76,46 @@ Examples:
 
 		handler := segment.NewHandler(c)
 
+		if ifNotExists, _ := cmd.Flags().GetBool("if-not-exists"); ifNotExists {
+			var seg map[string]interface{}
+			if err := json.Unmarshal(jsonData, &seg); err != nil {
+				return fmt.Errorf("failed to parse segment definition: %w", err)
+			}
+			if uid, _ := seg["uid"].(string); uid != "" {
+				existing, err := handler.Get(uid)
+				if err == nil {
+					reportAlreadyExists(cmd, "segment", &existingResource{ID: existing.UID, Name: existing.Name})
+					return nil
+				}
+				if !segment.IsNotFound(err) {
+					return fmt.Errorf("failed to check for existing segment: %w", err)
+				}
+			}
+			if name, _ := seg["name"].(string); name != "" {
+				matches, err := resolver.NewResolver(c).FindExact(resolver.TypeSegment, name)
+				if err != nil {
+					return fmt.Errorf("failed to check for existing segment: %w", err)
+				}
+				if existing := singleExisting(matches); existing != nil {
+					reportAlreadyExists(cmd, "segment", existing)
+					return nil
+				}
+			}
+		}
+
 		result, err := handler.Create(jsonData)
 		if err != nil {
 			return fmt.Errorf("failed to create segment: %w", err)
 		}
 
+		if printQuietID(cmd, result.UID) {
+			return nil
+		}
+
+		if handled, err := printCreateResult(CreateResult{ResourceType: "segment", ID: result.UID, Name: result.Name}); handled {
+			return err
+		}
+
 		output.PrintSuccess("Segment %q created (UID: %s)", result.Name, result.UID)
 		return nil
 	},
@@ -88,4 +123,7 @@ Examples:
 
 func init() {
 	createSegmentCmd.Flags().StringP("file", "f", "", "file containing segment definition (YAML or JSON)")
+	addInputFormatFlag(createSegmentCmd)
+	addIfNotExistsFlag(createSegmentCmd)
+	addQuietFlag(createSegmentCmd)
 }
@@ -4,13 +4,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
 
+	"github.com/dynatrace-oss/dtctl/pkg/apply"
 	"github.com/dynatrace-oss/dtctl/pkg/output"
 	"github.com/dynatrace-oss/dtctl/pkg/resources/settings"
 	"github.com/dynatrace-oss/dtctl/pkg/safety"
-	"github.com/dynatrace-oss/dtctl/pkg/util/format"
 	"github.com/dynatrace-oss/dtctl/pkg/util/template"
 )
 
@@ -30,6 +31,15 @@ Examples:
   # Dry run to preview
   dtctl create settings -f settings.yaml --schema builtin:openpipeline.logs.pipelines --scope environment --dry-run
 
+  # Place a processing rule directly after an existing one (ordered schemas)
+  dtctl create settings -f rule.yaml --schema builtin:openpipeline.logs.pipelines --scope environment --insert-after <object-id>
+
+  # Make it the first object of the schema
+  dtctl create settings -f rule.yaml --schema builtin:openpipeline.logs.pipelines --scope environment --position 1
+
+  # Skip the create when an object with the same value already exists
+  dtctl create settings -f settings.yaml --schema builtin:openpipeline.logs.pipelines --scope environment --if-not-exists
+
   # Validate against the API without creating
   dtctl create settings -f settings.yaml --schema builtin:openpipeline.logs.pipelines --scope environment --validate-only
 `,
@@ -38,8 +48,11 @@ Examples:
 		file, _ := cmd.Flags().GetString("file")
 		schemaID, _ := cmd.Flags().GetString("schema")
 		scope, _ := cmd.Flags().GetString("scope")
-		setFlags, _ := cmd.Flags().GetStringArray("set")
 		validateOnly, _ := cmd.Flags().GetBool("validate-only")
+		insertAfter, _ := cmd.Flags().GetString("insert-after")
+		position, _ := cmd.Flags().GetInt("position")
+		insertAfterSet := cmd.Flags().Changed("insert-after")
+		positionSet := cmd.Flags().Changed("position")
 
 		if file == "" {
 			return fmt.Errorf("--file is required")
@@ -50,6 +63,12 @@ Examples:
 		if scope == "" {
 			return fmt.Errorf("--scope is required")
 		}
+		if insertAfterSet && positionSet {
+			return fmt.Errorf("--insert-after and --position are mutually exclusive")
+		}
+		if positionSet && position < 1 {
+			return fmt.Errorf("--position must be 1 or greater")
+		}
 
 		// Read the file
 		fileData, err := os.ReadFile(file)
@@ -58,24 +77,32 @@ Examples:
 		}
 
 		// Convert to JSON if needed
-		jsonData, err := format.ValidateAndConvert(fileData)
+		jsonData, err := convertInputFile(cmd, fileData)
 		if err != nil {
-			return fmt.Errorf("invalid file format: %w", err)
+			return err
 		}
 
 		// Apply template rendering if variables provided
-		if len(setFlags) > 0 {
-			templateVars, err := template.ParseSetFlags(setFlags)
-			if err != nil {
-				return fmt.Errorf("invalid --set flag: %w", err)
-			}
-			rendered, err := template.RenderTemplate(string(jsonData), templateVars)
+		templateVars, err := templateVarsFromFlags(cmd)
+		if err != nil {
+			return fmt.Errorf("invalid template variable: %w", err)
+		}
+		if len(templateVars) > 0 {
+			rendered, err := template.RenderJSONTemplate(string(jsonData), templateVars)
 			if err != nil {
 				return fmt.Errorf("template rendering failed: %w", err)
 			}
 			jsonData = []byte(rendered)
 		}
 
+		// Keep the manifest as written so a dry run never shows secrets pulled in
+		// from valueFrom.file references.
+		manifest := jsonData
+		jsonData, err = apply.ResolveValueFrom(jsonData, filepath.Dir(file))
+		if err != nil {
+			return err
+		}
+
 		// Parse the value
 		var value map[string]any
 		if err := json.Unmarshal(jsonData, &value); err != nil {
@@ -87,8 +114,14 @@ Examples:
 			fmt.Printf("Dry run: would create settings object\n")
 			fmt.Printf("Schema: %s\n", schemaID)
 			fmt.Printf("Scope: %s\n", scope)
+			switch {
+			case insertAfterSet:
+				fmt.Printf("Insert after: %q\n", insertAfter)
+			case positionSet:
+				fmt.Printf("Position: %d\n", position)
+			}
 			fmt.Println("---")
-			fmt.Println(string(jsonData))
+			fmt.Println(string(manifest))
 			fmt.Println("---")
 			return nil
 		}
@@ -99,16 +132,27 @@ Examples:
 			Value:    value,
 		}
 
+		if insertAfterSet {
+			req.InsertAfter = &insertAfter
+		}
+
 		if validateOnly {
 			_, c, err := SetupClient()
 			if err != nil {
 				return err
 			}
 			handler := settings.NewHandler(c)
+			if positionSet {
+				if req.InsertAfter, err = handler.InsertAfterPosition(schemaID, scope, position, allPageOptions()); err != nil {
+					return err
+				}
+			}
 			if err := handler.ValidateCreate(req); err != nil {
 				return fmt.Errorf("validation failed: %w", err)
 			}
-			output.PrintSuccess("Validation passed")
+			if quiet, _ := cmd.Flags().GetBool("quiet"); !quiet {
+				output.PrintSuccess("Validation passed")
+			}
 			return nil
 		}
 
@@ -119,11 +163,36 @@ Examples:
 
 		handler := settings.NewHandler(c)
 
+		if ifNotExists, _ := cmd.Flags().GetBool("if-not-exists"); ifNotExists {
+			existing, err := findExistingSettings(c, schemaID, scope, value)
+			if err != nil {
+				return fmt.Errorf("failed to check for existing settings object: %w", err)
+			}
+			if existing != nil {
+				reportAlreadyExists(cmd, "settings object", existing)
+				return nil
+			}
+		}
+
+		if positionSet {
+			if req.InsertAfter, err = handler.InsertAfterPosition(schemaID, scope, position, allPageOptions()); err != nil {
+				return err
+			}
+		}
+
 		result, err := handler.Create(req)
 		if err != nil {
 			return fmt.Errorf("failed to create settings object: %w", err)
 		}
 
+		if printQuietID(cmd, result.ObjectID) {
+			return nil
+		}
+
+		if handled, err := printCreateResult(CreateResult{ResourceType: "settings", ID: result.ObjectID}); handled {
+			return err
+		}
+
 		output.PrintSuccess("Settings object %q created", result.ObjectID)
 		return nil
 	},
@@ -134,8 +203,13 @@ func init() {
 	createSettingsCmd.Flags().StringP("file", "f", "", "file containing settings value (required)")
 	createSettingsCmd.Flags().String("schema", "", "schema ID (required)")
 	createSettingsCmd.Flags().String("scope", "", "scope for the settings object (required)")
-	createSettingsCmd.Flags().StringArray("set", []string{}, "set template variable (key=value)")
+	addTemplateVarFlags(createSettingsCmd)
+	addInputFormatFlag(createSettingsCmd)
 	createSettingsCmd.Flags().Bool("validate-only", false, "validate the settings object against the API without creating it")
+	createSettingsCmd.Flags().String("insert-after", "", "for ordered schemas: object ID to insert the new object after (empty string = first)")
+	createSettingsCmd.Flags().Int("position", 0, "for ordered schemas: 1-based position of the new object (past the end appends)")
+	addIfNotExistsFlag(createSettingsCmd)
+	addQuietFlag(createSettingsCmd)
 	_ = createSettingsCmd.MarkFlagRequired("file")
 	_ = createSettingsCmd.MarkFlagRequired("schema")
 	_ = createSettingsCmd.MarkFlagRequired("scope")
@@ -1,7 +1,10 @@
 package cmd
 
 import (
+	"encoding/json"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -85,3 +88,111 @@ func TestCreateSettingsValidateOnly_ValidationFailed(t *testing.T) {
 		t.Errorf("expected error containing 'validation failed', got %q", err.Error())
 	}
 }
+
+func TestCreateSettings_Position(t *testing.T) {
+	tests := []struct {
+		name            string
+		flag, value     string
+		wantInsertAfter any
+	}{
+		{name: "position resolves to preceding object", flag: "position", value: "3", wantInsertAfter: "obj-2"},
+		{name: "position 1 inserts first", flag: "position", value: "1", wantInsertAfter: ""},
+		{name: "position past the end appends", flag: "position", value: "9", wantInsertAfter: nil},
+		{name: "insert-after passed through", flag: "insert-after", value: "obj-1", wantInsertAfter: "obj-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body []map[string]any
+			ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+				"/platform/classic/environment-api/v2/settings/objects": func(w http.ResponseWriter, r *http.Request) {
+					if r.Method == http.MethodGet {
+						_, _ = w.Write([]byte(`{"items":[{"objectId":"obj-1"},{"objectId":"obj-2"},{"objectId":"obj-3"}],"totalCount":3}`))
+						return
+					}
+					_ = json.NewDecoder(r.Body).Decode(&body)
+					_, _ = w.Write([]byte(`[{"objectId":"new-obj","code":200}]`))
+				},
+			})
+			defer ms.Close()
+
+			configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+			defer cleanup()
+
+			settingsFile := testutil.CreateTempFile(t, `{"key": "value"}`, "settings-*.json")
+
+			origCfgFile := cfgFile
+			origPlain := plainMode
+			defer func() {
+				cfgFile = origCfgFile
+				plainMode = origPlain
+			}()
+			cfgFile = configPath
+			plainMode = true
+
+			testutil.ResetCommandFlags(createSettingsCmd)
+			_ = createSettingsCmd.Flags().Set("file", settingsFile)
+			_ = createSettingsCmd.Flags().Set("schema", "builtin:openpipeline.logs.pipelines")
+			_ = createSettingsCmd.Flags().Set("scope", "environment")
+			_ = createSettingsCmd.Flags().Set(tt.flag, tt.value)
+
+			if err := createSettingsCmd.RunE(createSettingsCmd, nil); err != nil {
+				t.Fatalf("RunE() error = %v", err)
+			}
+			if len(body) != 1 {
+				t.Fatalf("expected one object in create body, got %v", body)
+			}
+			if got := body[0]["insertAfter"]; got != tt.wantInsertAfter {
+				t.Errorf("insertAfter = %#v, want %#v", got, tt.wantInsertAfter)
+			}
+		})
+	}
+}
+
+func TestCreateSettings_PositionAndInsertAfterExclusive(t *testing.T) {
+	testutil.ResetCommandFlags(createSettingsCmd)
+	_ = createSettingsCmd.Flags().Set("file", "x.json")
+	_ = createSettingsCmd.Flags().Set("schema", "builtin:openpipeline.logs.pipelines")
+	_ = createSettingsCmd.Flags().Set("scope", "environment")
+	_ = createSettingsCmd.Flags().Set("position", "2")
+	_ = createSettingsCmd.Flags().Set("insert-after", "obj-1")
+
+	err := createSettingsCmd.RunE(createSettingsCmd, nil)
+	if err == nil || !strings.Contains(err.Error(), "mutually exclusive") {
+		t.Errorf("expected mutually exclusive error, got %v", err)
+	}
+}
+
+func TestCreateSettings_DryRunHidesValueFromSecrets(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "secret.txt"), []byte("s3cr3t\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	settingsFile := filepath.Join(dir, "settings.json")
+	if err := os.WriteFile(settingsFile, []byte(`{"clientSecret": {"valueFrom": {"file": "secret.txt"}}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	origDryRun := dryRun
+	defer func() { dryRun = origDryRun }()
+	dryRun = true
+
+	testutil.ResetCommandFlags(createSettingsCmd)
+	_ = createSettingsCmd.Flags().Set("file", settingsFile)
+	_ = createSettingsCmd.Flags().Set("schema", "builtin:alerting.profile")
+	_ = createSettingsCmd.Flags().Set("scope", "environment")
+
+	var runErr error
+	out := captureStdout(t, func() {
+		runErr = createSettingsCmd.RunE(createSettingsCmd, nil)
+	})
+	if runErr != nil {
+		t.Fatalf("RunE() error = %v", runErr)
+	}
+	if strings.Contains(out, "s3cr3t") {
+		t.Errorf("dry run printed the resolved secret:\n%s", out)
+	}
+	if !strings.Contains(out, "valueFrom") {
+		t.Errorf("dry run should print the manifest as written, got:\n%s", out)
+	}
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 
@@ -9,15 +10,18 @@ import (
 	"github.com/dynatrace-oss/dtctl/pkg/output"
 	"github.com/dynatrace-oss/dtctl/pkg/resources/slo"
 	"github.com/dynatrace-oss/dtctl/pkg/safety"
-	"github.com/dynatrace-oss/dtctl/pkg/util/format"
 	"github.com/dynatrace-oss/dtctl/pkg/util/template"
 )
 
-// createSLOCmd creates an SLO from a file
+// createSLOCmd creates an SLO from a file or an objective template
 var createSLOCmd = &cobra.Command{
-	Use:   "slo -f <file>",
-	Short: "Create a service-level objective from a file",
-	Long: `Create a new SLO from a YAML or JSON file.
+	Use:   "slo -f <file> | --from-template <template-id>",
+	Short: "Create a service-level objective from a file or template",
+	Long: `Create a new SLO from a YAML or JSON file, or from an SLO objective template.
+
+With --from-template, the template is fetched and every template variable must
+be given a value with --set name=value. The SLO references the template and
+uses --target (and optionally --warning) over the --timeframe window.
 
 Examples:
   # Create an SLO from YAML
@@ -26,40 +30,57 @@ Examples:
   # Create with template variables
   dtctl create slo -f slo.yaml --set target=99.9
 
+  # Skip creation if an SLO with the same ID or name exists
+  dtctl create slo -f slo.yaml --if-not-exists
+
   # Dry run to preview
   dtctl create slo -f slo.yaml --dry-run
+
+  # Create an SLO from an objective template
+  dtctl create slo --from-template template-456 --set services=SERVICE-123 \
+    --name "API Availability" --target 99.9
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		file, _ := cmd.Flags().GetString("file")
-		if file == "" {
-			return fmt.Errorf("--file is required")
+		templateID, _ := cmd.Flags().GetString("from-template")
+		if file == "" && templateID == "" {
+			return fmt.Errorf("either --file or --from-template is required")
 		}
 
 		setFlags, _ := cmd.Flags().GetStringArray("set")
 
-		// Read the file
-		fileData, err := os.ReadFile(file)
-		if err != nil {
-			return fmt.Errorf("failed to read file: %w", err)
-		}
-
-		// Convert to JSON if needed
-		jsonData, err := format.ValidateAndConvert(fileData)
-		if err != nil {
-			return fmt.Errorf("invalid file format: %w", err)
-		}
+		var jsonData []byte
+		if templateID != "" {
+			data, err := instantiateSLOTemplate(cmd, templateID, setFlags)
+			if err != nil {
+				return err
+			}
+			jsonData = data
+		} else {
+			// Read the file
+			fileData, err := os.ReadFile(file)
+			if err != nil {
+				return fmt.Errorf("failed to read file: %w", err)
+			}
 
-		// Apply template rendering if variables provided
-		if len(setFlags) > 0 {
-			templateVars, err := template.ParseSetFlags(setFlags)
+			// Convert to JSON if needed
+			jsonData, err = convertInputFile(cmd, fileData)
 			if err != nil {
-				return fmt.Errorf("invalid --set flag: %w", err)
+				return err
 			}
-			rendered, err := template.RenderTemplate(string(jsonData), templateVars)
+
+			// Apply template rendering if variables provided
+			templateVars, err := templateVarsFromFlags(cmd)
 			if err != nil {
-				return fmt.Errorf("template rendering failed: %w", err)
+				return fmt.Errorf("invalid template variable: %w", err)
+			}
+			if len(templateVars) > 0 {
+				rendered, err := template.RenderJSONTemplate(string(jsonData), templateVars)
+				if err != nil {
+					return fmt.Errorf("template rendering failed: %w", err)
+				}
+				jsonData = []byte(rendered)
 			}
-			jsonData = []byte(rendered)
 		}
 
 		// Handle dry-run
@@ -78,11 +99,36 @@ Examples:
 
 		handler := slo.NewHandler(c)
 
+		if ifNotExists, _ := cmd.Flags().GetBool("if-not-exists"); ifNotExists {
+			var def map[string]interface{}
+			if err := json.Unmarshal(jsonData, &def); err != nil {
+				return fmt.Errorf("failed to parse SLO JSON: %w", err)
+			}
+			id, _ := def["id"].(string)
+			name, _ := def["name"].(string)
+			existing, err := findExistingSLO(c, id, name)
+			if err != nil {
+				return fmt.Errorf("failed to check for existing SLO: %w", err)
+			}
+			if existing != nil {
+				reportAlreadyExists(cmd, "SLO", existing)
+				return nil
+			}
+		}
+
 		result, err := handler.Create(jsonData)
 		if err != nil {
 			return fmt.Errorf("failed to create SLO: %w", err)
 		}
 
+		if printQuietID(cmd, result.ID) {
+			return nil
+		}
+
+		if handled, err := printCreateResult(CreateResult{ResourceType: "slo", ID: result.ID, Name: result.Name, URL: fmt.Sprintf("%s/ui/apps/dynatrace.site.reliability/slos/%s", c.BaseURL(), result.ID)}); handled {
+			return err
+		}
+
 		output.PrintSuccess("SLO %q created", result.Name)
 		output.PrintInfo("  ID:   %s", result.ID)
 		output.PrintInfo("  Name: %s", result.Name)
@@ -91,9 +137,53 @@ Examples:
 	},
 }
 
+// instantiateSLOTemplate fetches an SLO objective template and builds an SLO
+// definition from it, using --set values for the template variables.
+func instantiateSLOTemplate(cmd *cobra.Command, templateID string, setFlags []string) ([]byte, error) {
+	if !cmd.Flags().Changed("target") {
+		return nil, fmt.Errorf("--target is required with --from-template")
+	}
+
+	templateVars, err := template.ParseSetFlags(setFlags)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --set flag: %w", err)
+	}
+	vars := make(map[string]string, len(templateVars))
+	for k, v := range templateVars {
+		vars[k] = fmt.Sprint(v)
+	}
+
+	opts := slo.InstantiateOptions{Variables: vars}
+	opts.Name, _ = cmd.Flags().GetString("name")
+	opts.Target, _ = cmd.Flags().GetFloat64("target")
+	opts.Timeframe, _ = cmd.Flags().GetString("timeframe")
+	if cmd.Flags().Changed("warning") {
+		warning, _ := cmd.Flags().GetFloat64("warning")
+		opts.Warning = &warning
+	}
+
+	_, c, err := SetupClient()
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := slo.NewHandler(c).GetTemplate(templateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SLO template %q: %w", templateID, err)
+	}
+	return tmpl.Instantiate(opts)
+}
+
 func init() {
 	// SLO flags
-	createSLOCmd.Flags().StringP("file", "f", "", "file containing SLO definition (required)")
-	createSLOCmd.Flags().StringArray("set", []string{}, "set template variable (key=value)")
-	_ = createSLOCmd.MarkFlagRequired("file")
+	createSLOCmd.Flags().StringP("file", "f", "", "file containing SLO definition")
+	addTemplateVarFlags(createSLOCmd)
+	addInputFormatFlag(createSLOCmd)
+	createSLOCmd.Flags().String("from-template", "", "create the SLO from an objective template ID")
+	createSLOCmd.Flags().String("name", "", "SLO name (with --from-template; defaults to the template name)")
+	createSLOCmd.Flags().Float64("target", 0, "target percentage (required with --from-template)")
+	createSLOCmd.Flags().Float64("warning", 0, "warning percentage (with --from-template)")
+	createSLOCmd.Flags().String("timeframe", slo.DefaultTemplateTimeframe, "evaluation timeframe start (with --from-template)")
+	createSLOCmd.MarkFlagsMutuallyExclusive("file", "from-template")
+	addIfNotExistsFlag(createSLOCmd)
+	addQuietFlag(createSLOCmd)
 }
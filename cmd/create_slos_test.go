@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+)
+
+func TestCreateSLO_FromTemplate(t *testing.T) {
+	var created map[string]interface{}
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/slo/v1/objective-templates/template-456": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"template-456","name":"Service availability","variables":[{"name":"services","scope":"SERVICE"}]}`))
+		},
+		"/platform/slo/v1/slos": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"slo-1","name":"API Availability"}`))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile := cfgFile
+	origOutput := outputFormat
+	origAgent := agentMode
+	defer func() {
+		testutil.ResetCommandFlags(createSLOCmd)
+		cfgFile = origCfgFile
+		outputFormat = origOutput
+		agentMode = origAgent
+	}()
+
+	testutil.ResetCommandFlags(createSLOCmd)
+	cfgFile = configPath
+	outputFormat = "json"
+	agentMode = false
+	_ = createSLOCmd.Flags().Set("from-template", "template-456")
+	_ = createSLOCmd.Flags().Set("set", "services=SERVICE-123")
+	_ = createSLOCmd.Flags().Set("name", "API Availability")
+	_ = createSLOCmd.Flags().Set("target", "99.9")
+
+	captureStdout(t, func() {
+		if err := createSLOCmd.RunE(createSLOCmd, nil); err != nil {
+			t.Fatalf("RunE() error = %v", err)
+		}
+	})
+
+	if created["name"] != "API Availability" {
+		t.Errorf("name = %v", created["name"])
+	}
+	ref, _ := created["sliReference"].(map[string]interface{})
+	if ref["templateId"] != "template-456" {
+		t.Errorf("sliReference = %v", created["sliReference"])
+	}
+	vars, _ := ref["variables"].([]interface{})
+	if len(vars) != 1 || vars[0].(map[string]interface{})["value"] != "SERVICE-123" {
+		t.Errorf("variables = %v", ref["variables"])
+	}
+}
+
+func TestCreateSLO_FromTemplateRequiresTarget(t *testing.T) {
+	defer testutil.ResetCommandFlags(createSLOCmd)
+	testutil.ResetCommandFlags(createSLOCmd)
+	_ = createSLOCmd.Flags().Set("from-template", "template-456")
+
+	if err := createSLOCmd.RunE(createSLOCmd, nil); err == nil {
+		t.Fatal("expected error without --target")
+	}
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 
@@ -9,7 +10,6 @@ import (
 	"github.com/dynatrace-oss/dtctl/pkg/output"
 	"github.com/dynatrace-oss/dtctl/pkg/resources/workflow"
 	"github.com/dynatrace-oss/dtctl/pkg/safety"
-	"github.com/dynatrace-oss/dtctl/pkg/util/format"
 
 	"github.com/dynatrace-oss/dtctl/pkg/util/template"
 )
@@ -27,6 +27,12 @@ Examples:
   # Create with template variables
   dtctl create workflow -f workflow.yaml --set env=prod --set owner=team-a
 
+  # Skip creation if a workflow with the same ID or title exists
+  dtctl create workflow -f workflow.yaml --if-not-exists
+
+  # Print only the new workflow ID (for scripts)
+  WF_ID=$(dtctl create workflow -f workflow.yaml -q)
+
   # Dry run to preview
   dtctl create workflow -f workflow.yaml --dry-run
 `,
@@ -36,8 +42,6 @@ Examples:
 			return fmt.Errorf("--file is required")
 		}
 
-		setFlags, _ := cmd.Flags().GetStringArray("set")
-
 		// Read the file
 		fileData, err := os.ReadFile(file)
 		if err != nil {
@@ -45,18 +49,18 @@ Examples:
 		}
 
 		// Convert to JSON if needed
-		jsonData, err := format.ValidateAndConvert(fileData)
+		jsonData, err := convertInputFile(cmd, fileData)
 		if err != nil {
-			return fmt.Errorf("invalid file format: %w", err)
+			return err
 		}
 
 		// Apply template rendering if variables provided
-		if len(setFlags) > 0 {
-			templateVars, err := template.ParseSetFlags(setFlags)
-			if err != nil {
-				return fmt.Errorf("invalid --set flag: %w", err)
-			}
-			rendered, err := template.RenderTemplate(string(jsonData), templateVars)
+		templateVars, err := templateVarsFromFlags(cmd)
+		if err != nil {
+			return fmt.Errorf("invalid template variable: %w", err)
+		}
+		if len(templateVars) > 0 {
+			rendered, err := template.RenderJSONTemplate(string(jsonData), templateVars)
 			if err != nil {
 				return fmt.Errorf("template rendering failed: %w", err)
 			}
@@ -79,11 +83,36 @@ Examples:
 
 		handler := workflow.NewHandler(c)
 
+		if ifNotExists, _ := cmd.Flags().GetBool("if-not-exists"); ifNotExists {
+			var wf map[string]interface{}
+			if err := json.Unmarshal(jsonData, &wf); err != nil {
+				return fmt.Errorf("failed to parse workflow JSON: %w", err)
+			}
+			id, _ := wf["id"].(string)
+			title, _ := wf["title"].(string)
+			existing, err := findExistingWorkflow(c, id, title)
+			if err != nil {
+				return fmt.Errorf("failed to check for existing workflow: %w", err)
+			}
+			if existing != nil {
+				reportAlreadyExists(cmd, "workflow", existing)
+				return nil
+			}
+		}
+
 		result, err := handler.Create(jsonData)
 		if err != nil {
 			return fmt.Errorf("failed to create workflow: %w", err)
 		}
 
+		if printQuietID(cmd, result.ID) {
+			return nil
+		}
+
+		if handled, err := printCreateResult(CreateResult{ResourceType: "workflow", ID: result.ID, Name: result.Title, URL: fmt.Sprintf("%s/ui/apps/dynatrace.automations/workflows/%s", c.BaseURL(), result.ID)}); handled {
+			return err
+		}
+
 		output.PrintSuccess("Workflow %q created", result.Title)
 		output.PrintInfo("  ID:   %s", result.ID)
 		output.PrintInfo("  Name: %s", result.Title)
@@ -95,6 +124,9 @@ Examples:
 func init() {
 	// Workflow flags
 	createWorkflowCmd.Flags().StringP("file", "f", "", "file containing workflow definition (required)")
-	createWorkflowCmd.Flags().StringArray("set", []string{}, "set template variable (key=value)")
+	addTemplateVarFlags(createWorkflowCmd)
+	addInputFormatFlag(createWorkflowCmd)
+	addIfNotExistsFlag(createWorkflowCmd)
+	addQuietFlag(createWorkflowCmd)
 	_ = createWorkflowCmd.MarkFlagRequired("file")
 }
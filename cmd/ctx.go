@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/dynatrace-oss/dtctl/pkg/config"
 	"github.com/dynatrace-oss/dtctl/pkg/diagnostic"
 	"github.com/dynatrace-oss/dtctl/pkg/output"
+	"github.com/dynatrace-oss/dtctl/pkg/prompt"
 )
 
 // ctxCmd is a top-level shortcut for context management.
@@ -48,7 +50,7 @@ Examples:
 		if len(args) != 0 {
 			return nil, cobra.ShellCompDirectiveNoFileComp
 		}
-		cfg, err := LoadConfig()
+		cfg, err := loadFileConfig()
 		if err != nil {
 			return nil, cobra.ShellCompDirectiveNoFileComp
 		}
@@ -79,7 +81,7 @@ var ctxTokenCmd = &cobra.Command{
 	Short: "Print the resolved token for a context",
 	Args:  cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		cfg, err := LoadConfig()
+		cfg, err := loadFileConfig()
 		if err != nil {
 			return err
 		}
@@ -105,7 +107,7 @@ var ctxCurrentCmd = &cobra.Command{
 	Use:   "current",
 	Short: "Display the current context name",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		cfg, err := LoadConfig()
+		cfg, err := loadFileConfig()
 		if err != nil {
 			return err
 		}
@@ -123,7 +125,7 @@ var ctxDescribeCmd = &cobra.Command{
 		if len(args) != 0 {
 			return nil, cobra.ShellCompDirectiveNoFileComp
 		}
-		cfg, err := LoadConfig()
+		cfg, err := loadFileConfig()
 		if err != nil {
 			return nil, cobra.ShellCompDirectiveNoFileComp
 		}
@@ -156,16 +158,14 @@ Safety Levels (from safest to most permissive):
 Examples:
   dtctl ctx set prod --environment https://prod.example.com --safety-level readonly
   dtctl ctx set staging --environment https://staging.example.com --token-ref my-token
+  dtctl ctx set prod --prompt PROD --color red --default-output yaml
 `,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		environment, _ := cmd.Flags().GetString("environment")
 		tokenRef, _ := cmd.Flags().GetString("token-ref")
-		safetyLevel, _ := cmd.Flags().GetString("safety-level")
-		description, _ := cmd.Flags().GetString("description")
-		profile, _ := cmd.Flags().GetString("profile")
 
-		return setContext(args[0], environment, tokenRef, safetyLevel, description, profile)
+		return setContext(args[0], environment, tokenRef, contextOptionsFromFlags(cmd))
 	},
 }
 
@@ -179,7 +179,7 @@ var ctxDeleteCmd = &cobra.Command{
 		if len(args) != 0 {
 			return nil, cobra.ShellCompDirectiveNoFileComp
 		}
-		cfg, err := LoadConfig()
+		cfg, err := loadFileConfig()
 		if err != nil {
 			return nil, cobra.ShellCompDirectiveNoFileComp
 		}
@@ -221,7 +221,7 @@ field so account commands no longer need --account-uuid or DTCTL_ACCOUNT_UUID.`,
 // via the IAM access-info endpoint. When save is true, the UUID is persisted to
 // the current context's account-uuid field.
 func discoverAccount(save bool) error {
-	cfg, err := LoadConfig()
+	cfg, err := loadFileConfig()
 	if err != nil {
 		return err
 	}
@@ -263,7 +263,7 @@ func discoverAccount(save bool) error {
 		return err
 	}
 	nc.Context.AccountUUID = uuid
-	if err := cfg.Save(); err != nil {
+	if err := saveConfig(cfg); err != nil {
 		return fmt.Errorf("failed to persist account-uuid: %w", err)
 	}
 	output.PrintSuccess("Saved account-uuid %s to context %q", uuid, cfg.CurrentContext)
@@ -272,7 +272,7 @@ func discoverAccount(save bool) error {
 
 // listContexts lists all available contexts (shared logic)
 func listContexts() error {
-	cfg, err := LoadConfig()
+	cfg, err := loadFileConfig()
 	if err != nil {
 		return err
 	}
@@ -328,7 +328,7 @@ func useContext(name string) error {
 
 // describeContext shows detailed info about a named context (shared logic)
 func describeContext(name string) error {
-	cfg, err := LoadConfig()
+	cfg, err := loadFileConfig()
 	if err != nil {
 		return err
 	}
@@ -377,11 +377,50 @@ func describeContext(name string) error {
 		output.DescribeKV("Description:", w, "%s", found.Context.Description)
 	}
 
+	if found.Context.Output != "" {
+		output.DescribeKV("Output:", w, "%s", found.Context.Output)
+	}
+	if found.Context.Prompt != "" {
+		output.DescribeKV("Prompt:", w, "%s", found.Context.Prompt)
+	}
+	if found.Context.Color != "" {
+		output.DescribeKV("Color:", w, "%s", found.Context.Color)
+	}
+
 	return nil
 }
 
+// addContextOptionFlags registers the optional context settings shared by
+// 'ctx set' and 'config set-context'.
+func addContextOptionFlags(cmd *cobra.Command) {
+	cmd.Flags().String("safety-level", "", "safety level (readonly, readwrite-mine, readwrite-all, dangerously-unrestricted)")
+	cmd.Flags().String("description", "", "human-readable description for this context")
+	cmd.Flags().String("profile", "", "command profile to bind (restricts the visible command surface; e.g. query, investigate, full)")
+	cmd.Flags().String("default-output", "", "default output format while this context is active (-o and DTCTL_OUTPUT still win)")
+	cmd.Flags().String("color", "", "color of the context in confirmation prompts (red, yellow, green, blue, magenta, cyan)")
+	cmd.Flags().String("prompt", "", "label shown first in confirmation prompts, e.g. PROD")
+}
+
+// contextOptionsFromFlags reads the flags registered by addContextOptionFlags.
+func contextOptionsFromFlags(cmd *cobra.Command) config.ContextOptions {
+	safetyLevel, _ := cmd.Flags().GetString("safety-level")
+	description, _ := cmd.Flags().GetString("description")
+	profile, _ := cmd.Flags().GetString("profile")
+	defaultOutput, _ := cmd.Flags().GetString("default-output")
+	color, _ := cmd.Flags().GetString("color")
+	promptLabel, _ := cmd.Flags().GetString("prompt")
+	return config.ContextOptions{
+		SafetyLevel: config.SafetyLevel(safetyLevel),
+		Description: description,
+		Profile:     profile,
+		Output:      defaultOutput,
+		Color:       strings.ToLower(color),
+		Prompt:      promptLabel,
+	}
+}
+
 // setContext creates or updates a named context (shared logic)
-func setContext(name, environment, tokenRef, safetyLevel, description, profile string) error {
+func setContext(name, environment, tokenRef string, opts config.ContextOptions) error {
 	cfg, err := loadConfigRaw()
 	if err != nil {
 		cfg = config.NewConfig()
@@ -416,27 +455,21 @@ func setContext(name, environment, tokenRef, safetyLevel, description, profile s
 		}
 	}
 
-	if safetyLevel != "" {
-		level := config.SafetyLevel(safetyLevel)
-		if !level.IsValid() {
-			return fmt.Errorf("invalid safety level %q. Valid values: readonly, readwrite-mine, readwrite-all, dangerously-unrestricted", safetyLevel)
-		}
+	if opts.SafetyLevel != "" && !opts.SafetyLevel.IsValid() {
+		return fmt.Errorf("invalid safety level %q. Valid values: readonly, readwrite-mine, readwrite-all, dangerously-unrestricted", opts.SafetyLevel)
+	}
+	if _, ok := prompt.Colors[opts.Color]; opts.Color != "" && !ok {
+		return fmt.Errorf("invalid color %q. Valid values: red, yellow, green, blue, magenta, cyan", opts.Color)
 	}
 
 	// Warn (don't fail) on a profile name that is not currently resolvable: the
 	// profile may be defined later, or in a different config file. A soft warning
 	// catches the common typo without blocking legitimate ahead-of-time binding.
-	if profile != "" && profile != config.ProfileFull && !cfg.ProfileExists(profile) {
-		output.PrintWarning("profile %q is not defined yet; define it under 'profiles:' or it will error when the context is used", profile)
-	}
-
-	opts := &config.ContextOptions{
-		SafetyLevel: config.SafetyLevel(safetyLevel),
-		Description: description,
-		Profile:     profile,
+	if opts.Profile != "" && opts.Profile != config.ProfileFull && !cfg.ProfileExists(opts.Profile) {
+		output.PrintWarning("profile %q is not defined yet; define it under 'profiles:' or it will error when the context is used", opts.Profile)
 	}
 
-	cfg.SetContextWithOptions(name, environment, tokenRef, opts)
+	cfg.SetContextWithOptions(name, environment, tokenRef, &opts)
 
 	// Always activate the context that was just created or updated.
 	// "ctx set" is the canonical way to configure a context, so the natural
@@ -505,8 +538,6 @@ func init() {
 	// Flags for ctx set
 	ctxSetCmd.Flags().String("environment", "", "environment URL")
 	ctxSetCmd.Flags().String("token-ref", "", "token reference name")
-	ctxSetCmd.Flags().String("safety-level", "", "safety level (readonly, readwrite-mine, readwrite-all, dangerously-unrestricted)")
-	ctxSetCmd.Flags().String("description", "", "human-readable description for this context")
-	ctxSetCmd.Flags().String("profile", "", "command profile to bind (restricts the visible command surface; e.g. query, investigate, full)")
+	addContextOptionFlags(ctxSetCmd)
 	_ = ctxSetCmd.RegisterFlagCompletionFunc("profile", completeProfileNames)
 }
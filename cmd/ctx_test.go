@@ -236,6 +236,76 @@ func TestCtxSetCmd(t *testing.T) {
 			t.Errorf("expected error about invalid safety level, got %q", err.Error())
 		}
 	})
+
+	t.Run("prompt hints and default output", func(t *testing.T) {
+		_ = ctxSetCmd.Flags().Set("environment", "https://prod.example.com")
+		_ = ctxSetCmd.Flags().Set("prompt", "PROD")
+		_ = ctxSetCmd.Flags().Set("color", "Red")
+		_ = ctxSetCmd.Flags().Set("default-output", "yaml")
+		defer func() {
+			_ = ctxSetCmd.Flags().Set("environment", "")
+			_ = ctxSetCmd.Flags().Set("prompt", "")
+			_ = ctxSetCmd.Flags().Set("color", "")
+			_ = ctxSetCmd.Flags().Set("default-output", "")
+		}()
+
+		if err := ctxSetCmd.RunE(ctxSetCmd, []string{"prod"}); err != nil {
+			t.Fatalf("ctx set prod failed: %v", err)
+		}
+		cfg, err := config.LoadFrom(configPath)
+		if err != nil {
+			t.Fatalf("failed to load config: %v", err)
+		}
+		ctx, err := cfg.CurrentContextObj()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ctx.Prompt != "PROD" || ctx.Color != "red" || ctx.Output != "yaml" {
+			t.Errorf("prompt/color/output = %q/%q/%q, want PROD/red/yaml", ctx.Prompt, ctx.Color, ctx.Output)
+		}
+
+		_ = ctxSetCmd.Flags().Set("color", "purple")
+		err = ctxSetCmd.RunE(ctxSetCmd, []string{"prod"})
+		if err == nil || !strings.Contains(err.Error(), "invalid color") {
+			t.Errorf("expected invalid color error, got %v", err)
+		}
+	})
+}
+
+func TestApplyContextPreferences(t *testing.T) {
+	origOutput := outputFormat
+	origAgent := agentMode
+	defer func() {
+		outputFormat = origOutput
+		agentMode = origAgent
+	}()
+	t.Setenv("DTCTL_OUTPUT", "")
+	agentMode = false
+
+	cfg := config.NewConfig()
+	cfg.SetContextWithOptions("prod", "https://prod.example.com", "prod-token", &config.ContextOptions{Output: "yaml"})
+	cfg.SetContext("dev", "https://dev.example.com", "dev-token")
+
+	cfg.CurrentContext = "prod"
+	outputFormat = "table"
+	applyContextPreferences(cfg)
+	if outputFormat != "yaml" {
+		t.Errorf("outputFormat = %q, want the context's yaml", outputFormat)
+	}
+
+	cfg.CurrentContext = "dev"
+	outputFormat = "table"
+	applyContextPreferences(cfg)
+	if outputFormat != "table" {
+		t.Errorf("outputFormat = %q, want table for a context without output", outputFormat)
+	}
+
+	t.Setenv("DTCTL_OUTPUT", "csv")
+	outputFormat = "csv"
+	applyContextPreferences(cfg)
+	if outputFormat != "csv" {
+		t.Errorf("outputFormat = %q, DTCTL_OUTPUT should win", outputFormat)
+	}
 }
 
 func TestCtxDeleteCmd(t *testing.T) {
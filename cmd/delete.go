@@ -146,6 +146,7 @@ func init() {
 	deleteCmd.AddCommand(deleteSLOCmd)
 	deleteCmd.AddCommand(deleteNotificationCmd)
 	deleteCmd.AddCommand(deleteBucketCmd)
+	deleteCmd.AddCommand(deleteRecordsCmd)
 	deleteCmd.AddCommand(deleteLookupCmd)
 	deleteCmd.AddCommand(deleteSettingsCmd)
 	deleteCmd.AddCommand(deleteAppCmd)
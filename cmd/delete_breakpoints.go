@@ -112,8 +112,9 @@ Examples:
 			return err
 		}
 
+		target := promptTarget(cfg)
 		if deleteAll {
-			return runDeleteAllBreakpointsWithOps(handler, workspaceID, rows, yes, verbose, ops)
+			return runDeleteAllBreakpointsWithOps(handler, workspaceID, rows, target, yes, verbose, ops)
 		}
 
 		identifier := args[0]
@@ -122,14 +123,14 @@ Examples:
 			if len(targets) == 0 {
 				return fmt.Errorf("no breakpoints found at %s:%d", fileName, lineNumber)
 			}
-			return runDeleteBreakpointRowsWithOps(handler, workspaceID, targets, yes, verbose, ops)
+			return runDeleteBreakpointRowsWithOps(handler, workspaceID, targets, target, yes, verbose, ops)
 		}
 
 		if row, ok := findBreakpointRowByID(rows, identifier); ok {
-			return runDeleteBreakpointRowsWithOps(handler, workspaceID, []breakpointRow{row}, yes, verbose, ops)
+			return runDeleteBreakpointRowsWithOps(handler, workspaceID, []breakpointRow{row}, target, yes, verbose, ops)
 		}
 
-		return runDeleteBreakpointRowsWithOps(handler, workspaceID, []breakpointRow{{ID: identifier}}, yes, verbose, ops)
+		return runDeleteBreakpointRowsWithOps(handler, workspaceID, []breakpointRow{{ID: identifier}}, target, yes, verbose, ops)
 	},
 }
 
@@ -157,18 +158,18 @@ func validateDeleteBreakpointArgs(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func runDeleteAllBreakpoints(handler *livedebugger.Handler, workspaceID string, rows []breakpointRow, yes bool, verbose bool) error {
-	return runDeleteAllBreakpointsWithOps(handler, workspaceID, rows, yes, verbose, defaultBreakpointDeleteOps())
+func runDeleteAllBreakpoints(handler *livedebugger.Handler, workspaceID string, rows []breakpointRow, target prompt.Target, yes bool, verbose bool) error {
+	return runDeleteAllBreakpointsWithOps(handler, workspaceID, rows, target, yes, verbose, defaultBreakpointDeleteOps())
 }
 
-func runDeleteAllBreakpointsWithOps(handler *livedebugger.Handler, workspaceID string, rows []breakpointRow, yes bool, verbose bool, ops breakpointDeleteOps) error {
+func runDeleteAllBreakpointsWithOps(handler *livedebugger.Handler, workspaceID string, rows []breakpointRow, target prompt.Target, yes bool, verbose bool, ops breakpointDeleteOps) error {
 	if len(rows) == 0 {
 		return printBreakpointMessage("delete", "No breakpoints found in the current workspace")
 	}
 
 	if !yes && !plainMode {
 		confirmMsg := fmt.Sprintf("Delete ALL %d breakpoint(s) in the current workspace?", len(rows))
-		if !prompt.Confirm(confirmMsg) {
+		if !prompt.Confirm(target, confirmMsg) {
 			return printBreakpointMessage("delete", "Deletion cancelled")
 		}
 	}
@@ -201,11 +202,11 @@ func runDeleteAllBreakpointsWithOps(handler *livedebugger.Handler, workspaceID s
 	return printBreakpointMessage("delete", fmt.Sprintf("Deleted %d breakpoint(s)", len(deletedIDs)))
 }
 
-func runDeleteBreakpointRows(handler *livedebugger.Handler, workspaceID string, rows []breakpointRow, yes bool, verbose bool) error {
-	return runDeleteBreakpointRowsWithOps(handler, workspaceID, rows, yes, verbose, defaultBreakpointDeleteOps())
+func runDeleteBreakpointRows(handler *livedebugger.Handler, workspaceID string, rows []breakpointRow, target prompt.Target, yes bool, verbose bool) error {
+	return runDeleteBreakpointRowsWithOps(handler, workspaceID, rows, target, yes, verbose, defaultBreakpointDeleteOps())
 }
 
-func runDeleteBreakpointRowsWithOps(handler *livedebugger.Handler, workspaceID string, rows []breakpointRow, yes bool, verbose bool, ops breakpointDeleteOps) error {
+func runDeleteBreakpointRowsWithOps(handler *livedebugger.Handler, workspaceID string, rows []breakpointRow, target prompt.Target, yes bool, verbose bool, ops breakpointDeleteOps) error {
 	if len(rows) == 0 {
 		return nil
 	}
@@ -213,12 +214,12 @@ func runDeleteBreakpointRowsWithOps(handler *livedebugger.Handler, workspaceID s
 	if !yes && !plainMode {
 		if len(rows) == 1 {
 			row := rows[0]
-			if !prompt.ConfirmDeletion("breakpoint", formatBreakpointLocation(row), row.ID) {
+			if !prompt.ConfirmDeletion(target, "breakpoint", formatBreakpointLocation(row), row.ID) {
 				return printBreakpointMessage("delete", "Deletion cancelled")
 			}
 		} else {
 			confirmMsg := fmt.Sprintf("Delete %d breakpoint(s) at %s?", len(rows), formatBreakpointLocation(rows[0]))
-			if !prompt.Confirm(confirmMsg) {
+			if !prompt.Confirm(target, confirmMsg) {
 				return printBreakpointMessage("delete", "Deletion cancelled")
 			}
 		}
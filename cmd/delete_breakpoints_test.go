@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/dynatrace-oss/dtctl/pkg/config"
+	"github.com/dynatrace-oss/dtctl/pkg/prompt"
 	"github.com/dynatrace-oss/dtctl/pkg/resources/livedebugger"
 )
 
@@ -178,7 +179,7 @@ func TestRunDeleteAllBreakpoints_NoRows(t *testing.T) {
 	plainMode = true
 
 	output := captureStdout(t, func() {
-		if err := runDeleteAllBreakpoints(nil, "workspace-1", nil, true, false); err != nil {
+		if err := runDeleteAllBreakpoints(nil, "workspace-1", nil, prompt.Target{}, true, false); err != nil {
 			t.Fatalf("runDeleteAllBreakpoints returned error: %v", err)
 		}
 	})
@@ -211,7 +212,7 @@ func TestRunDeleteBreakpointRows_DryRun(t *testing.T) {
 	}
 
 	output := captureStdout(t, func() {
-		if err := runDeleteBreakpointRows(nil, "workspace-1", rows, true, false); err != nil {
+		if err := runDeleteBreakpointRows(nil, "workspace-1", rows, prompt.Target{}, true, false); err != nil {
 			t.Fatalf("runDeleteBreakpointRows returned error: %v", err)
 		}
 	})
@@ -256,7 +257,7 @@ func TestRunDeleteAllBreakpoints_Success(t *testing.T) {
 
 	rows := []breakpointRow{{ID: "bp-1", Filename: "A.java", Line: 10}}
 	output := captureStdout(t, func() {
-		if err := runDeleteAllBreakpointsWithOps(nil, "workspace-1", rows, true, false, ops); err != nil {
+		if err := runDeleteAllBreakpointsWithOps(nil, "workspace-1", rows, prompt.Target{}, true, false, ops); err != nil {
 			t.Fatalf("runDeleteAllBreakpoints returned error: %v", err)
 		}
 	})
@@ -280,7 +281,7 @@ func TestRunDeleteAllBreakpoints_MalformedResponse(t *testing.T) {
 	}
 
 	rows := []breakpointRow{{ID: "bp-1", Filename: "A.java", Line: 10}}
-	err := runDeleteAllBreakpointsWithOps(nil, "workspace-1", rows, true, false, ops)
+	err := runDeleteAllBreakpointsWithOps(nil, "workspace-1", rows, prompt.Target{}, true, false, ops)
 	if err == nil {
 		t.Fatalf("expected error for malformed deleteAll response")
 	}
@@ -290,7 +291,7 @@ func TestRunDeleteAllBreakpoints_MalformedResponse(t *testing.T) {
 }
 
 func TestRunDeleteBreakpointRows_Empty(t *testing.T) {
-	if err := runDeleteBreakpointRows(nil, "workspace-1", nil, true, false); err != nil {
+	if err := runDeleteBreakpointRows(nil, "workspace-1", nil, prompt.Target{}, true, false); err != nil {
 		t.Fatalf("expected nil error for empty rows, got: %v", err)
 	}
 }
@@ -326,7 +327,7 @@ func TestRunDeleteBreakpointRows_PartialFailure(t *testing.T) {
 	}
 
 	output := captureStdout(t, func() {
-		err := runDeleteBreakpointRowsWithOps(nil, "workspace-1", rows, true, false, ops)
+		err := runDeleteBreakpointRowsWithOps(nil, "workspace-1", rows, prompt.Target{}, true, false, ops)
 		if err == nil {
 			t.Fatalf("expected partial failure error")
 		}
@@ -366,7 +367,7 @@ func TestRunDeleteBreakpointRows_CancelledConfirmation(t *testing.T) {
 	os.Stdin = r
 
 	output := captureStdout(t, func() {
-		if err := runDeleteBreakpointRows(nil, "workspace-1", []breakpointRow{{ID: "bp-1", Filename: "A.java", Line: 10}}, false, false); err != nil {
+		if err := runDeleteBreakpointRows(nil, "workspace-1", []breakpointRow{{ID: "bp-1", Filename: "A.java", Line: 10}}, prompt.Target{}, false, false); err != nil {
 			t.Fatalf("unexpected error: %v", err)
 		}
 	})
@@ -391,7 +392,7 @@ func TestRunDeleteBreakpointRows_VerbosePrintError(t *testing.T) {
 		},
 	}
 
-	err := runDeleteBreakpointRowsWithOps(nil, "workspace-1", []breakpointRow{{ID: "bp-1", Filename: "A.java", Line: 10}}, true, true, ops)
+	err := runDeleteBreakpointRowsWithOps(nil, "workspace-1", []breakpointRow{{ID: "bp-1", Filename: "A.java", Line: 10}}, prompt.Target{}, true, true, ops)
 	if err == nil {
 		t.Fatalf("expected printGraphQLResponse marshal error")
 	}
@@ -413,7 +414,7 @@ func TestRunDeleteAllBreakpoints_VerbosePrintError(t *testing.T) {
 		},
 	}
 
-	err := runDeleteAllBreakpointsWithOps(nil, "workspace-1", []breakpointRow{{ID: "bp-1", Filename: "A.java", Line: 10}}, true, true, ops)
+	err := runDeleteAllBreakpointsWithOps(nil, "workspace-1", []breakpointRow{{ID: "bp-1", Filename: "A.java", Line: 10}}, prompt.Target{}, true, true, ops)
 	if err == nil {
 		t.Fatalf("expected printGraphQLResponse marshal error")
 	}
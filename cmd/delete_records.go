@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dynatrace-oss/dtctl/pkg/client"
+	"github.com/dynatrace-oss/dtctl/pkg/exec"
+	"github.com/dynatrace-oss/dtctl/pkg/output"
+	"github.com/dynatrace-oss/dtctl/pkg/prompt"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/bucket"
+	"github.com/dynatrace-oss/dtctl/pkg/safety"
+	"github.com/dynatrace-oss/dtctl/pkg/util/timeframe"
+)
+
+// deleteRecordsCmd deletes the records of a bucket that match a DQL predicate
+var deleteRecordsCmd = &cobra.Command{
+	Use:     "records --bucket <bucket-name> --filter <dql-predicate>",
+	Aliases: []string{"record"},
+	Short:   "Delete records from a Grail bucket by DQL filter",
+	Long: `Delete the records of a Grail storage bucket that match a DQL predicate.
+
+The predicate is applied as a DQL filter to the bucket's records within the
+timeframe given by --from and --to. --from defaults to the bucket's retention
+period, so all stored records are considered. Before deleting, dtctl counts the
+matching records and asks you to type the bucket name to confirm.
+
+Requires the 'dangerously-unrestricted' safety level and the
+storage:records:delete scope.
+
+WARNING: This operation is irreversible.
+
+Examples:
+  # Delete all logs of a user (requires typing the bucket name to confirm)
+  dtctl delete records --bucket default_logs --filter 'user.email == "jane@example.com"'
+
+  # Restrict the timeframe
+  dtctl delete records --bucket default_logs --filter 'host.name == "web-1"' --from 7d
+
+  # Show the query and affected count without deleting
+  dtctl delete records --bucket default_logs --filter 'host.name == "web-1"' --dry-run
+
+  # Non-interactive
+  dtctl delete records --bucket default_logs --filter 'host.name == "web-1"' --confirm=default_logs
+`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		bucketName, _ := cmd.Flags().GetString("bucket")
+		predicate, _ := cmd.Flags().GetString("filter")
+		fromFlag, _ := cmd.Flags().GetString("from")
+		toFlag, _ := cmd.Flags().GetString("to")
+
+		now := time.Now()
+		from, to := "", ""
+		if fromFlag != "" {
+			t, err := timeframe.Parse(fromFlag, now)
+			if err != nil {
+				return fmt.Errorf("invalid --from: %w", err)
+			}
+			from = dqlTimestamp(t)
+		}
+		if toFlag != "" {
+			t, err := timeframe.Until(toFlag, now)
+			if err != nil {
+				return fmt.Errorf("invalid --to: %w", err)
+			}
+			to = dqlTimestamp(t)
+		}
+
+		cfg, c, err := SetupClient()
+		if err != nil {
+			return err
+		}
+
+		handler := bucket.NewHandler(c)
+		b, err := handler.Get(bucketName)
+		if err != nil {
+			return err
+		}
+		if from == "" {
+			from = fmt.Sprintf("now()-%dd", b.RetentionDays)
+		}
+
+		query := bucket.RecordQuery(b.Table, bucketName, from, to, predicate)
+		estimate := countMatchingRecords(c, query)
+
+		if dryRun {
+			fmt.Printf("Dry run: would delete records from bucket %q\n", bucketName)
+			fmt.Printf("Estimated records: %s\n\n%s\n", estimate, query)
+			return nil
+		}
+
+		checker, err := NewSafetyChecker(cfg)
+		if err != nil {
+			return err
+		}
+		if err := checker.CheckError(safety.OperationDeleteRecords, safety.OwnershipUnknown); err != nil {
+			return err
+		}
+
+		confirmFlag, _ := cmd.Flags().GetString("confirm")
+		if !forceDelete && !plainMode {
+			if confirmFlag != "" {
+				if !prompt.ValidateConfirmFlag(confirmFlag, bucketName) {
+					return fmt.Errorf("confirmation value %q does not match bucket name %q", confirmFlag, bucketName)
+				}
+			} else if !prompt.ConfirmRecordDeletion(promptTarget(cfg), bucketName, predicate, estimate) {
+				fmt.Println("Deletion cancelled")
+				return nil
+			}
+		}
+
+		result, err := handler.DeleteRecords(query)
+		if err != nil {
+			return err
+		}
+
+		output.PrintSuccess("Record deletion in bucket %q initiated (task %s)", bucketName, result.TaskID)
+		return nil
+	},
+}
+
+// countMatchingRecords runs a pre-count of query and returns the count as
+// text, or "unknown" when it cannot be determined.
+func countMatchingRecords(c *client.Client, query string) string {
+	result, err := exec.NewDQLExecutor(c).ExecuteQuery(query + "\n| summarize count = count()")
+	if err != nil {
+		return "unknown"
+	}
+	records := exec.ExtractQueryRecords(result)
+	if len(records) == 0 {
+		return "0"
+	}
+	if n, ok := records[0]["count"]; ok {
+		return fmt.Sprint(n)
+	}
+	return "unknown"
+}
+
+// dqlTimestamp formats t as a quoted DQL timeframe bound.
+func dqlTimestamp(t time.Time) string {
+	return strconv.Quote(t.Format(time.RFC3339Nano))
+}
+
+func init() {
+	deleteRecordsCmd.Flags().String("bucket", "", "bucket to delete records from (required)")
+	deleteRecordsCmd.Flags().String("filter", "", "DQL predicate selecting the records to delete (required)")
+	deleteRecordsCmd.Flags().String("from", "", "timeframe start: duration (7d, now-2h), date, or RFC3339 timestamp (default: bucket retention)")
+	deleteRecordsCmd.Flags().String("to", "", "timeframe end: duration, date, or RFC3339 timestamp (default: now)")
+	deleteRecordsCmd.Flags().BoolVarP(&forceDelete, "yes", "y", false, "Skip confirmation prompt")
+	deleteRecordsCmd.Flags().String("confirm", "", "Confirm deletion by providing the bucket name (for non-interactive use)")
+	_ = deleteRecordsCmd.MarkFlagRequired("bucket")
+	_ = deleteRecordsCmd.MarkFlagRequired("filter")
+	_ = deleteRecordsCmd.RegisterFlagCompletionFunc("bucket", completeResourceNames("buckets"))
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+)
+
+func TestDeleteRecordsCmd_DryRunSkipsSafetyCheck(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/storage/management/v1/bucket-definitions/custom_logs": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{"bucketName": "custom_logs", "table": "logs", "retentionDays": 35})
+		},
+		"/platform/storage/record/v1/delete:execute": func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("unexpected %s during --dry-run", r.Method)
+		},
+	})
+	defer ms.Close()
+
+	// The test context is readwrite-all, which does not allow record deletion.
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile, origForce, origDryRun := cfgFile, forceDelete, dryRun
+	defer func() {
+		testutil.ResetCommandFlags(deleteRecordsCmd)
+		cfgFile, forceDelete, dryRun = origCfgFile, origForce, origDryRun
+	}()
+	testutil.ResetCommandFlags(deleteRecordsCmd)
+	cfgFile = configPath
+	forceDelete = true
+	dryRun = true
+
+	for flag, value := range map[string]string{
+		"bucket": "custom_logs",
+		"filter": `host.name == "web-1"`,
+		"from":   "2026-01-02",
+		"to":     "2026-01-03T04:05:06Z",
+	} {
+		if err := deleteRecordsCmd.Flags().Set(flag, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var runErr error
+	got := captureStdout(t, func() { runErr = deleteRecordsCmd.RunE(deleteRecordsCmd, nil) })
+	if runErr != nil {
+		t.Fatalf("RunE() error = %v", runErr)
+	}
+	want := `fetch logs, from:"2026-01-02T00:00:00Z", to:"2026-01-03T04:05:06Z"`
+	if !strings.Contains(got, want) {
+		t.Errorf("stdout = %q, want query with %s", got, want)
+	}
+
+	dryRun = false
+	if err := deleteRecordsCmd.RunE(deleteRecordsCmd, nil); err == nil || !strings.Contains(err.Error(), "record deletion") {
+		t.Errorf("RunE() without --dry-run error = %v, want the safety check to block record deletion", err)
+	}
+
+	if err := deleteRecordsCmd.Flags().Set("from", "yesterday-ish"); err != nil {
+		t.Fatal(err)
+	}
+	if err := deleteRecordsCmd.RunE(deleteRecordsCmd, nil); err == nil || !strings.Contains(err.Error(), "invalid --from") {
+		t.Errorf("RunE() with a bad --from error = %v, want invalid --from", err)
+	}
+}
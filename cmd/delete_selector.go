@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/dynatrace-oss/dtctl/pkg/client"
+	"github.com/dynatrace-oss/dtctl/pkg/config"
+	"github.com/dynatrace-oss/dtctl/pkg/output"
+	"github.com/dynatrace-oss/dtctl/pkg/prompt"
+	"github.com/dynatrace-oss/dtctl/pkg/safety"
+)
+
+// selectedObject is a resource picked for deletion by --selector.
+type selectedObject struct {
+	ID      string
+	Name    string
+	Owner   string
+	Version int
+}
+
+// deleteSelected deletes the objects matched by a --selector after checking
+// the safety level for each of them and a single confirmation. With --dry-run
+// it only lists them, without the safety check. Individual failures do not stop the remaining
+// deletions; they are reported in the returned error.
+func deleteSelected(cfg *config.Config, c *client.Client, kind, selector string, objs []selectedObject, del func(selectedObject) error) error {
+	if len(objs) == 0 {
+		output.PrintInfo("No %ss match selector %q", kind, selector)
+		return nil
+	}
+
+	if dryRun {
+		fmt.Printf("Dry run: would delete %d %s(s) matching %q:\n", len(objs), kind, selector)
+		for _, o := range objs {
+			fmt.Printf("  %s  %s\n", o.ID, o.Name)
+		}
+		return nil
+	}
+
+	checker, err := NewSafetyChecker(cfg)
+	if err != nil {
+		return err
+	}
+	currentUserID, _ := c.CurrentUserID()
+	for _, o := range objs {
+		ownership := safety.DetermineOwnership(o.Owner, currentUserID)
+		if err := checker.CheckError(safety.OperationDelete, ownership); err != nil {
+			return fmt.Errorf("%s %q: %w", kind, o.Name, err)
+		}
+	}
+
+	if !forceDelete && !plainMode {
+		fmt.Printf("\nYou are about to delete %d %s(s) matching %q:\n", len(objs), kind, selector)
+		for _, o := range objs {
+			fmt.Printf("  %s  %s\n", o.ID, o.Name)
+		}
+		fmt.Println()
+		if !prompt.Confirm(promptTarget(cfg), "Are you sure you want to delete these resources?") {
+			fmt.Println("Deletion cancelled")
+			return nil
+		}
+	}
+
+	failed := 0
+	for _, o := range objs {
+		if err := del(o); err != nil {
+			output.PrintWarning("Failed to delete %s %q: %v", kind, o.Name, err)
+			failed++
+			continue
+		}
+		output.PrintSuccess("%s %q deleted", kind, o.Name)
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to delete %d of %d %ss", failed, len(objs), kind)
+	}
+	return nil
+}
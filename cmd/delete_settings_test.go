@@ -3,6 +3,7 @@ package cmd
 import (
 	"net/http"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/dynatrace-oss/dtctl/cmd/testutil"
@@ -89,3 +90,149 @@ func TestDeleteSettingsValidateOnly_ValidationFailed(t *testing.T) {
 		t.Errorf("expected error containing 'validation failed', got %q", err.Error())
 	}
 }
+
+func TestDeleteSettingsAll(t *testing.T) {
+	const schemaID = "builtin:openpipeline.logs.pipelines"
+
+	var mu sync.Mutex
+	deleted := map[string]string{}
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/classic/environment-api/v2/settings/objects": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("schemaIds") != schemaID {
+				t.Errorf("schemaIds = %q, want %q", r.URL.Query().Get("schemaIds"), schemaID)
+			}
+			if r.URL.Query().Get("scopes") != "environment" {
+				t.Errorf("scopes = %q, want environment", r.URL.Query().Get("scopes"))
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"items":[
+				{"objectId":"obj-1","schemaVersion":"1.2","summary":"A"},
+				{"objectId":"obj-2","schemaVersion":"1.3","summary":"B"}],"totalCount":2}`))
+		},
+		"/platform/classic/environment-api/v2/settings/objects/": func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodDelete {
+				t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+				return
+			}
+			mu.Lock()
+			deleted[strings.TrimPrefix(r.URL.Path, "/platform/classic/environment-api/v2/settings/objects/")] = r.Header.Get("If-Match")
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile := cfgFile
+	origPlain := plainMode
+	origDryRun := dryRun
+	defer func() {
+		cfgFile = origCfgFile
+		plainMode = origPlain
+		dryRun = origDryRun
+	}()
+	cfgFile = configPath
+	plainMode = true
+
+	run := func() error {
+		testutil.ResetCommandFlags(deleteSettingsCmd)
+		_ = deleteSettingsCmd.Flags().Set("schema", schemaID)
+		_ = deleteSettingsCmd.Flags().Set("scope", "environment")
+		_ = deleteSettingsCmd.Flags().Set("all", "true")
+		return deleteSettingsCmd.RunE(deleteSettingsCmd, nil)
+	}
+
+	dryRun = true
+	var runErr error
+	out := captureStdout(t, func() { runErr = run() })
+	if runErr != nil {
+		t.Fatalf("dry run error = %v", runErr)
+	}
+	if !strings.Contains(out, "would delete 2 settings object(s)") || len(deleted) != 0 {
+		t.Fatalf("dry run output = %q, deleted = %v", out, deleted)
+	}
+
+	dryRun = false
+	if err := run(); err != nil {
+		t.Fatalf("RunE() error = %v", err)
+	}
+	if deleted["obj-1"] != "1.2" || deleted["obj-2"] != "1.3" {
+		t.Errorf("deleted = %v, want obj-1@1.2 and obj-2@1.3", deleted)
+	}
+}
+
+// TestDeleteSettingsAll_IgnoresListLimits checks that --limit, --no-paginate
+// and --chunk-size 0 do not shrink an --all delete to a subset.
+func TestDeleteSettingsAll_IgnoresListLimits(t *testing.T) {
+	var mu sync.Mutex
+	deleted := map[string]bool{}
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/classic/environment-api/v2/settings/objects": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Query().Get("nextPageKey") == "page-2" {
+				_, _ = w.Write([]byte(`{"items":[{"objectId":"obj-3","summary":"C"}],"totalCount":3}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"items":[{"objectId":"obj-1","summary":"A"},{"objectId":"obj-2","summary":"B"}],"totalCount":3,"nextPageKey":"page-2"}`))
+		},
+		"/platform/classic/environment-api/v2/settings/objects/": func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			deleted[strings.TrimPrefix(r.URL.Path, "/platform/classic/environment-api/v2/settings/objects/")] = true
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile, origPlain := cfgFile, plainMode
+	origLimit, origNoPaginate, origChunkSize := listLimit, noPaginate, chunkSize
+	defer func() {
+		cfgFile, plainMode = origCfgFile, origPlain
+		listLimit, noPaginate, chunkSize = origLimit, origNoPaginate, origChunkSize
+	}()
+	cfgFile = configPath
+	plainMode = true
+	listLimit, noPaginate, chunkSize = 1, true, 0
+
+	testutil.ResetCommandFlags(deleteSettingsCmd)
+	_ = deleteSettingsCmd.Flags().Set("schema", "builtin:openpipeline.logs.pipelines")
+	_ = deleteSettingsCmd.Flags().Set("all", "true")
+	if err := deleteSettingsCmd.RunE(deleteSettingsCmd, nil); err != nil {
+		t.Fatalf("RunE() error = %v", err)
+	}
+	if len(deleted) != 3 {
+		t.Errorf("deleted = %v, want obj-1, obj-2 and obj-3", deleted)
+	}
+}
+
+func TestDeleteSettingsAll_FlagValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		flags   map[string]string
+		args    []string
+		wantErr string
+	}{
+		{name: "all without schema", flags: map[string]string{"all": "true"}, wantErr: "--all requires --schema"},
+		{name: "all with object ID", flags: map[string]string{"all": "true", "schema": "s"}, args: []string{"obj"}, wantErr: "cannot be combined with an object ID"},
+		{name: "schema without all", flags: map[string]string{"schema": "s"}, args: []string{"obj"}, wantErr: "require --all"},
+		{name: "no ID", wantErr: "requires an object ID"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.ResetCommandFlags(deleteSettingsCmd)
+			for k, v := range tt.flags {
+				_ = deleteSettingsCmd.Flags().Set(k, v)
+			}
+			err := deleteSettingsCmd.RunE(deleteSettingsCmd, tt.args)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -2,6 +2,10 @@ package cmd
 
 import (
 	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 
@@ -14,11 +18,19 @@ var describeUserCmd = &cobra.Command{
 	Use:     "user <user-uuid>",
 	Aliases: []string{"users"},
 	Short:   "Show details of an IAM user",
-	Long: `Show detailed information about an IAM user.
+	Long: `Show detailed information about an IAM user, including the groups they
+belong to. With --effective-permissions the permissions granted by the
+policies bound to the user and their groups are resolved as well.
 
 Examples:
   # Describe a user by UUID
   dtctl describe user <user-uuid>
+
+  # Include effective permissions for an access review
+  dtctl describe user <user-uuid> --effective-permissions
+
+  # Structured output (includes groups and effectivePermissions)
+  dtctl describe user <user-uuid> --effective-permissions -o json
 `,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -36,30 +48,81 @@ Examples:
 			return err
 		}
 
-		// For table output, show detailed human-readable information
-		if outputFormat == "table" {
-			const w = 13
-			output.DescribeKV("UUID:", w, "%s", user.UID)
-			output.DescribeKV("Email:", w, "%s", user.Email)
-			if user.Name != "" {
-				output.DescribeKV("Name:", w, "%s", user.Name)
-			}
-			if user.Surname != "" {
-				output.DescribeKV("Surname:", w, "%s", user.Surname)
-			}
-			if user.Description != "" {
-				output.DescribeKV("Description:", w, "%s", user.Description)
+		desc := &userDescription{User: user}
+
+		// Group membership is best-effort: a token without group read access
+		// should still describe the user.
+		if desc.Groups, err = handler.GetUserGroups(userUUID); err != nil {
+			output.PrintWarning("Could not list groups of user %s: %v", userUUID, err)
+		}
+
+		if withPerms, _ := cmd.Flags().GetBool("effective-permissions"); withPerms {
+			if desc.EffectivePermissions, err = handler.GetUserEffectivePermissions(userUUID); err != nil {
+				return err
 			}
+		}
 
+		// For table output, show detailed human-readable information
+		if outputFormat == "table" {
+			printUserDescribe(os.Stdout, desc)
 			return nil
 		}
 
 		// For other formats, use standard printer
 		enrichAgent(printer, "describe", "user")
-		return printer.Print(user)
+		return printer.Print(desc)
 	},
 }
 
+// userDescription is the structured describe output for a user: the profile
+// with group memberships and, on request, effective permissions alongside.
+type userDescription struct {
+	*iam.User            `yaml:",inline"`
+	Groups               []iam.Group               `json:"groups,omitempty" yaml:"groups,omitempty"`
+	EffectivePermissions []iam.EffectivePermission `json:"effectivePermissions,omitempty" yaml:"effectivePermissions,omitempty"`
+}
+
+func printUserDescribe(w io.Writer, desc *userDescription) {
+	const kw = 13
+	output.FprintDescribeKV(w, "UUID:", kw, "%s", desc.UID)
+	output.FprintDescribeKV(w, "Email:", kw, "%s", desc.Email)
+	if desc.Name != "" {
+		output.FprintDescribeKV(w, "Name:", kw, "%s", desc.Name)
+	}
+	if desc.Surname != "" {
+		output.FprintDescribeKV(w, "Surname:", kw, "%s", desc.Surname)
+	}
+	if desc.Description != "" {
+		output.FprintDescribeKV(w, "Description:", kw, "%s", desc.Description)
+	}
+
+	if len(desc.Groups) > 0 {
+		fmt.Fprintln(w)
+		output.FprintDescribeSection(w, fmt.Sprintf("Groups: %d", len(desc.Groups)))
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "  NAME\tTYPE\tUUID")
+		for _, g := range desc.Groups {
+			fmt.Fprintf(tw, "  %s\t%s\t%s\n", g.GroupName, g.Type, g.UUID)
+		}
+		_ = tw.Flush()
+	}
+
+	if len(desc.EffectivePermissions) > 0 {
+		fmt.Fprintln(w)
+		output.FprintDescribeSection(w, fmt.Sprintf("Effective Permissions: %d", len(desc.EffectivePermissions)))
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "  PERMISSION\tEFFECT\tCONDITIONS\tPOLICIES")
+		for _, p := range desc.EffectivePermissions {
+			conditions := strings.Join(p.Conditions, " AND ")
+			if conditions == "" {
+				conditions = "-"
+			}
+			fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\n", p.Permission, p.Effect, conditions, p.PolicyList)
+		}
+		_ = tw.Flush()
+	}
+}
+
 // describeGroupCmd shows detailed info about a group
 var describeGroupCmd = &cobra.Command{
 	Use:     "group <group-uuid>",
@@ -84,7 +147,7 @@ Examples:
 		handler := iam.NewHandler(c)
 
 		// Since there's no get single group endpoint, we list and filter
-		list, err := handler.ListGroups("", []string{groupUUID}, GetChunkSize())
+		list, err := handler.ListGroups("", []string{groupUUID}, GetChunkSize(), 0)
 		if err != nil {
 			return err
 		}
@@ -110,3 +173,7 @@ Examples:
 		return printer.Print(group)
 	},
 }
+
+func init() {
+	describeUserCmd.Flags().Bool("effective-permissions", false, "Resolve the permissions granted by the policies bound to the user and their groups")
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/pkg/resources/iam"
+)
+
+func TestPrintUserDescribe(t *testing.T) {
+	desc := &userDescription{
+		User:   &iam.User{UID: "user-1", Email: "a@example.invalid"},
+		Groups: []iam.Group{{UUID: "g-1", GroupName: "Admins", Type: "LOCAL"}},
+		EffectivePermissions: []iam.EffectivePermission{
+			{Permission: "storage:logs:read", Effect: "ALLOW", Conditions: []string{"storage:bucket-name = 'default_logs'"}, PolicyList: "Log readers"},
+			{Permission: "settings:objects:write", Effect: "DENY", PolicyList: "Guardrails"},
+		},
+	}
+
+	var buf bytes.Buffer
+	printUserDescribe(&buf, desc)
+	got := buf.String()
+
+	for _, want := range []string{
+		"Email:       a@example.invalid",
+		"Groups: 1",
+		"Admins",
+		"Effective Permissions: 2",
+		"storage:bucket-name = 'default_logs'",
+		"settings:objects:write",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("describe output missing %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestUserDescriptionJSON(t *testing.T) {
+	desc := &userDescription{
+		User:   &iam.User{UID: "user-1", Email: "a@example.invalid"},
+		Groups: []iam.Group{{UUID: "g-1", GroupName: "Admins"}},
+	}
+	data, err := json.Marshal(desc)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var got map[string]any
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got["uid"] != "user-1" || got["groups"] == nil {
+		t.Errorf("user fields not inlined or groups missing: %s", data)
+	}
+	if _, ok := got["effectivePermissions"]; ok {
+		t.Errorf("effectivePermissions should be omitted when not requested: %s", data)
+	}
+}
@@ -108,6 +108,12 @@ Examples:
   # Describe a settings schema
   dtctl describe settings-schema builtin:openpipeline.logs.pipelines
   dtctl describe schema builtin:anomaly-detection.infrastructure
+
+  # One row per leaf property: path, type, required, default, enum values
+  dtctl describe schema builtin:anomaly-detection.infrastructure --properties
+
+  # Same, as JSON
+  dtctl describe schema builtin:anomaly-detection.infrastructure --properties -o json
 `,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -125,6 +131,11 @@ Examples:
 			return err
 		}
 
+		if showProperties, _ := cmd.Flags().GetBool("properties"); showProperties {
+			enrichAgent(printer, "describe", "settings-schema")
+			return printer.PrintList(settings.FlattenProperties(schema))
+		}
+
 		// For table output, show detailed human-readable information
 		if outputFormat == "table" {
 			const w = 18
@@ -150,7 +161,7 @@ Examples:
 			// Print properties if available
 			if properties, ok := schema["properties"].(map[string]any); ok && len(properties) > 0 {
 				fmt.Println()
-				output.DescribeKV("Properties:", w, "%d defined", len(properties))
+				output.DescribeKV("Properties:", w, "%d defined (list them with --properties)", len(properties))
 			}
 
 			// Print scopes if available
@@ -172,3 +183,7 @@ Examples:
 		return printer.Print(schema)
 	},
 }
+
+func init() {
+	describeSettingsSchemaCmd.Flags().Bool("properties", false, "list the schema's leaf properties (path, type, required, default, enum values), with nested objects flattened into dotted paths")
+}
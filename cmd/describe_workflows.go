@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -20,10 +21,19 @@ var describeWorkflowCmd = &cobra.Command{
 	Short:   "Show details of a workflow",
 	Long: `Show detailed information about a workflow including triggers, tasks, and recent executions.
 
+Structured output (-o json/yaml) includes the most recent executions in a
+recentExecutions array alongside the workflow definition.
+
 Examples:
   # Describe a workflow
   dtctl describe workflow <workflow-id>
   dtctl describe wf <workflow-id>
+
+  # Include the last 20 executions
+  dtctl describe workflow <workflow-id> --executions 20
+
+  # Structured output with recent executions
+  dtctl describe workflow <workflow-id> -o json
 `,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -43,23 +53,44 @@ Examples:
 			return err
 		}
 
-		// For table output, show detailed human-readable information
-		if outputFormat == "table" {
-			execList, err := execHandler.List(workflow.ExecutionFilters{WorkflowID: workflowID}, 10)
+		// Recent executions are best-effort: a workflow the caller cannot
+		// list executions for should still describe successfully.
+		limit, _ := cmd.Flags().GetInt64("executions")
+		var execList *workflow.ExecutionList
+		if limit > 0 {
+			execList, err = execHandler.List(workflow.ExecutionFilters{WorkflowID: workflowID}, limit)
 			if err != nil {
 				execList = nil
 			}
-			printWorkflowDescribeTable(os.Stdout, wf, execList)
+		}
 
+		// For table output, show detailed human-readable information
+		if outputFormat == "table" {
+			printWorkflowDescribeTable(os.Stdout, wf, execList)
 			return nil
 		}
 
 		// For other formats, use standard printer
 		enrichAgent(printer, "describe", "workflow")
-		return printer.Print(wf)
+		return printer.Print(newWorkflowDescription(wf, execList))
 	},
 }
 
+// workflowDescription is the structured describe output for a workflow: the
+// workflow definition with its most recent executions alongside.
+type workflowDescription struct {
+	*workflow.Workflow `yaml:",inline"`
+	RecentExecutions   []workflow.Execution `json:"recentExecutions" yaml:"recentExecutions"`
+}
+
+func newWorkflowDescription(wf *workflow.Workflow, execList *workflow.ExecutionList) *workflowDescription {
+	desc := &workflowDescription{Workflow: wf, RecentExecutions: []workflow.Execution{}}
+	if execList != nil && execList.Results != nil {
+		desc.RecentExecutions = execList.Results
+	}
+	return desc
+}
+
 func printWorkflowDescribeTable(w io.Writer, wf *workflow.Workflow, execList *workflow.ExecutionList) {
 	const kw = 13
 	output.FprintDescribeKV(w, "ID:", kw, "%s", wf.ID)
@@ -75,6 +106,12 @@ func printWorkflowDescribeTable(w io.Writer, wf *workflow.Workflow, execList *wo
 	if summary := triggerSummary(wf.Trigger); summary != "" {
 		output.FprintDescribeKV(w, "Trigger:", kw, "%s", summary)
 	}
+	if schedule, next := workflow.DescribeSchedule(wf.Trigger, time.Now()); schedule != "" {
+		output.FprintDescribeKV(w, "Schedule:", kw, "%s", schedule)
+		if next != nil {
+			output.FprintDescribeKV(w, "Next Run:", kw, "%s", next.Format("2006-01-02 15:04 MST"))
+		}
+	}
 
 	printWorkflowDefinitionFields(w, kw, wf)
 
@@ -186,6 +223,10 @@ func nestedTriggerString(root map[string]interface{}, path ...string) string {
 	return value
 }
 
+func init() {
+	describeWorkflowCmd.Flags().Int64("executions", 10, "Number of recent executions to include (0 to skip)")
+}
+
 // describeWorkflowExecutionCmd shows detailed info about a workflow execution
 var describeWorkflowExecutionCmd = &cobra.Command{
 	Use:     "workflow-execution <execution-id>",
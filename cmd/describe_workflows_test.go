@@ -373,3 +373,56 @@ func TestDescribeWorkflowCmd_TableOutputFetchesExecutions(t *testing.T) {
 		t.Errorf("expected 2 requests (get workflow + list executions), got %d", ms.RequestCount)
 	}
 }
+
+func TestDescribeWorkflowCmd_StructuredOutputIncludesRecentExecutions(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/automation/v1/workflows/wf-describe-2": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{"id": "wf-describe-2", "title": "Nightly"})
+		},
+		"/platform/automation/v1/executions": func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("workflow"); got != "wf-describe-2" {
+				t.Errorf("executions filtered by workflow %q, want wf-describe-2", got)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{"count": 1, "results": []any{
+				map[string]any{"id": "exec-1", "workflow": "wf-describe-2", "state": "ERROR", "startedAt": "2026-05-18T12:30:00Z"},
+			}})
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile, origOutputFormat, origAgentMode := cfgFile, outputFormat, agentMode
+	defer func() {
+		testutil.ResetCommandFlags(describeWorkflowCmd)
+		cfgFile, outputFormat, agentMode = origCfgFile, origOutputFormat, origAgentMode
+	}()
+	testutil.ResetCommandFlags(describeWorkflowCmd)
+	cfgFile = configPath
+	outputFormat = "json"
+	agentMode = false
+
+	out := captureStdout(t, func() {
+		if err := describeWorkflowCmd.RunE(describeWorkflowCmd, []string{"wf-describe-2"}); err != nil {
+			t.Fatalf("RunE() error = %v", err)
+		}
+	})
+
+	var got struct {
+		ID               string               `json:"id"`
+		Title            string               `json:"title"`
+		RecentExecutions []workflow.Execution `json:"recentExecutions"`
+	}
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, out)
+	}
+	if got.ID != "wf-describe-2" || got.Title != "Nightly" {
+		t.Errorf("workflow fields not inlined: %+v", got)
+	}
+	if len(got.RecentExecutions) != 1 || got.RecentExecutions[0].State != "ERROR" {
+		t.Errorf("recentExecutions = %+v", got.RecentExecutions)
+	}
+}
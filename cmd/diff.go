@@ -9,7 +9,9 @@ import (
 
 	"github.com/dynatrace-oss/dtctl/pkg/client"
 	"github.com/dynatrace-oss/dtctl/pkg/diff"
+	"github.com/dynatrace-oss/dtctl/pkg/output"
 	"github.com/dynatrace-oss/dtctl/pkg/resources/document"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/settings"
 	"github.com/dynatrace-oss/dtctl/pkg/resources/workflow"
 	"github.com/dynatrace-oss/dtctl/pkg/util/format"
 )
@@ -29,6 +31,10 @@ Examples:
   
   # Explicit resource specification
   dtctl diff workflow my-workflow -f local.yaml
+
+  # Compare a settings object's value with a file (key-level diff, e.g. for
+  # drift detection of openpipeline configs in CI)
+  dtctl diff settings <object-id> -f pipeline.yaml
   
   # Compare two remote resources (dtctl extension)
   dtctl diff workflow prod-wf staging-wf
@@ -67,7 +73,7 @@ func init() {
 	diffCmd.Flags().Bool("ignore-metadata", false, "Ignore metadata fields (timestamps, versions)")
 	diffCmd.Flags().Bool("ignore-order", false, "Ignore array order for comparison")
 	diffCmd.Flags().Int("context", 3, "Number of context lines")
-	diffCmd.Flags().Bool("color", true, "Colorize output")
+	diffCmd.Flags().Bool("color", false, "Colorize output (default: on when stdout is a terminal; NO_COLOR and --plain always disable it)")
 	diffCmd.Flags().StringP("output", "o", "", "Output format (overrides --format): json-patch, semantic")
 }
 
@@ -86,7 +92,11 @@ func runDiff(cmd *cobra.Command, args []string) error {
 	ignoreMetadata, _ := cmd.Flags().GetBool("ignore-metadata")
 	ignoreOrder, _ := cmd.Flags().GetBool("ignore-order")
 	contextLines, _ := cmd.Flags().GetInt("context")
-	colorize, _ := cmd.Flags().GetBool("color")
+	colorize := output.ColorEnabled()
+	if cmd.Flags().Changed("color") {
+		explicit, _ := cmd.Flags().GetBool("color")
+		colorize = explicit && output.ColorAllowed()
+	}
 	outputFormat, _ := cmd.Flags().GetString("output")
 
 	if outputFormat != "" {
@@ -122,6 +132,15 @@ func runDiff(cmd *cobra.Command, args []string) error {
 		result, err = handleTwoFiles(differ, files[0], files[1])
 	case len(files) == 1 && len(args) == 0:
 		result, err = handleFileVsRemote(differ, files[0])
+	case len(files) == 1 && len(args) == 2 && normalizeResourceType(args[0]) == "settings":
+		if !cmd.Flags().Changed("format") && outputFormat == "" && !sideBySide {
+			differ = diff.NewDiffer(diff.DiffOptions{
+				Format:      diff.DiffFormatSemantic,
+				IgnoreOrder: ignoreOrder,
+				Semantic:    true,
+			})
+		}
+		result, err = handleSettingsDiff(differ, files[0], args[1])
 	case len(files) == 1 && len(args) >= 2:
 		result, err = handleFileVsNamedResource(differ, files[0], args[0], args[1])
 	case len(files) == 0 && len(args) == 3:
@@ -194,6 +213,53 @@ func handleFileVsNamedResource(differ *diff.Differ, file, resourceType, resource
 	return differ.Compare(remoteData, localData, fmt.Sprintf("remote: %s/%s", resourceType, resourceID), fmt.Sprintf("local: %s", file))
 }
 
+// handleSettingsDiff compares the value of a settings object with the value in
+// a file. Only the values are compared; the envelope (objectId, schemaVersion,
+// modificationInfo, ...) is server-managed and ignored.
+func handleSettingsDiff(differ *diff.Differ, file, objectID string) (*diff.DiffResult, error) {
+	localData, err := parseYAMLFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse file: %w", err)
+	}
+	localValue, localSchema, err := settingsFileValue(localData)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+
+	_, c, err := SetupClient()
+	if err != nil {
+		return nil, err
+	}
+	obj, err := settings.NewHandler(c).Get(objectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch settings object: %w", err)
+	}
+	if localSchema != "" && localSchema != obj.SchemaID {
+		return nil, fmt.Errorf("%s is a %s object but %s has schema %s", file, localSchema, objectID, obj.SchemaID)
+	}
+
+	remoteValue := map[string]interface{}(obj.Value)
+	if remoteValue == nil {
+		remoteValue = map[string]interface{}{}
+	}
+	return differ.Compare(remoteValue, localValue, fmt.Sprintf("remote: settings/%s", objectID), fmt.Sprintf("local: %s", file))
+}
+
+// settingsFileValue returns the value and schema ID of a settings object
+// file, as written by 'get settings -o yaml'.
+func settingsFileValue(data interface{}) (map[string]interface{}, string, error) {
+	m, ok := data.(map[string]interface{})
+	if !ok {
+		return nil, "", fmt.Errorf("expected a settings object")
+	}
+	value, ok := m["value"].(map[string]interface{})
+	if !ok {
+		return nil, "", fmt.Errorf("no \"value\" object found; expected a settings object as written by 'dtctl get settings <object-id> -o yaml'")
+	}
+	schemaID, _ := m["schemaId"].(string)
+	return value, schemaID, nil
+}
+
 func handleTwoRemoteResources(differ *diff.Differ, resourceType, id1, id2 string) (*diff.DiffResult, error) {
 	_, c, err := SetupClient()
 	if err != nil {
@@ -302,6 +368,8 @@ func normalizeResourceType(resourceType string) string {
 		return "dashboard"
 	case "nb", "notebooks":
 		return "notebook"
+	case "setting":
+		return "settings"
 	default:
 		return resourceType
 	}
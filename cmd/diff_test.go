@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+	"github.com/dynatrace-oss/dtctl/pkg/diff"
+)
+
+func TestHandleSettingsDiff(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/classic/environment-api/v2/settings/objects/obj-1": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{
+				"objectId": "obj-1",
+				"schemaId": "builtin:openpipeline.logs.pipelines",
+				"schemaVersion": "1.40",
+				"modificationInfo": {"lastModifiedTime": "2026-10-01T00:00:00Z"},
+				"value": {"displayName": "Logs", "enabled": true, "processors": ["a"]}
+			}`))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+	origCfgFile := cfgFile
+	defer func() { cfgFile = origCfgFile }()
+	cfgFile = configPath
+
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	differ := diff.NewDiffer(diff.DiffOptions{Format: diff.DiffFormatSemantic, Semantic: true})
+
+	// Envelope fields differ from the server's, but only the value counts.
+	same := write("same.yaml", `objectId: obj-1
+schemaId: builtin:openpipeline.logs.pipelines
+schemaVersion: "1.0"
+value:
+  displayName: Logs
+  enabled: true
+  processors: [a]
+`)
+	result, err := handleSettingsDiff(differ, same, "obj-1")
+	if err != nil {
+		t.Fatalf("handleSettingsDiff() error = %v", err)
+	}
+	if result.HasChanges {
+		t.Errorf("expected no changes, got:\n%s", result.Patch)
+	}
+
+	changed := write("changed.yaml", `value:
+  displayName: Logs
+  enabled: false
+  owner: team-a
+`)
+	result, err = handleSettingsDiff(differ, changed, "obj-1")
+	if err != nil {
+		t.Fatalf("handleSettingsDiff() error = %v", err)
+	}
+	for _, want := range []string{"~ enabled: true → false", "+ owner: \"team-a\"", `- processors: ["a"]`} {
+		if !strings.Contains(result.Patch, want) {
+			t.Errorf("patch missing %q:\n%s", want, result.Patch)
+		}
+	}
+
+	otherSchema := write("other.yaml", "schemaId: builtin:alerting.profile\nvalue:\n  name: x\n")
+	if _, err := handleSettingsDiff(differ, otherSchema, "obj-1"); err == nil || !strings.Contains(err.Error(), "builtin:alerting.profile") {
+		t.Errorf("expected schema mismatch error, got %v", err)
+	}
+
+	noValue := write("novalue.yaml", "displayName: Logs\n")
+	if _, err := handleSettingsDiff(differ, noValue, "obj-1"); err == nil || !strings.Contains(err.Error(), "value") {
+		t.Errorf("expected missing value error, got %v", err)
+	}
+}
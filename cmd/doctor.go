@@ -23,8 +23,8 @@ type checkResult struct {
 }
 
 // checkKeyringFunc is the function used to probe keyring availability.
-// It defaults to config.CheckKeyring and can be overridden in tests.
-var checkKeyringFunc = config.CheckKeyring
+// It defaults to config.KeyringMode.CheckKeyring and can be overridden in tests.
+var checkKeyringFunc = config.KeyringMode.CheckKeyring
 
 // doctorCmd runs health checks on the dtctl configuration and connectivity
 var doctorCmd = &cobra.Command{
@@ -86,10 +86,7 @@ func runDoctorChecksWithClient(httpClient *http.Client) []checkResult {
 		return results // Cannot continue without config
 	}
 
-	configPath := config.FindLocalConfig()
-	if configPath == "" {
-		configPath = config.DefaultConfigPath()
-	}
+	configPath := effectiveConfigPath(cfgFile)
 	results = append(results, checkResult{
 		Name:   "Configuration",
 		Status: "ok",
@@ -145,8 +142,15 @@ func runDoctorChecksWithClient(httpClient *http.Client) []checkResult {
 	}
 
 	// 5. Keyring status
-	if keyringErr := checkKeyringFunc(); keyringErr != nil {
-		if config.IsFileTokenStorage() {
+	mode := cfg.KeyringMode()
+	if keyringErr := checkKeyringFunc(mode); keyringErr != nil {
+		if mode == config.KeyringModeFile {
+			results = append(results, checkResult{
+				Name:   "Token storage",
+				Status: "ok",
+				Detail: "file-based (--no-keyring); tokens are stored in plaintext",
+			})
+		} else if mode.FileTokenStorage() {
 			results = append(results, checkResult{
 				Name:   "Token storage",
 				Status: "ok",
@@ -188,10 +192,10 @@ func runDoctorChecksWithClient(httpClient *http.Client) []checkResult {
 	}
 
 	tokenSource := "config file"
-	if config.IsKeyringAvailable() {
+	if mode.KeyringAvailable() {
 		tokenSource = fmt.Sprintf("keyring (%s)", config.KeyringBackend())
-	} else if config.IsFileTokenStorage() {
-		tokenSource = fmt.Sprintf("file store (%s)", config.OAuthStorageBackend())
+	} else if mode.FileTokenStorage() {
+		tokenSource = fmt.Sprintf("file store (%s)", mode.OAuthStorageBackend())
 	}
 	// Mask token for display
 	maskedToken := token
@@ -205,7 +209,7 @@ func runDoctorChecksWithClient(httpClient *http.Client) []checkResult {
 	})
 
 	// OAuth session state (only applies when the stored token is OAuth)
-	session, sessionErr := buildSessionStatusFunc(cfg.CurrentContext, ctx, ctx.TokenRef)
+	session, sessionErr := buildSessionStatusFunc(cfg, cfg.CurrentContext, ctx, ctx.TokenRef)
 	if sessionErr != nil {
 		results = append(results, checkResult{
 			Name:   "OAuth session",
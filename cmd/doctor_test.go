@@ -523,7 +523,7 @@ func TestDoctorKeyringCollectionRecoverySuggestion(t *testing.T) {
 	// collection-unlock message.
 	origFunc := checkKeyringFunc
 	defer func() { checkKeyringFunc = origFunc }()
-	checkKeyringFunc = func() error {
+	checkKeyringFunc = func(config.KeyringMode) error {
 		return fmt.Errorf("keyring probe failed: %s", config.ErrMsgCollectionUnlock)
 	}
 
@@ -92,7 +92,7 @@ Examples:
 // addAnalyzerInputFlags registers the input-source flags shared by
 // "exec analyzer" and "verify analyzer".
 func addAnalyzerInputFlags(cmd *cobra.Command) {
-	cmd.Flags().StringP("file", "f", "", "read input from JSON file")
+	cmd.Flags().StringP("file", "f", "", "read input from JSON or YAML file")
 	cmd.Flags().String("input", "", "inline JSON input")
 	cmd.Flags().String("query", "", "DQL query shorthand (for timeseries analyzers)")
 }
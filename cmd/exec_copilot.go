@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -118,9 +119,15 @@ Examples:
 
   # Output as JSON (includes messageToken for feedback)
   dtctl exec copilot nl2dql "find hosts with high CPU" -o json
+
+  # Generate the query and run it right away (the DQL is echoed to stderr)
+  dtctl exec copilot nl2dql "show me failing requests" --execute -o table
+
+  # Or pipe the generated DQL into 'dtctl query'
+  dtctl exec copilot nl2dql "show me failing requests" | dtctl query -f -
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		_, c, err := SetupClient()
+		cfg, c, err := SetupClient()
 		if err != nil {
 			return err
 		}
@@ -148,6 +155,14 @@ Examples:
 			return err
 		}
 
+		if execute, _ := cmd.Flags().GetBool("execute"); execute {
+			if strings.TrimSpace(result.DQL) == "" {
+				return fmt.Errorf("copilot did not generate a DQL query (status: %s)", result.Status)
+			}
+			fmt.Fprintf(os.Stderr, "Generated DQL:\n%s\n\n", result.DQL)
+			return NewDQLExecutorFromConfig(cfg, c).Execute(result.DQL, outputFormat)
+		}
+
 		// Check output format
 		if outputFormat == "" || outputFormat == "table" {
 			// Default: just print the DQL
@@ -288,6 +303,7 @@ func init() {
 
 	// CoPilot nl2dql flags
 	execCopilotNl2DqlCmd.Flags().StringP("file", "f", "", "read prompt from file")
+	execCopilotNl2DqlCmd.Flags().Bool("execute", false, "run the generated query and print its results (the DQL is echoed to stderr)")
 
 	// CoPilot dql2nl flags
 	execCopilotDql2NlCmd.Flags().StringP("file", "f", "", "read DQL query from file")
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+)
+
+func TestExecCopilotNl2Dql_Execute(t *testing.T) {
+	var executed string
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/davis/copilot/v1/skills/nl2dql:generate": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"dql":"fetch logs | filter loglevel == \"ERROR\"","status":"SUCCESSFUL"}`))
+		},
+		"/platform/storage/query/v1/query:execute": func(w http.ResponseWriter, r *http.Request) {
+			var req map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			executed, _ = req["query"].(string)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"state":"SUCCEEDED","result":{"records":[{"content":"boom"}]}}`))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile := cfgFile
+	origOutput := outputFormat
+	defer func() {
+		testutil.ResetCommandFlags(execCopilotNl2DqlCmd)
+		cfgFile = origCfgFile
+		outputFormat = origOutput
+	}()
+
+	testutil.ResetCommandFlags(execCopilotNl2DqlCmd)
+	cfgFile = configPath
+	outputFormat = "json"
+	_ = execCopilotNl2DqlCmd.Flags().Set("execute", "true")
+
+	out := captureStdout(t, func() {
+		if err := execCopilotNl2DqlCmd.RunE(execCopilotNl2DqlCmd, []string{"show me error logs"}); err != nil {
+			t.Fatalf("RunE() error = %v", err)
+		}
+	})
+
+	if executed != `fetch logs | filter loglevel == "ERROR"` {
+		t.Errorf("executed query = %q", executed)
+	}
+	if !strings.Contains(out, "boom") {
+		t.Errorf("expected query results on stdout, got %q", out)
+	}
+}
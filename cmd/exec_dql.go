@@ -1,16 +1,22 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/spf13/cobra"
 
+	"github.com/dynatrace-oss/dtctl/pkg/exec"
 	"github.com/dynatrace-oss/dtctl/pkg/output"
+	"github.com/dynatrace-oss/dtctl/pkg/util/template"
 )
 
 // execDQLCmd executes a DQL query (DEPRECATED)
 var execDQLCmd = &cobra.Command{
-	Use:    "dql [query]",
+	Use:    "dql [query | --from-file <path>]",
 	Short:  "Execute a DQL query (DEPRECATED: use 'dtctl query')",
 	Hidden: true, // Hide from help output
 	Long: `Execute a DQL query against Grail storage.
@@ -26,35 +32,104 @@ Examples:
   # Execute from file (use 'dtctl query -f' instead)
   dtctl query -f query.dql
 
+  # Read a long query from a file and fill in {{ .var }} placeholders
+  dtctl exec dql --from-file queries.dql --param host=h-123 --param limit=50
+
+  # Read the query from stdin
+  cat queries.dql | dtctl exec dql --from-file -
+
   # Output as JSON (use 'dtctl query -o json' instead)
   dtctl query "fetch logs" -o json
+
+  # Output as a Markdown table with selected columns
+  dtctl query "fetch logs | limit 10" -o markdown --columns timestamp,content
+
+  # Fetch the results of the last query (use 'dtctl query --resume' instead)
+  dtctl query --resume
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Show deprecation warning
 		output.PrintWarning("'dtctl exec dql' is deprecated. Use 'dtctl query' instead.")
+		resume, requestToken, err := queryResumeFlags(cmd, args)
+		if err != nil {
+			return err
+		}
+
+		queryFile, _ := cmd.Flags().GetString("file")
+		fromFile, _ := cmd.Flags().GetString("from-file")
+		params, _ := cmd.Flags().GetStringArray("param")
+		if queryFile != "" && fromFile != "" {
+			return fmt.Errorf("--file and --from-file cannot be used together")
+		}
+		if fromFile != "" {
+			queryFile = fromFile
+		}
+		if queryFile != "" && len(args) > 0 {
+			return fmt.Errorf("a query argument cannot be combined with --from-file")
+		}
+		var vars map[string]interface{}
+		if len(params) > 0 {
+			if vars, err = template.ParseSetFlags(params); err != nil {
+				return fmt.Errorf("invalid --param flag: %w", err)
+			}
+		}
+
 		cfg, c, err := SetupClient()
 		if err != nil {
 			return err
 		}
 
-		executor := NewDQLExecutorFromConfig(cfg, c)
+		executor := NewDQLExecutorFromConfig(cfg, c).WithLastQueryFile(lastQueryFile())
 
-		queryFile, _ := cmd.Flags().GetString("file")
+		// Set up signal handling so a running Grail query is cancelled on Ctrl+C / SIGTERM.
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
 
-		if queryFile != "" {
-			return executor.ExecuteFromFile(queryFile, outputFormat)
+		sigCh := make(chan os.Signal, 1)
+		signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+		defer signal.Stop(sigCh)
+		go func() {
+			<-sigCh
+			cancel()
+		}()
+
+		opts := exec.DQLExecuteOptions{OutputFormat: outputFormat, Columns: columns, ContextName: cfg.CurrentContext}
+		if resume {
+			return executor.ResumeWithContext(ctx, requestToken, opts)
 		}
 
-		if len(args) == 0 {
-			return fmt.Errorf("query string or --file is required")
+		explain, _ := cmd.Flags().GetBool("explain")
+
+		var query string
+		switch {
+		case queryFile != "":
+			if query, err = readQueryFile(queryFile); err != nil {
+				return err
+			}
+		case len(args) > 0:
+			query = args[0]
+		default:
+			return fmt.Errorf("query string or --from-file is required")
 		}
 
-		query := args[0]
-		return executor.Execute(query, outputFormat)
+		if vars != nil {
+			if query, err = template.RenderTemplate(query, vars); err != nil {
+				return fmt.Errorf("template rendering failed: %w", err)
+			}
+		}
+
+		if explain {
+			return explainQuery(executor, query, exec.DQLVerifyOptions{}, outputFormat)
+		}
+		return executor.ExecuteWithContext(ctx, query, opts)
 	},
 }
 
 func init() {
 	// DQL flags
 	execDQLCmd.Flags().StringP("file", "f", "", "read query from file")
+	execDQLCmd.Flags().String("from-file", "", "read query from file (use '-' for stdin)")
+	execDQLCmd.Flags().StringArray("param", []string{}, "substitute a template variable in the query (key=value, repeatable)")
+	execDQLCmd.Flags().Bool("explain", false, "verify the query and print its canonical form without executing it")
+	addQueryResumeFlags(execDQLCmd)
 }
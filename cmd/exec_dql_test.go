@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/adrg/xdg"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+	"github.com/dynatrace-oss/dtctl/pkg/exec"
+)
+
+func TestExecDQL_FromFileWithParams(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	xdg.Reload()
+	defer xdg.Reload()
+
+	var gotQuery string
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/storage/query/v1/query:execute": func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			gotQuery, _ = body["query"].(string)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"state":"SUCCEEDED","result":{"records":[{"host":"h-123"}]}}`))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	queryPath := filepath.Join(t.TempDir(), "queries.dql")
+	content := "fetch logs\n| filter host == \"{{ .host }}\"\n| limit {{ .limit }}\n"
+	if err := os.WriteFile(queryPath, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	origCfgFile, origOutput := cfgFile, outputFormat
+	defer func() {
+		testutil.ResetCommandFlags(execDQLCmd)
+		cfgFile, outputFormat = origCfgFile, origOutput
+	}()
+	testutil.ResetCommandFlags(execDQLCmd)
+	cfgFile = configPath
+	outputFormat = "json"
+	_ = execDQLCmd.Flags().Set("from-file", queryPath)
+	_ = execDQLCmd.Flags().Set("param", "host=h-123")
+	_ = execDQLCmd.Flags().Set("param", "limit=50")
+
+	out := captureStdout(t, func() {
+		if err := execDQLCmd.RunE(execDQLCmd, nil); err != nil {
+			t.Fatalf("RunE() error = %v", err)
+		}
+	})
+
+	want := "fetch logs\n| filter host == \"h-123\"\n| limit 50\n"
+	if gotQuery != want {
+		t.Errorf("query = %q, want %q", gotQuery, want)
+	}
+	if !strings.Contains(out, "h-123") {
+		t.Errorf("expected records in output, got:\n%s", out)
+	}
+}
+
+func TestExecDQL_FlagValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		flags   map[string][]string
+		args    []string
+		wantErr string
+	}{
+		{name: "file and from-file", flags: map[string][]string{"file": {"a.dql"}, "from-file": {"b.dql"}}, wantErr: "cannot be used together"},
+		{name: "query and from-file", flags: map[string][]string{"from-file": {"a.dql"}}, args: []string{"fetch logs"}, wantErr: "cannot be combined"},
+		{name: "malformed param", flags: map[string][]string{"from-file": {"a.dql"}, "param": {"novalue"}}, wantErr: "invalid --param"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.ResetCommandFlags(execDQLCmd)
+			defer testutil.ResetCommandFlags(execDQLCmd)
+			for name, values := range tt.flags {
+				for _, v := range values {
+					_ = execDQLCmd.Flags().Set(name, v)
+				}
+			}
+			err := execDQLCmd.RunE(execDQLCmd, tt.args)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExecDQL_ResumeFromOtherContext(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	xdg.Reload()
+	defer xdg.Reload()
+
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/storage/query/v1/query:poll": func(w http.ResponseWriter, r *http.Request) {
+			t.Error("a query from another context must not be polled")
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	if err := exec.SaveLastQuery(lastQueryFile(), &exec.LastQuery{Query: "fetch logs", RequestToken: "tok-1", State: exec.LastQueryRunning, Context: "prod"}); err != nil {
+		t.Fatal(err)
+	}
+
+	origCfgFile := cfgFile
+	defer func() {
+		testutil.ResetCommandFlags(execDQLCmd)
+		cfgFile = origCfgFile
+	}()
+	testutil.ResetCommandFlags(execDQLCmd)
+	cfgFile = configPath
+	_ = execDQLCmd.Flags().Set("resume", "true")
+
+	err := execDQLCmd.RunE(execDQLCmd, nil)
+	if err == nil || !strings.Contains(err.Error(), `ran in context "prod", not the current context "test"`) {
+		t.Fatalf("error = %v, want a context mismatch", err)
+	}
+}
@@ -40,9 +40,13 @@ Examples:
 
   # Execute with payload
   dtctl exec function -f script.js --payload '{"input":"data"}'
+
+  # Pin the SDK version for ad-hoc code (default: preferences.sdk-version,
+  # else the version marked default by 'dtctl get sdk-versions')
+  dtctl exec function -f script.js --sdk 1.4.0
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		_, c, err := SetupClient()
+		cfg, c, err := SetupClient()
 		if err != nil {
 			return err
 		}
@@ -56,6 +60,10 @@ Examples:
 		sourceCode, _ := cmd.Flags().GetString("code")
 		sourceCodeFile, _ := cmd.Flags().GetString("file")
 		defer_, _ := cmd.Flags().GetBool("defer")
+		sdkVersion, _ := cmd.Flags().GetString("sdk")
+		if sdkVersion == "" && (sourceCode != "" || sourceCodeFile != "") {
+			sdkVersion = cfg.Preferences.SDKVersion
+		}
 
 		opts := exec.FunctionExecuteOptions{
 			Method:         method,
@@ -64,6 +72,7 @@ Examples:
 			SourceCode:     sourceCode,
 			SourceCodeFile: sourceCodeFile,
 			Defer:          defer_,
+			SDKVersion:     sdkVersion,
 		}
 
 		// Parse function reference from args if provided
@@ -91,4 +100,5 @@ func init() {
 	execFunctionCmd.Flags().String("code", "", "JavaScript code to execute (for ad-hoc execution)")
 	execFunctionCmd.Flags().StringP("file", "f", "", "read JavaScript code from file (for ad-hoc execution)")
 	execFunctionCmd.Flags().Bool("defer", false, "defer execution (async, for resumable functions)")
+	execFunctionCmd.Flags().String("sdk", "", "function executor SDK version for ad-hoc code (default: preferences.sdk-version, else the service default)")
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"strings"
 	"time"
 
@@ -62,7 +64,11 @@ var execWorkflowCmd = &cobra.Command{
 	Use:     "workflow <workflow-id>",
 	Aliases: []string{"wf"},
 	Short:   "Execute a workflow",
-	Long:    "Execute an automation workflow. Workflow input must be provided as a JSON object via --input.",
+	Long: `Execute an automation workflow.
+
+Workflow input is a JSON object given inline or as a file via --input (use
+"-" for stdin). Use --set key=value to set or override single fields; dotted
+keys create nested objects and values are parsed as JSON when possible.`,
 	Example: strings.Join([]string{
 		"  # Execute workflow",
 		"  dtctl exec workflow my-workflow-id",
@@ -70,6 +76,12 @@ var execWorkflowCmd = &cobra.Command{
 		"  # Execute with workflow input",
 		"  dtctl exec workflow my-workflow-id --input '{\"foo\":\"bar\", \"baz\":3}'",
 		"",
+		"  # Replay an event payload from a file and wait for the result",
+		"  dtctl exec workflow my-workflow-id --input event.json --wait",
+		"",
+		"  # Override single input fields",
+		"  dtctl exec workflow my-workflow-id --input event.json --set event.severity=critical --set retries=3",
+		"",
 		"  # Execute and wait for completion",
 		"  dtctl exec workflow my-workflow-id --wait",
 		"",
@@ -278,11 +290,80 @@ func buildWorkflowExecutionRequest(cmd *cobra.Command) (exec.WorkflowExecutionRe
 	inputJSONValue, _ := cmd.Flags().GetString("input")
 	inputJSONValues := []string{}
 	if cmd.Flags().Lookup("input").Changed {
-		inputJSONValues = append(inputJSONValues, inputJSONValue)
+		raw, err := readWorkflowInput(cmd, inputJSONValue)
+		if err != nil {
+			return exec.WorkflowExecutionRequest{}, err
+		}
+		inputJSONValues = append(inputJSONValues, raw)
 	}
 	paramStrings, _ := cmd.Flags().GetStringSlice("params")
 
-	return buildWorkflowExecutionRequestFromValues(inputJSONValues, paramStrings)
+	request, err := buildWorkflowExecutionRequestFromValues(inputJSONValues, paramStrings)
+	if err != nil {
+		return request, err
+	}
+	sets, _ := cmd.Flags().GetStringArray("set")
+	request.Input, err = applyWorkflowInputSets(request.Input, sets)
+	return request, err
+}
+
+// readWorkflowInput returns the raw JSON of --input: the value itself when it
+// is inline JSON, stdin for "-", and otherwise the contents of the named file.
+func readWorkflowInput(cmd *cobra.Command, value string) (string, error) {
+	trimmed := strings.TrimSpace(value)
+	switch {
+	case trimmed == "" || strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "["):
+		return value, nil
+	case trimmed == "-":
+		data, err := io.ReadAll(cmd.InOrStdin())
+		if err != nil {
+			return "", fmt.Errorf("failed to read --input from stdin: %w", err)
+		}
+		return string(data), nil
+	}
+	data, err := os.ReadFile(trimmed)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return value, nil // not a file; let JSON parsing report the problem
+		}
+		return "", fmt.Errorf("failed to read --input file: %w", err)
+	}
+	return string(data), nil
+}
+
+// applyWorkflowInputSets applies --set key=value overrides to input. Dotted
+// keys address nested objects, which are created as needed. Values that parse
+// as JSON keep their type; anything else is a string.
+func applyWorkflowInputSets(input map[string]any, sets []string) (map[string]any, error) {
+	for _, set := range sets {
+		key, raw, ok := strings.Cut(set, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid --set %q: expected key=value", set)
+		}
+		var value any
+		if err := json.Unmarshal([]byte(raw), &value); err != nil {
+			value = raw
+		}
+
+		if input == nil {
+			input = map[string]any{}
+		}
+		parts := strings.Split(key, ".")
+		obj := input
+		for _, part := range parts[:len(parts)-1] {
+			next, ok := obj[part].(map[string]any)
+			if !ok {
+				if _, exists := obj[part]; exists {
+					return nil, fmt.Errorf("invalid --set %q: %q is not an object", set, part)
+				}
+				next = map[string]any{}
+				obj[part] = next
+			}
+			obj = next
+		}
+		obj[parts[len(parts)-1]] = value
+	}
+	return input, nil
 }
 
 func buildWorkflowExecutionRequestFromValues(inputJSONValues []string, paramStrings []string) (exec.WorkflowExecutionRequest, error) {
@@ -333,7 +414,8 @@ func parseWorkflowInputJSON(raw string) (map[string]any, error) {
 
 func registerWorkflowExecFlags(cmd *cobra.Command) {
 	var inputJSON string
-	cmd.Flags().Var(&singleUseStringValue{value: &inputJSON}, "input", "workflow input as a JSON object")
+	cmd.Flags().Var(&singleUseStringValue{value: &inputJSON}, "input", "workflow input as a JSON object, or a file containing one (\"-\" for stdin)")
+	cmd.Flags().StringArray("set", nil, "set an input field (key=value, dotted keys for nested fields; repeatable)")
 	cmd.Flags().StringSlice("params", []string{}, "workflow parameters (key=value)")
 	cmd.Flags().Bool("wait", false, "wait for workflow execution to complete")
 	cmd.Flags().Duration("timeout", 30*time.Minute, "timeout when waiting for completion")
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -291,3 +293,40 @@ func TestExecWorkflowExampleFormatting(t *testing.T) {
 		t.Fatalf("expected workflow example to avoid tab indentation, got: %q", execWorkflowCmd.Example)
 	}
 }
+
+func TestBuildWorkflowExecutionRequest_InputFileAndSets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "event.json")
+	if err := os.WriteFile(path, []byte(`{"event":{"id":"E-1","severity":"low"}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := newExecWorkflowRunCmdForTest()
+	if err := cmd.ParseFlags([]string{"--input", path, "--set", "event.severity=critical", "--set", "retries=3", "--set", "target.host=web-1"}); err != nil {
+		t.Fatalf("ParseFlags() error = %v", err)
+	}
+	request, err := buildWorkflowExecutionRequest(cmd)
+	if err != nil {
+		t.Fatalf("buildWorkflowExecutionRequest() error = %v", err)
+	}
+
+	event, _ := request.Input["event"].(map[string]any)
+	if event["id"] != "E-1" || event["severity"] != "critical" {
+		t.Errorf("event = %#v, want id from file and overridden severity", event)
+	}
+	if request.Input["retries"] != float64(3) {
+		t.Errorf("retries = %#v, want 3", request.Input["retries"])
+	}
+	target, _ := request.Input["target"].(map[string]any)
+	if target["host"] != "web-1" {
+		t.Errorf("target = %#v, want nested host", request.Input["target"])
+	}
+}
+
+func TestApplyWorkflowInputSets_Errors(t *testing.T) {
+	if _, err := applyWorkflowInputSets(nil, []string{"novalue"}); err == nil {
+		t.Error("expected error for --set without '='")
+	}
+	if _, err := applyWorkflowInputSets(map[string]any{"a": "x"}, []string{"a.b=1"}); err == nil {
+		t.Error("expected error when a dotted key crosses a non-object")
+	}
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
@@ -19,6 +20,14 @@ var getBreakpointsCmd = &cobra.Command{
 	RunE:  runGetBreakpoints,
 }
 
+var (
+	// showManagedFields is get's --show-managed-fields flag.
+	showManagedFields bool
+	// stripManagedFields is set for get commands run without
+	// --show-managed-fields; NewPrinter passes it on to the json/yaml printers.
+	stripManagedFields bool
+)
+
 // getCmd represents the get command
 var getCmd = &cobra.Command{
 	Use:   "get",
@@ -57,20 +66,37 @@ Use 'dtctl get <resource> --help' for resource-specific options.`,
   # Watch workflows for changes in real-time
   dtctl get workflows --watch
 
+  # Print only changed executions, with timestamps, while a deploy runs
+  dtctl get workflow-executions --watch-only-changes
+
   # List with wide output (extra columns)
-  dtctl get workflows -o wide`,
+  dtctl get workflows -o wide
+
+  # List with a view saved by 'dtctl config set-view'
+  dtctl get --view prod-workflows`,
 	RunE: requireSubcommand,
 }
 
+// setCountTotal hands a server-reported total to the --count printer, for
+// listings that fetched only the first page.
+func setCountTotal(printer output.Printer, total int) {
+	if cp, ok := printer.(*output.CountPrinter); ok {
+		cp.SetTotal(total)
+	}
+}
+
 // executeWithWatch wraps a fetcher function with watch mode support
 func executeWithWatch(cmd *cobra.Command, fetcher watch.ResourceFetcher, printer interface{}) error {
-	watchMode, _ := cmd.Flags().GetBool("watch")
-	if !watchMode {
+	if !watchEnabled(cmd) {
 		return nil
 	}
+	if countOnly {
+		return fmt.Errorf("--count cannot be used with --watch")
+	}
 
 	interval, _ := cmd.Flags().GetDuration("interval")
 	watchOnly, _ := cmd.Flags().GetBool("watch-only")
+	onlyChanges, _ := cmd.Flags().GetBool("watch-only-changes")
 
 	if interval < time.Second {
 		interval = time.Second
@@ -88,13 +114,16 @@ func executeWithWatch(cmd *cobra.Command, fetcher watch.ResourceFetcher, printer
 
 	basePrinter := printer.(output.Printer)
 	watchPrinter := output.NewWatchPrinter(basePrinter)
+	if onlyChanges {
+		watchPrinter.WithTimestamps()
+	}
 
 	watcher := watch.NewWatcher(watch.WatcherOptions{
 		Interval:    interval,
 		Client:      c,
 		Fetcher:     fetcher,
 		Printer:     watchPrinter,
-		ShowInitial: !watchOnly,
+		ShowInitial: !watchOnly && !onlyChanges,
 	})
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -110,15 +139,37 @@ func executeWithWatch(cmd *cobra.Command, fetcher watch.ResourceFetcher, printer
 	return watcher.Start(ctx)
 }
 
+// watchEnabled reports whether the command should run in watch mode.
+// --watch-only-changes implies --watch.
+func watchEnabled(cmd *cobra.Command) bool {
+	watchMode, _ := cmd.Flags().GetBool("watch")
+	onlyChanges, _ := cmd.Flags().GetBool("watch-only-changes")
+	return watchMode || onlyChanges
+}
+
+// isGetCommand reports whether cmd is 'get' or one of its subcommands.
+func isGetCommand(cmd *cobra.Command) bool {
+	for c := cmd; c != nil; c = c.Parent() {
+		if c == getCmd {
+			return true
+		}
+	}
+	return false
+}
+
 // addWatchFlags adds watch-related flags to a command
 func addWatchFlags(cmd *cobra.Command) {
 	cmd.Flags().Bool("watch", false, "Watch for changes")
 	cmd.Flags().Duration("interval", 2*time.Second, "Polling interval (minimum: 1s)")
 	cmd.Flags().Bool("watch-only", false, "Only show changes, not initial state")
+	cmd.Flags().Bool("watch-only-changes", false, "Watch and print only added (+), changed (~) and removed (-) rows, each with a timestamp (implies --watch)")
 }
 
 func init() {
 	rootCmd.AddCommand(getCmd)
+	getCmd.Flags().String("view", "", "list resources with a view saved by 'dtctl config set-view'")
+	getCmd.PersistentFlags().BoolVar(&showManagedFields, "show-managed-fields", false, "include server-managed fields (version, owner, modificationInfo, ...) in json and yaml output")
+	_ = getCmd.RegisterFlagCompletionFunc("view", completeViewNames)
 
 	// Get subcommands (command definitions live in get_*.go files)
 	getCmd.AddCommand(getWorkflowsCmd)
@@ -1,8 +1,13 @@
 package cmd
 
 import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
 	"github.com/spf13/cobra"
 
+	"github.com/dynatrace-oss/dtctl/pkg/output"
 	"github.com/dynatrace-oss/dtctl/pkg/resources/analyzer"
 	"github.com/dynatrace-oss/dtctl/pkg/resources/copilot"
 )
@@ -21,13 +26,25 @@ Examples:
   # Get a specific analyzer definition
   dtctl get analyzer dt.statistics.GenericForecastAnalyzer
 
+  # Scaffold a commented input file to fill in and execute
+  dtctl get analyzer dt.statistics.GenericForecastAnalyzer --schema > input.yaml
+  dtctl exec analyzer dt.statistics.GenericForecastAnalyzer -f input.yaml
+
   # Filter analyzers
   dtctl get analyzers --filter "name contains 'forecast'"
 
   # Output as JSON
   dtctl get analyzers -o json
+
+To run an analyzer, use 'dtctl exec analyzer <name> -f input.json'.
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		schema, _ := cmd.Flags().GetBool("schema")
+		example, _ := cmd.Flags().GetBool("example")
+		if (schema || example) && len(args) == 0 {
+			return fmt.Errorf("--schema requires an analyzer name")
+		}
+
 		_, c, printer, err := Setup()
 		if err != nil {
 			return err
@@ -37,6 +54,19 @@ Examples:
 
 		// Get specific analyzer if name provided
 		if len(args) > 0 {
+			if schema || example {
+				inputSchema, err := handler.GetInputSchema(args[0])
+				if err != nil {
+					return err
+				}
+				data, err := analyzer.Scaffold(args[0], inputSchema)
+				if err != nil {
+					return err
+				}
+				_, err = os.Stdout.Write(data)
+				return err
+			}
+
 			az, err := handler.Get(args[0])
 			if err != nil {
 				return err
@@ -57,18 +87,25 @@ Examples:
 
 // getCopilotSkillsCmd retrieves Davis CoPilot skills
 var getCopilotSkillsCmd = &cobra.Command{
-	Use:     "copilot-skills",
+	Use:     "copilot-skills [name]",
 	Aliases: []string{"copilot-skill"},
 	Short:   "Get Davis CoPilot skills",
 	Long: `Get available Davis CoPilot skills.
 
+With a skill name, shows the skill's description, its input parameters, and
+the dtctl command that invokes it.
+
 Examples:
   # List all CoPilot skills
   dtctl get copilot-skills
 
+  # Show the parameters of a skill and how to call it
+  dtctl get copilot-skill nl2dql
+
   # Output as JSON
   dtctl get copilot-skills -o json
 `,
+	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		_, c, printer, err := Setup()
 		if err != nil {
@@ -77,6 +114,22 @@ Examples:
 
 		handler := copilot.NewHandler(c)
 
+		if len(args) > 0 {
+			skill, err := handler.GetSkill(args[0])
+			if err != nil {
+				return err
+			}
+			if useAnalyzerDescribeTextView() {
+				printCopilotSkill(skill)
+				return nil
+			}
+			ap := enrichAgent(printer, "get", "copilot-skill")
+			if ap != nil && skill.Example != "" {
+				ap.Context().Suggestions = []string{skill.Example + "  -- invoke this skill"}
+			}
+			return printer.Print(skill)
+		}
+
 		list, err := handler.ListSkills()
 		if err != nil {
 			return err
@@ -86,7 +139,43 @@ Examples:
 	},
 }
 
+// printCopilotSkill prints the human-readable view of a single skill.
+func printCopilotSkill(s *copilot.SkillDetail) {
+	const w = 13
+	output.DescribeKV("Name:", w, "%s", s.Name)
+	if s.Description != "" {
+		output.DescribeKV("Description:", w, "%s", s.Description)
+	}
+	if s.Endpoint != "" {
+		output.DescribeKV("Endpoint:", w, "%s", s.Endpoint)
+	}
+	if s.Example == "" {
+		fmt.Println()
+		fmt.Println("  dtctl has no parameter details for this skill.")
+		return
+	}
+
+	if len(s.Parameters) > 0 {
+		fmt.Println()
+		output.DescribeSection("Parameters:")
+		tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, p := range s.Parameters {
+			req := "optional"
+			if p.Required {
+				req = "required"
+			}
+			fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\n", p.Name, p.Type, req, p.Description)
+		}
+		_ = tw.Flush()
+	}
+
+	fmt.Println()
+	fmt.Printf("  Example:  %s\n", s.Example)
+}
+
 func init() {
 	// Analyzer flags
 	getAnalyzersCmd.Flags().String("filter", "", "Filter analyzers (e.g., \"name contains 'forecast'\")")
+	getAnalyzersCmd.Flags().Bool("schema", false, "print a commented YAML skeleton of the analyzer's input, for 'exec analyzer -f'")
+	getAnalyzersCmd.Flags().Bool("example", false, "alias for --schema")
 }
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/analyzer"
+)
+
+func TestGetAnalyzersCmd_Schema(t *testing.T) {
+	const name = "dt.statistics.GenericForecastAnalyzer"
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/davis/analyzers/v1/analyzers/" + name + "/json-schema/input": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{
+				"type":     "object",
+				"required": []any{"timeSeriesData"},
+				"properties": map[string]any{
+					"timeSeriesData":  map[string]any{"type": "string", "description": "DQL query"},
+					"forecastHorizon": map[string]any{"type": "integer", "default": 100},
+				},
+			})
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile := cfgFile
+	defer func() { cfgFile = origCfgFile }()
+	cfgFile = configPath
+
+	testutil.ResetCommandFlags(getAnalyzersCmd)
+	defer testutil.ResetCommandFlags(getAnalyzersCmd)
+	_ = getAnalyzersCmd.Flags().Set("schema", "true")
+
+	out := captureStdout(t, func() {
+		if err := getAnalyzersCmd.RunE(getAnalyzersCmd, []string{name}); err != nil {
+			t.Fatalf("RunE() error = %v", err)
+		}
+	})
+	for _, want := range []string{"# DQL query", "timeSeriesData: \"\"", "forecastHorizon: 100"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+
+	// The skeleton round-trips through exec analyzer's -f parser.
+	path := filepath.Join(t.TempDir(), "input.yaml")
+	if err := os.WriteFile(path, []byte(out), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	input, err := analyzer.ParseInputFromFile(path)
+	if err != nil {
+		t.Fatalf("ParseInputFromFile() error = %v", err)
+	}
+	if input["forecastHorizon"] != float64(100) {
+		t.Errorf("forecastHorizon = %#v, want 100", input["forecastHorizon"])
+	}
+}
+
+func TestGetAnalyzersCmd_SchemaRequiresName(t *testing.T) {
+	configPath, cleanup := testutil.SetupTestConfig(t, "http://127.0.0.1:0")
+	defer cleanup()
+
+	origCfgFile := cfgFile
+	defer func() { cfgFile = origCfgFile }()
+	cfgFile = configPath
+
+	testutil.ResetCommandFlags(getAnalyzersCmd)
+	defer testutil.ResetCommandFlags(getAnalyzersCmd)
+	_ = getAnalyzersCmd.Flags().Set("schema", "true")
+
+	err := getAnalyzersCmd.RunE(getAnalyzersCmd, nil)
+	if err == nil || !strings.Contains(err.Error(), "requires an analyzer name") {
+		t.Errorf("RunE() error = %v, want name required", err)
+	}
+}
+
+func TestGetCopilotSkillsCmd_Single(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/davis/copilot/v1/skills": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{"skills": []string{"conversation", "nl2dql"}})
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile, origFormat := cfgFile, outputFormat
+	defer func() { cfgFile, outputFormat = origCfgFile, origFormat }()
+	cfgFile = configPath
+	outputFormat = "table"
+
+	out := captureStdout(t, func() {
+		if err := getCopilotSkillsCmd.RunE(getCopilotSkillsCmd, []string{"nl2dql"}); err != nil {
+			t.Fatalf("RunE() error = %v", err)
+		}
+	})
+	for _, want := range []string{"nl2dql", "Parameters:", "text", "required", "dtctl exec copilot nl2dql"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+
+	if err := getCopilotSkillsCmd.RunE(getCopilotSkillsCmd, []string{"nope"}); err == nil {
+		t.Error("expected an error for a skill that is not available")
+	}
+}
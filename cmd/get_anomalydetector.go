@@ -111,7 +111,7 @@ Examples:
 	RunE: func(cmd *cobra.Command, args []string) error {
 		identifier := args[0]
 
-		_, c, err := SetupWithSafety(safety.OperationDelete)
+		cfg, c, err := SetupWithSafety(safety.OperationDelete)
 		if err != nil {
 			return err
 		}
@@ -126,7 +126,7 @@ Examples:
 
 		// Confirm deletion unless --yes or --plain
 		if !forceDelete && !plainMode {
-			if !prompt.ConfirmDeletion("anomaly detector", ad.Title, ad.ObjectID) {
+			if !prompt.ConfirmDeletion(promptTarget(cfg), "anomaly detector", ad.Title, ad.ObjectID) {
 				fmt.Println("Deletion cancelled")
 				return nil
 			}
@@ -62,15 +62,25 @@ var getSDKVersionsCmd = &cobra.Command{
 	Short:   "Get available SDK versions for function execution",
 	Long: `Get available SDK versions for the function executor.
 
+The DEFAULT column marks the version the service uses when none is pinned.
+Ad-hoc 'dtctl exec function' runs use --sdk, then preferences.sdk-version,
+then that default (or the latest version when none is marked).
+
 Examples:
   # List all SDK versions
   dtctl get sdk-versions
 
+  # Show only the version 'exec function' would use
+  dtctl get sdk-versions --default
+
+  # Pin a team-wide default
+  dtctl config set preferences.sdk-version 1.4.0
+
   # Output as JSON
   dtctl get sdk-versions -o json
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		_, c, printer, err := Setup()
+		cfg, c, printer, err := Setup()
 		if err != nil {
 			return err
 		}
@@ -82,10 +92,32 @@ Examples:
 			return err
 		}
 
+		if onlyDefault, _ := cmd.Flags().GetBool("default"); onlyDefault {
+			return printer.PrintList(defaultSDKVersions(versions, cfg.Preferences.SDKVersion))
+		}
 		return printer.PrintList(versions.Versions)
 	},
 }
 
+// defaultSDKVersions returns the single version ad-hoc function execution
+// uses: the pinned preference when set, otherwise the service default.
+func defaultSDKVersions(versions *appengine.SDKVersionsResponse, pinned string) []appengine.SDKVersion {
+	want := pinned
+	if want == "" {
+		want = versions.DefaultVersion()
+	}
+	if want == "" {
+		return []appengine.SDKVersion{}
+	}
+	for _, v := range versions.Versions {
+		if v.Version == want {
+			return []appengine.SDKVersion{v}
+		}
+	}
+	// A pinned version the service no longer lists is still what runs.
+	return []appengine.SDKVersion{{Version: want}}
+}
+
 // deleteAppCmd deletes an app
 var deleteAppCmd = &cobra.Command{
 	Use:     "app <app-id>",
@@ -104,7 +136,7 @@ Examples:
 	RunE: func(cmd *cobra.Command, args []string) error {
 		appID := args[0]
 
-		_, c, err := SetupWithSafety(safety.OperationDelete)
+		cfg, c, err := SetupWithSafety(safety.OperationDelete)
 		if err != nil {
 			return err
 		}
@@ -119,7 +151,7 @@ Examples:
 
 		// Confirm deletion unless --force or --plain
 		if !forceDelete && !plainMode {
-			if !prompt.ConfirmDeletion("app", app.Name, appID) {
+			if !prompt.ConfirmDeletion(promptTarget(cfg), "app", app.Name, appID) {
 				fmt.Println("Deletion cancelled")
 				return nil
 			}
@@ -135,6 +167,8 @@ Examples:
 }
 
 func init() {
+	getSDKVersionsCmd.Flags().Bool("default", false, "show only the SDK version ad-hoc 'exec function' runs use")
+
 	// Delete confirmation flags
 	deleteAppCmd.Flags().BoolVarP(&forceDelete, "yes", "y", false, "Skip confirmation prompt")
 }
@@ -2,6 +2,9 @@ package cmd
 
 import (
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -11,6 +14,13 @@ import (
 	"github.com/dynatrace-oss/dtctl/pkg/safety"
 )
 
+// Bucket usage enrichment fetches every bucket individually; keep it polite
+// and bounded on environments with many buckets.
+const (
+	bucketUsageConcurrency = 4
+	bucketUsageTimeout     = 30 * time.Second
+)
+
 // getBucketsCmd retrieves Grail buckets
 var getBucketsCmd = &cobra.Command{
 	Use:     "buckets [name]",
@@ -25,10 +35,29 @@ Examples:
   # Get a specific bucket
   dtctl get bucket <bucket-name>
 
+  # Show record counts and approximate size per bucket
+  dtctl get buckets --usage
+
+  # Only log buckets
+  dtctl get buckets --table logs
+
+  # Segment the table by bucket table type
+  dtctl get buckets --group-by table
+
   # Output as JSON
   dtctl get buckets -o json
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		usage, _ := cmd.Flags().GetBool("usage")
+		table, _ := cmd.Flags().GetString("table")
+		groupBy, _ := cmd.Flags().GetString("group-by")
+		if groupBy != "" && groupBy != "table" {
+			return fmt.Errorf("invalid --group-by %q (supported: table)", groupBy)
+		}
+		if len(args) > 0 && (table != "" || groupBy != "") {
+			return fmt.Errorf("--table and --group-by apply only when listing buckets")
+		}
+
 		_, c, printer, err := Setup()
 		if err != nil {
 			return err
@@ -42,19 +71,105 @@ Examples:
 			if err != nil {
 				return err
 			}
+			if usage {
+				return printer.Print(bucketUsageFrom(b))
+			}
 			return printer.Print(b)
 		}
 
+		if usage {
+			list, incomplete, err := handler.ListUsage(bucketUsageConcurrency, bucketUsageTimeout)
+			if err != nil {
+				return err
+			}
+			if incomplete > 0 {
+				output.PrintWarning("size unavailable for %d of %d buckets (request failed or timed out)", incomplete, len(list))
+			}
+			for i := range list {
+				list[i].Size = formatBucketSize(list[i].EstimatedUncompressedBytes)
+			}
+			list = filterBucketsByTable(list, table, func(b bucket.BucketUsage) string { return b.Table })
+			return printBucketList(printer, list, groupBy, func(b bucket.BucketUsage) string { return b.Table })
+		}
+
 		// List all buckets
 		list, err := handler.List()
 		if err != nil {
 			return err
 		}
 
-		return printer.PrintList(list.Buckets)
+		buckets := filterBucketsByTable(list.Buckets, table, func(b bucket.Bucket) string { return b.Table })
+		return printBucketList(printer, buckets, groupBy, func(b bucket.Bucket) string { return b.Table })
 	},
 }
 
+// filterBucketsByTable keeps the buckets whose table (logs, events, bizevents,
+// metrics, ...) matches table, case-insensitively. An empty table keeps all.
+func filterBucketsByTable[T any](items []T, table string, tableOf func(T) string) []T {
+	if table == "" {
+		return items
+	}
+	filtered := make([]T, 0, len(items))
+	for _, item := range items {
+		if strings.EqualFold(tableOf(item), table) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+// printBucketList prints buckets, segmented into one table per bucket table
+// type under a subheader when grouping by table. Grouping only changes the
+// table/wide rendering; structured formats get the flat list.
+func printBucketList[T any](printer output.Printer, items []T, groupBy string, tableOf func(T) string) error {
+	if groupBy == "" || agentMode || (outputFormat != "table" && outputFormat != "wide") {
+		return printer.PrintList(items)
+	}
+
+	groups := map[string][]T{}
+	var tables []string
+	for _, item := range items {
+		t := tableOf(item)
+		if _, seen := groups[t]; !seen {
+			tables = append(tables, t)
+		}
+		groups[t] = append(groups[t], item)
+	}
+	sort.Strings(tables)
+
+	for i, t := range tables {
+		if i > 0 {
+			fmt.Println()
+		}
+		output.DescribeSection(fmt.Sprintf("Table: %s (%d)", t, len(groups[t])))
+		if err := printer.PrintList(groups[t]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bucketUsageFrom converts a fetched bucket to its usage view.
+func bucketUsageFrom(b *bucket.Bucket) bucket.BucketUsage {
+	return bucket.BucketUsage{
+		BucketName:                 b.BucketName,
+		Table:                      b.Table,
+		Status:                     b.Status,
+		RetentionDays:              b.RetentionDays,
+		Records:                    b.Records,
+		EstimatedUncompressedBytes: b.EstimatedUncompressedBytes,
+		Size:                       formatBucketSize(b.EstimatedUncompressedBytes),
+	}
+}
+
+// formatBucketSize renders an estimated uncompressed size, or "-" if unknown.
+func formatBucketSize(bytes *int64) string {
+	if bytes == nil {
+		return "-"
+	}
+	return formatBytes(*bytes)
+}
+
 // deleteBucketCmd deletes a bucket
 var deleteBucketCmd = &cobra.Command{
 	Use:     "bucket <bucket-name>",
@@ -78,7 +193,7 @@ Examples:
 	RunE: func(cmd *cobra.Command, args []string) error {
 		bucketName := args[0]
 
-		_, c, err := SetupWithSafety(safety.OperationDeleteBucket)
+		cfg, c, err := SetupWithSafety(safety.OperationDeleteBucket)
 		if err != nil {
 			return err
 		}
@@ -100,7 +215,7 @@ Examples:
 				}
 			} else {
 				// Interactive confirmation - require typing the bucket name
-				if !prompt.ConfirmDataDeletion("bucket", bucketName) {
+				if !prompt.ConfirmDataDeletion(promptTarget(cfg), "bucket", bucketName) {
 					fmt.Println("Deletion cancelled")
 					return nil
 				}
@@ -117,6 +232,16 @@ Examples:
 }
 
 func init() {
+	getBucketsCmd.Flags().Bool("usage", false, "include record counts and estimated size (fetches each bucket)")
+	getBucketsCmd.Flags().String("table", "", "only list buckets for this table (e.g. logs, events, bizevents, metrics)")
+	getBucketsCmd.Flags().String("group-by", "", "segment table output under a subheader per value (supported: table)")
+	_ = getBucketsCmd.RegisterFlagCompletionFunc("table", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return []string{"logs", "events", "bizevents", "metrics", "spans"}, cobra.ShellCompDirectiveNoFileComp
+	})
+	_ = getBucketsCmd.RegisterFlagCompletionFunc("group-by", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return []string{"table"}, cobra.ShellCompDirectiveNoFileComp
+	})
+
 	// Delete confirmation flags
 	deleteBucketCmd.Flags().BoolVarP(&forceDelete, "yes", "y", false, "Skip confirmation prompt")
 	deleteBucketCmd.Flags().String("confirm", "", "Confirm deletion by providing the bucket name (for non-interactive use)")
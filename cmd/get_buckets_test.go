@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+)
+
+const bucketListBody = `{"buckets":[
+	{"bucketName":"default_logs","table":"logs","status":"active","retentionDays":35},
+	{"bucketName":"default_events","table":"events","status":"active","retentionDays":35},
+	{"bucketName":"custom_logs","table":"logs","status":"active","retentionDays":90}
+]}`
+
+func runGetBuckets(t *testing.T, flags map[string]string) (string, error) {
+	t.Helper()
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/storage/management/v1/bucket-definitions": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(bucketListBody))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile, origOutput, origAgent := cfgFile, outputFormat, agentMode
+	defer func() {
+		testutil.ResetCommandFlags(getBucketsCmd)
+		cfgFile, outputFormat, agentMode = origCfgFile, origOutput, origAgent
+	}()
+	testutil.ResetCommandFlags(getBucketsCmd)
+	cfgFile = configPath
+	agentMode = false
+	outputFormat = "table"
+	if f, ok := flags["output"]; ok {
+		outputFormat = f
+		delete(flags, "output")
+	}
+	for k, v := range flags {
+		_ = getBucketsCmd.Flags().Set(k, v)
+	}
+
+	var runErr error
+	out := captureStdout(t, func() {
+		runErr = getBucketsCmd.RunE(getBucketsCmd, nil)
+	})
+	return out, runErr
+}
+
+func TestGetBuckets_TableFilter(t *testing.T) {
+	out, err := runGetBuckets(t, map[string]string{"table": "LOGS", "output": "json"})
+	if err != nil {
+		t.Fatalf("RunE() error = %v", err)
+	}
+	if !strings.Contains(out, "default_logs") || !strings.Contains(out, "custom_logs") {
+		t.Errorf("expected both log buckets, got: %s", out)
+	}
+	if strings.Contains(out, "default_events") {
+		t.Errorf("events bucket should be filtered out, got: %s", out)
+	}
+}
+
+func TestGetBuckets_GroupByTable(t *testing.T) {
+	out, err := runGetBuckets(t, map[string]string{"group-by": "table"})
+	if err != nil {
+		t.Fatalf("RunE() error = %v", err)
+	}
+	events := strings.Index(out, "Table: events (1)")
+	logs := strings.Index(out, "Table: logs (2)")
+	if events < 0 || logs < 0 {
+		t.Fatalf("expected a subheader per table, got: %s", out)
+	}
+	if events > logs {
+		t.Errorf("expected groups sorted by table name, got: %s", out)
+	}
+	if !strings.Contains(out[logs:], "custom_logs") || strings.Contains(out[logs:], "default_events") {
+		t.Errorf("logs group has the wrong buckets: %s", out[logs:])
+	}
+}
+
+func TestGetBuckets_GroupByIgnoredForJSON(t *testing.T) {
+	out, err := runGetBuckets(t, map[string]string{"group-by": "table", "output": "json"})
+	if err != nil {
+		t.Fatalf("RunE() error = %v", err)
+	}
+	if strings.Contains(out, "Table:") {
+		t.Errorf("json output must not contain subheaders, got: %s", out)
+	}
+	if !strings.Contains(out, "default_events") {
+		t.Errorf("json output should stay unfiltered, got: %s", out)
+	}
+}
+
+func TestGetBuckets_InvalidGroupBy(t *testing.T) {
+	_, err := runGetBuckets(t, map[string]string{"group-by": "status"})
+	if err == nil || !strings.Contains(err.Error(), "invalid --group-by") {
+		t.Errorf("expected invalid --group-by error, got %v", err)
+	}
+}
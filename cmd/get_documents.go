@@ -8,6 +8,8 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/dynatrace-oss/dtctl/pkg/client"
+	"github.com/dynatrace-oss/dtctl/pkg/config"
+	"github.com/dynatrace-oss/dtctl/pkg/labels"
 	"github.com/dynatrace-oss/dtctl/pkg/output"
 	"github.com/dynatrace-oss/dtctl/pkg/prompt"
 	"github.com/dynatrace-oss/dtctl/pkg/resources/document"
@@ -38,6 +40,13 @@ Examples:
 
   # List only my dashboards
   dtctl get dashboards --mine
+
+  # List dashboards others shared with me, or that are public
+  dtctl get dashboards --shared
+  dtctl get dashboards --public
+
+  # Filter by labels set with 'dtctl apply --label'
+  dtctl get dashboards --selector team=checkout
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		_, c, printer, err := Setup()
@@ -61,26 +70,33 @@ Examples:
 		if err != nil {
 			return err
 		}
+		sel, err := selectorFromFlags(cmd)
+		if err != nil {
+			return err
+		}
 
 		// Check if watch mode is enabled
-		watchMode, _ := cmd.Flags().GetBool("watch")
-		if watchMode {
+		if watchEnabled(cmd) {
 			fetcher := func() (interface{}, error) {
 				list, err := handler.List(filters)
 				if err != nil {
 					return nil, err
 				}
-				return document.ConvertToDocuments(list), nil
+				return selectByLabels(document.ConvertToDocuments(list), sel, documentLabelSource), nil
 			}
 			return executeWithWatch(cmd, fetcher, printer)
 		}
 
+		countFromTotal := useDocumentCountTotal(&filters, sel)
 		list, err := handler.List(filters)
 		if err != nil {
 			return err
 		}
+		if countFromTotal {
+			setCountTotal(printer, list.TotalCount)
+		}
 
-		return printer.PrintList(document.ConvertToDocuments(list))
+		return printer.PrintList(selectByLabels(document.ConvertToDocuments(list), sel, documentLabelSource))
 	},
 }
 
@@ -107,8 +123,23 @@ Examples:
 
   # List only my notebooks
   dtctl get notebooks --mine
+
+  # List notebooks others shared with me, or that are public
+  dtctl get notebooks --shared
+  dtctl get notebooks --public
+
+  # Filter by labels set with 'dtctl apply --label'
+  dtctl get notebooks --selector team=checkout
+
+  # Print a notebook's text and queries as Markdown
+  dtctl get notebook <notebook-id> --render-markdown > notebook.md
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		renderMarkdown, _ := cmd.Flags().GetBool("render-markdown")
+		if renderMarkdown && len(args) == 0 {
+			return fmt.Errorf("--render-markdown requires a notebook ID")
+		}
+
 		_, c, printer, err := Setup()
 		if err != nil {
 			return err
@@ -122,6 +153,17 @@ Examples:
 			if err != nil {
 				return err
 			}
+			if renderMarkdown {
+				if doc.Type != "notebook" {
+					return fmt.Errorf("document %s is a %s, not a notebook", args[0], doc.Type)
+				}
+				text, err := document.RenderNotebookMarkdown(doc.Name, doc.Content)
+				if err != nil {
+					return err
+				}
+				fmt.Print(text)
+				return nil
+			}
 			return printer.Print(doc)
 		}
 
@@ -130,26 +172,33 @@ Examples:
 		if err != nil {
 			return err
 		}
+		sel, err := selectorFromFlags(cmd)
+		if err != nil {
+			return err
+		}
 
 		// Check if watch mode is enabled
-		watchMode, _ := cmd.Flags().GetBool("watch")
-		if watchMode {
+		if watchEnabled(cmd) {
 			fetcher := func() (interface{}, error) {
 				list, err := handler.List(filters)
 				if err != nil {
 					return nil, err
 				}
-				return document.ConvertToDocuments(list), nil
+				return selectByLabels(document.ConvertToDocuments(list), sel, documentLabelSource), nil
 			}
 			return executeWithWatch(cmd, fetcher, printer)
 		}
 
+		countFromTotal := useDocumentCountTotal(&filters, sel)
 		list, err := handler.List(filters)
 		if err != nil {
 			return err
 		}
+		if countFromTotal {
+			setCountTotal(printer, list.TotalCount)
+		}
 
-		return printer.PrintList(document.ConvertToDocuments(list))
+		return printer.PrintList(selectByLabels(document.ConvertToDocuments(list), sel, documentLabelSource))
 	},
 }
 
@@ -219,8 +268,7 @@ Examples:
 		}
 
 		// Check if watch mode is enabled
-		watchMode, _ := cmd.Flags().GetBool("watch")
-		if watchMode {
+		if watchEnabled(cmd) {
 			fetcher := func() (interface{}, error) {
 				return handler.List(opts)
 			}
@@ -253,16 +301,22 @@ Examples:
 
   # Delete without confirmation
   dtctl delete dashboard "Production Dashboard" -y
+
+  # Delete every dashboard labelled team=checkout
+  dtctl delete dashboards --selector team=checkout
 `,
-	Args: cobra.ExactArgs(1),
+	Args: idOrSelectorArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		identifier := args[0]
-
 		cfg, c, err := SetupClient()
 		if err != nil {
 			return err
 		}
 
+		if len(args) == 0 {
+			return deleteDocumentsBySelector(cmd, cfg, c, "dashboard")
+		}
+		identifier := args[0]
+
 		// Resolve name to ID
 		res := resolver.NewResolver(c)
 		dashboardID, err := res.ResolveID(resolver.TypeDashboard, identifier)
@@ -291,7 +345,7 @@ Examples:
 
 		// Confirm deletion unless --force or --plain
 		if !forceDelete && !plainMode {
-			if !prompt.ConfirmDeletion("dashboard", metadata.Name, dashboardID) {
+			if !prompt.ConfirmDeletion(promptTarget(cfg), "dashboard", metadata.Name, dashboardID) {
 				fmt.Println("Deletion cancelled")
 				return nil
 			}
@@ -302,6 +356,7 @@ Examples:
 		}
 
 		output.PrintSuccess("Dashboard %q deleted (moved to trash)", metadata.Name)
+		output.PrintHint("Restore it with: dtctl restore trash %s", dashboardID)
 		return nil
 	},
 }
@@ -322,16 +377,22 @@ Examples:
 
   # Delete without confirmation
   dtctl delete notebook "Analysis Notebook" -y
+
+  # Delete every notebook labelled team=checkout
+  dtctl delete notebooks --selector team=checkout
 `,
-	Args: cobra.ExactArgs(1),
+	Args: idOrSelectorArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		identifier := args[0]
-
 		cfg, c, err := SetupClient()
 		if err != nil {
 			return err
 		}
 
+		if len(args) == 0 {
+			return deleteDocumentsBySelector(cmd, cfg, c, "notebook")
+		}
+		identifier := args[0]
+
 		// Resolve name to ID
 		res := resolver.NewResolver(c)
 		notebookID, err := res.ResolveID(resolver.TypeNotebook, identifier)
@@ -360,7 +421,7 @@ Examples:
 
 		// Confirm deletion unless --force or --plain
 		if !forceDelete && !plainMode {
-			if !prompt.ConfirmDeletion("notebook", metadata.Name, notebookID) {
+			if !prompt.ConfirmDeletion(promptTarget(cfg), "notebook", metadata.Name, notebookID) {
 				fmt.Println("Deletion cancelled")
 				return nil
 			}
@@ -371,6 +432,7 @@ Examples:
 		}
 
 		output.PrintSuccess("Notebook %q deleted (moved to trash)", metadata.Name)
+		output.PrintHint("Restore it with: dtctl restore trash %s", notebookID)
 		return nil
 	},
 }
@@ -402,7 +464,7 @@ Examples:
 			return fmt.Errorf("--permanent flag is required to delete from trash")
 		}
 
-		_, c, err := SetupWithSafety(safety.OperationDelete)
+		cfg, c, err := SetupWithSafety(safety.OperationDelete)
 		if err != nil {
 			return err
 		}
@@ -423,7 +485,7 @@ Examples:
 			}
 
 			confirmMsg := fmt.Sprintf("PERMANENTLY DELETE %d document(s) from trash? This cannot be undone.", len(args))
-			if !prompt.Confirm(confirmMsg) {
+			if !prompt.Confirm(promptTarget(cfg), confirmMsg) {
 				fmt.Println("Deletion cancelled")
 				return nil
 			}
@@ -526,8 +588,9 @@ Examples:
 		if typesMode {
 			// Fetch all documents (no type filter) and count by type
 			allFilters := document.DocumentFilters{
-				Owner:     filters.Owner,
-				ChunkSize: GetChunkSize(),
+				Owner:    filters.Owner,
+				PageSize: filters.PageSize,
+				AllPages: filters.AllPages,
 			}
 			list, err := handler.List(allFilters)
 			if err != nil {
@@ -545,8 +608,7 @@ Examples:
 		}
 
 		// Check if watch mode is enabled
-		watchMode, _ := cmd.Flags().GetBool("watch")
-		if watchMode {
+		if watchEnabled(cmd) {
 			fetcher := func() (interface{}, error) {
 				list, err := handler.List(filters)
 				if err != nil {
@@ -557,10 +619,14 @@ Examples:
 			return executeWithWatch(cmd, fetcher, printer)
 		}
 
+		countFromTotal := useDocumentCountTotal(&filters, nil)
 		list, err := handler.List(filters)
 		if err != nil {
 			return err
 		}
+		if countFromTotal {
+			setCountTotal(printer, list.TotalCount)
+		}
 
 		return printer.PrintList(document.ConvertToDocuments(list))
 	},
@@ -622,7 +688,7 @@ Examples:
 
 		// Confirm deletion unless --force or --plain
 		if !forceDelete && !plainMode {
-			if !prompt.ConfirmDeletion(metadata.Type, metadata.Name, documentID) {
+			if !prompt.ConfirmDeletion(promptTarget(cfg), metadata.Type, metadata.Name, documentID) {
 				fmt.Println("Deletion cancelled")
 				return nil
 			}
@@ -633,30 +699,47 @@ Examples:
 		}
 
 		output.PrintSuccess("Document %q (%s) deleted (moved to trash)", metadata.Name, metadata.Type)
+		output.PrintHint("Restore it with: dtctl restore trash %s", documentID)
 		return nil
 	},
 }
 
+// documentListFilters returns filters listing every document of docType,
+// paged according to the global pagination flags.
+func documentListFilters(docType string) document.DocumentFilters {
+	page := GetPageOptions()
+	return document.DocumentFilters{Type: docType, PageSize: page.PageSize, AllPages: !page.FirstPageOnly}
+}
+
 // buildDocumentFilters reads the listing flags from cmd. implicitType is the
 // type baked into the subcommand (empty for `dtctl get documents`).
 func buildDocumentFilters(cmd *cobra.Command, c *client.Client, implicitType string) (document.DocumentFilters, error) {
 	rawFilter, _ := cmd.Flags().GetString("filter")
 	nameFilter, _ := cmd.Flags().GetString("name")
 	mineOnly, _ := cmd.Flags().GetBool("mine")
+	sharedOnly, _ := cmd.Flags().GetBool("shared")
+	publicOnly, _ := cmd.Flags().GetBool("public")
 	sortOrder, _ := cmd.Flags().GetString("sort")
 	addFields, _ := cmd.Flags().GetStringSlice("add-fields")
 	adminAccess, _ := cmd.Flags().GetBool("admin-access")
 
+	page := GetPageOptions()
 	filters := document.DocumentFilters{
-		ChunkSize:   GetChunkSize(),
+		PageSize:    page.PageSize,
+		AllPages:    !page.FirstPageOnly,
+		Limit:       page.Limit,
 		Sort:        sortOrder,
 		AddFields:   addFields,
 		AdminAccess: adminAccess,
 	}
 
+	if mineOnly && sharedOnly {
+		return filters, fmt.Errorf("--mine and --shared are mutually exclusive")
+	}
+
 	if rawFilter != "" {
-		if nameFilter != "" || mineOnly {
-			fmt.Fprintln(os.Stderr, "warning: --filter overrides --name/--mine; the raw filter is sent verbatim to the API")
+		if nameFilter != "" || mineOnly || sharedOnly || publicOnly {
+			fmt.Fprintln(os.Stderr, "warning: --filter overrides --name/--mine/--shared/--public; the raw filter is sent verbatim to the API")
 		}
 		// For type-scoped commands (dashboards, notebooks), enforce the implicit
 		// type even when --filter is provided, so dtctl get dashboards --filter "..."
@@ -671,16 +754,70 @@ func buildDocumentFilters(cmd *cobra.Command, c *client.Client, implicitType str
 
 	filters.Type = implicitType
 	filters.Name = nameFilter
-	if mineOnly {
+	filters.PublicOnly = publicOnly
+	if mineOnly || sharedOnly {
 		userID, err := c.CurrentUserID()
 		if err != nil {
-			return filters, fmt.Errorf("failed to get current user ID for --mine filter: %w", err)
+			flag := "--mine"
+			if sharedOnly {
+				flag = "--shared"
+			}
+			return filters, fmt.Errorf("failed to get current user ID for %s filter: %w", flag, err)
+		}
+		if mineOnly {
+			filters.Owner = userID
+		} else {
+			// The API only lists documents the caller can read, so every
+			// document not owned by the caller has been shared with them.
+			filters.NotOwner = userID
 		}
-		filters.Owner = userID
 	}
 	return filters, nil
 }
 
+// useDocumentCountTotal limits a --count listing to its first page when the
+// server's totalCount answers the question, i.e. no label selector has to be
+// applied to every document and no --limit caps the count.
+func useDocumentCountTotal(filters *document.DocumentFilters, sel labels.Selector) bool {
+	if !countOnly || sel != nil || filters.Limit > 0 {
+		return false
+	}
+	filters.AllPages = false
+	return true
+}
+
+// documentLabelSource returns the description that carries a document's dtctl labels.
+func documentLabelSource(d document.Document) string {
+	return d.Description
+}
+
+// deleteDocumentsBySelector moves every document of docType whose labels
+// match --selector to the trash.
+func deleteDocumentsBySelector(cmd *cobra.Command, cfg *config.Config, c *client.Client, docType string) error {
+	selector, err := selectorString(cmd)
+	if err != nil {
+		return err
+	}
+	sel, err := labels.ParseSelector(selector)
+	if err != nil {
+		return err
+	}
+
+	handler := document.NewHandler(c)
+	list, err := handler.List(documentListFilters(docType))
+	if err != nil {
+		return err
+	}
+
+	var objs []selectedObject
+	for _, d := range selectByLabels(document.ConvertToDocuments(list), sel, documentLabelSource) {
+		objs = append(objs, selectedObject{ID: d.ID, Name: d.Name, Owner: d.Owner, Version: d.Version})
+	}
+	return deleteSelected(cfg, c, docType, selector, objs, func(o selectedObject) error {
+		return handler.Delete(o.ID, o.Version)
+	})
+}
+
 // addDocumentListFlags registers the flags shared by dashboards/notebooks/documents listing commands.
 func addDocumentListFlags(cmd *cobra.Command, includeType bool) {
 	if includeType {
@@ -688,7 +825,9 @@ func addDocumentListFlags(cmd *cobra.Command, includeType bool) {
 	}
 	cmd.Flags().String("name", "", "Filter by name (partial match, case-insensitive)")
 	cmd.Flags().Bool("mine", false, "Show only documents owned by current user")
-	cmd.Flags().String("filter", "", "Raw Document API filter expression, ANDed with the type scope (overrides --name/--mine)")
+	cmd.Flags().Bool("shared", false, "Show only documents shared with the current user (readable but owned by someone else)")
+	cmd.Flags().Bool("public", false, "Show only documents that are not private")
+	cmd.Flags().String("filter", "", "Raw Document API filter expression, ANDed with the type scope (overrides --name/--mine/--shared/--public)")
 	cmd.Flags().String("sort", "", "Sort fields, comma-separated, prefix with '-' for descending (e.g. \"name,-modificationInfo.lastModifiedTime\")")
 	cmd.Flags().StringSlice("add-fields", nil, "Request fields the API omits by default (e.g. originExtensionId,labels,shareInfo.isShared)")
 	cmd.Flags().Bool("admin-access", false, "List documents as effective owner; requires document:documents:admin permission")
@@ -703,9 +842,14 @@ func init() {
 
 	// Dashboard flags
 	addDocumentListFlags(getDashboardsCmd, false)
+	addSelectorFlag(getDashboardsCmd)
+	addSelectorFlag(deleteDashboardCmd)
 
 	// Notebook flags
 	addDocumentListFlags(getNotebooksCmd, false)
+	addSelectorFlag(getNotebooksCmd)
+	getNotebooksCmd.Flags().Bool("render-markdown", false, "With a notebook ID, print its markdown sections as text and its DQL and code sections as fenced blocks")
+	addSelectorFlag(deleteNotebookCmd)
 
 	// Generic document flags
 	addDocumentListFlags(getDocumentsCmd, true)
@@ -1,9 +1,13 @@
 package cmd
 
 import (
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/spf13/cobra"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
 )
 
 func newDocFlagsCmd(includeType bool) *cobra.Command {
@@ -14,7 +18,7 @@ func newDocFlagsCmd(includeType bool) *cobra.Command {
 
 func TestAddDocumentListFlags_RegistersAll(t *testing.T) {
 	cmd := newDocFlagsCmd(true)
-	for _, name := range []string{"type", "name", "mine", "filter", "sort", "add-fields", "admin-access"} {
+	for _, name := range []string{"type", "name", "mine", "shared", "public", "filter", "sort", "add-fields", "admin-access"} {
 		if cmd.Flags().Lookup(name) == nil {
 			t.Errorf("expected flag %q registered with includeType=true", name)
 		}
@@ -26,7 +30,7 @@ func TestAddDocumentListFlags_SkipsTypeForImplicitTypeCommands(t *testing.T) {
 	if cmd.Flags().Lookup("type") != nil {
 		t.Error("expected --type flag NOT registered when includeType=false")
 	}
-	for _, name := range []string{"name", "mine", "filter", "sort", "add-fields", "admin-access"} {
+	for _, name := range []string{"name", "mine", "shared", "public", "filter", "sort", "add-fields", "admin-access"} {
 		if cmd.Flags().Lookup(name) == nil {
 			t.Errorf("expected flag %q registered", name)
 		}
@@ -106,3 +110,154 @@ func TestBuildDocumentFilters_WiresSortAddFieldsAdminAccess(t *testing.T) {
 		t.Errorf("expected AdminAccess=true, got false")
 	}
 }
+
+func TestBuildDocumentFilters_Public(t *testing.T) {
+	cmd := newDocFlagsCmd(false)
+	_ = cmd.Flags().Set("public", "true")
+
+	filters, err := buildDocumentFilters(cmd, nil, "dashboard")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !filters.PublicOnly {
+		t.Error("expected PublicOnly=true")
+	}
+	if filters.Owner != "" || filters.NotOwner != "" {
+		t.Errorf("expected no owner filters, got Owner=%q NotOwner=%q", filters.Owner, filters.NotOwner)
+	}
+}
+
+func TestBuildDocumentFilters_MineAndSharedConflict(t *testing.T) {
+	cmd := newDocFlagsCmd(false)
+	_ = cmd.Flags().Set("mine", "true")
+	_ = cmd.Flags().Set("shared", "true")
+
+	if _, err := buildDocumentFilters(cmd, nil, "dashboard"); err == nil {
+		t.Fatal("expected error for --mine with --shared")
+	}
+}
+
+func TestGetDashboards_SharedFiltersOutOwnDocuments(t *testing.T) {
+	var gotFilter string
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/metadata/v1/user": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"userId":"user-me"}`))
+		},
+		"/platform/document/v1/documents": func(w http.ResponseWriter, r *http.Request) {
+			gotFilter = r.URL.Query().Get("filter")
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"documents":[{"id":"d-1","name":"Team board","type":"dashboard","owner":"user-other","version":1}],"totalCount":1}`))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile, origOutputFormat, origAgentMode := cfgFile, outputFormat, agentMode
+	defer func() {
+		testutil.ResetCommandFlags(getDashboardsCmd)
+		cfgFile, outputFormat, agentMode = origCfgFile, origOutputFormat, origAgentMode
+	}()
+	testutil.ResetCommandFlags(getDashboardsCmd)
+	cfgFile = configPath
+	outputFormat = "json"
+	agentMode = false
+	_ = getDashboardsCmd.Flags().Set("shared", "true")
+	_ = getDashboardsCmd.Flags().Set("public", "true")
+
+	captureStdout(t, func() {
+		if err := getDashboardsCmd.RunE(getDashboardsCmd, nil); err != nil {
+			t.Fatalf("RunE() error = %v", err)
+		}
+	})
+
+	want := "type=='dashboard' and owner!='user-me' and isPrivate==false"
+	if gotFilter != want {
+		t.Errorf("filter = %q, want %q", gotFilter, want)
+	}
+}
+
+func TestGetNotebooks_RenderMarkdownRequiresID(t *testing.T) {
+	defer testutil.ResetCommandFlags(getNotebooksCmd)
+	testutil.ResetCommandFlags(getNotebooksCmd)
+	_ = getNotebooksCmd.Flags().Set("render-markdown", "true")
+
+	err := getNotebooksCmd.RunE(getNotebooksCmd, nil)
+	if err == nil || err.Error() != "--render-markdown requires a notebook ID" {
+		t.Errorf("error = %v, want --render-markdown requires a notebook ID", err)
+	}
+}
+
+func TestGetDashboards_Count(t *testing.T) {
+	var requests int
+	var gotFilter string
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/metadata/v1/user": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"userId":"user-me"}`))
+		},
+		"/platform/document/v1/documents": func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Query().Get("page-key") != "" {
+				_, _ = w.Write([]byte(`{"documents":[],"totalCount":42}`))
+				return
+			}
+			gotFilter = r.URL.Query().Get("filter")
+			_, _ = w.Write([]byte(`{"documents":[
+				{"id":"d-1","name":"A","type":"dashboard","owner":"user-me","version":1,"description":"dtctl.io/labels: {\"team\":\"checkout\"}"},
+				{"id":"d-2","name":"B","type":"dashboard","owner":"user-me","version":1}
+			],"totalCount":42,"nextPageKey":"next"}`))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile, origOutputFormat, origAgentMode, origCount := cfgFile, outputFormat, agentMode, countOnly
+	defer func() {
+		testutil.ResetCommandFlags(getDashboardsCmd)
+		cfgFile, outputFormat, agentMode, countOnly = origCfgFile, origOutputFormat, origAgentMode, origCount
+	}()
+	cfgFile = configPath
+	outputFormat = "table"
+	agentMode = false
+	countOnly = true
+
+	// Without a selector the first page's totalCount answers the question.
+	testutil.ResetCommandFlags(getDashboardsCmd)
+	_ = getDashboardsCmd.Flags().Set("mine", "true")
+	out := captureStdout(t, func() {
+		if err := getDashboardsCmd.RunE(getDashboardsCmd, nil); err != nil {
+			t.Fatalf("RunE() error = %v", err)
+		}
+	})
+	if out != "42\n" {
+		t.Errorf("--count output = %q, want %q", out, "42\n")
+	}
+	if requests != 1 {
+		t.Errorf("expected a single page request, got %d", requests)
+	}
+	if !strings.Contains(gotFilter, "owner=='user-me'") {
+		t.Errorf("--mine filter not sent, got %q", gotFilter)
+	}
+
+	// A label selector is applied client-side, so the matches are counted.
+	requests = 0
+	testutil.ResetCommandFlags(getDashboardsCmd)
+	_ = getDashboardsCmd.Flags().Set("selector", "team=checkout")
+	out = captureStdout(t, func() {
+		if err := getDashboardsCmd.RunE(getDashboardsCmd, nil); err != nil {
+			t.Fatalf("RunE() error = %v", err)
+		}
+	})
+	if out != "1\n" {
+		t.Errorf("--count with --selector = %q, want %q", out, "1\n")
+	}
+	if requests != 2 {
+		t.Errorf("a selector must list every page, got %d requests", requests)
+	}
+}
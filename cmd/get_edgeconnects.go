@@ -25,6 +25,10 @@ Examples:
   # Get a specific EdgeConnect
   dtctl get edgeconnect <id>
 
+  # Show connectivity state (connected hosts, last seen)
+  dtctl get edgeconnects --status
+  dtctl get edgeconnect <id> --status
+
   # Output as JSON
   dtctl get edgeconnects -o json
 `,
@@ -35,6 +39,22 @@ Examples:
 		}
 
 		handler := edgeconnect.NewHandler(c)
+		showStatus, _ := cmd.Flags().GetBool("status")
+
+		if showStatus {
+			if len(args) > 0 {
+				status, err := handler.GetStatus(args[0])
+				if err != nil {
+					return err
+				}
+				return printer.Print(status)
+			}
+			statuses, err := handler.ListStatus()
+			if err != nil {
+				return err
+			}
+			return printer.PrintList(statuses)
+		}
 
 		// Get specific EdgeConnect if ID provided
 		if len(args) > 0 {
@@ -73,7 +93,7 @@ Examples:
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ecID := args[0]
 
-		_, c, err := SetupWithSafety(safety.OperationDelete)
+		cfg, c, err := SetupWithSafety(safety.OperationDelete)
 		if err != nil {
 			return err
 		}
@@ -88,7 +108,7 @@ Examples:
 
 		// Confirm deletion unless --force or --plain
 		if !forceDelete && !plainMode {
-			if !prompt.ConfirmDeletion("EdgeConnect", ec.Name, ecID) {
+			if !prompt.ConfirmDeletion(promptTarget(cfg), "EdgeConnect", ec.Name, ecID) {
 				fmt.Println("Deletion cancelled")
 				return nil
 			}
@@ -104,6 +124,8 @@ Examples:
 }
 
 func init() {
+	getEdgeConnectsCmd.Flags().Bool("status", false, "Show connectivity state: connected hosts and when an instance was last seen")
+
 	// Delete confirmation flags
 	deleteEdgeConnectCmd.Flags().BoolVarP(&forceDelete, "yes", "y", false, "Skip confirmation prompt")
 }
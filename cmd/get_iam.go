@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
 
 	"github.com/dynatrace-oss/dtctl/pkg/resources/iam"
@@ -23,6 +25,15 @@ Examples:
   # Filter users by email or name
   dtctl get users --filter "john"
 
+  # List the members of a group
+  dtctl get users --group <group-uuid>
+
+  # List the groups a user belongs to
+  dtctl get user <user-uuid> --groups
+
+  # Show the permissions a user holds through their bound policies
+  dtctl get user <user-uuid> --effective-permissions
+
   # Output as JSON
   dtctl get users -o json
 `,
@@ -33,9 +44,33 @@ Examples:
 		}
 
 		handler := iam.NewHandler(c)
+		groupUUID, _ := cmd.Flags().GetString("group")
+		showGroups, _ := cmd.Flags().GetBool("groups")
+		showPerms, _ := cmd.Flags().GetBool("effective-permissions")
+
+		if (showGroups || showPerms) && len(args) == 0 {
+			return fmt.Errorf("--groups and --effective-permissions require a user UUID")
+		}
+		if groupUUID != "" && len(args) > 0 {
+			return fmt.Errorf("--group lists the members of a group and cannot be combined with a user UUID")
+		}
 
 		// Get specific user if UUID provided
 		if len(args) > 0 {
+			switch {
+			case showGroups:
+				groups, err := handler.GetUserGroups(args[0])
+				if err != nil {
+					return err
+				}
+				return printer.PrintList(groups)
+			case showPerms:
+				perms, err := handler.GetUserEffectivePermissions(args[0])
+				if err != nil {
+					return err
+				}
+				return printer.PrintList(perms)
+			}
 			user, err := handler.GetUser(args[0])
 			if err != nil {
 				return err
@@ -43,9 +78,17 @@ Examples:
 			return printer.Print(user)
 		}
 
+		if groupUUID != "" {
+			members, err := handler.ListGroupUsers(groupUUID)
+			if err != nil {
+				return err
+			}
+			return printer.PrintList(members)
+		}
+
 		// List all users with optional filter
 		filterStr, _ := cmd.Flags().GetString("filter")
-		list, err := handler.ListUsers(filterStr, nil, GetChunkSize())
+		list, err := handler.ListUsersWithOptions(filterStr, nil, GetPageOptions())
 		if err != nil {
 			return err
 		}
@@ -81,7 +124,7 @@ Examples:
 
 		// List all groups with optional filter
 		filterStr, _ := cmd.Flags().GetString("filter")
-		list, err := handler.ListGroups(filterStr, nil, GetChunkSize())
+		list, err := handler.ListGroupsWithOptions(filterStr, nil, GetPageOptions())
 		if err != nil {
 			return err
 		}
@@ -93,5 +136,9 @@ Examples:
 func init() {
 	// IAM flags
 	getUsersCmd.Flags().String("filter", "", "Filter users by email or name (partial match)")
+	getUsersCmd.Flags().String("group", "", "List the members of the group with this UUID")
+	getUsersCmd.Flags().Bool("groups", false, "List the groups the given user belongs to")
+	getUsersCmd.Flags().Bool("effective-permissions", false, "List the permissions the given user holds through their bound policies")
+	getUsersCmd.MarkFlagsMutuallyExclusive("groups", "effective-permissions")
 	getGroupsCmd.Flags().String("filter", "", "Filter groups by name (partial match)")
 }
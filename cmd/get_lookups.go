@@ -47,8 +47,26 @@ Examples:
 
   # List all lookups with additional columns
   dtctl get lookups -o wide
+
+  # Peek at the first 10 rows of a large lookup
+  dtctl get lookup /lookups/grail/pm/error_codes --head 10
+
+  # Print only the record count (no data is loaded)
+  dtctl get lookup /lookups/grail/pm/error_codes --count
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		head, _ := cmd.Flags().GetInt("head")
+		count, _ := cmd.Flags().GetBool("count")
+		if head < 0 {
+			return fmt.Errorf("--head must be a positive number of rows")
+		}
+		if (head > 0 || count) && len(args) == 0 {
+			return fmt.Errorf("--head and --count require a lookup path")
+		}
+		if head > 0 && count {
+			return fmt.Errorf("--head and --count cannot be used together")
+		}
+
 		_, c, printer, err := Setup()
 		if err != nil {
 			return err
@@ -58,9 +76,21 @@ Examples:
 
 		// Get specific lookup if path provided
 		if len(args) > 0 {
+			if count {
+				lu, err := handler.Metadata(args[0])
+				if err != nil {
+					return err
+				}
+				if !agentMode && (outputFormat == "table" || outputFormat == "wide") {
+					fmt.Println(lu.Records)
+					return nil
+				}
+				return printer.Print(map[string]interface{}{"path": lu.Path, "records": lu.Records})
+			}
+
 			// For table output, show the actual lookup table data (not metadata)
 			if outputFormat == "table" || outputFormat == "wide" {
-				dataResult, err := handler.GetData(args[0], 0)
+				dataResult, err := handler.GetData(args[0], head)
 				if err != nil {
 					return err
 				}
@@ -70,7 +100,7 @@ Examples:
 
 			// For CSV/JSON output, return full data
 			if outputFormat == "csv" || outputFormat == "json" {
-				dataResult, err := handler.GetData(args[0], 0)
+				dataResult, err := handler.GetData(args[0], head)
 				if err != nil {
 					return err
 				}
@@ -79,7 +109,7 @@ Examples:
 			}
 
 			// For YAML output, return full structure (metadata + data)
-			lookupData, notifications, err := handler.GetWithData(args[0], 0)
+			lookupData, notifications, err := handler.GetWithData(args[0], head)
 			if err != nil {
 				return err
 			}
@@ -117,7 +147,7 @@ Examples:
 	RunE: func(cmd *cobra.Command, args []string) error {
 		path := args[0]
 
-		_, c, err := SetupWithSafety(safety.OperationDelete)
+		cfg, c, err := SetupWithSafety(safety.OperationDelete)
 		if err != nil {
 			return err
 		}
@@ -136,7 +166,7 @@ Examples:
 			if displayName == "" {
 				displayName = path
 			}
-			if !prompt.ConfirmDeletion("lookup table", displayName, path) {
+			if !prompt.ConfirmDeletion(promptTarget(cfg), "lookup table", displayName, path) {
 				fmt.Println("Deletion cancelled")
 				return nil
 			}
@@ -152,6 +182,9 @@ Examples:
 }
 
 func init() {
+	getLookupsCmd.Flags().Int("head", 0, "with a path, return only the first N rows")
+	getLookupsCmd.Flags().Bool("count", false, "with a path, print only the record count from the table's metadata")
+
 	// Delete confirmation flags
 	deleteLookupCmd.Flags().BoolVarP(&forceDelete, "yes", "y", false, "Skip confirmation prompt")
 }
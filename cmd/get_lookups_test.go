@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+)
+
+func TestGetLookupCmd_HeadAndCount(t *testing.T) {
+	var queries []string
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/storage/query/v1/query:execute": func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Query string `json:"query"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			queries = append(queries, body.Query)
+
+			w.Header().Set("Content-Type", "application/json")
+			if strings.HasPrefix(body.Query, "fetch dt.system.files") {
+				_, _ = w.Write([]byte(`{"state":"SUCCEEDED","result":{"records":[{"name":"/lookups/codes","records":"1234"}]}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"state":"SUCCEEDED","result":{"records":[{"code":"E1"},{"code":"E2"}]}}`))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile, origOutput := cfgFile, outputFormat
+	defer func() {
+		testutil.ResetCommandFlags(getLookupsCmd)
+		cfgFile, outputFormat = origCfgFile, origOutput
+	}()
+	cfgFile = configPath
+
+	testutil.ResetCommandFlags(getLookupsCmd)
+	outputFormat = "json"
+	_ = getLookupsCmd.Flags().Set("head", "2")
+	out := captureStdout(t, func() {
+		if err := getLookupsCmd.RunE(getLookupsCmd, []string{"/lookups/codes"}); err != nil {
+			t.Fatalf("RunE() error = %v", err)
+		}
+	})
+	if len(queries) != 1 || queries[0] != `load "/lookups/codes" | limit 2` {
+		t.Errorf("queries = %q, want a single limited load", queries)
+	}
+	if !strings.Contains(out, "E2") {
+		t.Errorf("output missing rows:\n%s", out)
+	}
+
+	queries = nil
+	testutil.ResetCommandFlags(getLookupsCmd)
+	outputFormat = "table"
+	_ = getLookupsCmd.Flags().Set("count", "true")
+	out = captureStdout(t, func() {
+		if err := getLookupsCmd.RunE(getLookupsCmd, []string{"/lookups/codes"}); err != nil {
+			t.Fatalf("RunE() error = %v", err)
+		}
+	})
+	if strings.TrimSpace(out) != "1234" {
+		t.Errorf("--count output = %q, want 1234", out)
+	}
+	for _, q := range queries {
+		if strings.HasPrefix(q, "load") {
+			t.Errorf("--count must not load data, ran %q", q)
+		}
+	}
+}
+
+func TestGetLookupCmd_FlagValidation(t *testing.T) {
+	defer testutil.ResetCommandFlags(getLookupsCmd)
+
+	tests := []struct {
+		name    string
+		flags   map[string]string
+		args    []string
+		wantErr string
+	}{
+		{name: "negative head", flags: map[string]string{"head": "-1"}, args: []string{"/lookups/x"}, wantErr: "--head must be"},
+		{name: "head without path", flags: map[string]string{"head": "5"}, wantErr: "require a lookup path"},
+		{name: "count without path", flags: map[string]string{"count": "true"}, wantErr: "require a lookup path"},
+		{name: "head and count", flags: map[string]string{"head": "5", "count": "true"}, args: []string{"/lookups/x"}, wantErr: "cannot be used together"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.ResetCommandFlags(getLookupsCmd)
+			for k, v := range tt.flags {
+				_ = getLookupsCmd.Flags().Set(k, v)
+			}
+			err := getLookupsCmd.RunE(getLookupsCmd, tt.args)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -30,9 +30,18 @@ Examples:
 
   # Output as JSON
   dtctl get notifications -o json
+
+  # List the webhook URLs the notifications deliver to
+  dtctl get notifications --output-webhook-urls
+
+Secrets in webhook URLs and configuration (Slack/Teams/PagerDuty path tokens,
+token or key query parameters, password fields) are masked as *** unless
+--show-secrets is set.
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		notifType, _ := cmd.Flags().GetString("type")
+		showSecrets, _ := cmd.Flags().GetBool("show-secrets")
+		webhookURLs, _ := cmd.Flags().GetBool("output-webhook-urls")
 
 		_, c, printer, err := Setup()
 		if err != nil {
@@ -41,22 +50,41 @@ Examples:
 
 		handler := notification.NewHandler(c)
 
+		var notifications []notification.EventNotification
+
 		// Get specific notification if ID provided
 		if len(args) > 0 {
 			n, err := handler.GetEventNotification(args[0])
 			if err != nil {
 				return err
 			}
-			return printer.Print(n)
+			notifications = []notification.EventNotification{*n}
+		} else {
+			list, err := handler.ListEventNotifications(notifType)
+			if err != nil {
+				return err
+			}
+			notifications = list.Results
 		}
 
-		// List all notifications
-		list, err := handler.ListEventNotifications(notifType)
-		if err != nil {
-			return err
+		if !showSecrets {
+			for i := range notifications {
+				notification.MaskSecrets(&notifications[i])
+			}
 		}
 
-		return printer.PrintList(list.Results)
+		if webhookURLs {
+			urls := []notification.WebhookURL{}
+			for i := range notifications {
+				urls = append(urls, notification.WebhookURLs(&notifications[i])...)
+			}
+			return printer.PrintList(urls)
+		}
+
+		if len(args) > 0 {
+			return printer.Print(&notifications[0])
+		}
+		return printer.PrintList(notifications)
 	},
 }
 
@@ -104,7 +132,7 @@ Examples:
 
 		// Confirm deletion unless --force or --plain
 		if !forceDelete && !plainMode {
-			if !prompt.ConfirmDeletion("notification", n.NotificationType, notifID) {
+			if !prompt.ConfirmDeletion(promptTarget(cfg), "notification", n.NotificationType, notifID) {
 				fmt.Println("Deletion cancelled")
 				return nil
 			}
@@ -122,6 +150,8 @@ Examples:
 func init() {
 	// Notification flags
 	getNotificationsCmd.Flags().String("type", "", "Filter by notification type")
+	getNotificationsCmd.Flags().Bool("show-secrets", false, "Show webhook tokens and other secrets instead of masking them")
+	getNotificationsCmd.Flags().Bool("output-webhook-urls", false, "List the webhook URLs configured in each notification")
 
 	// Delete confirmation flags
 	deleteNotificationCmd.Flags().BoolVarP(&forceDelete, "yes", "y", false, "Skip confirmation prompt")
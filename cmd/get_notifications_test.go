@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+)
+
+func TestGetNotificationCmd_MasksWebhookSecrets(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/notification/v2/event-notifications/n-1": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"n-1","notificationType":"problem","enabled":true,` +
+				`"actionConfig":{"webhookUrl":"https://hooks.slack.com/services/T000/B000/SLACKSECRET"}}`))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile, origOutputFormat, origAgentMode := cfgFile, outputFormat, agentMode
+	defer func() {
+		testutil.ResetCommandFlags(getNotificationsCmd)
+		cfgFile, outputFormat, agentMode = origCfgFile, origOutputFormat, origAgentMode
+	}()
+	testutil.ResetCommandFlags(getNotificationsCmd)
+	cfgFile = configPath
+	outputFormat = "json"
+	agentMode = false
+
+	run := func() string {
+		return captureStdout(t, func() {
+			if err := getNotificationsCmd.RunE(getNotificationsCmd, []string{"n-1"}); err != nil {
+				t.Fatalf("RunE() error = %v", err)
+			}
+		})
+	}
+
+	out := run()
+	if strings.Contains(out, "SLACKSECRET") {
+		t.Errorf("webhook token leaked without --show-secrets:\n%s", out)
+	}
+	if !strings.Contains(out, `"destination": "slack"`) {
+		t.Errorf("expected destination field, got:\n%s", out)
+	}
+
+	_ = getNotificationsCmd.Flags().Set("output-webhook-urls", "true")
+	out = run()
+	if !strings.Contains(out, `"path": "webhookUrl"`) || !strings.Contains(out, "services/***/***/***") {
+		t.Errorf("expected masked webhook URL listing, got:\n%s", out)
+	}
+
+	_ = getNotificationsCmd.Flags().Set("show-secrets", "true")
+	if out = run(); !strings.Contains(out, "SLACKSECRET") {
+		t.Errorf("expected unmasked URL with --show-secrets, got:\n%s", out)
+	}
+}
@@ -116,7 +116,7 @@ Examples:
 					return fmt.Errorf("confirmation value %q does not match segment UID %q", confirmFlag, uid)
 				}
 			} else {
-				if !prompt.ConfirmDataDeletion("segment", displayName) {
+				if !prompt.ConfirmDataDeletion(promptTarget(cfg), "segment", displayName) {
 					fmt.Println("Deletion cancelled")
 					return nil
 				}
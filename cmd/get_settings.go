@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 
@@ -22,13 +23,34 @@ Examples:
   # List all settings schemas
   dtctl get settings-schemas
 
+  # One row per schema (highest version) with a VERSIONS count
+  dtctl get settings-schemas --latest-version-only
+
+  # Only schemas whose ID matches a glob
+  dtctl get settings-schemas --filter "builtin:openpipeline.*"
+
   # Get a specific schema definition
   dtctl get settings-schema builtin:openpipeline.logs.pipelines
 
+  # Scaffold a commented settings value to edit and create
+  dtctl get settings-schema builtin:alerting.profile --example > profile.yaml
+  dtctl create settings -f profile.yaml --schema builtin:alerting.profile --scope environment
+
   # Output as JSON
   dtctl get settings-schemas -o json
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		example, _ := cmd.Flags().GetBool("example")
+		scaffold, _ := cmd.Flags().GetBool("scaffold")
+		latestOnly, _ := cmd.Flags().GetBool("latest-version-only")
+		filter, _ := cmd.Flags().GetString("filter")
+		if (example || scaffold) && len(args) == 0 {
+			return fmt.Errorf("--example requires a schema ID")
+		}
+		if (latestOnly || filter != "") && len(args) > 0 {
+			return fmt.Errorf("--latest-version-only and --filter cannot be combined with a schema ID")
+		}
+
 		_, c, printer, err := Setup()
 		if err != nil {
 			return err
@@ -42,6 +64,14 @@ Examples:
 			if err != nil {
 				return err
 			}
+			if example || scaffold {
+				data, err := settings.Scaffold(schema)
+				if err != nil {
+					return err
+				}
+				_, err = os.Stdout.Write(data)
+				return err
+			}
 			return printer.Print(schema)
 		}
 
@@ -50,11 +80,21 @@ Examples:
 		if err != nil {
 			return err
 		}
-
-		return printer.PrintList(list.Items)
+		schemas, err := settings.FilterSchemas(list.Items, filter)
+		if err != nil {
+			return err
+		}
+		if latestOnly {
+			return printer.PrintList(settings.LatestSchemaVersions(schemas))
+		}
+		return printer.PrintList(schemas)
 	},
 }
 
+// settingsSweepConcurrency bounds the parallel per-schema requests made by
+// get settings --all-schemas.
+const settingsSweepConcurrency = 8
+
 // getSettingsCmd retrieves settings objects
 var getSettingsCmd = &cobra.Command{
 	Use:     "settings [object-id]",
@@ -69,15 +109,44 @@ Examples:
   # List settings with a specific scope
   dtctl get settings --schema builtin:openpipeline.logs.pipelines --scope environment
 
+  # Filter server-side by field values
+  dtctl get settings --schema builtin:openpipeline.logs.pipelines --field-selector "value.enabled==true"
+  dtctl get settings --schema builtin:alerting.profile --field-selector "value.name contains prod"
+
   # Get a specific settings object by objectId
   dtctl get settings vu9U3hXa3q0AAAABABRidWlsdGluOnJ1bS53ZWIubmFtZQ...
 
   # Output as JSON
   dtctl get settings --schema builtin:openpipeline.logs.pipelines -o json
+
+  # Snapshot objects across every schema (or a glob of schemas)
+  dtctl get settings --all-schemas -o yaml > settings-snapshot.yaml
+  dtctl get settings --all-schemas --schema-filter "builtin:openpipeline.*"
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		schemaID, _ := cmd.Flags().GetString("schema")
 		scope, _ := cmd.Flags().GetString("scope")
+		fieldSelector, _ := cmd.Flags().GetString("field-selector")
+		allSchemas, _ := cmd.Flags().GetBool("all-schemas")
+		schemaFilter, _ := cmd.Flags().GetString("schema-filter")
+
+		if allSchemas && (schemaID != "" || len(args) > 0) {
+			return fmt.Errorf("--all-schemas cannot be combined with --schema or an object ID")
+		}
+		if schemaFilter != "" && !allSchemas {
+			return fmt.Errorf("--schema-filter requires --all-schemas")
+		}
+
+		var filter string
+		if fieldSelector != "" {
+			if len(args) > 0 {
+				return fmt.Errorf("--field-selector cannot be combined with an object ID")
+			}
+			var err error
+			if filter, err = settings.FieldSelectorFilter(fieldSelector); err != nil {
+				return err
+			}
+		}
 
 		_, c, printer, err := Setup()
 		if err != nil {
@@ -95,12 +164,26 @@ Examples:
 			return printer.Print(obj)
 		}
 
+		if allSchemas {
+			items, failed, err := handler.ListObjectsAllSchemas(schemaFilter, scope, filter, GetPageOptions(), settingsSweepConcurrency)
+			if err != nil {
+				return err
+			}
+			for _, f := range failed {
+				output.PrintWarning("skipped schema %s: %v", f.SchemaID, f.Err)
+			}
+			if limit := GetLimit(); limit > 0 && int64(len(items)) > limit {
+				items = items[:limit]
+			}
+			return printer.PrintList(items)
+		}
+
 		// List objects for schema
 		if schemaID == "" {
-			return fmt.Errorf("--schema is required when listing settings objects")
+			return fmt.Errorf("--schema is required when listing settings objects (or use --all-schemas)")
 		}
 
-		list, err := handler.ListObjects(schemaID, scope, GetChunkSize())
+		list, err := handler.ListObjectsWithOptions(schemaID, scope, filter, GetPageOptions())
 		if err != nil {
 			return err
 		}
@@ -111,9 +194,13 @@ Examples:
 
 // deleteSettingsCmd deletes a settings object
 var deleteSettingsCmd = &cobra.Command{
-	Use:   "settings <object-id>",
+	Use:   "settings <object-id> | --schema <schema-id> --all",
 	Short: "Delete a settings object",
-	Long: `Delete a settings object by objectId.
+	Long: `Delete a settings object by objectId, or every object of a schema with --all.
+
+With --all, the objects of --schema (optionally limited to --scope) are listed
+and deleted one by one after a single confirmation that shows their count and
+asks you to type the schema ID. Use --dry-run to see what would be deleted.
 
 Examples:
   # Delete by objectId
@@ -124,13 +211,42 @@ Examples:
 
   # Validate deletion against the API without deleting
   dtctl delete settings <object-id> --validate-only
+
+  # Delete every object of a schema
+  dtctl delete settings --schema builtin:openpipeline.logs.pipelines --all
+
+  # Only the objects in one scope; preview first
+  dtctl delete settings --schema builtin:alerting.profile --scope environment --all --dry-run
 `,
 	Aliases: []string{"setting"},
-	Args:    cobra.ExactArgs(1),
+	Args:    cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		objectID := args[0]
+		all, _ := cmd.Flags().GetBool("all")
+		schemaID, _ := cmd.Flags().GetString("schema")
+		scope, _ := cmd.Flags().GetString("scope")
 		validateOnly, _ := cmd.Flags().GetBool("validate-only")
 
+		if all {
+			if len(args) > 0 {
+				return fmt.Errorf("--all cannot be combined with an object ID")
+			}
+			if schemaID == "" {
+				return fmt.Errorf("--all requires --schema")
+			}
+			if validateOnly {
+				return fmt.Errorf("--validate-only cannot be combined with --all")
+			}
+			return deleteAllSettings(schemaID, scope)
+		}
+		if len(args) == 0 {
+			return fmt.Errorf("requires an object ID, or --schema with --all")
+		}
+		if schemaID != "" || scope != "" {
+			return fmt.Errorf("--schema and --scope require --all")
+		}
+
+		objectID := args[0]
+
 		if validateOnly {
 			_, c, err := SetupClient()
 			if err != nil {
@@ -144,7 +260,7 @@ Examples:
 			return nil
 		}
 
-		_, c, err := SetupWithSafety(safety.OperationDelete)
+		cfg, c, err := SetupWithSafety(safety.OperationDelete)
 		if err != nil {
 			return err
 		}
@@ -163,7 +279,7 @@ Examples:
 			if summary == "" {
 				summary = obj.SchemaID
 			}
-			if !prompt.ConfirmDeletion("settings object", summary, objectID) {
+			if !prompt.ConfirmDeletion(promptTarget(cfg), "settings object", summary, objectID) {
 				fmt.Println("Deletion cancelled")
 				return nil
 			}
@@ -178,12 +294,82 @@ Examples:
 	},
 }
 
+// deleteAllSettings deletes every object of a schema (optionally limited to a
+// scope) after a single typed confirmation.
+func deleteAllSettings(schemaID, scope string) error {
+	cfg, c, err := SetupWithSafety(safety.OperationDelete)
+	if err != nil {
+		return err
+	}
+
+	handler := settings.NewHandler(c)
+	list, err := handler.ListObjectsWithOptions(schemaID, scope, "", allPageOptions())
+	if err != nil {
+		return err
+	}
+	objs := list.Items
+
+	where := schemaID
+	if scope != "" {
+		where = fmt.Sprintf("%s (scope %s)", schemaID, scope)
+	}
+	if len(objs) == 0 {
+		output.PrintInfo("No settings objects found in %s", where)
+		return nil
+	}
+
+	if dryRun {
+		fmt.Printf("Dry run: would delete %d settings object(s) in %s:\n", len(objs), where)
+		for _, obj := range objs {
+			fmt.Printf("  %s  %s\n", obj.ObjectID, obj.Summary)
+		}
+		return nil
+	}
+
+	if !forceDelete && !plainMode {
+		fmt.Printf("\nSettings objects in %s:\n", where)
+		for _, obj := range objs {
+			fmt.Printf("  %s  %s\n", obj.ObjectID, obj.Summary)
+		}
+		if !prompt.ConfirmBulkDeletion(promptTarget(cfg), "settings object", schemaID, len(objs)) {
+			fmt.Println("Deletion cancelled")
+			return nil
+		}
+	}
+
+	failed := 0
+	for _, obj := range objs {
+		if err := handler.DeleteVersion(obj.ObjectID, obj.SchemaVersion); err != nil {
+			output.PrintWarning("Failed to delete settings object %q: %v", obj.ObjectID, err)
+			failed++
+			continue
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to delete %d of %d settings objects", failed, len(objs))
+	}
+	output.PrintSuccess("Deleted %d settings object(s) in %s", len(objs), where)
+	return nil
+}
+
 func init() {
+	// Settings schema flags
+	getSettingsSchemasCmd.Flags().Bool("example", false, "print a commented YAML skeleton of a settings value for the schema")
+	getSettingsSchemasCmd.Flags().Bool("scaffold", false, "alias for --example")
+	getSettingsSchemasCmd.Flags().Bool("latest-version-only", false, "show only the highest version of each schema, with a VERSIONS count")
+	getSettingsSchemasCmd.Flags().String("filter", "", "glob limiting the list to matching schema IDs (e.g. 'builtin:openpipeline.*')")
+
 	// Settings flags
 	getSettingsCmd.Flags().String("schema", "", "Schema ID (required when listing settings objects)")
 	getSettingsCmd.Flags().String("scope", "", "Scope to filter settings (e.g., 'environment')")
+	getSettingsCmd.Flags().Bool("all-schemas", false, "List objects across all schemas (schemas that fail to list are skipped with a warning)")
+	getSettingsCmd.Flags().String("schema-filter", "", "Glob limiting --all-schemas to matching schema IDs (e.g. 'builtin:openpipeline.*')")
+	getSettingsCmd.Flags().String("field-selector", "", "filter objects server-side, e.g. 'value.enabled==true' (operators: ==, !=, contains; comma-separated terms are ANDed)")
 
 	// Delete settings flags
 	deleteSettingsCmd.Flags().BoolVarP(&forceDelete, "yes", "y", false, "Skip confirmation prompt")
 	deleteSettingsCmd.Flags().Bool("validate-only", false, "validate the deletion against the API without deleting")
+	deleteSettingsCmd.Flags().Bool("all", false, "delete every object of --schema (optionally limited to --scope)")
+	deleteSettingsCmd.Flags().String("schema", "", "schema ID whose objects --all deletes")
+	deleteSettingsCmd.Flags().String("scope", "", "limit --all to objects in this scope (e.g. 'environment')")
 }
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+)
+
+func TestGetSettingsSchemas_LatestVersionOnly(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/classic/environment-api/v2/settings/schemas": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"items":[
+				{"schemaId":"builtin:openpipeline.logs.pipelines","displayName":"Logs","version":"1.9"},
+				{"schemaId":"builtin:alerting.profile","displayName":"Alerting","version":"8.1"},
+				{"schemaId":"builtin:openpipeline.logs.pipelines","displayName":"Logs","version":"1.10"}
+			],"totalCount":3}`))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile, origOutput := cfgFile, outputFormat
+	defer func() {
+		cfgFile = origCfgFile
+		outputFormat = origOutput
+	}()
+	cfgFile = configPath
+
+	testutil.ResetCommandFlags(getSettingsSchemasCmd)
+	outputFormat = "json"
+	_ = getSettingsSchemasCmd.Flags().Set("latest-version-only", "true")
+	_ = getSettingsSchemasCmd.Flags().Set("filter", "builtin:openpipeline.*")
+
+	var runErr error
+	out := captureStdout(t, func() { runErr = getSettingsSchemasCmd.RunE(getSettingsSchemasCmd, nil) })
+	if runErr != nil {
+		t.Fatalf("RunE() error = %v", runErr)
+	}
+
+	var rows []struct {
+		SchemaID string `json:"schemaId"`
+		Version  string `json:"version"`
+		Versions int    `json:"versions"`
+	}
+	if err := json.Unmarshal([]byte(out), &rows); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, out)
+	}
+	if len(rows) != 1 || rows[0].SchemaID != "builtin:openpipeline.logs.pipelines" || rows[0].Version != "1.10" || rows[0].Versions != 2 {
+		t.Errorf("rows = %+v, want one logs.pipelines row at 1.10 with 2 versions", rows)
+	}
+}
+
+func TestGetSettingsSchemas_FilterRejectsSchemaID(t *testing.T) {
+	testutil.ResetCommandFlags(getSettingsSchemasCmd)
+	_ = getSettingsSchemasCmd.Flags().Set("latest-version-only", "true")
+
+	if err := getSettingsSchemasCmd.RunE(getSettingsSchemasCmd, []string{"builtin:alerting.profile"}); err == nil {
+		t.Fatal("expected error combining --latest-version-only with a schema ID")
+	}
+}
@@ -2,6 +2,9 @@ package cmd
 
 import (
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -30,9 +33,22 @@ Examples:
 
   # Output as JSON
   dtctl get slos -o json
+
+  # Evaluate every SLO and show only those that are breaching
+  dtctl get slos --status breaching
+
+  # What's on fire: breaching or warning, lowest error budget first
+  dtctl get slos --degrading
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		filter, _ := cmd.Flags().GetString("filter")
+		statuses, err := sloStatusFilter(cmd)
+		if err != nil {
+			return err
+		}
+		if len(statuses) > 0 && len(args) > 0 {
+			return fmt.Errorf("--status and --degrading filter SLO lists; use 'dtctl exec slo %s' to evaluate a single SLO", args[0])
+		}
 
 		_, c, printer, err := Setup()
 		if err != nil {
@@ -51,15 +67,51 @@ Examples:
 		}
 
 		// List all SLOs
-		list, err := handler.List(filter, GetChunkSize())
+		list, err := handler.ListWithOptions(filter, GetPageOptions())
 		if err != nil {
 			return err
 		}
 
-		return printer.PrintList(list.SLOs)
+		if len(statuses) == 0 {
+			return printer.PrintList(list.SLOs)
+		}
+
+		timeout, _ := cmd.Flags().GetDuration("eval-timeout")
+		rows := handler.EvaluateAll(list.SLOs, sloEvalConcurrency, timeout)
+		for _, r := range slo.FilterByStatus(rows, slo.StatusUnknown) {
+			output.PrintWarning("skipped SLO %s (%s): %s", r.Name, r.ID, r.Error)
+		}
+		rows = slo.FilterByStatus(rows, statuses...)
+		slo.SortByErrorBudget(rows)
+		return printer.PrintList(rows)
 	},
 }
 
+// sloEvalConcurrency bounds the parallel SLO evaluations made by
+// get slos --status/--degrading.
+const sloEvalConcurrency = 8
+
+// sloStatusFilter returns the statuses selected by --status and --degrading
+// (nil when neither is set).
+func sloStatusFilter(cmd *cobra.Command) ([]string, error) {
+	statuses, _ := cmd.Flags().GetStringSlice("status")
+	degrading, _ := cmd.Flags().GetBool("degrading")
+	if degrading && len(statuses) > 0 {
+		return nil, fmt.Errorf("--degrading and --status are mutually exclusive")
+	}
+	if degrading {
+		return []string{slo.StatusBreaching, slo.StatusWarning}, nil
+	}
+	for i, st := range statuses {
+		st = strings.ToLower(strings.TrimSpace(st))
+		if !slices.Contains(slo.ValidStatusFilters, st) {
+			return nil, fmt.Errorf("invalid --status %q (valid values: %s)", st, strings.Join(slo.ValidStatusFilters, ", "))
+		}
+		statuses[i] = st
+	}
+	return statuses, nil
+}
+
 // getSLOTemplatesCmd retrieves SLO templates
 var getSLOTemplatesCmd = &cobra.Command{
 	Use:     "slo-templates [id]",
@@ -126,7 +178,7 @@ Examples:
 	RunE: func(cmd *cobra.Command, args []string) error {
 		sloID := args[0]
 
-		_, c, err := SetupWithSafety(safety.OperationDelete)
+		cfg, c, err := SetupWithSafety(safety.OperationDelete)
 		if err != nil {
 			return err
 		}
@@ -141,7 +193,7 @@ Examples:
 
 		// Confirm deletion unless --force or --plain
 		if !forceDelete && !plainMode {
-			if !prompt.ConfirmDeletion("SLO", s.Name, sloID) {
+			if !prompt.ConfirmDeletion(promptTarget(cfg), "SLO", s.Name, sloID) {
 				fmt.Println("Deletion cancelled")
 				return nil
 			}
@@ -159,6 +211,12 @@ Examples:
 func init() {
 	// SLO flags
 	getSLOsCmd.Flags().String("filter", "", "Filter SLOs (e.g., \"name~'production'\")")
+	getSLOsCmd.Flags().StringSlice("status", nil, "Evaluate each SLO and keep those with this status: breaching, warning, healthy (comma-separated)")
+	getSLOsCmd.Flags().Bool("degrading", false, "Evaluate each SLO and keep those breaching or warning (same as --status breaching,warning)")
+	getSLOsCmd.Flags().Duration("eval-timeout", 30*time.Second, "Maximum time to wait for each SLO evaluation with --status/--degrading")
+	_ = getSLOsCmd.RegisterFlagCompletionFunc("status", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		return slo.ValidStatusFilters, cobra.ShellCompDirectiveNoFileComp
+	})
 	getSLOTemplatesCmd.Flags().String("filter", "", "Filter templates (e.g., \"builtIn==true\")")
 
 	// Delete confirmation flags
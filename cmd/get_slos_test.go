@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+)
+
+func TestGetSLOsCmd_Pagination(t *testing.T) {
+	tests := []struct {
+		name         string
+		pageSize     int64
+		noPaginate   bool
+		wantCalls    int
+		wantPageSize string
+	}{
+		{name: "page size follows all pages", pageSize: 1, wantCalls: 2, wantPageSize: "1"},
+		{name: "no-paginate fetches first page", pageSize: 1, noPaginate: true, wantCalls: 1, wantPageSize: "1"},
+		{name: "default uses chunk size", wantCalls: 2, wantPageSize: "500"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			firstPageSize := ""
+			ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+				"/platform/slo/v1/slos": func(w http.ResponseWriter, r *http.Request) {
+					calls++
+					resp := map[string]any{"slos": []any{map[string]any{"id": "slo-2", "name": "Latency"}}, "totalCount": 2}
+					if r.URL.Query().Get("page-key") == "" {
+						firstPageSize = r.URL.Query().Get("page-size")
+						resp = map[string]any{"slos": []any{map[string]any{"id": "slo-1", "name": "Availability"}}, "totalCount": 2, "nextPageKey": "page-2"}
+					}
+					w.Header().Set("Content-Type", "application/json")
+					json.NewEncoder(w).Encode(resp)
+				},
+			})
+			defer ms.Close()
+
+			configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+			defer cleanup()
+
+			origCfgFile, origOutput := cfgFile, outputFormat
+			origChunk, origPage, origNoPag := chunkSize, pageSize, noPaginate
+			defer func() {
+				cfgFile, outputFormat = origCfgFile, origOutput
+				chunkSize, pageSize, noPaginate = origChunk, origPage, origNoPag
+			}()
+			cfgFile = configPath
+			outputFormat = "json"
+			chunkSize, pageSize, noPaginate = defaultChunkSize, tt.pageSize, tt.noPaginate
+
+			testutil.ResetCommandFlags(getSLOsCmd)
+			captureStdout(t, func() {
+				if err := getSLOsCmd.RunE(getSLOsCmd, nil); err != nil {
+					t.Fatalf("RunE() error = %v", err)
+				}
+			})
+
+			if calls != tt.wantCalls {
+				t.Errorf("API called %d times, want %d", calls, tt.wantCalls)
+			}
+			if firstPageSize != tt.wantPageSize {
+				t.Errorf("page-size = %q, want %q", firstPageSize, tt.wantPageSize)
+			}
+		})
+	}
+}
+
+func TestGetSLOsCmd_Degrading(t *testing.T) {
+	statusByID := map[string]string{"slo-1": "SUCCESS", "slo-2": "FAILURE", "slo-3": "WARNING"}
+	budgetByID := map[string]float64{"slo-1": 90, "slo-2": -4, "slo-3": 12}
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/slo/v1/slos": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{"totalCount": 3, "slos": []any{
+				map[string]any{"id": "slo-1", "name": "Healthy"},
+				map[string]any{"id": "slo-3", "name": "Warning"},
+				map[string]any{"id": "slo-2", "name": "Breaching"},
+			}})
+		},
+		"/platform/slo/v1/slos/evaluation:start": func(w http.ResponseWriter, r *http.Request) {
+			var body struct{ ID string }
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{"evaluationResults": []any{
+				map[string]any{"criteria": "c", "status": statusByID[body.ID], "errorBudget": budgetByID[body.ID]},
+			}})
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile, origOutput, origAgent := cfgFile, outputFormat, agentMode
+	defer func() { cfgFile, outputFormat, agentMode = origCfgFile, origOutput, origAgent }()
+	cfgFile = configPath
+	outputFormat = "json"
+	agentMode = false
+
+	testutil.ResetCommandFlags(getSLOsCmd)
+	_ = getSLOsCmd.Flags().Set("degrading", "true")
+
+	out := captureStdout(t, func() {
+		if err := getSLOsCmd.RunE(getSLOsCmd, nil); err != nil {
+			t.Fatalf("RunE() error = %v", err)
+		}
+	})
+
+	var rows []struct {
+		ID     string `json:"id"`
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal([]byte(out), &rows); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, out)
+	}
+	if len(rows) != 2 || rows[0].ID != "slo-2" || rows[1].ID != "slo-3" {
+		t.Fatalf("want breaching then warning sorted by error budget, got %+v", rows)
+	}
+	if rows[0].Status != "breaching" || rows[1].Status != "warning" {
+		t.Errorf("unexpected statuses: %+v", rows)
+	}
+}
+
+func TestGetSLOsCmd_StatusValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		flags   map[string]string
+		args    []string
+		wantErr string
+	}{
+		{name: "invalid status", flags: map[string]string{"status": "burning"}, wantErr: `invalid --status "burning"`},
+		{name: "degrading with status", flags: map[string]string{"status": "healthy", "degrading": "true"}, wantErr: "mutually exclusive"},
+		{name: "status with ID", flags: map[string]string{"status": "breaching"}, args: []string{"slo-1"}, wantErr: "dtctl exec slo slo-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.ResetCommandFlags(getSLOsCmd)
+			for k, v := range tt.flags {
+				_ = getSLOsCmd.Flags().Set(k, v)
+			}
+			err := getSLOsCmd.RunE(getSLOsCmd, tt.args)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dynatrace-oss/dtctl/pkg/resources/workflow"
+)
+
+// executionWithLogs is the structured output of get wfe <id> --logs: the
+// execution with the log and result of each task nested under it.
+type executionWithLogs struct {
+	*workflow.Execution `yaml:",inline"`
+	Tasks               []workflow.TaskLog `json:"tasks" yaml:"tasks"`
+}
+
+// printTaskLogs writes the task logs of an execution below its summary. A
+// task that logged nothing but returned a value shows the value instead.
+func printTaskLogs(w io.Writer, tasks []workflow.TaskLog) {
+	if len(tasks) == 0 {
+		fmt.Fprintln(w, "\nNo tasks executed.")
+		return
+	}
+	for _, task := range tasks {
+		fmt.Fprintf(w, "\n=== Task: %s [%s] ===\n", task.Name, task.State)
+		if task.StateInfo != nil && *task.StateInfo != "" {
+			fmt.Fprintf(w, "State info: %s\n", *task.StateInfo)
+		}
+		switch {
+		case task.LogError != "":
+			fmt.Fprintf(w, "(failed to fetch log: %s)\n", task.LogError)
+		case task.Log != "":
+			fmt.Fprint(w, task.Log)
+			if !strings.HasSuffix(task.Log, "\n") {
+				fmt.Fprintln(w)
+			}
+		case task.Result == nil:
+			fmt.Fprintln(w, "(no log output)")
+		}
+		if task.Log == "" && task.Result != nil {
+			data, err := json.MarshalIndent(task.Result, "", "  ")
+			if err != nil {
+				fmt.Fprintf(w, "(failed to encode result: %v)\n", err)
+				continue
+			}
+			fmt.Fprintf(w, "Result:\n%s\n", data)
+		}
+	}
+}
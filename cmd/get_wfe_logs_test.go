@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/workflow"
+)
+
+func TestPrintTaskLogs(t *testing.T) {
+	failed := "Task failed"
+	var buf bytes.Buffer
+	printTaskLogs(&buf, []workflow.TaskLog{
+		{Name: "fetch", State: "SUCCESS", Log: "fetched 3 records"},
+		{Name: "compute", State: "SUCCESS", Result: map[string]any{"count": 3}},
+		{Name: "notify", State: "ERROR", StateInfo: &failed, LogError: "HTTP 500"},
+		{Name: "noop", State: "SUCCESS"},
+	})
+	got := buf.String()
+
+	for _, want := range []string{
+		"=== Task: fetch [SUCCESS] ===\nfetched 3 records\n",
+		"=== Task: compute [SUCCESS] ===\nResult:\n{\n  \"count\": 3\n}\n",
+		"State info: Task failed\n(failed to fetch log: HTTP 500)\n",
+		"=== Task: noop [SUCCESS] ===\n(no log output)\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output missing %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestGetWfeCmd_LogsJSON(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/automation/v1/executions/exec-1": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"exec-1","workflow":"wf-1","title":"Nightly","state":"ERROR","startedAt":"2026-05-18T02:00:00Z"}`))
+		},
+		"/platform/automation/v1/executions/exec-1/tasks": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"run_query":{"id":"t1","name":"run_query","state":"ERROR","startedAt":"2026-05-18T02:00:01Z"}}`))
+		},
+		"/platform/automation/v1/executions/exec-1/tasks/run_query/log": func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`"query timed out\n"`))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile, origOutputFormat, origAgentMode := cfgFile, outputFormat, agentMode
+	defer func() {
+		testutil.ResetCommandFlags(getWorkflowExecutionsCmd)
+		cfgFile, outputFormat, agentMode = origCfgFile, origOutputFormat, origAgentMode
+	}()
+	testutil.ResetCommandFlags(getWorkflowExecutionsCmd)
+	cfgFile = configPath
+	outputFormat = "json"
+	agentMode = false
+	_ = getWorkflowExecutionsCmd.Flags().Set("logs", "true")
+
+	out := captureStdout(t, func() {
+		if err := getWorkflowExecutionsCmd.RunE(getWorkflowExecutionsCmd, []string{"exec-1"}); err != nil {
+			t.Fatalf("RunE() error = %v", err)
+		}
+	})
+
+	var got struct {
+		ID    string `json:"id"`
+		Tasks []struct {
+			Name string `json:"name"`
+			Log  string `json:"log"`
+		} `json:"tasks"`
+	}
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("output is not JSON: %v\n%s", err, out)
+	}
+	if got.ID != "exec-1" || len(got.Tasks) != 1 || got.Tasks[0].Log != "query timed out\n" {
+		t.Errorf("unexpected output: %+v", got)
+	}
+}
+
+func TestGetWfeCmd_LogsRequiresID(t *testing.T) {
+	defer testutil.ResetCommandFlags(getWorkflowExecutionsCmd)
+	testutil.ResetCommandFlags(getWorkflowExecutionsCmd)
+	_ = getWorkflowExecutionsCmd.Flags().Set("logs", "true")
+
+	err := getWorkflowExecutionsCmd.RunE(getWorkflowExecutionsCmd, nil)
+	if err == nil || !strings.Contains(err.Error(), "requires an execution ID") {
+		t.Errorf("expected --logs without ID error, got %v", err)
+	}
+}
+
+func TestGetWfeCmd_RawInput(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/automation/v1/executions/exec-1": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"exec-1","state":"ERROR","triggerType":"Event","input":{"event.type":"PROBLEM_OPEN","display_id":"P-42"}}`))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile, origOutputFormat, origAgentMode := cfgFile, outputFormat, agentMode
+	defer func() {
+		testutil.ResetCommandFlags(getWorkflowExecutionsCmd)
+		cfgFile, outputFormat, agentMode = origCfgFile, origOutputFormat, origAgentMode
+	}()
+	cfgFile = configPath
+	agentMode = false
+
+	for _, format := range []string{"table", "json"} {
+		testutil.ResetCommandFlags(getWorkflowExecutionsCmd)
+		outputFormat = format
+		_ = getWorkflowExecutionsCmd.Flags().Set("raw-input", "true")
+
+		out := captureStdout(t, func() {
+			if err := getWorkflowExecutionsCmd.RunE(getWorkflowExecutionsCmd, []string{"exec-1"}); err != nil {
+				t.Fatalf("RunE() error = %v", err)
+			}
+		})
+		var got map[string]any
+		if err := json.Unmarshal([]byte(out), &got); err != nil {
+			t.Fatalf("-o %s: output is not the JSON payload: %v\n%s", format, err, out)
+		}
+		if got["display_id"] != "P-42" || got["id"] != nil {
+			t.Errorf("-o %s: expected only the trigger payload, got %v", format, got)
+		}
+	}
+
+	testutil.ResetCommandFlags(getWorkflowExecutionsCmd)
+	_ = getWorkflowExecutionsCmd.Flags().Set("raw-input", "true")
+	if err := getWorkflowExecutionsCmd.RunE(getWorkflowExecutionsCmd, nil); err == nil || !strings.Contains(err.Error(), "requires an execution ID") {
+		t.Errorf("expected missing ID error, got %v", err)
+	}
+	_ = getWorkflowExecutionsCmd.Flags().Set("logs", "true")
+	if err := getWorkflowExecutionsCmd.RunE(getWorkflowExecutionsCmd, []string{"exec-1"}); err == nil || !strings.Contains(err.Error(), "--logs") {
+		t.Errorf("expected --logs conflict error, got %v", err)
+	}
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -9,11 +10,15 @@ import (
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
+	"github.com/dynatrace-oss/dtctl/pkg/client"
+	"github.com/dynatrace-oss/dtctl/pkg/config"
+	"github.com/dynatrace-oss/dtctl/pkg/labels"
 	"github.com/dynatrace-oss/dtctl/pkg/output"
 	"github.com/dynatrace-oss/dtctl/pkg/prompt"
 	"github.com/dynatrace-oss/dtctl/pkg/resources/resolver"
 	"github.com/dynatrace-oss/dtctl/pkg/resources/workflow"
 	"github.com/dynatrace-oss/dtctl/pkg/safety"
+	"github.com/dynatrace-oss/dtctl/pkg/util/timeframe"
 )
 
 // workflowFilter holds the workflow ID filter for executions
@@ -32,6 +37,10 @@ func validateWorkflowChunkSize(chunk int64) error {
 	return nil
 }
 
+// unusedWorkflowConcurrency bounds the execution lookups get workflows
+// --unused runs in parallel.
+const unusedWorkflowConcurrency = 4
+
 // triggerTypeCaser normalizes trigger-type filter values to the API's title-case form
 // (e.g. "schedule" -> "Schedule").
 var triggerTypeCaser = cases.Title(language.Und)
@@ -50,13 +59,47 @@ Examples:
   # Get a specific workflow
   dtctl get workflow <workflow-id>
 
+  # Show a workflow's task dependency graph
+  dtctl get workflow <workflow-id> --show-tasks
+
+  # Export a workflow for version control or another environment
+  dtctl get workflow <workflow-id> --export-runnable > workflow.yaml
+
+  # Show schedules in plain English with the next run time
+  dtctl get workflows --trigger schedule --format-cron
+
   # Output as JSON
   dtctl get workflows -o json
 
   # List only my workflows
   dtctl get workflows --mine
+
+  # Filter by labels set with 'dtctl apply --label'
+  dtctl get workflows --selector team=checkout
+
+  # Find workflows that are disabled or have not run in 90 days
+  dtctl get workflows --unused --older-than 90d
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		unused, _ := cmd.Flags().GetBool("unused")
+		olderThan, _ := cmd.Flags().GetString("older-than")
+		if cmd.Flags().Changed("older-than") && !unused {
+			return fmt.Errorf("--older-than requires --unused")
+		}
+		if unused && len(args) > 0 {
+			return fmt.Errorf("--unused cannot be combined with a workflow ID")
+		}
+		if formatCron, _ := cmd.Flags().GetBool("format-cron"); unused && (watchEnabled(cmd) || formatCron) {
+			return fmt.Errorf("--unused cannot be combined with --watch or --format-cron")
+		}
+		var unusedSince time.Time
+		if unused {
+			var err error
+			if unusedSince, err = timeframe.Parse(olderThan, time.Now()); err != nil {
+				return fmt.Errorf("invalid --older-than: %w", err)
+			}
+		}
+
 		_, c, printer, err := Setup()
 		if err != nil {
 			return err
@@ -65,18 +108,45 @@ Examples:
 		handler := workflow.NewHandler(c)
 		ap := enrichAgent(printer, "get", "workflow")
 
+		showTasks, _ := cmd.Flags().GetBool("show-tasks")
+		if showTasks && len(args) == 0 {
+			return fmt.Errorf("--show-tasks requires a workflow ID")
+		}
+		formatCron, _ := cmd.Flags().GetBool("format-cron")
+		exportRunnable, _ := cmd.Flags().GetBool("export-runnable")
+		if exportRunnable && len(args) == 0 {
+			return fmt.Errorf("--export-runnable requires a workflow ID")
+		}
+		if exportRunnable && (showTasks || formatCron) {
+			return fmt.Errorf("--export-runnable cannot be combined with --show-tasks or --format-cron")
+		}
+
 		// Get specific workflow if ID provided
 		if len(args) > 0 {
 			wf, err := handler.Get(args[0])
 			if err != nil {
 				return err
 			}
+			if showTasks {
+				if len(wf.Tasks) == 0 {
+					fmt.Printf("Workflow %q has no tasks\n", wf.Title)
+					return nil
+				}
+				workflow.RenderTaskTree(os.Stdout, wf.Tasks)
+				return nil
+			}
+			if exportRunnable {
+				return printRunnableWorkflow(wf, printer, ap)
+			}
 			if ap != nil {
 				ap.SetSuggestions([]string{
 					fmt.Sprintf("Run 'dtctl exec workflow %s' to trigger this workflow", args[0]),
 					fmt.Sprintf("Run 'dtctl get workflow-executions --workflow %s' to see past executions", args[0]),
 				})
 			}
+			if formatCron {
+				return printer.Print(workflow.ScheduleRows([]workflow.Workflow{*wf}, time.Now())[0])
+			}
 			return printer.Print(wf)
 		}
 
@@ -110,23 +180,51 @@ Examples:
 			filters.Owner = userID
 		}
 
+		sel, err := selectorFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+
+		// Labels are matched client-side, so with a selector --limit caps the
+		// matches rather than the workflows fetched.
+		fetchLimit := limit
+		if sel != nil {
+			fetchLimit = 0
+		}
+
 		// Check if watch mode is enabled
-		watchMode, _ := cmd.Flags().GetBool("watch")
-		if watchMode {
+		if watchEnabled(cmd) {
 			fetcher := func() (interface{}, error) {
-				list, err := handler.List(filters, chunk, limit)
+				list, err := handler.List(filters, chunk, fetchLimit)
 				if err != nil {
 					return nil, err
 				}
-				return list.Results, nil
+				results := limitWorkflows(selectByLabels(list.Results, sel, workflowLabelSource), limit)
+				if formatCron {
+					return workflow.ScheduleRows(results, time.Now()), nil
+				}
+				return results, nil
 			}
 			return executeWithWatch(cmd, fetcher, printer)
 		}
 
-		list, err := handler.List(filters, chunk, limit)
+		list, err := handler.List(filters, chunk, fetchLimit)
 		if err != nil {
 			return err
 		}
+		list.Results = limitWorkflows(selectByLabels(list.Results, sel, workflowLabelSource), limit)
+
+		if unused {
+			found, err := workflow.NewExecutionHandler(c).FindUnused(list.Results, unusedSince, unusedWorkflowConcurrency)
+			if err != nil {
+				return err
+			}
+			if ap != nil {
+				ap.SetTotal(len(found))
+				ap.SetSuggestions([]string{"Run 'dtctl delete workflow <id>' to remove a workflow that is no longer needed"})
+			}
+			return printer.PrintList(found)
+		}
 
 		if ap != nil {
 			ap.SetTotal(len(list.Results))
@@ -148,10 +246,18 @@ Examples:
 			ap.SetSuggestions(suggestions)
 		}
 
+		if formatCron {
+			return printer.PrintList(workflow.ScheduleRows(list.Results, time.Now()))
+		}
 		return printer.PrintList(list.Results)
 	},
 }
 
+// maxExecutionSummaryLimit is the execution limit --summary uses unless
+// --limit is set explicitly, so the stats cover as much of the window as the
+// API returns.
+const maxExecutionSummaryLimit = 1000
+
 // getWorkflowExecutionsCmd retrieves workflow executions
 var getWorkflowExecutionsCmd = &cobra.Command{
 	Use:     "workflow-executions [id]",
@@ -171,10 +277,41 @@ Examples:
   # Get a specific execution
   dtctl get wfe <execution-id>
 
+  # Get an execution with the logs and results of all its tasks
+  dtctl get wfe <execution-id> --logs
+  dtctl get wfe <execution-id> --logs -o json
+
+  # Show the payload that triggered an execution (e.g. the event)
+  dtctl get wfe <execution-id> --raw-input
+  dtctl get wfe <execution-id> --raw-input -o yaml
+
   # Output as JSON
   dtctl get wfe -o json
+
+  # Reliability stats for a workflow over the last 7 days
+  dtctl get wfe --summary --workflow <workflow-id> --started-since 7d
+  dtctl get wfe --summary -w <workflow-id> --started-since 7d -o json
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		withLogs, _ := cmd.Flags().GetBool("logs")
+		if withLogs && len(args) == 0 {
+			return fmt.Errorf("--logs requires an execution ID")
+		}
+		rawInput, _ := cmd.Flags().GetBool("raw-input")
+		if rawInput && len(args) == 0 {
+			return fmt.Errorf("--raw-input requires an execution ID")
+		}
+		if rawInput && withLogs {
+			return fmt.Errorf("--raw-input cannot be combined with --logs")
+		}
+		summary, _ := cmd.Flags().GetBool("summary")
+		if summary && len(args) > 0 {
+			return fmt.Errorf("--summary cannot be used with an execution ID")
+		}
+		if summary && watchEnabled(cmd) {
+			return fmt.Errorf("--summary cannot be used with --watch")
+		}
+
 		_, c, printer, err := Setup()
 		if err != nil {
 			return err
@@ -185,16 +322,34 @@ Examples:
 
 		// Get specific execution if ID provided
 		if len(args) > 0 {
+			if rawInput {
+				return printExecutionInput(handler, args[0], printer)
+			}
 			exec, err := handler.Get(args[0])
 			if err != nil {
 				return err
 			}
-			if ap != nil {
-				ap.SetSuggestions([]string{
-					fmt.Sprintf("Run 'dtctl logs workflow-execution %s' to view execution logs", args[0]),
-				})
+			if !withLogs {
+				if ap != nil {
+					ap.SetSuggestions([]string{
+						fmt.Sprintf("Run 'dtctl logs workflow-execution %s' to view execution logs", args[0]),
+					})
+				}
+				return printer.Print(exec)
 			}
-			return printer.Print(exec)
+
+			tasks, err := handler.GetTaskLogs(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to get task logs: %w", err)
+			}
+			if !agentMode && (outputFormat == "table" || outputFormat == "wide") {
+				if err := printer.Print(exec); err != nil {
+					return err
+				}
+				printTaskLogs(os.Stdout, tasks)
+				return nil
+			}
+			return printer.Print(&executionWithLogs{Execution: exec, Tasks: tasks})
 		}
 
 		// List executions (optionally filtered)
@@ -213,17 +368,41 @@ Examples:
 			return fmt.Errorf("invalid --started-until: %w", err)
 		}
 
-		list, err := handler.List(workflow.ExecutionFilters{
+		filters := workflow.ExecutionFilters{
 			WorkflowID:   workflowFilter,
 			State:        strings.ToUpper(stateStr),
 			TriggerType:  triggerTypeCaser.String(strings.ToLower(triggerStr)),
 			StartedSince: since,
 			StartedUntil: until,
-		}, limit)
+		}
+
+		if watchEnabled(cmd) {
+			fetcher := func() (interface{}, error) {
+				list, err := handler.List(filters, limit)
+				if err != nil {
+					return nil, err
+				}
+				return list.Results, nil
+			}
+			return executeWithWatch(cmd, fetcher, printer)
+		}
+
+		if summary && !cmd.Flags().Changed("limit") {
+			limit = maxExecutionSummaryLimit
+		}
+
+		list, err := handler.List(filters, limit)
 		if err != nil {
 			return err
 		}
 
+		if summary {
+			if list.Count > len(list.Results) {
+				output.PrintWarning("summary covers the %d most recent of %d executions; narrow the window with --started-since or raise --limit", len(list.Results), list.Count)
+			}
+			return printer.Print(workflow.SummarizeExecutions(workflowFilter, list.Results))
+		}
+
 		if ap != nil {
 			ap.SetTotal(len(list.Results))
 			suggestions := []string{
@@ -259,11 +438,12 @@ Examples:
 
   # Delete without confirmation
   dtctl delete workflow "My Workflow" -y
+
+  # Delete every workflow labelled team=checkout
+  dtctl delete workflows --selector team=checkout
 `,
-	Args: cobra.ExactArgs(1),
+	Args: idOrSelectorArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		identifier := args[0]
-
 		cfg, err := LoadConfig()
 		if err != nil {
 			return err
@@ -274,6 +454,11 @@ Examples:
 			return err
 		}
 
+		if len(args) == 0 {
+			return deleteWorkflowsBySelector(cmd, cfg, c)
+		}
+		identifier := args[0]
+
 		// Resolve name to ID
 		res := resolver.NewResolver(c)
 		workflowID, err := res.ResolveID(resolver.TypeWorkflow, identifier)
@@ -302,7 +487,7 @@ Examples:
 
 		// Confirm deletion unless --force or --plain
 		if !forceDelete && !plainMode {
-			if !prompt.ConfirmDeletion("workflow", wf.Title, workflowID) {
+			if !prompt.ConfirmDeletion(promptTarget(cfg), "workflow", wf.Title, workflowID) {
 				fmt.Println("Deletion cancelled")
 				return nil
 			}
@@ -335,46 +520,121 @@ Examples:
 
 func init() {
 	addWatchFlags(getWorkflowsCmd)
+	addWatchFlags(getWorkflowExecutionsCmd)
 
 	getWorkflowExecutionsCmd.Flags().StringVarP(&workflowFilter, "workflow", "w", "", "Filter executions by workflow ID")
+	getWorkflowExecutionsCmd.Flags().Bool("logs", false, "With an execution ID, include the log and result of every task")
+	getWorkflowExecutionsCmd.Flags().Bool("raw-input", false, "With an execution ID, print the payload that triggered the execution (e.g. the event)")
 	getWorkflowExecutionsCmd.Flags().Int64("limit", 100, "Maximum number of executions to return (max 1000)")
+	getWorkflowExecutionsCmd.Flags().Bool("summary", false, "Print aggregate stats (per-state counts, success rate, p50/p95 runtime) instead of the executions")
 	getWorkflowExecutionsCmd.Flags().String("state", "", "Filter by state: RUNNING, SUCCESS, ERROR, CANCELLED, UNKNOWN")
 	getWorkflowExecutionsCmd.Flags().String("trigger", "", "Filter by trigger type: Manual, Schedule, Event, Workflow")
-	getWorkflowExecutionsCmd.Flags().String("started-since", "", "Show executions started at or after this time (YYYY-MM-DD or ISO 8601)")
-	getWorkflowExecutionsCmd.Flags().String("started-until", "", "Show executions started at or before this time (YYYY-MM-DD = end of day 23:59:59, or ISO 8601)")
+	getWorkflowExecutionsCmd.Flags().String("started-since", "", "Show executions started at or after this time (now-24h, 7d, YYYY-MM-DD, or ISO 8601)")
+	getWorkflowExecutionsCmd.Flags().String("started-until", "", "Show executions started at or before this time (now-1h, YYYY-MM-DD = end of day 23:59:59, or ISO 8601)")
 	getWorkflowsCmd.Flags().Bool("mine", false, "Show only workflows owned by current user")
+	getWorkflowsCmd.Flags().Bool("show-tasks", false, "render the task dependency graph of a single workflow as a tree")
 	getWorkflowsCmd.Flags().String("filter", "", "Search workflows by title")
 	getWorkflowsCmd.Flags().String("type", "", "Filter by workflow type: standard or simple")
 	getWorkflowsCmd.Flags().String("trigger", "", "Filter by trigger type: Manual, Schedule, Event")
 	getWorkflowsCmd.Flags().Int64("limit", 0, "Maximum number of workflows to return (0 = unlimited)")
+	getWorkflowsCmd.Flags().Bool("export-runnable", false, "With a workflow ID, print a re-appliable body without server-managed fields and with credentials replaced by template variables")
+	getWorkflowsCmd.Flags().Bool("format-cron", false, "Add SCHEDULE and NEXT RUN columns describing schedule triggers in plain English")
+	getWorkflowsCmd.Flags().Bool("unused", false, "List only workflows that are disabled or have not run within --older-than")
+	getWorkflowsCmd.Flags().String("older-than", "90d", "With --unused, how long a workflow must have gone without a run (e.g. 30d, 12w, YYYY-MM-DD)")
+	addSelectorFlag(getWorkflowsCmd)
 
 	deleteWorkflowCmd.Flags().BoolVarP(&forceDelete, "yes", "y", false, "Skip confirmation prompt")
+	addSelectorFlag(deleteWorkflowCmd)
 }
 
-// parseExecTime parses a date string as YYYY-MM-DD or ISO 8601 and returns RFC3339.
-// When endOfDay is true and input is date-only, the time is set to 23:59:59.
-func parseExecTime(s string, endOfDay bool) (string, error) {
-	if s == "" {
-		return "", nil
+// workflowLabelSource returns the description that carries a workflow's dtctl labels.
+func workflowLabelSource(wf workflow.Workflow) string {
+	return wf.Description
+}
+
+// limitWorkflows keeps at most limit workflows (0 = all).
+func limitWorkflows(wfs []workflow.Workflow, limit int64) []workflow.Workflow {
+	if limit > 0 && int64(len(wfs)) > limit {
+		return wfs[:limit]
 	}
-	// Common ISO 8601 date-time forms (with/without seconds, with/without zone).
-	for _, layout := range []string{
-		time.RFC3339,             // 2006-01-02T15:04:05Z07:00
-		"2006-01-02T15:04Z07:00", // seconds omitted, with zone
-		"2006-01-02T15:04:05",    // no zone (treated as UTC)
-		"2006-01-02T15:04",       // no seconds, no zone
-	} {
-		if t, err := time.Parse(layout, s); err == nil {
-			return t.UTC().Format(time.RFC3339), nil
+	return wfs
+}
+
+// deleteWorkflowsBySelector deletes every workflow whose labels match --selector.
+func deleteWorkflowsBySelector(cmd *cobra.Command, cfg *config.Config, c *client.Client) error {
+	selector, err := selectorString(cmd)
+	if err != nil {
+		return err
+	}
+	sel, err := labels.ParseSelector(selector)
+	if err != nil {
+		return err
+	}
+
+	handler := workflow.NewHandler(c)
+	list, err := handler.List(workflow.WorkflowFilters{}, allPagesChunkSize(), 0)
+	if err != nil {
+		return err
+	}
+
+	var objs []selectedObject
+	for _, wf := range selectByLabels(list.Results, sel, workflowLabelSource) {
+		objs = append(objs, selectedObject{ID: wf.ID, Name: wf.Title, Owner: wf.Owner})
+	}
+	return deleteSelected(cfg, c, "workflow", selector, objs, func(o selectedObject) error {
+		return handler.Delete(o.ID)
+	})
+}
+
+// printRunnableWorkflow prints the --export-runnable form of a workflow (YAML
+// unless a structured format was requested) and lists the template variables
+// that must be set when applying it.
+func printRunnableWorkflow(wf *workflow.Workflow, printer output.Printer, ap *output.AgentPrinter) error {
+	doc, vars, err := workflow.ExportRunnable(wf)
+	if err != nil {
+		return err
+	}
+
+	if len(vars) > 0 {
+		setFlags := make([]string, 0, len(vars))
+		for _, v := range vars {
+			setFlags = append(setFlags, fmt.Sprintf("--set %s=<value>", v))
+		}
+		applyHint := fmt.Sprintf("Apply with: dtctl apply -f <file> %s", strings.Join(setFlags, " "))
+		if ap != nil {
+			ap.SetSuggestions([]string{applyHint})
+		} else {
+			output.PrintHint("Credentials were replaced with template variables: %s", strings.Join(vars, ", "))
+			output.PrintHint("%s", applyHint)
 		}
 	}
-	// Fall back to date-only
-	t, err := time.Parse("2006-01-02", s)
+
+	if !agentMode && jqFilter == "" && (outputFormat == "table" || outputFormat == "wide") {
+		return output.NewPrinterWithOpts(output.PrinterOptions{Format: "yaml", Writer: os.Stdout, PlainMode: plainMode}).Print(doc)
+	}
+	return printer.Print(doc)
+}
+
+// printExecutionInput prints the trigger input of an execution. The payload is
+// free-form, so table output falls back to indented JSON.
+func printExecutionInput(handler *workflow.ExecutionHandler, id string, printer output.Printer) error {
+	input, err := handler.GetInput(id)
 	if err != nil {
-		return "", fmt.Errorf("use YYYY-MM-DD or ISO 8601 (e.g. 2006-01-02T15:04:05Z)")
+		return err
+	}
+	if input == nil {
+		output.PrintInfo("Execution %s has no trigger input", id)
+		return nil
 	}
-	if endOfDay {
-		t = t.Add(23*time.Hour + 59*time.Minute + 59*time.Second)
+	if !agentMode && jqFilter == "" && (outputFormat == "table" || outputFormat == "wide") {
+		return output.NewPrinterWithOpts(output.PrinterOptions{Format: "json", Writer: os.Stdout, PlainMode: plainMode}).Print(input)
 	}
-	return t.UTC().Format(time.RFC3339), nil
+	return printer.Print(input)
+}
+
+// parseExecTime resolves a --started-since/--started-until value (YYYY-MM-DD,
+// ISO 8601, or a relative expression such as now-7d) to RFC3339.
+// When endOfDay is true and input is date-only, the time is set to 23:59:59.
+func parseExecTime(s string, endOfDay bool) (string, error) {
+	return timeframe.RFC3339(s, endOfDay)
 }
@@ -3,6 +3,7 @@ package cmd
 import (
 	"encoding/json"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/dynatrace-oss/dtctl/cmd/testutil"
@@ -54,6 +55,108 @@ func TestGetWorkflowsCmd_ListWithFilters(t *testing.T) {
 	}
 }
 
+func TestGetWorkflowsCmd_FormatCron(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/automation/v1/workflows": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{"count": 1, "results": []any{
+				map[string]any{"id": "wf-1", "title": "Nightly", "trigger": map[string]any{
+					"schedule": map[string]any{
+						"isActive": true,
+						"trigger":  map[string]any{"type": "cron", "cron": "0 2 * * *"},
+						"timezone": "UTC",
+					},
+				}},
+			}})
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile, origOutput, origAgent, origPlain := cfgFile, outputFormat, agentMode, plainMode
+	defer func() {
+		cfgFile, outputFormat, agentMode, plainMode = origCfgFile, origOutput, origAgent, origPlain
+	}()
+
+	cfgFile = configPath
+	agentMode = false
+	plainMode = false
+	outputFormat = "table"
+
+	testutil.ResetCommandFlags(getWorkflowsCmd)
+	_ = getWorkflowsCmd.Flags().Set("format-cron", "true")
+
+	out := captureStdout(t, func() {
+		if err := getWorkflowsCmd.RunE(getWorkflowsCmd, nil); err != nil {
+			t.Fatalf("RunE() error = %v", err)
+		}
+	})
+	for _, want := range []string{"SCHEDULE", "NEXT RUN", "Every day at 02:00 UTC"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestGetWorkflowsCmd_Unused(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/automation/v1/workflows": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{"count": 2, "results": []any{
+				map[string]any{"id": "wf-busy", "title": "Busy", "isDeployed": true},
+				map[string]any{"id": "wf-dead", "title": "Dead", "isDeployed": true},
+			}})
+		},
+		"/platform/automation/v1/executions": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Query().Get("workflow") == "wf-busy" {
+				json.NewEncoder(w).Encode(map[string]any{"count": 1, "results": []any{map[string]any{"id": "e1"}}})
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]any{"count": 0, "results": []any{}})
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile, origOutput, origAgent := cfgFile, outputFormat, agentMode
+	defer func() {
+		testutil.ResetCommandFlags(getWorkflowsCmd)
+		cfgFile, outputFormat, agentMode = origCfgFile, origOutput, origAgent
+	}()
+	cfgFile = configPath
+	agentMode = false
+	outputFormat = "table"
+
+	testutil.ResetCommandFlags(getWorkflowsCmd)
+	_ = getWorkflowsCmd.Flags().Set("unused", "true")
+	_ = getWorkflowsCmd.Flags().Set("older-than", "30d")
+	out := captureStdout(t, func() {
+		if err := getWorkflowsCmd.RunE(getWorkflowsCmd, nil); err != nil {
+			t.Fatalf("RunE() error = %v", err)
+		}
+	})
+	if !strings.Contains(out, "wf-dead") || !strings.Contains(out, "no runs since") || strings.Contains(out, "wf-busy") {
+		t.Errorf("unexpected --unused output:\n%s", out)
+	}
+
+	testutil.ResetCommandFlags(getWorkflowsCmd)
+	_ = getWorkflowsCmd.Flags().Set("older-than", "30d")
+	if err := getWorkflowsCmd.RunE(getWorkflowsCmd, nil); err == nil || !strings.Contains(err.Error(), "--older-than requires --unused") {
+		t.Errorf("error = %v, want --older-than requires --unused", err)
+	}
+
+	testutil.ResetCommandFlags(getWorkflowsCmd)
+	_ = getWorkflowsCmd.Flags().Set("unused", "true")
+	if err := getWorkflowsCmd.RunE(getWorkflowsCmd, []string{"wf-dead"}); err == nil || !strings.Contains(err.Error(), "cannot be combined with a workflow ID") {
+		t.Errorf("error = %v, want rejection of a workflow ID", err)
+	}
+}
+
 func TestGetWorkflowsCmd_InvalidChunkSize(t *testing.T) {
 	origCfgFile := cfgFile
 	origChunk := chunkSize
@@ -118,6 +221,57 @@ func TestGetWorkflowsCmd_HasMoreWithLimit(t *testing.T) {
 	}
 }
 
+func TestGetWorkflowsCmd_ManagedFields(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/automation/v1/workflows": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{"count": 1, "results": []any{
+				map[string]any{"id": "wf-1", "title": "Deploy", "owner": "u1", "ownerType": "USER", "actor": "u2"},
+			}})
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile, origOutput, origShow, origStrip := cfgFile, outputFormat, showManagedFields, stripManagedFields
+	defer func() {
+		cfgFile, outputFormat, showManagedFields, stripManagedFields = origCfgFile, origOutput, origShow, origStrip
+	}()
+	cfgFile = configPath
+
+	for _, show := range []bool{false, true} {
+		outputFormat = "yaml"
+		showManagedFields = show
+		if err := rootCmd.PersistentPreRunE(getWorkflowsCmd, nil); err != nil {
+			t.Fatalf("PersistentPreRunE() error = %v", err)
+		}
+		testutil.ResetCommandFlags(getWorkflowsCmd)
+
+		var runErr error
+		got := captureStdout(t, func() { runErr = getWorkflowsCmd.RunE(getWorkflowsCmd, nil) })
+		if runErr != nil {
+			t.Fatalf("RunE() error = %v", runErr)
+		}
+		if !strings.Contains(got, "actor: u2") {
+			t.Errorf("show=%v: output = %q, want actor kept", show, got)
+		}
+		if hasOwner := strings.Contains(got, "owner: u1"); hasOwner != show {
+			t.Errorf("show=%v: output = %q, owner present = %v", show, got, hasOwner)
+		}
+	}
+
+	// Other verbs print the full object.
+	showManagedFields = false
+	if err := rootCmd.PersistentPreRunE(describeCmd, nil); err != nil {
+		t.Fatalf("PersistentPreRunE() error = %v", err)
+	}
+	if stripManagedFields {
+		t.Error("stripManagedFields = true for describe, want only get to strip")
+	}
+}
+
 func TestGetWorkflowExecutionsCmd_ListWithFilters(t *testing.T) {
 	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
 		"/platform/automation/v1/executions": func(w http.ResponseWriter, r *http.Request) {
@@ -254,6 +408,64 @@ func TestGetWorkflowExecutionsCmd_HasMore(t *testing.T) {
 	}
 }
 
+func TestGetWorkflowExecutionsCmd_Summary(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/automation/v1/executions": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("limit") != "1000" {
+				t.Errorf("expected limit=1000, got %q", r.URL.Query().Get("limit"))
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{"count": 3, "results": []any{
+				map[string]any{"id": "exec-1", "workflow": "wf-abc", "state": "SUCCESS", "startedAt": "2026-06-01T10:00:00Z", "runtime": 5},
+				map[string]any{"id": "exec-2", "workflow": "wf-abc", "state": "SUCCESS", "startedAt": "2026-06-02T10:00:00Z", "runtime": 7},
+				map[string]any{"id": "exec-3", "workflow": "wf-abc", "state": "ERROR", "startedAt": "2026-06-03T10:00:00Z", "runtime": 30},
+			}})
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile := cfgFile
+	origOutput := outputFormat
+	origFilter := workflowFilter
+	defer func() {
+		cfgFile = origCfgFile
+		outputFormat = origOutput
+		workflowFilter = origFilter
+	}()
+
+	cfgFile = configPath
+
+	testutil.ResetCommandFlags(getWorkflowExecutionsCmd)
+	outputFormat = "json"
+	_ = getWorkflowExecutionsCmd.Flags().Set("workflow", "wf-abc")
+	_ = getWorkflowExecutionsCmd.Flags().Set("summary", "true")
+
+	var runErr error
+	out := captureStdout(t, func() { runErr = getWorkflowExecutionsCmd.RunE(getWorkflowExecutionsCmd, nil) })
+	if runErr != nil {
+		t.Fatalf("RunE() error = %v", runErr)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("invalid JSON output %q: %v", out, err)
+	}
+	if got["total"] != float64(3) || got["success"] != float64(2) || got["error"] != float64(1) {
+		t.Errorf("summary counts = %v", got)
+	}
+	if got["successRate"] != 66.7 || got["p50Runtime"] != float64(7) || got["p95Runtime"] != float64(30) {
+		t.Errorf("summary stats = %v", got)
+	}
+
+	_ = getWorkflowExecutionsCmd.Flags().Set("summary", "true")
+	if err := getWorkflowExecutionsCmd.RunE(getWorkflowExecutionsCmd, []string{"exec-1"}); err == nil {
+		t.Error("expected error for --summary with an execution ID")
+	}
+}
+
 func TestParseExecTime(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -307,3 +519,83 @@ func TestValidateWorkflowChunkSize(t *testing.T) {
 		})
 	}
 }
+
+func TestGetWorkflow_ShowTasks(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/automation/v1/workflows/wf-1": func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"id":"wf-1","title":"Deploy","tasks":{
+				"build":{"action":"dynatrace.automations:run-javascript"},
+				"deploy":{"action":"dynatrace.automations:http-function","predecessors":["build"],"conditions":{"states":{"build":"OK"}}}}}`))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile := cfgFile
+	defer func() { cfgFile = origCfgFile }()
+	cfgFile = configPath
+
+	testutil.ResetCommandFlags(getWorkflowsCmd)
+	_ = getWorkflowsCmd.Flags().Set("show-tasks", "true")
+
+	out := captureStdout(t, func() {
+		if err := getWorkflowsCmd.RunE(getWorkflowsCmd, []string{"wf-1"}); err != nil {
+			t.Fatalf("RunE() error = %v", err)
+		}
+	})
+	want := "build [dynatrace.automations:run-javascript]\n└── deploy [dynatrace.automations:http-function] (after build: OK)\n"
+	if out != want {
+		t.Errorf("output =\n%s\nwant:\n%s", out, want)
+	}
+
+	if err := getWorkflowsCmd.RunE(getWorkflowsCmd, nil); err == nil {
+		t.Error("expected error for --show-tasks without an ID")
+	}
+}
+
+func TestGetWorkflow_ExportRunnable(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/automation/v1/workflows/wf-1": func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"id":"wf-1","title":"Notify","owner":"user-1","ownerType":"USER","tasks":{
+				"notify":{"action":"dynatrace.slack:slack-send-message","input":{"connection":"vu9U3hXa3q0AAAABAB","channel":"#alerts"}}}}`))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile := cfgFile
+	origOutput := outputFormat
+	defer func() {
+		cfgFile = origCfgFile
+		outputFormat = origOutput
+	}()
+	cfgFile = configPath
+
+	testutil.ResetCommandFlags(getWorkflowsCmd)
+	outputFormat = "table"
+	_ = getWorkflowsCmd.Flags().Set("export-runnable", "true")
+
+	out := captureStdout(t, func() {
+		if err := getWorkflowsCmd.RunE(getWorkflowsCmd, []string{"wf-1"}); err != nil {
+			t.Fatalf("RunE() error = %v", err)
+		}
+	})
+	for _, want := range []string{"title: Notify", "connection: '{{ .notify_connection }}'", "channel: '#alerts'"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	for _, notWant := range []string{"wf-1", "user-1", "ownerType", "vu9U3hXa3q0AAAABAB"} {
+		if strings.Contains(out, notWant) {
+			t.Errorf("output unexpectedly contains %q:\n%s", notWant, out)
+		}
+	}
+
+	if err := getWorkflowsCmd.RunE(getWorkflowsCmd, nil); err == nil {
+		t.Error("expected error for --export-runnable without an ID")
+	}
+}
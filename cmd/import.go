@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dynatrace-oss/dtctl/pkg/exec"
+	"github.com/dynatrace-oss/dtctl/pkg/grafana"
+	"github.com/dynatrace-oss/dtctl/pkg/output"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/document"
+	"github.com/dynatrace-oss/dtctl/pkg/safety"
+	"github.com/dynatrace-oss/dtctl/pkg/util/format"
+)
+
+// importCmd represents the import command
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import resources exported from other tools",
+	Long: `Convert a resource exported from another tool and create it on the
+Dynatrace platform.
+
+Supported resources:
+  grafana-dashboard (grafana)`,
+	Example: `  # Import a dashboard exported from Grafana
+  dtctl import grafana-dashboard -f grafana.json`,
+	RunE: requireSubcommand,
+}
+
+// importGrafanaDashboardCmd converts a Grafana dashboard export and creates it
+var importGrafanaDashboardCmd = &cobra.Command{
+	Use:     "grafana-dashboard -f <file>",
+	Aliases: []string{"grafana"},
+	Short:   "Create a dashboard from a Grafana dashboard export",
+	Long: `Convert a Grafana dashboard JSON export into a Dynatrace dashboard and create it.
+
+The conversion is best effort. Panels keep their grid position and title:
+  - text panels become markdown tiles
+  - time series and graph panels become line chart tiles
+  - table panels become table tiles
+  - rows become markdown headings
+  - every other panel becomes a markdown placeholder quoting its original
+    definition
+
+Grafana queries cannot be translated to DQL, so chart and table tiles carry the
+original query as DQL comments for you to rewrite; queries of the Dynatrace
+data source are kept as they are. Dashboard variables are not converted. A
+warning is printed for everything that needs manual follow-up.
+
+Both the dashboard JSON model ("Export > Export as JSON") and the HTTP API
+response wrapping it in {"dashboard": ...} are accepted.`,
+	Example: `  # Import a Grafana dashboard
+  dtctl import grafana-dashboard -f grafana.json
+
+  # Import under a different name
+  dtctl import grafana-dashboard -f grafana.json --name "Checkout (migrated)"
+
+  # Review the converted dashboard first, then create it
+  dtctl import grafana-dashboard -f grafana.json --dry-run > dashboard.yaml
+  dtctl create dashboard -f dashboard.yaml`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		file, _ := cmd.Flags().GetString("file")
+		if file == "" {
+			return fmt.Errorf("--file is required")
+		}
+
+		content, err := exec.ReadFileOrStdin(file)
+		if err != nil {
+			return err
+		}
+		jsonData, err := format.ValidateAndConvert([]byte(content))
+		if err != nil {
+			return fmt.Errorf("invalid file format: %w", err)
+		}
+
+		dashboard, err := grafana.ConvertDashboard(jsonData)
+		if err != nil {
+			return err
+		}
+		if name, _ := cmd.Flags().GetString("name"); name != "" {
+			dashboard.Name = name
+		}
+
+		for _, w := range dashboard.Warnings {
+			output.PrintWarning("%s", w)
+		}
+
+		if dryRun {
+			return printConvertedDashboard(dashboard)
+		}
+
+		contentData, err := json.Marshal(dashboard.Content)
+		if err != nil {
+			return fmt.Errorf("failed to encode dashboard: %w", err)
+		}
+
+		_, c, err := SetupWithSafety(safety.OperationCreate)
+		if err != nil {
+			return err
+		}
+
+		handler := document.NewHandler(c)
+		result, err := handler.Create(document.CreateRequest{
+			Name:        dashboard.Name,
+			Type:        "dashboard",
+			Description: dashboard.Description,
+			Content:     contentData,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create dashboard: %w", err)
+		}
+
+		if printQuietID(cmd, result.ID) {
+			return nil
+		}
+
+		url := documentURL(c.BaseURL(), "dashboard", result.ID)
+		if handled, err := printCreateResult(CreateResult{ResourceType: "dashboard", ID: result.ID, Name: dashboard.Name, URL: url}); handled {
+			return err
+		}
+
+		output.PrintSuccess("Dashboard imported from Grafana")
+		output.PrintInfo("  Name:  %s", dashboard.Name)
+		output.PrintInfo("  ID:    %s", result.ID)
+		output.PrintInfo("  Tiles: %d (%d placeholder(s))", dashboard.Tiles, dashboard.Placeholders)
+		if url != "" {
+			output.PrintInfo("  URL:   %s", url)
+		}
+		return nil
+	},
+}
+
+// printConvertedDashboard writes a converted dashboard to stdout in the form
+// 'dtctl create dashboard -f' reads, as JSON with -o json and YAML otherwise.
+func printConvertedDashboard(dashboard *grafana.Dashboard) error {
+	doc := map[string]interface{}{
+		"type":    "dashboard",
+		"name":    dashboard.Name,
+		"content": dashboard.Content,
+	}
+	if dashboard.Description != "" {
+		doc["description"] = dashboard.Description
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("failed to encode dashboard: %w", err)
+	}
+
+	var result []byte
+	if outputFormat == "json" {
+		result, err = format.PrettyJSON(data)
+		result = append(result, '\n')
+	} else {
+		result, err = format.JSONToYAML(data)
+	}
+	if err != nil {
+		return err
+	}
+	output.PrintInfo("Dry run: would create dashboard %q with %d tile(s) (%d placeholder(s))", dashboard.Name, dashboard.Tiles, dashboard.Placeholders)
+	_, err = os.Stdout.Write(result)
+	return err
+}
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+	importCmd.AddCommand(importGrafanaDashboardCmd)
+
+	importGrafanaDashboardCmd.Flags().StringP("file", "f", "", "Grafana dashboard JSON export (use - for stdin)")
+	importGrafanaDashboardCmd.Flags().String("name", "", "name for the dashboard (default: the Grafana title)")
+	addQuietFlag(importGrafanaDashboardCmd)
+}
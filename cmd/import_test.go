@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+)
+
+const grafanaExport = `{"title": "Checkout", "panels": [
+  {"type": "text", "title": "About", "gridPos": {"x": 0, "y": 0, "w": 24, "h": 3}, "options": {"content": "Owned by checkout"}},
+  {"type": "stat", "title": "Uptime", "gridPos": {"x": 0, "y": 3, "w": 6, "h": 4}}
+]}`
+
+func TestImportGrafanaDashboard(t *testing.T) {
+	var sentName string
+	var sentContent map[string]interface{}
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/document/v1/documents": func(w http.ResponseWriter, r *http.Request) {
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Errorf("ParseMultipartForm() error = %v", err)
+			}
+			sentName = r.FormValue("name")
+			if f, _, err := r.FormFile("content"); err == nil {
+				data, _ := io.ReadAll(f)
+				_ = json.Unmarshal(data, &sentContent)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"dash-1","name":"Checkout","type":"dashboard","version":1}`))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	file := filepath.Join(t.TempDir(), "grafana.json")
+	if err := os.WriteFile(file, []byte(grafanaExport), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	origCfgFile := cfgFile
+	defer func() {
+		testutil.ResetCommandFlags(importGrafanaDashboardCmd)
+		cfgFile = origCfgFile
+	}()
+	testutil.ResetCommandFlags(importGrafanaDashboardCmd)
+	cfgFile = configPath
+	_ = importGrafanaDashboardCmd.Flags().Set("file", file)
+	_ = importGrafanaDashboardCmd.Flags().Set("quiet", "true")
+
+	var runErr error
+	got := captureStdout(t, func() { runErr = importGrafanaDashboardCmd.RunE(importGrafanaDashboardCmd, nil) })
+	if runErr != nil {
+		t.Fatalf("RunE() error = %v", runErr)
+	}
+	if got != "dash-1\n" {
+		t.Errorf("stdout = %q, want the new ID", got)
+	}
+	if sentName != "Checkout" {
+		t.Errorf("name = %q, want the Grafana title", sentName)
+	}
+	tiles, _ := sentContent["tiles"].(map[string]interface{})
+	if len(tiles) != 2 {
+		t.Fatalf("tiles = %v, want 2", sentContent["tiles"])
+	}
+	if tile := tiles["1"].(map[string]interface{}); !strings.Contains(tile["content"].(string), "`stat` panel could not be converted") {
+		t.Errorf("placeholder tile = %v", tile)
+	}
+}
+
+func TestImportGrafanaDashboard_DryRun(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "grafana.json")
+	if err := os.WriteFile(file, []byte(grafanaExport), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	origDryRun := dryRun
+	defer func() {
+		testutil.ResetCommandFlags(importGrafanaDashboardCmd)
+		dryRun = origDryRun
+	}()
+	testutil.ResetCommandFlags(importGrafanaDashboardCmd)
+	dryRun = true
+	_ = importGrafanaDashboardCmd.Flags().Set("file", file)
+	_ = importGrafanaDashboardCmd.Flags().Set("name", "Checkout (migrated)")
+
+	var runErr error
+	got := captureStdout(t, func() { runErr = importGrafanaDashboardCmd.RunE(importGrafanaDashboardCmd, nil) })
+	if runErr != nil {
+		t.Fatalf("RunE() error = %v", runErr)
+	}
+	for _, want := range []string{"name: Checkout (migrated)", "type: dashboard", "Owned by checkout", "version: 15"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("stdout = %q, want %q", got, want)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dynatrace-oss/dtctl/pkg/util/format"
+)
+
+// addInputFormatFlag registers --input-format on commands that read a
+// resource definition from a file.
+func addInputFormatFlag(cmd *cobra.Command) {
+	cmd.Flags().String("input-format", "", "parse the file as json or yaml instead of detecting the format from its content")
+}
+
+// inputFormatFromFlags returns the format given with --input-format, or ""
+// when the format should be detected from the content.
+func inputFormatFromFlags(cmd *cobra.Command) (format.Format, error) {
+	name, _ := cmd.Flags().GetString("input-format")
+	if name == "" {
+		return "", nil
+	}
+	f, err := format.ParseFormat(name)
+	if err != nil {
+		return "", fmt.Errorf("invalid --input-format: %w", err)
+	}
+	return f, nil
+}
+
+// convertInputFile converts a resource definition read from a file to JSON,
+// parsing it as the --input-format format when one is given.
+func convertInputFile(cmd *cobra.Command, data []byte) ([]byte, error) {
+	f, err := inputFormatFromFlags(cmd)
+	if err != nil {
+		return nil, err
+	}
+	jsonData, err := format.ConvertAs(data, f)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file format: %w", err)
+	}
+	return jsonData, nil
+}
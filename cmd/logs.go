@@ -2,21 +2,28 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/dynatrace-oss/dtctl/pkg/output"
 	"github.com/dynatrace-oss/dtctl/pkg/resources/workflow"
+	"github.com/dynatrace-oss/dtctl/pkg/util/timeframe"
 )
 
 var taskName string
 var followLogs bool
 var allTaskLogs bool
 var tasksOnlyLogs bool
+var logsSince string
+var logsUntil string
+var logsResultFallback bool
 
 // logsCmd represents the logs command
 var logsCmd = &cobra.Command{
@@ -48,9 +55,19 @@ Examples:
   dtctl logs wfe <execution-id> --task <task-name>
   dtctl logs wfe <execution-id> -t <task-name>
 
+  # Show the task's return value when it wrote no logs (e.g. JavaScript tasks)
+  dtctl logs wfe <execution-id> --task <task-name> --result-fallback
+
   # Follow logs in real-time (stream until execution completes)
   dtctl logs wfe <execution-id> --follow
   dtctl logs wfe <execution-id> -f
+
+  # Only lines from the last 10 minutes, or within a window
+  dtctl logs wfe <execution-id> --since 10m
+  dtctl logs wfe <execution-id> --since 2026-03-01T10:00:00Z --until 2026-03-01T10:30:00Z
+
+Lines are filtered by their leading timestamp; lines without one (continuations
+of multi-line messages) follow the line before them.
 `,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -63,6 +80,17 @@ Examples:
 		if taskName != "" && (allTaskLogs || tasksOnlyLogs) {
 			return fmt.Errorf("cannot use --task with --all or --tasks flags")
 		}
+		if logsResultFallback && taskName == "" {
+			return fmt.Errorf("--result-fallback requires --task")
+		}
+		if logsResultFallback && followLogs {
+			return fmt.Errorf("cannot use --result-fallback with --follow")
+		}
+
+		since, until, err := parseLogsWindow(logsSince, logsUntil, time.Now())
+		if err != nil {
+			return err
+		}
 
 		_, c, err := SetupClient()
 		if err != nil {
@@ -72,7 +100,7 @@ Examples:
 		handler := workflow.NewExecutionHandler(c)
 
 		if followLogs {
-			return followExecutionLogs(handler, executionID, taskName, allTaskLogs, tasksOnlyLogs)
+			return followExecutionLogs(handler, executionID, taskName, allTaskLogs, tasksOnlyLogs, since, until)
 		}
 
 		var logs string
@@ -103,6 +131,10 @@ Examples:
 			}
 		}
 
+		logs = workflow.FilterLogLines(logs, since, until)
+		if logs == "" && logsResultFallback {
+			return printTaskResultFallback(handler, executionID, taskName)
+		}
 		if logs == "" {
 			fmt.Println("No logs available.")
 			return nil
@@ -113,8 +145,30 @@ Examples:
 	},
 }
 
-// followExecutionLogs streams logs in real-time until the execution completes
-func followExecutionLogs(handler *workflow.ExecutionHandler, executionID, task string, allLogs, tasksOnly bool) error {
+// printTaskResultFallback prints the return value of a task that wrote no logs,
+// so --result-fallback shows whatever output the task produced.
+func printTaskResultFallback(handler *workflow.ExecutionHandler, executionID, task string) error {
+	result, err := handler.GetTaskResult(executionID, task)
+	if err != nil {
+		return fmt.Errorf("no logs available; failed to get task result: %w", err)
+	}
+	if result == nil {
+		fmt.Println("No logs or result available.")
+		return nil
+	}
+
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode task result: %w", err)
+	}
+	output.PrintInfo("Task %q wrote no logs; showing its result instead", task)
+	fmt.Println(string(data))
+	return nil
+}
+
+// followExecutionLogs streams logs in real-time until the execution completes.
+// A non-zero since or until filters the streamed lines by timestamp.
+func followExecutionLogs(handler *workflow.ExecutionHandler, executionID, task string, allLogs, tasksOnly bool, since, until time.Time) error {
 	// Set up signal handling for graceful shutdown
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -129,6 +183,18 @@ func followExecutionLogs(handler *workflow.ExecutionHandler, executionID, task s
 	var lastLogLen int
 	pollInterval := 2 * time.Second
 
+	// While filtering, only complete lines are considered so a partially
+	// written line cannot be dropped before its timestamp is readable.
+	filter := func(logs string, final bool) string {
+		if since.IsZero() && until.IsZero() {
+			return logs
+		}
+		if !final {
+			logs = logs[:strings.LastIndex(logs, "\n")+1]
+		}
+		return workflow.FilterLogLines(logs, since, until)
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -155,6 +221,7 @@ func followExecutionLogs(handler *workflow.ExecutionHandler, executionID, task s
 		if err != nil {
 			return err
 		}
+		logs = filter(logs, false)
 
 		// Print only new content
 		if len(logs) > lastLogLen {
@@ -181,6 +248,7 @@ func followExecutionLogs(handler *workflow.ExecutionHandler, executionID, task s
 			default:
 				logs, _ = handler.GetExecutionLog(executionID)
 			}
+			logs = filter(logs, true)
 			if len(logs) > lastLogLen {
 				fmt.Print(logs[lastLogLen:])
 			}
@@ -193,6 +261,28 @@ func followExecutionLogs(handler *workflow.ExecutionHandler, executionID, task s
 	}
 }
 
+// parseLogsWindow resolves --since and --until. Unset flags yield zero times,
+// which leave that side of the window open; in particular --until does not
+// default to now, so --follow keeps printing new lines.
+func parseLogsWindow(sinceExpr, untilExpr string, now time.Time) (time.Time, time.Time, error) {
+	var since, until time.Time
+	var err error
+	if sinceExpr != "" {
+		if since, err = timeframe.Parse(sinceExpr, now); err != nil {
+			return since, until, fmt.Errorf("invalid --since: %w", err)
+		}
+	}
+	if untilExpr != "" {
+		if until, err = timeframe.Until(untilExpr, now); err != nil {
+			return since, until, fmt.Errorf("invalid --until: %w", err)
+		}
+	}
+	if !since.IsZero() && !until.IsZero() && since.After(until) {
+		return since, until, fmt.Errorf("--since %s is after --until %s", since.Format(time.RFC3339), until.Format(time.RFC3339))
+	}
+	return since, until, nil
+}
+
 // isTerminalState checks if the execution state is terminal
 func isTerminalState(state string) bool {
 	switch state {
@@ -210,4 +300,7 @@ func init() {
 	logsWorkflowExecutionCmd.Flags().BoolVarP(&followLogs, "follow", "f", false, "Follow logs in real-time until execution completes")
 	logsWorkflowExecutionCmd.Flags().BoolVarP(&allTaskLogs, "all", "a", false, "Get all logs (workflow execution log + all task logs)")
 	logsWorkflowExecutionCmd.Flags().BoolVar(&tasksOnlyLogs, "tasks", false, "Get task logs only (all tasks with headers)")
+	logsWorkflowExecutionCmd.Flags().StringVar(&logsSince, "since", "", "Only show lines at or after this time (e.g. 10m, now-1h, 2026-03-01T10:00:00Z)")
+	logsWorkflowExecutionCmd.Flags().StringVar(&logsUntil, "until", "", "Only show lines at or before this time")
+	logsWorkflowExecutionCmd.Flags().BoolVar(&logsResultFallback, "result-fallback", false, "With --task, print the task result when the task wrote no logs")
 }
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+)
+
+func TestParseLogsWindow(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	since, until, err := parseLogsWindow("", "", now)
+	if err != nil || !since.IsZero() || !until.IsZero() {
+		t.Errorf("empty flags = %v, %v, %v; want open window", since, until, err)
+	}
+
+	since, until, err = parseLogsWindow("10m", "", now)
+	if err != nil || !since.Equal(now.Add(-10*time.Minute)) || !until.IsZero() {
+		t.Errorf("--since 10m = %v, %v, %v; want until left open for --follow", since, until, err)
+	}
+
+	if _, _, err := parseLogsWindow("now-1h", "now-2h", now); err == nil || !strings.Contains(err.Error(), "after --until") {
+		t.Errorf("expected inverted window error, got %v", err)
+	}
+	if _, _, err := parseLogsWindow("yesterday-ish", "", now); err == nil || !strings.Contains(err.Error(), "invalid --since") {
+		t.Errorf("expected invalid --since error, got %v", err)
+	}
+}
+
+func TestLogsWfeCmd_ResultFallback(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/automation/v1/executions/exec-1/tasks/run_js/log": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`""`))
+		},
+		"/platform/automation/v1/executions/exec-1/tasks/run_js/result": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"count":3}`))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile, origTask, origFallback := cfgFile, taskName, logsResultFallback
+	defer func() {
+		testutil.ResetCommandFlags(logsWorkflowExecutionCmd)
+		cfgFile, taskName, logsResultFallback = origCfgFile, origTask, origFallback
+	}()
+	testutil.ResetCommandFlags(logsWorkflowExecutionCmd)
+	cfgFile = configPath
+	taskName = "run_js"
+
+	out := captureStdout(t, func() {
+		if err := logsWorkflowExecutionCmd.RunE(logsWorkflowExecutionCmd, []string{"exec-1"}); err != nil {
+			t.Fatalf("RunE() error = %v", err)
+		}
+	})
+	if !strings.Contains(out, "No logs available.") || ms.RequestCount != 1 {
+		t.Errorf("without --result-fallback: output %q, %d requests; want plain no-logs message", out, ms.RequestCount)
+	}
+
+	logsResultFallback = true
+	out = captureStdout(t, func() {
+		if err := logsWorkflowExecutionCmd.RunE(logsWorkflowExecutionCmd, []string{"exec-1"}); err != nil {
+			t.Fatalf("RunE() error = %v", err)
+		}
+	})
+	if !strings.Contains(out, `"count": 3`) {
+		t.Errorf("expected task result in output, got %q", out)
+	}
+}
+
+func TestLogsWfeCmd_ResultFallbackRequiresTask(t *testing.T) {
+	origTask, origFallback := taskName, logsResultFallback
+	defer func() { taskName, logsResultFallback = origTask, origFallback }()
+	taskName, logsResultFallback = "", true
+
+	err := logsWorkflowExecutionCmd.RunE(logsWorkflowExecutionCmd, []string{"exec-1"})
+	if err == nil || !strings.Contains(err.Error(), "requires --task") {
+		t.Errorf("expected --task requirement error, got %v", err)
+	}
+}
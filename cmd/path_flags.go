@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/dynatrace-oss/dtctl/pkg/util/pathutil"
+)
+
+// pathFlags names the flags whose values are local file or directory paths.
+// Their values get ~ and $VAR expansion before the command runs, so quoted
+// or "--file=~/wf.yaml" style arguments behave as they would in the shell.
+var pathFlags = map[string]bool{
+	"config":             true,
+	"file":               true,
+	"from-file":          true,
+	"data-file":          true,
+	"segments-file":      true,
+	"selector-from-file": true,
+	"spill-to":           true,
+}
+
+// expandPathFlags expands the values of the path flags set on cmd.
+func expandPathFlags(cmd *cobra.Command) error {
+	var firstErr error
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		if firstErr != nil || !pathFlags[f.Name] {
+			return
+		}
+		if err := expandFlagValue(f); err != nil {
+			firstErr = fmt.Errorf("invalid --%s: %w", f.Name, err)
+		}
+	})
+	return firstErr
+}
+
+func expandFlagValue(f *pflag.Flag) error {
+	if sv, ok := f.Value.(pflag.SliceValue); ok {
+		paths := sv.GetSlice()
+		for i, p := range paths {
+			expanded, err := pathutil.Expand(p)
+			if err != nil {
+				return err
+			}
+			paths[i] = expanded
+		}
+		return sv.Replace(paths)
+	}
+	if f.Value.Type() != "string" {
+		return nil
+	}
+	expanded, err := pathutil.Expand(f.Value.String())
+	if err != nil {
+		return err
+	}
+	if expanded == f.Value.String() {
+		return nil
+	}
+	return f.Value.Set(expanded)
+}
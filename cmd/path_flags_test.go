@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestExpandPathFlags(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	t.Setenv("DTCTL_TEST_DIR", "/srv/configs")
+
+	var file, fromFile, selectorFile, name string
+	var files []string
+	cmd := &cobra.Command{Use: "test"}
+	cmd.Flags().StringVarP(&file, "file", "f", "", "")
+	cmd.Flags().StringVar(&fromFile, "from-file", "", "")
+	cmd.Flags().StringSliceVar(&files, "segments-file", nil, "")
+	cmd.Flags().StringVar(&selectorFile, "selector-from-file", "", "")
+	cmd.Flags().StringVar(&name, "name", "", "")
+
+	if err := cmd.ParseFlags([]string{
+		"--file=~/wf.yaml",
+		"--from-file", "~/q.dql",
+		"--segments-file", "$DTCTL_TEST_DIR/a.yaml,~/b.yaml",
+		"--selector-from-file=$DTCTL_TEST_DIR/selector.txt",
+		"--name", "~not-a-path",
+	}); err != nil {
+		t.Fatalf("ParseFlags() error = %v", err)
+	}
+	if err := expandPathFlags(cmd); err != nil {
+		t.Fatalf("expandPathFlags() error = %v", err)
+	}
+
+	if want := filepath.Join(home, "wf.yaml"); file != want {
+		t.Errorf("--file = %q, want %q", file, want)
+	}
+	if want := filepath.Join(home, "q.dql"); fromFile != want {
+		t.Errorf("--from-file = %q, want %q", fromFile, want)
+	}
+	if len(files) != 2 || files[0] != "/srv/configs/a.yaml" || files[1] != filepath.Join(home, "b.yaml") {
+		t.Errorf("--segments-file = %q", files)
+	}
+	if selectorFile != "/srv/configs/selector.txt" {
+		t.Errorf("--selector-from-file = %q", selectorFile)
+	}
+	if name != "~not-a-path" {
+		t.Errorf("--name = %q, non-path flags must be left alone", name)
+	}
+}
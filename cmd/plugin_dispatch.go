@@ -114,12 +114,12 @@ func builtinCommandNames() map[string]bool {
 
 // credentialEnvVars are the environment variables dtctl documents as token
 // carriers (docs/site/_docs/ai-agent-mode.md, `dtctl config init`'s
-// ${DT_API_TOKEN} scaffold). They are stripped from a plugin's environment:
+// ${DT_API_TOKEN} scaffold, the config-less DT_TOKEN fallback). They are stripped from a plugin's environment:
 // plugins resolve credentials themselves through the sdk (the same config
 // file and keyring service), never through inherited env. Tokens placed in
 // user-chosen variables referenced via ${...} config expansion cannot be
 // recognized and are inherited like the rest of the environment.
-var credentialEnvVars = []string{"DTCTL_TOKEN", "DT_API_TOKEN"}
+var credentialEnvVars = []string{"DTCTL_TOKEN", "DT_TOKEN", "DT_API_TOKEN"}
 
 // pluginEnv builds the environment for a plugin exec from dtctl's own leading
 // flags: the inherited environment minus credentialEnvVars, plus the
@@ -155,13 +155,16 @@ func pluginAgentMode(leading []string) bool {
 		(aidetect.Detect().Detected && !hasRawFlag(leading, "--no-agent"))
 }
 
-// effectiveConfigPath resolves the config file path in effect for the plugin
-// contract: the explicit --config value, else a discovered local .dtctl.yaml,
-// else the default global path.
+// effectiveConfigPath resolves the config file path in effect: the explicit
+// --config value, else DTCTL_CONFIG, else a discovered local .dtctl.yaml, else
+// the default global path. It mirrors the precedence of config.Load.
 func effectiveConfigPath(explicit string) string {
 	if explicit != "" {
 		return explicit
 	}
+	if envPath := os.Getenv(config.EnvConfig); envPath != "" {
+		return envPath
+	}
 	if local := config.FindLocalConfig(); local != "" {
 		return local
 	}
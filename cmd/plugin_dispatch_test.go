@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"testing"
 
@@ -157,6 +158,22 @@ func TestPluginEnv_IgnoresPluginOwnFlags(t *testing.T) {
 	}
 }
 
+// An inherited DTCTL_CONFIG is the config in effect and must be passed on
+// unchanged, not replaced by a discovered local or the global config path.
+func TestPluginEnv_KeepsEnvConfig(t *testing.T) {
+	t.Setenv("DTCTL_CONFIG", "/tmp/ci/dtctl.yaml")
+
+	env := pluginEnv([]string{"--plain"})
+	if !slices.Contains(env, "DTCTL_CONFIG=/tmp/ci/dtctl.yaml") {
+		t.Errorf("DTCTL_CONFIG not passed through: %v", env)
+	}
+
+	env = pluginEnv([]string{"--config", "/tmp/other.yaml"})
+	if !slices.Contains(env, "DTCTL_CONFIG=/tmp/other.yaml") {
+		t.Errorf("--config must override DTCTL_CONFIG: %v", env)
+	}
+}
+
 func TestPluginEnv_AgentImpliesPlain(t *testing.T) {
 	env := pluginEnv([]string{"-A", "myplug"})
 	joined := strings.Join(env, "\n")
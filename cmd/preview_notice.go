@@ -13,6 +13,11 @@ var previewNoticeShown = map[string]bool{}
 
 func attachPreviewNotice(cmd *cobra.Command, area string) {
 	prev := cmd.PersistentPreRunE
+	if prev == nil {
+		// Cobra runs only the nearest PersistentPreRunE; keep the root's
+		// flag handling for commands below this one.
+		prev = rootCmd.PersistentPreRunE
+	}
 	cmd.PersistentPreRunE = func(c *cobra.Command, args []string) error {
 		printPreviewNotice(area)
 		if prev != nil {
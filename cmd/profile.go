@@ -158,15 +158,7 @@ func extractContextOverride(args []string) string {
 // for the full command tree, and a non-nil error only when a referenced profile
 // name does not exist.
 func resolveActiveProfile(args []string) (*config.Profile, error) {
-	var (
-		cfg *config.Config
-		err error
-	)
-	if cfgPath := extractFlagValue(args, "config"); cfgPath != "" {
-		cfg, err = config.LoadFrom(cfgPath)
-	} else {
-		cfg, err = config.Load()
-	}
+	cfg, err := loadConfigForArgs(args)
 	if err != nil {
 		// No usable config → full command tree. The real command will surface
 		// any config error later with proper context.
@@ -18,6 +18,7 @@ import (
 	"github.com/dynatrace-oss/dtctl/pkg/output"
 	"github.com/dynatrace-oss/dtctl/pkg/resources/resolver"
 	"github.com/dynatrace-oss/dtctl/pkg/util/template"
+	"github.com/dynatrace-oss/dtctl/pkg/util/timeframe"
 )
 
 // isTerminal checks if the given file is a terminal
@@ -34,11 +35,6 @@ const (
 	colorCyan   = "\033[36m"
 )
 
-// isStderrTerminal checks if stderr is a terminal (for color output)
-func isStderrTerminal() bool {
-	return term.IsTerminal(int(os.Stderr.Fd()))
-}
-
 // formatRequiresIncludeTypes reports whether the output format needs DQL column
 // type metadata to render correctly, so the query layer can request it even when
 // the user did not pass --include-types. Parquet derives its columnar schema from
@@ -49,7 +45,7 @@ func formatRequiresIncludeTypes(format string) bool {
 
 func isSupportedQueryOutputFormat(format string) bool {
 	switch strings.ToLower(strings.TrimSpace(format)) {
-	case "", "table", "wide", "json", "yaml", "yml", "csv", "jsonl", "parquet", "toon", "chart", "sparkline", "spark", "barchart", "bar", "braille", "br":
+	case "", "table", "wide", "json", "yaml", "yml", "csv", "markdown", "md", "jsonl", "parquet", "toon", "chart", "sparkline", "spark", "barchart", "bar", "braille", "br":
 		return true
 	default:
 		return false
@@ -97,6 +93,9 @@ Examples:
   dtctl query "fetch logs" -o json
   dtctl query "fetch logs" -o csv
 
+  # Output as a GitHub-flavored Markdown table (for tickets and PRs)
+  dtctl query "fetch logs | limit 10" -o markdown --columns timestamp,loglevel,content
+
   # Output as JSON Lines (one JSON object per line) or Parquet for large exports
   dtctl query "fetch logs" -o jsonl
   dtctl query "fetch logs" --max-result-records 100000 -o parquet > logs.parquet
@@ -151,18 +150,34 @@ Examples:
 
   # Apply segments with variables from a YAML file
   dtctl query "fetch logs | limit 10" --segments-file segments.yaml
+
+  # Show the canonical form and any errors without running the query
+  dtctl query -f query.dql --explain
+
+  # Fetch the results of the last query after the client timed out
+  dtctl query --resume
+  dtctl query --request-token <token>
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		// The global --limit caps list pagination; DQL results are limited in
+		// the query itself, so reject it rather than silently ignore it.
+		if cmd.Flags().Changed("limit") {
+			return fmt.Errorf("--limit does not apply to queries — DQL limits rows inside the query text: append `| limit N`")
+		}
 		if !isSupportedQueryOutputFormat(outputFormat) {
 			return fmt.Errorf("unsupported output format %q for query", outputFormat)
 		}
+		resume, requestToken, err := queryResumeFlags(cmd, args)
+		if err != nil {
+			return err
+		}
 
 		cfg, c, err := SetupClient()
 		if err != nil {
 			return err
 		}
 
-		executor := NewDQLExecutorFromConfig(cfg, c)
+		executor := NewDQLExecutorFromConfig(cfg, c).WithLastQueryFile(lastQueryFile())
 
 		// Set up signal handling so a running Grail query is cancelled on Ctrl+C / SIGTERM.
 		ctx, cancel := context.WithCancel(context.Background())
@@ -201,20 +216,12 @@ Examples:
 
 		var query string
 
-		if queryFile != "" {
-			// Read query from file (use "-" for stdin)
-			if queryFile == "-" {
-				content, err := io.ReadAll(os.Stdin)
-				if err != nil {
-					return fmt.Errorf("failed to read query from stdin: %w", err)
-				}
-				query = string(content)
-			} else {
-				content, err := os.ReadFile(queryFile)
-				if err != nil {
-					return fmt.Errorf("failed to read query file: %w", err)
-				}
-				query = string(content)
+		if resume {
+			// The recorded query is polled by its request token; nothing to read.
+		} else if queryFile != "" {
+			query, err = readQueryFile(queryFile)
+			if err != nil {
+				return err
 			}
 		} else if len(args) > 0 {
 			// Use inline query
@@ -245,6 +252,12 @@ Examples:
 			query = rendered
 		}
 
+		if explain, _ := cmd.Flags().GetBool("explain"); explain {
+			locale, _ := cmd.Flags().GetString("locale")
+			timezone, _ := cmd.Flags().GetString("timezone")
+			return explainQuery(executor, query, exec.DQLVerifyOptions{Locale: locale, Timezone: timezone}, outputFormat)
+		}
+
 		// Get visualization options
 		live, _ := cmd.Flags().GetBool("live")
 		interval, _ := cmd.Flags().GetDuration("interval")
@@ -281,6 +294,9 @@ Examples:
 		// Get timeframe options
 		defaultTimeframeStart, _ := cmd.Flags().GetString("default-timeframe-start")
 		defaultTimeframeEnd, _ := cmd.Flags().GetString("default-timeframe-end")
+		if defaultTimeframeStart, defaultTimeframeEnd, err = resolveDefaultTimeframe(defaultTimeframeStart, defaultTimeframeEnd); err != nil {
+			return err
+		}
 
 		// Get localization options
 		locale, _ := cmd.Flags().GetString("locale")
@@ -394,6 +410,8 @@ Examples:
 		opts := exec.DQLExecuteOptions{
 			OutputFormat:                 outputFormat,
 			JQFilter:                     jqFilter,
+			NoHeaders:                    noHeaders,
+			Columns:                      columns,
 			AgentMode:                    agentMode,
 			Decode:                       decodeMode,
 			Width:                        width,
@@ -425,6 +443,10 @@ Examples:
 			ShowProgress: !noProgress,
 		}
 
+		if resume {
+			return executor.ResumeWithContext(ctx, requestToken, opts)
+		}
+
 		// Handle live mode
 		if live {
 			// Warn about flags that are not meaningfully applicable in live mode
@@ -463,6 +485,8 @@ Examples:
 				Height:     height,
 				Fullscreen: fullscreen,
 				AgentMode:  agentMode,
+				NoHeaders:  noHeaders,
+				Columns:    columns,
 			}
 
 			printer := output.NewPrinterWithOpts(printerOpts)
@@ -506,6 +530,22 @@ Examples:
 // maxSegmentsPerQuery is the maximum number of filter segments allowed per query (Dynatrace limit).
 const maxSegmentsPerQuery = 10
 
+// readQueryFile reads a DQL query from a file, or from stdin when path is "-".
+func readQueryFile(path string) (string, error) {
+	if path == "-" {
+		content, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("failed to read query from stdin: %w", err)
+		}
+		return string(content), nil
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read query file: %w", err)
+	}
+	return string(content), nil
+}
+
 // parseSegmentFlags parses --segment flag values into FilterSegmentRef entries.
 // Each value can be a plain segment ID/name, or include inline variable bindings
 // using URL-query-style syntax: "SEGMENT?var=val&var2=val1,val2"
@@ -750,6 +790,8 @@ func init() {
 	queryCmd.Flags().StringP("file", "f", "", "read query from file")
 	queryCmd.Flags().StringArray("set", []string{}, "set template variable (key=value)")
 	queryCmd.Flags().String("dql", "", "DQL text (alias for the positional argument)")
+	queryCmd.Flags().Bool("explain", false, "verify the query and print its canonical form and any errors or warnings without executing it")
+	addQueryResumeFlags(queryCmd)
 
 	// Live mode flags
 	queryCmd.Flags().Bool("live", false, "enable live mode with periodic updates")
@@ -776,8 +818,8 @@ func init() {
 	queryCmd.Flags().Bool("typed", false, "cast scalar columns (long, double, duration, boolean) to native JSON/YAML types instead of the API's string encoding; opt-in, implies --include-types")
 
 	// Timeframe flags
-	queryCmd.Flags().String("default-timeframe-start", "", "query timeframe start timestamp (ISO-8601/RFC3339, e.g., '2022-04-20T12:10:04.123Z')")
-	queryCmd.Flags().String("default-timeframe-end", "", "query timeframe end timestamp (ISO-8601/RFC3339, e.g., '2022-04-20T13:10:04.123Z')")
+	queryCmd.Flags().String("default-timeframe-start", "", "query timeframe start: ISO-8601/RFC3339 timestamp (e.g., '2022-04-20T12:10:04.123Z') or relative (e.g., 'now-2h', '7d')")
+	queryCmd.Flags().String("default-timeframe-end", "", "query timeframe end: ISO-8601/RFC3339 timestamp (e.g., '2022-04-20T13:10:04.123Z') or relative (e.g., 'now-1h')")
 
 	// Localization flags
 	queryCmd.Flags().String("locale", "", "query locale (e.g., 'en_US', 'de_DE')")
@@ -890,3 +932,22 @@ func metadataFieldCompletion(_ *cobra.Command, _ []string, toComplete string) ([
 
 	return suggestions, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
 }
+
+// resolveDefaultTimeframe converts --default-timeframe-start/-end values to
+// the RFC3339 timestamps the query API expects. DQL expressions such as
+// now()-6h@h are passed through for the server to evaluate; shorthand forms
+// (now-6h, 24h, YYYY-MM-DD) are resolved locally.
+func resolveDefaultTimeframe(start, end string) (string, string, error) {
+	var err error
+	if !strings.HasPrefix(start, "now()") {
+		if start, err = timeframe.RFC3339(start, false); err != nil {
+			return "", "", fmt.Errorf("invalid --default-timeframe-start: %w", err)
+		}
+	}
+	if !strings.HasPrefix(end, "now()") {
+		if end, err = timeframe.RFC3339(end, true); err != nil {
+			return "", "", fmt.Errorf("invalid --default-timeframe-end: %w", err)
+		}
+	}
+	return start, end, nil
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dynatrace-oss/dtctl/pkg/config"
+)
+
+// lastQueryFile is where the query commands record the last asynchronous
+// query so it can be resumed.
+func lastQueryFile() string {
+	return filepath.Join(config.CacheDir(), "last-query.json")
+}
+
+// addQueryResumeFlags registers --resume and --request-token.
+func addQueryResumeFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("resume", false, "fetch the results of the last query by its saved request token instead of running a new query")
+	cmd.Flags().String("request-token", "", "fetch the results of the query with this request token (implies --resume)")
+}
+
+// queryResumeFlags reads --resume and --request-token and rejects
+// combinations that would start a new query.
+func queryResumeFlags(cmd *cobra.Command, args []string) (bool, string, error) {
+	resume, _ := cmd.Flags().GetBool("resume")
+	requestToken, _ := cmd.Flags().GetString("request-token")
+	if requestToken != "" {
+		resume = true
+	}
+	if !resume {
+		return false, "", nil
+	}
+	if len(args) > 0 {
+		return false, "", fmt.Errorf("--resume does not take a query; it polls the recorded one")
+	}
+	for _, name := range []string{"file", "from-file", "param", "dql", "explain", "live"} {
+		if f := cmd.Flags().Lookup(name); f != nil && f.Changed {
+			return false, "", fmt.Errorf("--resume cannot be combined with --%s", name)
+		}
+	}
+	return true, requestToken, nil
+}
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/adrg/xdg"
+	"github.com/spf13/cobra"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+)
+
+func TestQueryResumeFlags(t *testing.T) {
+	newCmd := func() *cobra.Command {
+		cmd := &cobra.Command{Use: "query"}
+		cmd.Flags().StringP("file", "f", "", "")
+		cmd.Flags().Bool("live", false, "")
+		addQueryResumeFlags(cmd)
+		return cmd
+	}
+
+	tests := []struct {
+		name      string
+		flags     map[string]string
+		args      []string
+		wantOn    bool
+		wantToken string
+		wantErr   string
+	}{
+		{name: "off by default"},
+		{name: "resume", flags: map[string]string{"resume": "true"}, wantOn: true},
+		{name: "token implies resume", flags: map[string]string{"request-token": "tok-1"}, wantOn: true, wantToken: "tok-1"},
+		{name: "query argument", flags: map[string]string{"resume": "true"}, args: []string{"fetch logs"}, wantErr: "does not take a query"},
+		{name: "with file", flags: map[string]string{"resume": "true", "file": "q.dql"}, wantErr: "--file"},
+		{name: "with live", flags: map[string]string{"request-token": "tok-1", "live": "true"}, wantErr: "--live"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newCmd()
+			for k, v := range tt.flags {
+				_ = cmd.Flags().Set(k, v)
+			}
+			on, token, err := queryResumeFlags(cmd, tt.args)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if on != tt.wantOn || token != tt.wantToken {
+				t.Errorf("got (%v, %q), want (%v, %q)", on, token, tt.wantOn, tt.wantToken)
+			}
+		})
+	}
+}
+
+func TestQuery_ResumeByRequestToken(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	xdg.Reload()
+	defer xdg.Reload()
+
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/storage/query/v1/query:execute": func(w http.ResponseWriter, r *http.Request) {
+			t.Error("query must not be re-executed with --request-token")
+		},
+		"/platform/storage/query/v1/query:poll": func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("request-token"); got != "tok-42" {
+				t.Errorf("request-token = %q, want tok-42", got)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"state":"SUCCEEDED","result":{"records":[{"status":"resumed"}]}}`))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile, origOutput := cfgFile, outputFormat
+	defer func() {
+		testutil.ResetCommandFlags(queryCmd)
+		cfgFile, outputFormat = origCfgFile, origOutput
+	}()
+	testutil.ResetCommandFlags(queryCmd)
+	cfgFile = configPath
+	outputFormat = "json"
+	_ = queryCmd.Flags().Set("request-token", "tok-42")
+
+	out := captureStdout(t, func() {
+		if err := queryCmd.RunE(queryCmd, nil); err != nil {
+			t.Fatalf("RunE() error = %v", err)
+		}
+	})
+	if !strings.Contains(out, "resumed") {
+		t.Errorf("expected resumed records, got:\n%s", out)
+	}
+}
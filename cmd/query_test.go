@@ -659,3 +659,25 @@ func TestApplySegmentVars(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveDefaultTimeframe(t *testing.T) {
+	start, end, err := resolveDefaultTimeframe("2026-01-02T17:00:00+02:00", "2026-01-02")
+	if err != nil {
+		t.Fatalf("resolveDefaultTimeframe() error = %v", err)
+	}
+	if start != "2026-01-02T15:00:00Z" || end != "2026-01-02T23:59:59Z" {
+		t.Errorf("resolveDefaultTimeframe() = %q, %q", start, end)
+	}
+
+	start, end, err = resolveDefaultTimeframe("now()-6h@h", "")
+	if err != nil {
+		t.Fatalf("resolveDefaultTimeframe() error = %v", err)
+	}
+	if start != "now()-6h@h" || end != "" {
+		t.Errorf("resolveDefaultTimeframe() = %q, %q; want DQL passthrough and empty end", start, end)
+	}
+
+	if _, _, err := resolveDefaultTimeframe("last tuesday", ""); err == nil {
+		t.Error("expected error for invalid start")
+	}
+}
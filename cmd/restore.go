@@ -84,7 +84,7 @@ Examples:
 		// Confirm restore unless --force or --plain
 		if !forceDelete && !plainMode {
 			confirmMsg := fmt.Sprintf("Restore workflow %q to version %d?", wf.Title, version)
-			if !prompt.Confirm(confirmMsg) {
+			if !prompt.Confirm(promptTarget(cfg), confirmMsg) {
 				fmt.Println("Restore cancelled")
 				return nil
 			}
@@ -165,7 +165,7 @@ Examples:
 		// Confirm restore unless --force or --plain
 		if !forceDelete && !plainMode {
 			confirmMsg := fmt.Sprintf("Restore dashboard %q from snapshot %d?", metadata.Name, version)
-			if !prompt.Confirm(confirmMsg) {
+			if !prompt.Confirm(promptTarget(cfg), confirmMsg) {
 				fmt.Println("Restore cancelled")
 				return nil
 			}
@@ -246,7 +246,7 @@ Examples:
 		// Confirm restore unless --force or --plain
 		if !forceDelete && !plainMode {
 			confirmMsg := fmt.Sprintf("Restore notebook %q from snapshot %d?", metadata.Name, version)
-			if !prompt.Confirm(confirmMsg) {
+			if !prompt.Confirm(promptTarget(cfg), confirmMsg) {
 				fmt.Println("Restore cancelled")
 				return nil
 			}
@@ -329,7 +329,7 @@ Examples:
 		// Confirm restore unless --force or --plain
 		if !forceDelete && !plainMode {
 			confirmMsg := fmt.Sprintf("Restore document %q (%s) from snapshot %d?", metadata.Name, metadata.Type, version)
-			if !prompt.Confirm(confirmMsg) {
+			if !prompt.Confirm(promptTarget(cfg), confirmMsg) {
 				fmt.Println("Restore cancelled")
 				return nil
 			}
@@ -370,7 +370,7 @@ Examples:
 `,
 	Args: cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		_, c, err := SetupWithSafety(safety.OperationUpdate)
+		cfg, c, err := SetupWithSafety(safety.OperationUpdate)
 		if err != nil {
 			return err
 		}
@@ -399,7 +399,7 @@ Examples:
 			// Confirm restore unless --force or --plain or restoring multiple
 			if !forceRestore && !plainMode && len(args) == 1 {
 				confirmMsg := fmt.Sprintf("Restore %s %q from trash?", doc.Type, doc.Name)
-				if !prompt.Confirm(confirmMsg) {
+				if !prompt.Confirm(promptTarget(cfg), confirmMsg) {
 					fmt.Println("Restore cancelled")
 					continue
 				}
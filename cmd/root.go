@@ -25,6 +25,7 @@ import (
 	"github.com/dynatrace-oss/dtctl/pkg/exec"
 	"github.com/dynatrace-oss/dtctl/pkg/inspect"
 	"github.com/dynatrace-oss/dtctl/pkg/output"
+	"github.com/dynatrace-oss/dtctl/pkg/prompt"
 	"github.com/dynatrace-oss/dtctl/pkg/safety"
 	"github.com/dynatrace-oss/dtctl/pkg/suggest"
 	"github.com/dynatrace-oss/dtctl/pkg/tracing"
@@ -42,9 +43,28 @@ var (
 	debugMode    bool // --debug flag (alias for -vv)
 	dryRun       bool
 	plainMode    bool
+	forceColor   bool     // --force-color flag: color even when output is not a TTY
+	noHeaders    bool     // --no-headers flag: omit the header row in table/wide/csv output
+	columns      []string // --columns flag: columns to print in markdown output
+	labelColumns []string // --label-columns flag: dtctl label keys shown as table columns
+	countOnly    bool     // --count flag: print only the number of listed items
+	toonWidth    int      // --toon-width flag: truncate TOON string values longer than this
 	chunkSize    int64
-	agentMode    bool // --agent/-A flag: wrap output in machine-readable envelope
-	noAgent      bool // --no-agent flag: opt out of auto-detected agent mode
+	pageSize     int64 // --page-size flag: per-request page size (0 = use --chunk-size)
+	allPages     bool  // --all-pages flag: follow next-page keys even with --chunk-size 0
+	listLimit    int64
+	noPaginate   bool
+	cacheTTL     time.Duration
+	rateLimit    float64 // --rate-limit flag: max requests per second across all clients (0 = off)
+	noKeyring    bool    // --no-keyring flag: keep tokens in the config file
+	keyringMode  string  // --keyring-backend flag: auto, os, or file
+	agentMode    bool    // --agent/-A flag: wrap output in machine-readable envelope
+	noAgent      bool    // --no-agent flag: opt out of auto-detected agent mode
+
+	// keyringOverride is the token storage mode selected by --no-keyring or
+	// --keyring-backend ("" = use preferences.keyring-backend); see
+	// applyKeyringMode.
+	keyringOverride config.KeyringMode
 
 	// tracingRootCtx holds the context carrying the root OTel span for this
 	// invocation. Set by execute() and read by NewClientFromConfig to inject
@@ -65,6 +85,11 @@ var rootCmd = &cobra.Command{
 	SilenceErrors: true,
 	SilenceUsage:  true,
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := expandPathFlags(cmd); err != nil {
+			return err
+		}
+		recordSafetyOverrideYes(cmd)
+		stripManagedFields = isGetCommand(cmd) && !showManagedFields
 		return validateGlobalFlags()
 	},
 	Long: `dtctl is a kubectl-inspired CLI tool for managing Dynatrace platform resources.
@@ -75,11 +100,45 @@ SLOs, queries, and other Dynatrace platform capabilities.`,
 
 // validateGlobalFlags enforces cross-command constraints for root persistent flags.
 func validateGlobalFlags() error {
-	if jqFilter == "" {
-		return nil
+	if err := validateSafetyLevelOverride(); err != nil {
+		return err
+	}
+	if err := validatePaginationFlags(); err != nil {
+		return err
+	}
+	if rateLimit < 0 {
+		return fmt.Errorf("--rate-limit must not be negative, got %g", rateLimit)
+	}
+	if err := client.SetRateLimit(rateLimit); err != nil {
+		return err
+	}
+	if err := applyKeyringFlags(); err != nil {
+		return err
+	}
+	if countOnly && jqFilter != "" {
+		return fmt.Errorf("--count cannot be combined with --jq")
+	}
+	if output.IsTemplateOutputFormat(outputFormat) {
+		if jqFilter != "" {
+			return fmt.Errorf("--jq cannot be combined with -o go-template or go-template-file")
+		}
+		// Surface a missing file or template syntax error before any API call.
+		if _, err := output.ParseTemplateFormat(outputFormat); err != nil {
+			return err
+		}
+	}
+	if jqFilter != "" {
+		outputFormat = output.NormalizeJQOutputFormat(outputFormat)
 	}
 
-	outputFormat = output.NormalizeJQOutputFormat(outputFormat)
+	// json/yaml consumers get warnings as NDJSON objects on stderr instead of
+	// prose, so "succeeded with warnings" is machine-detectable.
+	switch outputFormat {
+	case "json", "yaml", "yml":
+		output.SetStructuredWarnings(true)
+	default:
+		output.SetStructuredWarnings(false)
+	}
 	return nil
 }
 
@@ -104,7 +163,7 @@ func execute() int {
 	// not the pre-expansion alias. Load config quietly; if it fails, skip alias
 	// resolution (the real command will produce the proper error later).
 	spanArgs := os.Args[1:]
-	if cfg, err := config.Load(); err == nil {
+	if cfg, err := loadConfigForArgs(os.Args[1:]); err == nil {
 		// Security: warn when an auto-discovered local .dtctl.yaml carries
 		// code-execution keys (aliases / apply hooks) that are ignored. This
 		// makes adoption of an untrusted per-project config visible instead of
@@ -134,6 +193,18 @@ func execute() int {
 			rootCmd.SetArgs(expanded)
 			spanArgs = expanded
 		}
+
+		// Saved views ('get --view <name>') expand after aliases so an alias
+		// may itself use a view.
+		viewArgs, err := resolveView(spanArgs, cfg)
+		if err != nil {
+			output.PrintHumanError("%s", err)
+			return 1
+		}
+		if viewArgs != nil {
+			rootCmd.SetArgs(viewArgs)
+			spanArgs = viewArgs
+		}
 	}
 	// --- End alias resolution ---
 
@@ -173,7 +244,7 @@ func execute() int {
 		fmt.Fprintf(os.Stderr, "dtctl: tracing: %v (check OTEL_EXPORTER_OTLP_ENDPOINT or unset it to disable export)\n", tracingErr)
 	}
 
-	if err := rootCmd.Execute(); err != nil {
+	if executedCmd, err := rootCmd.ExecuteC(); err != nil {
 		// silentExitError carries an exit code only (e.g. --check-scopes already
 		// printed its verdict); set the status and return without re-printing.
 		var silent *silentExitError
@@ -204,6 +275,9 @@ func execute() int {
 			err = enhanceFlagError(rootCmd, err)
 		}
 
+		// Explain 403s with the scopes and safety level the command needs
+		err = wrapForbiddenError(executedCmd, err)
+
 		// Check for URL-related hints (e.g., wrong domain like live.dynatrace.com)
 		urlHints := getURLHintsForError(err)
 
@@ -213,6 +287,10 @@ func execute() int {
 		allHints := make([]string, 0, len(urlHints)+len(authHints))
 		allHints = append(allHints, urlHints...)
 		allHints = append(allHints, authHints...)
+		var scopeErr *InsufficientScopeError
+		if errors.As(err, &scopeErr) && !agentMode && !plainMode {
+			allHints = append(allHints, scopeErr.Suggestions()...)
+		}
 
 		// Record the error on the root span so it appears in traces.
 		rootSpan.SetStatus(codes.Error, err.Error())
@@ -472,7 +550,7 @@ func dqlErrorAdvice(e *sdkquery.QueryError) []string {
 		s = append(s, `toRelationships/fromRelationships are classic Environment-API fields, not DQL — topology hops use smartscapeEdges: dtctl query 'smartscapeEdges "runs_on" | filter in(source_id, {toSmartscapeId("SERVICE-…")}) | fields target_id'`)
 	}
 	if e.ErrorType == "INVALID_TIMEFRAME" {
-		s = append(s, "timeframe values accept ISO-8601 timestamps or now()-relative expressions — parentheses required: now()-6h, not now-6h — e.g. from:now()-6h, to:now() or --default-timeframe-start 'now()-6h'")
+		s = append(s, "timeframe values accept ISO-8601 timestamps or now()-relative expressions — parentheses required: now()-6h, not now-6h — e.g. from:now()-6h, to:now() or --default-timeframe-start 'now()-6h' (the flag also accepts now-6h and 6h)")
 	}
 	return s
 }
@@ -480,6 +558,18 @@ func dqlErrorAdvice(e *sdkquery.QueryError) []string {
 // errorToDetail converts any error into a structured ErrorDetail for agent/plain mode output.
 // It uses errors.As to extract rich context from typed errors when available.
 func errorToDetail(err error) *output.ErrorDetail {
+	// InsufficientScopeError — a 403 annotated with the command's required
+	// scopes; checked first because it wraps the API/diagnostic error.
+	var insufficientErr *InsufficientScopeError
+	if errors.As(err, &insufficientErr) {
+		detail := errorToDetail(insufficientErr.Err)
+		detail.Code = "insufficient_scope"
+		detail.StatusCode = 403
+		detail.RequiredScopes = insufficientErr.Required
+		detail.Suggestions = append(detail.Suggestions, insufficientErr.Suggestions()...)
+		return detail
+	}
+
 	// diagnostic.Error — wraps API errors with operation context and suggestions
 	var diagErr *diagnostic.Error
 	if errors.As(err, &diagErr) {
@@ -732,6 +822,11 @@ func exitCodeForError(err error) int {
 		return client.ExitPermissionError
 	}
 
+	var insufficientErr *InsufficientScopeError
+	if errors.As(err, &insufficientErr) {
+		return client.ExitPermissionError
+	}
+
 	var diagErr *diagnostic.Error
 	if errors.As(err, &diagErr) {
 		return diagErr.ExitCode()
@@ -801,9 +896,75 @@ func GetPlainMode() bool {
 	return plainMode
 }
 
-// GetChunkSize returns the current chunk size setting for pagination
+// defaultChunkSize is the --chunk-size default, used as the page size when
+// none is configured.
+const defaultChunkSize = 500
+
+// validatePaginationFlags rejects contradictory or out-of-range pagination
+// flags.
+func validatePaginationFlags() error {
+	if pageSize < 0 {
+		return fmt.Errorf("--page-size must be 0 or positive (got %d)", pageSize)
+	}
+	if allPages && noPaginate {
+		return fmt.Errorf("--all-pages and --no-paginate are mutually exclusive")
+	}
+	return nil
+}
+
+// GetPageOptions resolves the pagination flags for list calls. --page-size
+// (falling back to --chunk-size) sets the per-request size, --no-paginate
+// fetches only the first page, --all-pages follows every next-page key, and
+// --limit caps the total. For compatibility, --chunk-size 0 without
+// --page-size or --all-pages still means "first page only".
+func GetPageOptions() httpclient.PageOptions {
+	opts := httpclient.PageOptions{PageSize: pageSize, Limit: listLimit}
+	if opts.PageSize == 0 {
+		opts.PageSize = chunkSize
+	}
+	legacySinglePage := chunkSize == 0 && pageSize == 0 && !allPages
+	opts.FirstPageOnly = noPaginate || legacySinglePage
+	return opts
+}
+
+// allPageOptions returns page options that follow every page at the chosen
+// page size, ignoring --limit, --no-paginate and a legacy --chunk-size 0, for
+// callers that act on the complete list rather than display it.
+func allPageOptions() httpclient.PageOptions {
+	opts := httpclient.PageOptions{PageSize: pageSize}
+	if opts.PageSize == 0 {
+		opts.PageSize = chunkSize
+	}
+	return opts
+}
+
+// allPagesChunkSize is allPageOptions for list calls that still take a single
+// chunk size, where 0 would mean the first page only.
+func allPagesChunkSize() int64 {
+	if size := allPageOptions().PageSize; size > 0 {
+		return size
+	}
+	return defaultChunkSize
+}
+
+// GetChunkSize returns the current chunk size setting for pagination, for
+// list calls that still take a single chunk size (0 = first page only).
+// --no-paginate forces single-page mode (0).
 func GetChunkSize() int64 {
-	return chunkSize
+	opts := GetPageOptions()
+	if opts.FirstPageOnly {
+		return 0
+	}
+	if opts.PageSize == 0 {
+		return defaultChunkSize
+	}
+	return opts.PageSize
+}
+
+// GetLimit returns the --limit cap on the total number of listed items
+// (0 = unlimited).
+func GetLimit() int64 {
+	return listLimit
 }
 
 // Setup creates a Config, Client, and Printer for read-only commands.
@@ -858,41 +1019,65 @@ func SetupWithSafety(op safety.Operation) (*config.Config, *client.Client, error
 	return cfg, c, nil
 }
 
-// NewSafetyChecker creates a new safety checker for the current context
+// NewSafetyChecker creates a new safety checker for the current context,
+// honoring a one-off --safety-level override.
 func NewSafetyChecker(cfg *config.Config) (*safety.Checker, error) {
 	ctx, err := cfg.CurrentContextObj()
 	if err != nil {
 		return nil, err
 	}
 
-	return safety.NewChecker(cfg.CurrentContext, ctx), nil
+	level, err := resolveSafetyLevelOverride(cfg.CurrentContext, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return safety.NewCheckerWithLevel(cfg.CurrentContext, level), nil
 }
 
 // NewPrinter creates a new printer respecting agent and plain mode settings
 func NewPrinter() output.Printer {
-	if agentMode {
-		ctx := &output.ResponseContext{}
-		ap := output.NewAgentPrinter(os.Stdout, ctx)
-		ap.SetJQFilter(jqFilter)
-		// If the user explicitly requested an output format via -o,
-		// use that format for the result field inside the agent envelope
-		// (e.g. -o toon for token-efficient encoding).
-		outputFlag := rootCmd.PersistentFlags().Lookup("output")
-		if outputFlag != nil && outputFlag.Changed {
-			ap.SetResultFormat(outputFormat)
+	if countOnly {
+		var next output.Printer
+		if agentMode {
+			next = newAgentPrinter()
 		}
-		return ap
+		return output.NewCountPrinter(os.Stdout, next)
+	}
+	if agentMode {
+		return newAgentPrinter()
 	}
 
 	return output.NewPrinterWithOpts(output.PrinterOptions{
-		Format:    outputFormat,
-		Writer:    os.Stdout,
-		PlainMode: plainMode,
-		JQFilter:  jqFilter,
-		AgentMode: agentMode,
+		Format:       outputFormat,
+		Writer:       os.Stdout,
+		PlainMode:    plainMode,
+		JQFilter:     jqFilter,
+		AgentMode:    agentMode,
+		NoHeaders:    noHeaders,
+		Columns:      columns,
+		ToonWidth:    toonWidth,
+		LabelColumns: labelColumns,
+
+		StripManagedFields: stripManagedFields,
 	})
 }
 
+// newAgentPrinter creates the agent-mode envelope printer.
+func newAgentPrinter() *output.AgentPrinter {
+	ctx := &output.ResponseContext{}
+	ap := output.NewAgentPrinter(os.Stdout, ctx)
+	ap.SetJQFilter(jqFilter)
+	// If the user explicitly requested an output format via -o,
+	// use that format for the result field inside the agent envelope
+	// (e.g. -o toon for token-efficient encoding).
+	outputFlag := rootCmd.PersistentFlags().Lookup("output")
+	if outputFlag != nil && outputFlag.Changed {
+		ap.SetResultFormat(outputFormat)
+	}
+	ap.SetToonWidth(toonWidth)
+	return ap
+}
+
 // enrichAgent configures agent-mode metadata on the printer if agent mode is active.
 // It is a no-op when the printer is not an AgentPrinter. Returns the AgentPrinter
 // for further customization (or nil if not in agent mode).
@@ -916,7 +1101,25 @@ func GetAgentMode() bool {
 // config file. Both overrides are session-local — the config file is never
 // written, so a scripted `DTCTL_CONTEXT=x dtctl ...` cannot repoint other
 // processes on the machine.
+//
+// Without an explicit context or config file (--config, DTCTL_CONFIG, or a
+// local .dtctl.yaml), DT_ENVIRONMENT_URL plus DT_TOKEN or DT_API_TOKEN yield
+// an in-memory "env" context that takes precedence over the global config.
+// Overall: --context > local .dtctl.yaml > env vars > global config.
 func LoadConfig() (*config.Config, error) {
+	if useEnvConfig() {
+		if envCfg, ok := config.LoadFromEnv(); ok {
+			return envCfg, nil
+		}
+	}
+	return loadFileConfig()
+}
+
+// loadFileConfig is LoadConfig without the environment-variable fallback.
+// Config-management commands (config, ctx, auth login/logout/refresh) use it
+// so they always show and modify the config file, never the in-memory "env"
+// context.
+func loadFileConfig() (*config.Config, error) {
 	var cfg *config.Config
 	var err error
 
@@ -939,9 +1142,105 @@ func LoadConfig() (*config.Config, error) {
 		cfg.CurrentContext = override
 	}
 
+	applyKeyringMode(cfg)
+	applyContextPreferences(cfg)
 	return cfg, nil
 }
 
+// applyContextPreferences applies the active context's output format as the
+// default for -o (the flag and DTCTL_OUTPUT still win).
+func applyContextPreferences(cfg *config.Config) {
+	ctx, err := cfg.CurrentContextObj()
+	if err != nil {
+		return
+	}
+
+	if agentMode || os.Getenv("DTCTL_OUTPUT") != "" {
+		return
+	}
+	if f := rootCmd.PersistentFlags().Lookup("output"); f == nil || f.Changed || outputFormat != f.DefValue {
+		return
+	}
+	if ctx.Output != "" {
+		outputFormat = ctx.Output
+	}
+}
+
+// promptTarget describes the active context of cfg for confirmation prompts:
+// the environment, safety level (including a --safety-level override) and the
+// context's prompt/color hints. It is empty when there is no active context.
+func promptTarget(cfg *config.Config) prompt.Target {
+	ctx, err := cfg.CurrentContextObj()
+	if err != nil {
+		return prompt.Target{}
+	}
+	return contextPromptTarget(cfg.CurrentContext, ctx)
+}
+
+// contextPromptTarget describes the named context for confirmation prompts.
+func contextPromptTarget(name string, ctx *config.Context) prompt.Target {
+	return prompt.Target{
+		Context:     name,
+		Environment: ctx.Environment,
+		SafetyLevel: string(effectiveSafetyLevel(ctx)),
+		Label:       ctx.Prompt,
+		Color:       ctx.Color,
+	}
+}
+
+// applyKeyringFlags validates --no-keyring and --keyring-backend and records
+// the token storage mode they select in keyringOverride ("" when neither
+// flag is given).
+func applyKeyringFlags() error {
+	keyringOverride = ""
+	mode, err := config.ParseKeyringMode(keyringMode)
+	if err != nil {
+		return fmt.Errorf("invalid --keyring-backend: %w", err)
+	}
+	if noKeyring {
+		if keyringMode != "" && mode != config.KeyringModeFile {
+			return fmt.Errorf("--no-keyring cannot be combined with --keyring-backend %s", mode)
+		}
+		mode = config.KeyringModeFile
+	}
+	if noKeyring || keyringMode != "" {
+		keyringOverride = mode
+	}
+	return nil
+}
+
+// applyKeyringMode sets the token storage mode of a loaded config: the
+// --no-keyring/--keyring-backend override when given, otherwise the config's
+// own preferences.keyring-backend, which is warned about when invalid.
+func applyKeyringMode(cfg *config.Config) {
+	if keyringOverride != "" {
+		cfg.SetKeyringMode(keyringOverride)
+		return
+	}
+	if cfg.Preferences.KeyringBackend == "" {
+		return
+	}
+	if _, err := config.ParseKeyringMode(cfg.Preferences.KeyringBackend); err != nil {
+		output.PrintWarning("ignoring preferences.keyring-backend: %v", err)
+	}
+}
+
+// newTokenManager creates an OAuth token manager that stores tokens
+// according to cfg's token storage mode.
+func newTokenManager(cfg *config.Config, oauthConfig *auth.OAuthConfig) (*auth.TokenManager, error) {
+	oauthConfig.KeyringMode = cfg.KeyringMode()
+	return auth.NewTokenManager(oauthConfig)
+}
+
+// useEnvConfig reports whether nothing more specific than the environment
+// variables selects a config or context.
+func useEnvConfig() bool {
+	return cfgFile == "" && contextName == "" &&
+		os.Getenv("DTCTL_CONTEXT") == "" &&
+		os.Getenv(config.EnvConfig) == "" &&
+		config.FindLocalConfig() == ""
+}
+
 // NewClientFromConfig creates a new client from config with verbose mode configured
 func NewClientFromConfig(cfg *config.Config) (*client.Client, error) {
 	c, err := client.NewFromConfig(cfg)
@@ -1058,7 +1357,7 @@ func resolveAccountToken(cfg *config.Config, accountUUID string) (string, error)
 		if ctx, err := cfg.CurrentContextObj(); err == nil {
 			env := auth.DetectEnvironment(ctx.Environment)
 			oauthCfg := auth.AccountOAuthConfig(env, ctx.SafetyLevel, accountUUID)
-			if tm, err := auth.NewTokenManager(oauthCfg); err == nil {
+			if tm, err := newTokenManager(cfg, oauthCfg); err == nil {
 				if token, err := tm.GetToken(accountTokenKeyName(accountUUID)); err == nil && token != "" {
 					return token, nil
 				}
@@ -1138,6 +1437,7 @@ func setupAccountClient(cfg *config.Config) (*httpclient.Client, string, error)
 	if err != nil {
 		return nil, "", err
 	}
+	client.ApplyRateLimit(c.HTTP())
 	level := verbosity
 	if debugMode {
 		level = 2
@@ -1159,11 +1459,20 @@ func setupAccountClient(cfg *config.Config) (*httpclient.Client, string, error)
 // init() at the bottom of this file.  TestFlagsTakingValues_SyncGuard verifies
 // this automatically.
 var flagsTakingValues = map[string]bool{
-	"--config":     true,
-	"--context":    true,
-	"--output":     true,
-	"--jq":         true,
-	"--chunk-size": true,
+	"--config":          true,
+	"--context":         true,
+	"--output":          true,
+	"--jq":              true,
+	"--chunk-size":      true,
+	"--page-size":       true,
+	"--limit":           true,
+	"--cache-ttl":       true,
+	"--toon-width":      true,
+	"--columns":         true,
+	"--label-columns":   true,
+	"--safety-level":    true,
+	"--rate-limit":      true,
+	"--keyring-backend": true,
 }
 
 // shortFlagsTakingValues maps short flag letters to true when they consume the
@@ -1241,7 +1550,7 @@ func extractSafeArgs(args []string) []string {
 // fresh token and retries without aborting the query.
 func NewDQLExecutorFromConfig(cfg *config.Config, c *client.Client) *exec.DQLExecutor {
 	executor := exec.NewDQLExecutor(c)
-	if config.IsOAuthStorageAvailable() {
+	if cfg.KeyringMode().OAuthStorageAvailable() {
 		ctx, err := cfg.CurrentContextObj()
 		if err == nil && ctx.TokenRef != "" {
 			tokenRef := ctx.TokenRef
@@ -1299,16 +1608,31 @@ Use "{{.CommandPath}} [command] --help" for more information about a command.{{e
 	// Global flags
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (searches .dtctl.yaml upward, then $XDG_CONFIG_HOME/dtctl/config)")
 	rootCmd.PersistentFlags().StringVar(&contextName, "context", "", "use a specific context for this invocation (env: DTCTL_CONTEXT; never persisted)")
-	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "table", "output format: json|yaml|csv|toon|table|wide")
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "table", "output format: json|yaml|csv|toon|markdown|name|table|wide|go-template=TEMPLATE|go-template-file=PATH")
 	rootCmd.PersistentFlags().StringVar(&jqFilter, "jq", "", "jq filter expression for structured output (json|yaml|toon); non-structured formats are auto-promoted to json")
-	rootCmd.PersistentFlags().CountVarP(&verbosity, "verbose", "v", "verbose output (-v for details, -vv for full debug including auth headers)")
+	rootCmd.PersistentFlags().CountVarP(&verbosity, "verbose", "v", "HTTP request logging to stderr (-v: method, URL, status, timing; -vv: also headers and bodies, credentials redacted)")
 	rootCmd.PersistentFlags().BoolVar(&debugMode, "debug", false, "enable debug mode (full HTTP request/response logging, equivalent to -vv)")
 	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "print what would be done without doing it")
 	rootCmd.PersistentFlags().BoolVar(&plainMode, "plain", false, "plain output for machine processing (no colors, no interactive prompts)")
+	rootCmd.PersistentFlags().BoolVar(&forceColor, "force-color", false, "use color even when output is not a terminal (NO_COLOR and --plain still disable it)")
+	rootCmd.PersistentFlags().BoolVar(&noHeaders, "no-headers", false, "omit the header row in table, wide, and csv output")
+	rootCmd.PersistentFlags().StringSliceVar(&columns, "columns", nil, "columns to print, in order, in markdown output (comma-separated)")
+	rootCmd.PersistentFlags().StringSliceVar(&labelColumns, "label-columns", nil, "dtctl label keys to show as extra columns in table and wide output, read from each object's description (e.g. team,env)")
+	rootCmd.PersistentFlags().BoolVar(&countOnly, "count", false, "print only the number of listed items (uses the API's total count when available)")
+	rootCmd.PersistentFlags().IntVar(&toonWidth, "toon-width", 0, "truncate string values longer than this many characters in toon output (0 = unlimited)")
 	rootCmd.PersistentFlags().BoolVarP(&agentMode, "agent", "A", false, "agent output mode: wrap output in a structured JSON envelope with metadata")
 	rootCmd.PersistentFlags().BoolVar(&noAgent, "no-agent", false, "disable auto-detected agent mode")
 	rootCmd.PersistentFlags().BoolVar(&checkScopes, "check-scopes", false, "check the active token has the scopes this command requires, then exit without running it")
-	rootCmd.PersistentFlags().Int64Var(&chunkSize, "chunk-size", 500, "Paginate through all results in chunks of this size. 0 returns only the first page.")
+	rootCmd.PersistentFlags().Int64Var(&chunkSize, "chunk-size", defaultChunkSize, "legacy page size; 0 returns only the first page (prefer --page-size and --no-paginate)")
+	rootCmd.PersistentFlags().Int64Var(&pageSize, "page-size", 0, "number of items requested per API call (default: --chunk-size)")
+	rootCmd.PersistentFlags().BoolVar(&allPages, "all-pages", false, "follow every next-page key, even with --chunk-size 0")
+	rootCmd.PersistentFlags().Int64Var(&listLimit, "limit", 0, "stop paginating once this many items are listed (0 = unlimited)")
+	rootCmd.PersistentFlags().BoolVar(&noPaginate, "no-paginate", false, "fetch only the first page of results")
+	rootCmd.PersistentFlags().StringVar(&safetyLevelOverride, "safety-level", "", "override the context's safety level for this invocation only (dangerously-unrestricted asks for confirmation unless -y)")
+	rootCmd.PersistentFlags().Float64Var(&rateLimit, "rate-limit", 0, "maximum API requests per second, shared by all concurrent workers (0 = no limit)")
+	rootCmd.PersistentFlags().BoolVar(&noKeyring, "no-keyring", false, "store and read tokens in the config file instead of the OS keyring (plaintext; for CI)")
+	rootCmd.PersistentFlags().StringVar(&keyringMode, "keyring-backend", "", "token storage: auto (OS keyring if reachable, else config file), os (require the OS keyring), file (same as --no-keyring) (default: preferences.keyring-backend, else auto)")
+	rootCmd.PersistentFlags().DurationVar(&cacheTTL, "cache-ttl", 0, "how long shell-completion candidates are cached (default: preferences.cache-ttl, else 5m; env DTCTL_NO_CACHE disables)")
 
 	// Bind flags to viper
 	_ = viper.BindPFlag("context", rootCmd.PersistentFlags().Lookup("context"))
@@ -1348,10 +1672,14 @@ func initConfig() {
 		}
 	}
 
-	// Propagate plain mode to the output package so ColorEnabled() respects --plain
+	// Propagate plain mode and --force-color to the output package so
+	// ColorEnabled() respects them
 	if plainMode {
 		output.SetPlainMode(true)
 	}
+	if forceColor {
+		output.SetForceColor(true)
+	}
 
 	if cfgFile != "" {
 		viper.SetConfigFile(cfgFile)
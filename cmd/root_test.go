@@ -11,6 +11,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/adrg/xdg"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -23,6 +24,7 @@ import (
 	"github.com/dynatrace-oss/dtctl/pkg/safety"
 	"github.com/dynatrace-oss/dtctl/pkg/suggest"
 	sdkquery "github.com/dynatrace-oss/dtctl/sdk/api/query"
+	"github.com/dynatrace-oss/dtctl/sdk/httpclient"
 )
 
 // TestBuildSpanName verifies that buildSpanName correctly extracts the verb and
@@ -414,6 +416,63 @@ func TestGlobalFlags_ChunkSize(t *testing.T) {
 	}
 }
 
+func TestGetPageOptions(t *testing.T) {
+	origChunk, origPage, origAll, origNoPag, origLimit := chunkSize, pageSize, allPages, noPaginate, listLimit
+	defer func() {
+		chunkSize, pageSize, allPages, noPaginate, listLimit = origChunk, origPage, origAll, origNoPag, origLimit
+	}()
+
+	tests := []struct {
+		name       string
+		chunkSize  int64
+		pageSize   int64
+		allPages   bool
+		noPaginate bool
+		limit      int64
+		want       httpclient.PageOptions
+		wantChunk  int64
+	}{
+		{name: "defaults", chunkSize: 500, want: httpclient.PageOptions{PageSize: 500}, wantChunk: 500},
+		{name: "page size overrides chunk size", chunkSize: 500, pageSize: 50, want: httpclient.PageOptions{PageSize: 50}, wantChunk: 50},
+		{name: "page size with no-paginate", chunkSize: 500, pageSize: 50, noPaginate: true, want: httpclient.PageOptions{PageSize: 50, FirstPageOnly: true}, wantChunk: 0},
+		{name: "legacy chunk size 0 is first page only", chunkSize: 0, want: httpclient.PageOptions{FirstPageOnly: true}, wantChunk: 0},
+		{name: "chunk size 0 with page size paginates", chunkSize: 0, pageSize: 50, want: httpclient.PageOptions{PageSize: 50}, wantChunk: 50},
+		{name: "chunk size 0 with all-pages uses API default size", chunkSize: 0, allPages: true, want: httpclient.PageOptions{}, wantChunk: defaultChunkSize},
+		{name: "limit caps total", chunkSize: 500, limit: 20, want: httpclient.PageOptions{PageSize: 500, Limit: 20}, wantChunk: 500},
+		{name: "limit with no-paginate", chunkSize: 500, limit: 20, noPaginate: true, want: httpclient.PageOptions{PageSize: 500, FirstPageOnly: true, Limit: 20}, wantChunk: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chunkSize, pageSize, allPages, noPaginate, listLimit = tt.chunkSize, tt.pageSize, tt.allPages, tt.noPaginate, tt.limit
+			if got := GetPageOptions(); got != tt.want {
+				t.Errorf("GetPageOptions() = %+v, want %+v", got, tt.want)
+			}
+			if got := GetChunkSize(); got != tt.wantChunk {
+				t.Errorf("GetChunkSize() = %d, want %d", got, tt.wantChunk)
+			}
+		})
+	}
+}
+
+func TestValidatePaginationFlags(t *testing.T) {
+	origPage, origAll, origNoPag := pageSize, allPages, noPaginate
+	defer func() { pageSize, allPages, noPaginate = origPage, origAll, origNoPag }()
+
+	pageSize, allPages, noPaginate = -1, false, false
+	if err := validatePaginationFlags(); err == nil {
+		t.Error("expected error for negative --page-size")
+	}
+	pageSize, allPages, noPaginate = 0, true, true
+	if err := validatePaginationFlags(); err == nil || !strings.Contains(err.Error(), "mutually exclusive") {
+		t.Errorf("expected mutual-exclusion error, got %v", err)
+	}
+	pageSize, allPages, noPaginate = 100, true, false
+	if err := validatePaginationFlags(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
 // TestNewPrinter tests the NewPrinter helper function
 func TestNewPrinter(t *testing.T) {
 	tests := []struct {
@@ -1420,6 +1479,7 @@ func TestValidateGlobalFlags_JQFormatConstraint(t *testing.T) {
 	defer func() {
 		outputFormat = origOutput
 		jqFilter = origJQ
+		output.SetStructuredWarnings(false)
 	}()
 
 	tests := []struct {
@@ -1453,6 +1513,39 @@ func TestValidateGlobalFlags_JQFormatConstraint(t *testing.T) {
 	}
 }
 
+func TestValidateGlobalFlags_StructuredWarnings(t *testing.T) {
+	origOutput := outputFormat
+	origJQ := jqFilter
+	defer func() {
+		outputFormat = origOutput
+		jqFilter = origJQ
+		output.SetStructuredWarnings(false)
+	}()
+
+	tests := []struct {
+		format string
+		jq     string
+		want   bool
+	}{
+		{format: "table", want: false},
+		{format: "csv", want: false},
+		{format: "json", want: true},
+		{format: "yaml", want: true},
+		{format: "table", jq: ".name", want: true},
+	}
+
+	for _, tt := range tests {
+		outputFormat = tt.format
+		jqFilter = tt.jq
+		if err := validateGlobalFlags(); err != nil {
+			t.Fatalf("unexpected error for format=%q: %v", tt.format, err)
+		}
+		if got := output.StructuredWarnings(); got != tt.want {
+			t.Errorf("format=%q jq=%q: StructuredWarnings() = %v, want %v", tt.format, tt.jq, got, tt.want)
+		}
+	}
+}
+
 func TestAgentJQ_KeepContextAndFilterResult(t *testing.T) {
 	origOutput := outputFormat
 	origJQ := jqFilter
@@ -1576,3 +1669,243 @@ func withCapturedStdout(t *testing.T, buf *bytes.Buffer, fn func()) {
 		t.Fatalf("failed to read captured stdout: %v", err)
 	}
 }
+
+// TestLoadConfig_EnvFallback tests that DT_ENVIRONMENT_URL/DT_TOKEN build an
+// in-memory context only when no explicit config or context is selected.
+func TestLoadConfig_EnvFallback(t *testing.T) {
+	// NOT parallel: os.Chdir is process-global.
+	origWd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.Chdir(origWd) }()
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+
+	origCfgFile, origContextName := cfgFile, contextName
+	defer func() { cfgFile, contextName = origCfgFile, origContextName }()
+	cfgFile, contextName = "", ""
+
+	t.Setenv("DTCTL_CONTEXT", "")
+	t.Setenv(config.EnvConfig, "")
+	t.Setenv(config.EnvEnvironmentURL, "https://env.dt.com")
+	t.Setenv(config.EnvToken, "env-token")
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	if cfg.CurrentContext != config.EnvContextName || !cfg.FromEnv() {
+		t.Fatalf("CurrentContext = %q, FromEnv = %v; want env context", cfg.CurrentContext, cfg.FromEnv())
+	}
+
+	// An explicit config file wins over the environment.
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	fileCfg := config.NewConfig()
+	fileCfg.SetContext("dev", "https://dev.dt.com", "dev-token")
+	fileCfg.CurrentContext = "dev"
+	if err := fileCfg.SaveTo(configPath); err != nil {
+		t.Fatal(err)
+	}
+	cfgFile = configPath
+	cfg, err = LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	if cfg.CurrentContext != "dev" || cfg.FromEnv() {
+		t.Errorf("CurrentContext = %q, want dev from --config", cfg.CurrentContext)
+	}
+}
+
+func TestGetChunkSize_NoPaginate(t *testing.T) {
+	origChunk, origNoPaginate := chunkSize, noPaginate
+	defer func() { chunkSize, noPaginate = origChunk, origNoPaginate }()
+
+	chunkSize, noPaginate = 200, false
+	if got := GetChunkSize(); got != 200 {
+		t.Errorf("GetChunkSize() = %d, want 200", got)
+	}
+	noPaginate = true
+	if got := GetChunkSize(); got != 0 {
+		t.Errorf("GetChunkSize() with --no-paginate = %d, want 0", got)
+	}
+}
+
+func TestValidateGlobalFlags_RateLimit(t *testing.T) {
+	origRate := rateLimit
+	defer func() {
+		rateLimit = origRate
+		_ = client.SetRateLimit(0)
+	}()
+
+	rateLimit = -1
+	if err := validateGlobalFlags(); err == nil || !strings.Contains(err.Error(), "--rate-limit") {
+		t.Fatalf("expected --rate-limit error, got %v", err)
+	}
+
+	rateLimit = 5
+	if err := validateGlobalFlags(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestValidateGlobalFlags_Keyring(t *testing.T) {
+	origNoKeyring, origMode, origOverride := noKeyring, keyringMode, keyringOverride
+	defer func() {
+		noKeyring, keyringMode, keyringOverride = origNoKeyring, origMode, origOverride
+	}()
+
+	noKeyring, keyringMode = true, ""
+	if err := validateGlobalFlags(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if keyringOverride != config.KeyringModeFile {
+		t.Errorf("--no-keyring: mode = %q, want file", keyringOverride)
+	}
+
+	noKeyring, keyringMode = false, "os"
+	if err := validateGlobalFlags(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if keyringOverride != config.KeyringModeOS {
+		t.Errorf("--keyring-backend os: mode = %q, want os", keyringOverride)
+	}
+
+	noKeyring, keyringMode = false, ""
+	if err := validateGlobalFlags(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if keyringOverride != "" {
+		t.Errorf("no flags: override = %q, want none", keyringOverride)
+	}
+
+	noKeyring, keyringMode = true, "os"
+	if err := validateGlobalFlags(); err == nil || !strings.Contains(err.Error(), "--no-keyring") {
+		t.Errorf("expected conflict error, got %v", err)
+	}
+
+	noKeyring, keyringMode = false, "vault"
+	if err := validateGlobalFlags(); err == nil || !strings.Contains(err.Error(), "--keyring-backend") {
+		t.Errorf("expected invalid backend error, got %v", err)
+	}
+}
+
+func TestApplyKeyringMode(t *testing.T) {
+	origOverride := keyringOverride
+	defer func() { keyringOverride = origOverride }()
+
+	cfg := config.NewConfig()
+	cfg.Preferences.KeyringBackend = "file"
+
+	keyringOverride = ""
+	applyKeyringMode(cfg)
+	if got := cfg.KeyringMode(); got != config.KeyringModeFile {
+		t.Errorf("preference: mode = %q, want file", got)
+	}
+
+	// The flag wins over the preference, and only for the loaded config.
+	keyringOverride = config.KeyringModeOS
+	applyKeyringMode(cfg)
+	if got := cfg.KeyringMode(); got != config.KeyringModeOS {
+		t.Errorf("flag should win: mode = %q, want os", got)
+	}
+	if got := config.NewConfig().KeyringMode(); got != config.KeyringModeAuto {
+		t.Errorf("unloaded config: mode = %q, want auto", got)
+	}
+}
+
+func TestValidateGlobalFlags_CountWithJQ(t *testing.T) {
+	origCount, origJQ := countOnly, jqFilter
+	defer func() { countOnly, jqFilter = origCount, origJQ }()
+
+	countOnly, jqFilter = true, ".[0]"
+	if err := validateGlobalFlags(); err == nil || !strings.Contains(err.Error(), "--count") {
+		t.Errorf("expected --count/--jq conflict, got %v", err)
+	}
+}
+
+func TestValidateGlobalFlags_Template(t *testing.T) {
+	origOutput, origJQ := outputFormat, jqFilter
+	defer func() { outputFormat, jqFilter = origOutput, origJQ }()
+
+	tmpl := filepath.Join(t.TempDir(), "report.tmpl")
+	if err := os.WriteFile(tmpl, []byte("{{range .}}{{.name}}\n{{end}}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		format, jq, wantErr string
+	}{
+		{format: "go-template-file=" + tmpl},
+		{format: "go-template={{.name}}"},
+		{format: "go-template-file=" + tmpl + ".missing", wantErr: "failed to read output template"},
+		{format: "go-template={{.name", wantErr: "invalid output template"},
+		{format: "go-template={{.name}}", jq: ".", wantErr: "--jq cannot be combined"},
+	}
+	for _, tt := range tests {
+		outputFormat, jqFilter = tt.format, tt.jq
+		err := validateGlobalFlags()
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("validateGlobalFlags(%q) error = %v", tt.format, err)
+			}
+			if outputFormat != tt.format {
+				t.Errorf("outputFormat = %q, want it unchanged", outputFormat)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("validateGlobalFlags(%q) error = %v, want %q", tt.format, err, tt.wantErr)
+		}
+	}
+}
+
+// TestConfigCommands_IgnoreEnvFallback tests that config-management commands
+// show the config file even when DT_ENVIRONMENT_URL/DT_TOKEN are set.
+func TestConfigCommands_IgnoreEnvFallback(t *testing.T) {
+	// NOT parallel: os.Chdir and xdg are process-global.
+	origWd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.Chdir(origWd) }()
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+	xdg.Reload()
+	defer xdg.Reload()
+
+	fileCfg := config.NewConfig()
+	fileCfg.SetContext("dev", "https://dev.dt.com", "dev-token")
+	fileCfg.CurrentContext = "dev"
+	if err := fileCfg.SaveTo(config.DefaultConfigPath()); err != nil {
+		t.Fatal(err)
+	}
+
+	origCfgFile, origContextName := cfgFile, contextName
+	defer func() { cfgFile, contextName = origCfgFile, origContextName }()
+	cfgFile, contextName = "", ""
+
+	t.Setenv("DTCTL_CONTEXT", "")
+	t.Setenv(config.EnvConfig, "")
+	t.Setenv(config.EnvEnvironmentURL, "https://env.dt.com")
+	t.Setenv(config.EnvToken, "env-token")
+
+	if cfg, err := LoadConfig(); err != nil || !cfg.FromEnv() {
+		t.Fatalf("LoadConfig() = %v, %v; want env context", cfg, err)
+	}
+
+	var buf bytes.Buffer
+	withCapturedStdout(t, &buf, func() {
+		if err := configCurrentContextCmd.RunE(configCurrentContextCmd, nil); err != nil {
+			t.Fatalf("current-context error = %v", err)
+		}
+	})
+	if got := strings.TrimSpace(buf.String()); got != "dev" {
+		t.Errorf("current-context = %q, want dev from the config file", got)
+	}
+}
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dynatrace-oss/dtctl/pkg/config"
+	"github.com/dynatrace-oss/dtctl/pkg/output"
+	"github.com/dynatrace-oss/dtctl/pkg/prompt"
+)
+
+var (
+	// safetyLevelOverride is the global --safety-level value. When set it
+	// replaces the current context's safety level for this invocation only;
+	// the config file is never modified.
+	safetyLevelOverride string
+
+	// safetyOverrideYes records whether the running command was given -y/--yes,
+	// which skips the acknowledgment for elevating to dangerously-unrestricted.
+	safetyOverrideYes bool
+
+	// safetyOverrideAccepted is set once the override has been logged (and
+	// acknowledged, if needed) so multi-resource commands ask only once.
+	safetyOverrideAccepted bool
+)
+
+// validateSafetyLevelOverride rejects unknown --safety-level values before any
+// command runs.
+func validateSafetyLevelOverride() error {
+	if safetyLevelOverride == "" {
+		return nil
+	}
+	if !config.SafetyLevel(safetyLevelOverride).IsValid() {
+		return fmt.Errorf("invalid --safety-level %q (valid values: %v)", safetyLevelOverride, config.ValidSafetyLevels())
+	}
+	return nil
+}
+
+// recordSafetyOverrideYes captures the running command's -y/--yes flag so
+// NewSafetyChecker, which has no access to the command, can honor it.
+func recordSafetyOverrideYes(cmd *cobra.Command) {
+	safetyOverrideYes = false
+	if f := cmd.Flags().Lookup("yes"); f != nil && f.Value.Type() == "bool" {
+		safetyOverrideYes = f.Value.String() == "true"
+	}
+}
+
+// effectiveSafetyLevel returns the safety level this invocation runs with:
+// the --safety-level override when given, otherwise the context's level. Unlike
+// resolveSafetyLevelOverride it neither logs nor asks for acknowledgment.
+func effectiveSafetyLevel(ctx *config.Context) config.SafetyLevel {
+	if safetyLevelOverride != "" {
+		return config.SafetyLevel(safetyLevelOverride)
+	}
+	return ctx.GetEffectiveSafetyLevel()
+}
+
+// resolveSafetyLevelOverride returns the safety level to enforce for the
+// current invocation. Without --safety-level it is the context's level. With
+// it, the override is logged and, when it elevates the context to
+// dangerously-unrestricted, must be acknowledged interactively unless -y was
+// given.
+func resolveSafetyLevelOverride(contextName string, ctx *config.Context) (config.SafetyLevel, error) {
+	current := ctx.GetEffectiveSafetyLevel()
+	if safetyLevelOverride == "" {
+		return current, nil
+	}
+	level := config.SafetyLevel(safetyLevelOverride)
+	if safetyOverrideAccepted || level == current {
+		return level, nil
+	}
+
+	if level == config.SafetyLevelDangerouslyUnrestricted && !safetyOverrideYes {
+		if plainMode {
+			return "", fmt.Errorf("--safety-level %s requires confirmation; re-run with -y/--yes to acknowledge", level)
+		}
+		output.PrintWarning("Context %q has safety level %s; --safety-level %s allows irreversible data deletion for this command.", contextName, current, level)
+		if !prompt.Confirm(contextPromptTarget(contextName, ctx), "Proceed with the elevated safety level?") {
+			return "", fmt.Errorf("safety level override cancelled")
+		}
+	}
+
+	output.PrintWarning("Safety level overridden for this invocation: %s (context %q has %s)", level, contextName, current)
+	safetyOverrideAccepted = true
+	return level, nil
+}
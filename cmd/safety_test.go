@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/dynatrace-oss/dtctl/pkg/config"
+	"github.com/dynatrace-oss/dtctl/pkg/prompt"
 	"github.com/dynatrace-oss/dtctl/pkg/safety"
 )
 
@@ -461,3 +462,141 @@ func TestSafetyErrorMessages(t *testing.T) {
 		}
 	}
 }
+
+// TestNewSafetyChecker_LevelOverride tests the one-off --safety-level override
+func TestNewSafetyChecker_LevelOverride(t *testing.T) {
+	tests := []struct {
+		name      string
+		override  string
+		yes       bool
+		plain     bool
+		wantLevel config.SafetyLevel
+		wantErr   string
+	}{
+		{
+			name:      "no override keeps context level",
+			wantLevel: config.SafetyLevelReadOnly,
+		},
+		{
+			name:      "override to readwrite-all",
+			override:  "readwrite-all",
+			wantLevel: config.SafetyLevelReadWriteAll,
+		},
+		{
+			name:      "dangerously-unrestricted with -y",
+			override:  "dangerously-unrestricted",
+			yes:       true,
+			plain:     true,
+			wantLevel: config.SafetyLevelDangerouslyUnrestricted,
+		},
+		{
+			name:     "dangerously-unrestricted without -y in plain mode",
+			override: "dangerously-unrestricted",
+			plain:    true,
+			wantErr:  "requires confirmation",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			configPath := filepath.Join(tmpDir, "config.yaml")
+
+			cfg := config.NewConfig()
+			cfg.SetContextWithOptions("prod", "https://prod.dt.com", "prod-token", &config.ContextOptions{
+				SafetyLevel: config.SafetyLevelReadOnly,
+			})
+			cfg.CurrentContext = "prod"
+			if err := cfg.SaveTo(configPath); err != nil {
+				t.Fatalf("failed to save config: %v", err)
+			}
+
+			origCfgFile, origOverride, origYes, origAccepted, origPlain := cfgFile, safetyLevelOverride, safetyOverrideYes, safetyOverrideAccepted, plainMode
+			defer func() {
+				cfgFile, safetyLevelOverride, safetyOverrideYes, safetyOverrideAccepted, plainMode = origCfgFile, origOverride, origYes, origAccepted, origPlain
+			}()
+
+			viper.Reset()
+			cfgFile = configPath
+			safetyLevelOverride = tt.override
+			safetyOverrideYes = tt.yes
+			safetyOverrideAccepted = false
+			plainMode = tt.plain
+
+			loadedCfg, err := LoadConfig()
+			if err != nil {
+				t.Fatalf("LoadConfig() error = %v", err)
+			}
+
+			checker, err := NewSafetyChecker(loadedCfg)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("NewSafetyChecker() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewSafetyChecker() error = %v", err)
+			}
+			if checker.SafetyLevel() != tt.wantLevel {
+				t.Errorf("SafetyLevel() = %v, want %v", checker.SafetyLevel(), tt.wantLevel)
+			}
+
+			// The saved context must be left untouched.
+			reloaded, err := LoadConfig()
+			if err != nil {
+				t.Fatalf("LoadConfig() error = %v", err)
+			}
+			ctx, _ := reloaded.CurrentContextObj()
+			if ctx.SafetyLevel != config.SafetyLevelReadOnly {
+				t.Errorf("context safety level = %v, want readonly", ctx.SafetyLevel)
+			}
+		})
+	}
+}
+
+// TestPromptTarget_SafetyLevelOverride tests that confirmation prompts show
+// the --safety-level override instead of the context's level
+func TestPromptTarget_SafetyLevelOverride(t *testing.T) {
+	origOverride := safetyLevelOverride
+	defer func() { safetyLevelOverride = origOverride }()
+
+	cfg := config.NewConfig()
+	cfg.SetContextWithOptions("prod", "https://prod.dt.com", "prod-token", &config.ContextOptions{
+		SafetyLevel: config.SafetyLevelReadOnly,
+		Prompt:      "PROD",
+	})
+	cfg.CurrentContext = "prod"
+
+	for override, want := range map[string]string{
+		"":                         "readonly",
+		"dangerously-unrestricted": "dangerously-unrestricted",
+	} {
+		safetyLevelOverride = override
+		target := promptTarget(cfg)
+		if target.Context != "prod" || target.Label != "PROD" || target.SafetyLevel != want {
+			t.Errorf("override %q: prompt target = %+v, want safety level %s", override, target, want)
+		}
+	}
+
+	cfg.CurrentContext = ""
+	if target := promptTarget(cfg); target != (prompt.Target{}) {
+		t.Errorf("prompt target without a context = %+v, want empty", target)
+	}
+}
+
+// TestValidateSafetyLevelOverride tests validation of the --safety-level flag
+func TestValidateSafetyLevelOverride(t *testing.T) {
+	orig := safetyLevelOverride
+	defer func() { safetyLevelOverride = orig }()
+
+	safetyLevelOverride = "readwrite-mine"
+	if err := validateSafetyLevelOverride(); err != nil {
+		t.Errorf("validateSafetyLevelOverride() error = %v", err)
+	}
+
+	safetyLevelOverride = "root"
+	if err := validateSafetyLevelOverride(); err == nil {
+		t.Error("expected error for invalid safety level")
+	}
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dynatrace-oss/dtctl/pkg/labels"
+)
+
+// addSelectorFlag registers --selector and --selector-from-file on a command
+// whose resources carry dtctl labels in their description (see package labels).
+func addSelectorFlag(cmd *cobra.Command) {
+	cmd.Flags().StringP("selector", "l", "", "Filter by dtctl labels set with 'apply --label' (e.g. team=checkout,env!=dev)")
+	cmd.Flags().String("selector-from-file", "", "Read a label selector from a file (one requirement per line, # comments), ANDed with --selector")
+}
+
+// selectorString returns the label selector from --selector and
+// --selector-from-file combined, or "" when neither is set.
+func selectorString(cmd *cobra.Command) (string, error) {
+	s, _ := cmd.Flags().GetString("selector")
+	file, _ := cmd.Flags().GetString("selector-from-file")
+	if file == "" {
+		return s, nil
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to read selector file: %w", err)
+	}
+	var parts []string
+	if s != "" {
+		parts = append(parts, s)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		if line = strings.TrimSpace(line); line != "" {
+			parts = append(parts, line)
+		}
+	}
+	if len(parts) == 0 {
+		return "", fmt.Errorf("selector file %q contains no requirements", file)
+	}
+	return strings.Join(parts, ","), nil
+}
+
+// selectorFromFlags parses --selector and --selector-from-file. It returns
+// nil when neither is set.
+func selectorFromFlags(cmd *cobra.Command) (labels.Selector, error) {
+	s, err := selectorString(cmd)
+	if err != nil || s == "" {
+		return nil, err
+	}
+	return labels.ParseSelector(s)
+}
+
+// selectByLabels keeps the items whose description labels satisfy sel. A nil
+// selector keeps everything.
+func selectByLabels[T any](items []T, sel labels.Selector, description func(T) string) []T {
+	if sel == nil {
+		return items
+	}
+	selected := make([]T, 0, len(items))
+	for _, item := range items {
+		if sel.MatchesDescription(description(item)) {
+			selected = append(selected, item)
+		}
+	}
+	return selected
+}
+
+// idOrSelectorArgs accepts either exactly one positional identifier or none
+// when --selector or --selector-from-file is set.
+func idOrSelectorArgs(cmd *cobra.Command, args []string) error {
+	selector, _ := cmd.Flags().GetString("selector")
+	file, _ := cmd.Flags().GetString("selector-from-file")
+	hasSelector := selector != "" || file != ""
+	switch {
+	case hasSelector && len(args) > 0:
+		return fmt.Errorf("specify either an identifier or --selector, not both")
+	case !hasSelector && len(args) != 1:
+		return fmt.Errorf("accepts 1 arg(s), received %d (or use --selector)", len(args))
+	}
+	return nil
+}
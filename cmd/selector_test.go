@@ -0,0 +1,285 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+	"github.com/dynatrace-oss/dtctl/pkg/client"
+	"github.com/dynatrace-oss/dtctl/pkg/config"
+	"github.com/dynatrace-oss/dtctl/pkg/labels"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/workflow"
+)
+
+func TestSelectByLabels(t *testing.T) {
+	wfs := []workflow.Workflow{
+		{ID: "a", Description: labels.InDescription("", labels.Labels{"team": "checkout"})},
+		{ID: "b", Description: labels.InDescription("", labels.Labels{"team": "payments"})},
+		{ID: "c", Description: "unlabelled"},
+	}
+
+	if got := selectByLabels(wfs, nil, workflowLabelSource); len(got) != 3 {
+		t.Errorf("nil selector kept %d items, want all 3", len(got))
+	}
+
+	sel, err := labels.ParseSelector("team=checkout")
+	if err != nil {
+		t.Fatalf("ParseSelector() error = %v", err)
+	}
+	got := selectByLabels(wfs, sel, workflowLabelSource)
+	if len(got) != 1 || got[0].ID != "a" {
+		t.Errorf("selectByLabels() = %+v, want only workflow a", got)
+	}
+}
+
+func TestDeleteWorkflowCmd_Selector(t *testing.T) {
+	var deleted []string
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/automation/v1/workflows": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{"count": 2, "results": []any{
+				map[string]any{"id": "wf-keep", "title": "Keep", "description": labels.InDescription("", labels.Labels{"team": "payments"})},
+				map[string]any{"id": "wf-drop", "title": "Drop", "description": labels.InDescription("Old", labels.Labels{"team": "checkout"})},
+			}})
+		},
+		"/platform/automation/v1/workflows/wf-drop": func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodDelete {
+				deleted = append(deleted, "wf-drop")
+			}
+			w.WriteHeader(http.StatusNoContent)
+		},
+		"/platform/automation/v1/workflows/wf-keep": func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("unexpected %s on workflow not matching the selector", r.Method)
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile, origForce := cfgFile, forceDelete
+	defer func() {
+		testutil.ResetCommandFlags(deleteWorkflowCmd)
+		cfgFile, forceDelete = origCfgFile, origForce
+	}()
+	testutil.ResetCommandFlags(deleteWorkflowCmd)
+	cfgFile = configPath
+	forceDelete = true
+
+	if err := deleteWorkflowCmd.Flags().Set("selector", "team=checkout"); err != nil {
+		t.Fatal(err)
+	}
+	if err := idOrSelectorArgs(deleteWorkflowCmd, nil); err != nil {
+		t.Fatalf("idOrSelectorArgs() error = %v", err)
+	}
+	if err := idOrSelectorArgs(deleteWorkflowCmd, []string{"wf-keep"}); err == nil {
+		t.Error("expected error when combining an identifier with --selector")
+	}
+	if err := deleteWorkflowCmd.RunE(deleteWorkflowCmd, nil); err != nil {
+		t.Fatalf("RunE() error = %v", err)
+	}
+	if len(deleted) != 1 {
+		t.Errorf("deleted = %v, want only wf-drop", deleted)
+	}
+}
+
+func TestDeleteWorkflowCmd_SelectorDryRun(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/automation/v1/workflows": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{"count": 1, "results": []any{
+				map[string]any{"id": "wf-drop", "title": "Drop", "description": labels.InDescription("Old", labels.Labels{"team": "checkout"})},
+			}})
+		},
+		"/platform/automation/v1/workflows/wf-drop": func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("unexpected %s during --dry-run", r.Method)
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile, origForce, origDryRun := cfgFile, forceDelete, dryRun
+	defer func() {
+		testutil.ResetCommandFlags(deleteWorkflowCmd)
+		cfgFile, forceDelete, dryRun = origCfgFile, origForce, origDryRun
+	}()
+	testutil.ResetCommandFlags(deleteWorkflowCmd)
+	cfgFile = configPath
+	forceDelete = true
+	dryRun = true
+
+	if err := deleteWorkflowCmd.Flags().Set("selector", "team=checkout"); err != nil {
+		t.Fatal(err)
+	}
+	var runErr error
+	got := captureStdout(t, func() { runErr = deleteWorkflowCmd.RunE(deleteWorkflowCmd, nil) })
+	if runErr != nil {
+		t.Fatalf("RunE() error = %v", runErr)
+	}
+	if !strings.Contains(got, "would delete 1 workflow(s)") || !strings.Contains(got, "wf-drop  Drop") {
+		t.Errorf("stdout = %q, want the matching workflow listed", got)
+	}
+}
+
+func TestDeleteSelected_DryRunSkipsSafetyCheck(t *testing.T) {
+	cfg := config.NewConfig()
+	cfg.SetContextWithOptions("prod", "https://prod.example.com", "prod-token", &config.ContextOptions{
+		SafetyLevel: config.SafetyLevelReadOnly,
+	})
+	cfg.CurrentContext = "prod"
+	c, err := client.NewForTesting("https://prod.example.com", "test-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	origForce, origDryRun := forceDelete, dryRun
+	defer func() { forceDelete, dryRun = origForce, origDryRun }()
+	forceDelete = true
+
+	objs := []selectedObject{{ID: "wf-1", Name: "Nightly"}}
+	del := func(selectedObject) error {
+		t.Error("unexpected delete")
+		return nil
+	}
+
+	dryRun = true
+	var runErr error
+	got := captureStdout(t, func() { runErr = deleteSelected(cfg, c, "workflow", "team=checkout", objs, del) })
+	if runErr != nil {
+		t.Fatalf("deleteSelected() with --dry-run error = %v", runErr)
+	}
+	if !strings.Contains(got, "would delete 1 workflow(s)") {
+		t.Errorf("stdout = %q, want the dry-run listing", got)
+	}
+
+	dryRun = false
+	if err := deleteSelected(cfg, c, "workflow", "team=checkout", objs, del); err == nil {
+		t.Error("expected the readonly context to block the delete")
+	}
+}
+
+func TestSelectorFromFlags_File(t *testing.T) {
+	defer testutil.ResetCommandFlags(getWorkflowsCmd)
+
+	file := filepath.Join(t.TempDir(), "selector.txt")
+	if err := os.WriteFile(file, []byte("# production checkout\nteam=checkout\n\nenv!=dev # not dev\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.ResetCommandFlags(getWorkflowsCmd)
+	_ = getWorkflowsCmd.Flags().Set("selector", "tier")
+	_ = getWorkflowsCmd.Flags().Set("selector-from-file", file)
+	got, err := selectorString(getWorkflowsCmd)
+	if err != nil {
+		t.Fatalf("selectorString() error = %v", err)
+	}
+	if want := "tier,team=checkout,env!=dev"; got != want {
+		t.Errorf("selectorString() = %q, want %q", got, want)
+	}
+	if err := idOrSelectorArgs(getWorkflowsCmd, nil); err != nil {
+		t.Errorf("idOrSelectorArgs() with a selector file = %v, want nil", err)
+	}
+
+	empty := filepath.Join(t.TempDir(), "empty.txt")
+	if err := os.WriteFile(empty, []byte("# nothing\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	testutil.ResetCommandFlags(getWorkflowsCmd)
+	_ = getWorkflowsCmd.Flags().Set("selector-from-file", empty)
+	if _, err := selectorFromFlags(getWorkflowsCmd); err == nil {
+		t.Error("expected error for a selector file without requirements")
+	}
+}
+
+func TestDeleteWorkflowCmd_SelectorAllPages(t *testing.T) {
+	var deleted []string
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/automation/v1/workflows": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			id := "wf-page1"
+			if r.URL.Query().Get("offset") != "" {
+				id = "wf-page2"
+			}
+			json.NewEncoder(w).Encode(map[string]any{"count": 2, "results": []any{
+				map[string]any{"id": id, "title": id, "description": labels.InDescription("", labels.Labels{"team": "checkout"})},
+			}})
+		},
+		"/platform/automation/v1/workflows/": func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodDelete {
+				deleted = append(deleted, strings.TrimPrefix(r.URL.Path, "/platform/automation/v1/workflows/"))
+			}
+			w.WriteHeader(http.StatusNoContent)
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile, origForce, origNoPaginate, origChunk := cfgFile, forceDelete, noPaginate, chunkSize
+	defer func() {
+		testutil.ResetCommandFlags(deleteWorkflowCmd)
+		cfgFile, forceDelete, noPaginate, chunkSize = origCfgFile, origForce, origNoPaginate, origChunk
+	}()
+	testutil.ResetCommandFlags(deleteWorkflowCmd)
+	cfgFile = configPath
+	forceDelete = true
+	noPaginate = true
+	chunkSize = 1
+
+	if err := deleteWorkflowCmd.Flags().Set("selector", "team=checkout"); err != nil {
+		t.Fatal(err)
+	}
+	if err := deleteWorkflowCmd.RunE(deleteWorkflowCmd, nil); err != nil {
+		t.Fatalf("RunE() error = %v", err)
+	}
+	if len(deleted) != 2 {
+		t.Errorf("deleted = %v, want the matches on both pages", deleted)
+	}
+}
+
+func TestGetWorkflowsCmd_SelectorLimit(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/automation/v1/workflows": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("limit") == "1" {
+				t.Error("--limit was applied before the label filter")
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]any{"count": 3, "results": []any{
+				map[string]any{"id": "wf-other", "title": "Other", "description": labels.InDescription("", labels.Labels{"team": "payments"})},
+				map[string]any{"id": "wf-a", "title": "A", "description": labels.InDescription("", labels.Labels{"team": "checkout"})},
+				map[string]any{"id": "wf-b", "title": "B", "description": labels.InDescription("", labels.Labels{"team": "checkout"})},
+			}})
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile, origOutput := cfgFile, outputFormat
+	defer func() {
+		testutil.ResetCommandFlags(getWorkflowsCmd)
+		cfgFile, outputFormat = origCfgFile, origOutput
+	}()
+	testutil.ResetCommandFlags(getWorkflowsCmd)
+	cfgFile = configPath
+	outputFormat = "json"
+
+	_ = getWorkflowsCmd.Flags().Set("selector", "team=checkout")
+	_ = getWorkflowsCmd.Flags().Set("limit", "1")
+	var runErr error
+	got := captureStdout(t, func() { runErr = getWorkflowsCmd.RunE(getWorkflowsCmd, nil) })
+	if runErr != nil {
+		t.Fatalf("RunE() error = %v", runErr)
+	}
+	if !strings.Contains(got, "wf-a") || strings.Contains(got, "wf-b") || strings.Contains(got, "wf-other") {
+		t.Errorf("stdout = %q, want only the first match wf-a", got)
+	}
+}
@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/dynatrace-oss/dtctl/pkg/util/template"
+)
+
+// addTemplateVarFlags registers the template variable flags shared by the
+// create and apply commands.
+func addTemplateVarFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArray("set", []string{}, "set template variable (key=value)")
+	cmd.Flags().StringArray("set-file", []string{}, "set template variable to the contents of a file (key=path)")
+	cmd.Flags().StringArray("set-json", []string{}, "set template variable to a JSON value, e.g. a list or object (key=json)")
+}
+
+// templateVarsFromFlags returns the variables given with --set, --set-file,
+// and --set-json, or nil when none were given.
+func templateVarsFromFlags(cmd *cobra.Command) (map[string]interface{}, error) {
+	setFlags, _ := cmd.Flags().GetStringArray("set")
+	setFileFlags, _ := cmd.Flags().GetStringArray("set-file")
+	setJSONFlags, _ := cmd.Flags().GetStringArray("set-json")
+	if len(setFlags) == 0 && len(setFileFlags) == 0 && len(setJSONFlags) == 0 {
+		return nil, nil
+	}
+	return template.ParseVarFlags(setFlags, setFileFlags, setJSONFlags)
+}
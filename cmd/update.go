@@ -1,9 +1,15 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/spf13/cobra"
+
+	"github.com/dynatrace-oss/dtctl/pkg/apply"
+	"github.com/dynatrace-oss/dtctl/pkg/output"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/settings"
+	"github.com/dynatrace-oss/dtctl/pkg/safety"
 )
 
 // updateCmd represents the update command.
@@ -17,7 +23,9 @@ fields of a resource using command-line flags. This is useful for quick,
 targeted changes without exporting and re-importing a full resource definition.
 
 Available resources:
+  settings                Patch fields of a settings object (--patch)
   breakpoint              Update breakpoint condition/enabled state or workspace filters
+  lookup                  Replace or append to the data of a lookup table
   azure connection        Update Azure connection credentials
   azure monitoring        Update Azure monitoring configuration
   gcp connection          Update GCP connection credentials (Preview)
@@ -36,26 +44,99 @@ Available resources:
 	RunE: requireSubcommand,
 }
 
-// updateSettingsHintCmd redirects users to 'apply' for file-based settings updates.
-var updateSettingsHintCmd = &cobra.Command{
-	Use:     "settings",
+// updateSettingsCmd patches a settings object. Without --patch it redirects
+// users to 'apply' for file-based settings updates.
+var updateSettingsCmd = &cobra.Command{
+	Use:     "settings <object-id> --patch <json>",
 	Aliases: []string{"setting"},
-	Short:   "Update a settings object (use 'apply' instead)",
-	Hidden:  true,
-	// Accept any args/flags so the command doesn't fail before RunE.
-	Args:               cobra.ArbitraryArgs,
-	DisableFlagParsing: true,
+	Short:   "Patch fields of a settings object",
+	Long: `Change individual fields of a settings object with a JSON merge patch.
+
+The current object is fetched, the patch is merged into it (RFC 7386: objects
+merge recursively, null removes a field, anything else replaces it), and the
+result is written back. Only "value" can be patched.
+
+To replace a settings object from a file, use 'dtctl apply -f <file>'
+(add --merge to merge the file into the current value instead).
+
+Examples:
+  # Disable a setting without sending the whole value
+  dtctl update settings <object-id> --patch '{"value":{"enabled":false}}'
+
+  # Remove an optional field
+  dtctl update settings <object-id> --patch '{"value":{"description":null}}'
+
+  # Preview the merged value
+  dtctl update settings <object-id> --patch '{"value":{"enabled":false}}' --dry-run
+`,
+	Args: cobra.ArbitraryArgs,
+	// Tolerate file flags from users who expect 'update -f' so they get the
+	// apply hint below rather than a flag parsing error.
+	FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return fmt.Errorf("to update settings objects from a file, use 'dtctl apply -f <file>' instead\n\n" +
-			"The file should include objectId, schemaId, scope, and value fields.\n" +
-			"If the objectId exists it will be updated; otherwise a new object is created.\n\n" +
-			"Example:\n" +
-			"  dtctl apply -f settings.yaml\n" +
-			"  dtctl apply -f settings.yaml --dry-run")
+		patch, _ := cmd.Flags().GetString("patch")
+		if patch == "" || len(args) != 1 {
+			return fmt.Errorf("to update settings objects from a file, use 'dtctl apply -f <file>' instead\n\n" +
+				"The file should include objectId, schemaId, scope, and value fields.\n" +
+				"If the objectId exists it will be updated; otherwise a new object is created.\n\n" +
+				"Example:\n" +
+				"  dtctl apply -f settings.yaml\n" +
+				"  dtctl apply -f settings.yaml --dry-run\n\n" +
+				"To change individual fields, pass a JSON merge patch:\n" +
+				"  dtctl update settings <object-id> --patch '{\"value\":{\"enabled\":false}}'")
+		}
+		objectID := args[0]
+
+		var patchDoc map[string]interface{}
+		if err := json.Unmarshal([]byte(patch), &patchDoc); err != nil {
+			return fmt.Errorf("invalid --patch: must be a JSON object: %w", err)
+		}
+		for key := range patchDoc {
+			if key != "value" {
+				return fmt.Errorf("invalid --patch: only \"value\" can be patched, got %q", key)
+			}
+		}
+		valuePatch, ok := patchDoc["value"].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("invalid --patch: \"value\" must be a JSON object")
+		}
+
+		_, c, err := SetupWithSafety(safety.OperationUpdate)
+		if err != nil {
+			return err
+		}
+		handler := settings.NewHandler(c)
+
+		current, err := handler.Get(objectID)
+		if err != nil {
+			return err
+		}
+		merged, _ := apply.MergePatch(current.Value, valuePatch).(map[string]interface{})
+
+		if dryRun {
+			data, err := json.MarshalIndent(merged, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Dry run: would update settings object %s\n", objectID)
+			fmt.Println("---")
+			fmt.Println(string(data))
+			fmt.Println("---")
+			return nil
+		}
+
+		updated, err := handler.Update(objectID, merged)
+		if err != nil {
+			return fmt.Errorf("failed to update settings object: %w", err)
+		}
+
+		output.PrintSuccess("Settings object %q updated", updated.ObjectID)
+		return nil
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(updateCmd)
-	updateCmd.AddCommand(updateSettingsHintCmd)
+	updateCmd.AddCommand(updateSettingsCmd)
+	updateSettingsCmd.Flags().String("patch", "", "JSON merge patch to apply, e.g. '{\"value\":{\"enabled\":false}}'")
 }
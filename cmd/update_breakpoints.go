@@ -154,7 +154,7 @@ Examples:
 				if err != nil {
 					return err
 				}
-				if count > 0 && !prompt.Confirm(filterChangeConfirmMessage(count, false)) {
+				if count > 0 && !prompt.Confirm(promptTarget(cfg), filterChangeConfirmMessage(count, false)) {
 					return printBreakpointMessage("update", "Cancelled")
 				}
 			}
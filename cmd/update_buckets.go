@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dynatrace-oss/dtctl/pkg/output"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/bucket"
+	"github.com/dynatrace-oss/dtctl/pkg/safety"
+)
+
+// updateBucketCmd changes a Grail bucket's display name or retention
+var updateBucketCmd = &cobra.Command{
+	Use:     "bucket <name>",
+	Aliases: []string{"buckets", "bkt"},
+	Short:   "Update a Grail bucket's display name or retention",
+	Long: `Update the display name and/or retention period of an existing Grail bucket.
+
+Only the flags you pass are changed. The current bucket version is fetched
+first so the update uses optimistic locking.
+
+Examples:
+  # Change the retention period
+  dtctl update bucket custom_logs --retention 90
+
+  # Change the display name and retention together
+  dtctl update bucket custom_logs --retention 90 --display-name "Custom Logs"
+
+  # Preview the change
+  dtctl update bucket custom_logs --retention 90 --dry-run
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+		retention, _ := cmd.Flags().GetInt("retention")
+		displayName, _ := cmd.Flags().GetString("display-name")
+		retentionSet := cmd.Flags().Changed("retention")
+		displayNameSet := cmd.Flags().Changed("display-name")
+
+		if !retentionSet && !displayNameSet {
+			return fmt.Errorf("nothing to update: pass --retention and/or --display-name")
+		}
+		if retentionSet {
+			if err := bucket.ValidateRetentionDays(retention); err != nil {
+				return fmt.Errorf("invalid --retention: %w", err)
+			}
+		}
+		if displayNameSet && displayName == "" {
+			return fmt.Errorf("--display-name must not be empty")
+		}
+
+		var req bucket.BucketUpdate
+		if retentionSet {
+			req.RetentionDays = retention
+		}
+		if displayNameSet {
+			req.DisplayName = displayName
+		}
+
+		if dryRun {
+			fmt.Printf("Dry run: would update bucket %s\n", name)
+			if retentionSet {
+				fmt.Printf("Retention: %d days\n", req.RetentionDays)
+			}
+			if displayNameSet {
+				fmt.Printf("Display Name: %s\n", req.DisplayName)
+			}
+			return nil
+		}
+
+		_, c, err := SetupWithSafety(safety.OperationUpdate)
+		if err != nil {
+			return err
+		}
+
+		handler := bucket.NewHandler(c)
+
+		existing, err := handler.Get(name)
+		if err != nil {
+			return err
+		}
+		if !existing.Updatable {
+			return fmt.Errorf("bucket %q cannot be updated (built-in or not yet active)", name)
+		}
+
+		if err := handler.Update(name, existing.Version, req); err != nil {
+			return fmt.Errorf("failed to update bucket: %w", err)
+		}
+
+		output.PrintSuccess("Bucket %q updated", name)
+		if retentionSet && retention != existing.RetentionDays {
+			output.PrintInfo("Retention: %d -> %d days", existing.RetentionDays, retention)
+		}
+		return nil
+	},
+}
+
+func init() {
+	updateCmd.AddCommand(updateBucketCmd)
+
+	updateBucketCmd.Flags().Int("retention", 0, "retention period in days (1-3657)")
+	updateBucketCmd.Flags().String("display-name", "", "display name for the bucket")
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+)
+
+func TestUpdateBucketCmd(t *testing.T) {
+	var patchBody map[string]interface{}
+	var lockVersion string
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/storage/management/v1/bucket-definitions/custom_logs": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.Method {
+			case http.MethodGet:
+				_, _ = w.Write([]byte(`{"bucketName":"custom_logs","table":"logs","displayName":"Old","status":"active","retentionDays":35,"version":4,"updatable":true}`))
+			case http.MethodPatch:
+				lockVersion = r.URL.Query().Get("optimistic-locking-version")
+				_ = json.NewDecoder(r.Body).Decode(&patchBody)
+				w.WriteHeader(http.StatusOK)
+			default:
+				t.Errorf("unexpected method %s", r.Method)
+			}
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile := cfgFile
+	defer func() {
+		testutil.ResetCommandFlags(updateBucketCmd)
+		cfgFile = origCfgFile
+	}()
+	testutil.ResetCommandFlags(updateBucketCmd)
+	cfgFile = configPath
+	_ = updateBucketCmd.Flags().Set("retention", "90")
+
+	if err := updateBucketCmd.RunE(updateBucketCmd, []string{"custom_logs"}); err != nil {
+		t.Fatalf("RunE() error = %v", err)
+	}
+	if lockVersion != "4" {
+		t.Errorf("optimistic-locking-version = %q, want 4", lockVersion)
+	}
+	if patchBody["retentionDays"] != float64(90) {
+		t.Errorf("retentionDays = %v, want 90", patchBody["retentionDays"])
+	}
+	if _, ok := patchBody["displayName"]; ok {
+		t.Errorf("displayName should not be sent when --display-name is not set: %v", patchBody)
+	}
+}
+
+func TestUpdateBucketCmd_Validation(t *testing.T) {
+	defer testutil.ResetCommandFlags(updateBucketCmd)
+
+	tests := []struct {
+		name  string
+		flags map[string]string
+		want  string
+	}{
+		{"no flags", nil, "nothing to update"},
+		{"retention too low", map[string]string{"retention": "0"}, "between 1 and 3657"},
+		{"retention too high", map[string]string{"retention": "4000"}, "between 1 and 3657"},
+		{"empty display name", map[string]string{"display-name": ""}, "must not be empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.ResetCommandFlags(updateBucketCmd)
+			for k, v := range tt.flags {
+				_ = updateBucketCmd.Flags().Set(k, v)
+			}
+			err := updateBucketCmd.RunE(updateBucketCmd, []string{"custom_logs"})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dynatrace-oss/dtctl/pkg/output"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/edgeconnect"
+	"github.com/dynatrace-oss/dtctl/pkg/safety"
+)
+
+// updateEdgeConnectCmd updates an EdgeConnect in place
+var updateEdgeConnectCmd = &cobra.Command{
+	Use:     "edgeconnect <id>",
+	Aliases: []string{"edgeconnects", "ec"},
+	Short:   "Update an EdgeConnect configuration",
+	Long: `Update an existing EdgeConnect configuration in place.
+
+Changing host patterns keeps the EdgeConnect ID and its OAuth client, so
+running EdgeConnect instances do not need new credentials. Use --rotate-secret
+to regenerate the OAuth client; the new secret is printed once.
+
+Examples:
+  # Replace the host patterns
+  dtctl update edgeconnect <id> --host-patterns "*.internal.example.com,api.example.com"
+
+  # Regenerate the OAuth client credentials
+  dtctl update edgeconnect <id> --rotate-secret
+
+  # Preview the change
+  dtctl update edgeconnect <id> --host-patterns "*.example.com" --dry-run
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id := args[0]
+		hostPatterns, _ := cmd.Flags().GetString("host-patterns")
+		rotate, _ := cmd.Flags().GetBool("rotate-secret")
+		patternsSet := cmd.Flags().Changed("host-patterns")
+
+		if !patternsSet && !rotate {
+			return fmt.Errorf("nothing to update: pass --host-patterns and/or --rotate-secret")
+		}
+
+		var patterns []string
+		if hostPatterns != "" {
+			patterns = strings.Split(hostPatterns, ",")
+			for i := range patterns {
+				patterns[i] = strings.TrimSpace(patterns[i])
+			}
+		}
+
+		if dryRun {
+			fmt.Printf("Dry run: would update EdgeConnect %s\n", id)
+			if patternsSet {
+				fmt.Printf("Host Patterns: %s\n", strings.Join(patterns, ", "))
+			}
+			if rotate {
+				fmt.Printf("OAuth client: would be regenerated\n")
+			}
+			return nil
+		}
+
+		_, c, err := SetupWithSafety(safety.OperationUpdate)
+		if err != nil {
+			return err
+		}
+
+		handler := edgeconnect.NewHandler(c)
+
+		if patternsSet {
+			existing, err := handler.Get(id)
+			if err != nil {
+				return err
+			}
+			existing.HostPatterns = patterns
+			// Server-managed fields are not part of the update payload.
+			existing.ModificationInfo = nil
+			existing.Metadata = nil
+			if err := handler.Update(id, *existing); err != nil {
+				return fmt.Errorf("failed to update EdgeConnect: %w", err)
+			}
+			output.PrintSuccess("EdgeConnect %q updated (ID: %s)", existing.Name, id)
+		}
+
+		if rotate {
+			result, err := handler.RotateSecret(id)
+			if err != nil {
+				return fmt.Errorf("failed to rotate EdgeConnect secret: %w", err)
+			}
+			output.PrintSuccess("EdgeConnect %q OAuth client regenerated (ID: %s)", result.Name, id)
+			output.PrintInfo("\nOAuth Client Credentials (save these, the secret won't be shown again):")
+			output.PrintInfo("  Client ID:     %s", result.OAuthClientID)
+			output.PrintInfo("  Client Secret: %s", result.OAuthClientSecret)
+			if result.OAuthClientResource != "" {
+				output.PrintInfo("  Resource:      %s", result.OAuthClientResource)
+			}
+			output.PrintHint("Update the credentials of running EdgeConnect instances.")
+		}
+		return nil
+	},
+}
+
+func init() {
+	updateCmd.AddCommand(updateEdgeConnectCmd)
+
+	updateEdgeConnectCmd.Flags().String("host-patterns", "", "comma-separated list of host patterns (replaces the existing list)")
+	updateEdgeConnectCmd.Flags().Bool("rotate-secret", false, "regenerate the OAuth client credentials and print them once")
+}
@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dynatrace-oss/dtctl/pkg/output"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/lookup"
+	"github.com/dynatrace-oss/dtctl/pkg/safety"
+)
+
+// updateLookupCmd replaces or appends to the data of an existing lookup table
+var updateLookupCmd = &cobra.Command{
+	Use:     "lookup <path> -f <file> --lookup-field <field> [--append|--replace]",
+	Aliases: []string{"lookups", "lkup", "lu"},
+	Short:   "Replace or append to the data of a lookup table",
+	Long: `Modify an existing lookup table in place, keeping its path.
+
+By default (--replace) the table's data is replaced with the file's content.
+With --append the current records are loaded and the CSV rows from the file are
+added to them; rows whose lookup field matches an existing record replace that
+record. The file's header must include every column of the existing table.
+
+The display name and description are kept unless you pass new ones.
+
+Examples:
+  # Replace the data of a lookup table
+  dtctl update lookup /lookups/grail/pm/error_codes -f error_codes.csv --lookup-field code
+
+  # Add new rows (and update changed ones) without re-uploading the whole table
+  dtctl update lookup /lookups/grail/pm/error_codes -f new_codes.csv --lookup-field code --append
+
+  # Preview the change
+  dtctl update lookup /lookups/grail/pm/error_codes -f new_codes.csv --lookup-field code --append --dry-run
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path := args[0]
+		file, _ := cmd.Flags().GetString("file")
+		appendRows, _ := cmd.Flags().GetBool("append")
+		replace, _ := cmd.Flags().GetBool("replace")
+		lookupField, _ := cmd.Flags().GetString("lookup-field")
+		parsePattern, _ := cmd.Flags().GetString("parse-pattern")
+
+		if file == "" {
+			return fmt.Errorf("--file is required")
+		}
+		if appendRows && replace {
+			return fmt.Errorf("--append and --replace cannot be used together")
+		}
+		if lookupField == "" {
+			return fmt.Errorf("--lookup-field is required (name of the key field)")
+		}
+		if appendRows && parsePattern != "" {
+			return fmt.Errorf("--parse-pattern cannot be used with --append (only CSV data can be appended)")
+		}
+		if err := lookup.ValidatePath(path); err != nil {
+			return err
+		}
+
+		fileData, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read file: %w", err)
+		}
+
+		req := lookup.CreateRequest{
+			LookupField:  lookupField,
+			ParsePattern: parsePattern,
+			DataContent:  fileData,
+		}
+		req.DisplayName, _ = cmd.Flags().GetString("display-name")
+		req.Description, _ = cmd.Flags().GetString("description")
+		req.SkippedRecords, _ = cmd.Flags().GetInt("skip-records")
+		req.Timezone, _ = cmd.Flags().GetString("timezone")
+		req.Locale, _ = cmd.Flags().GetString("locale")
+
+		mode := "replace"
+		if appendRows {
+			mode = "append to"
+		}
+
+		if dryRun {
+			fmt.Printf("Dry run: would %s lookup table %s\n", mode, path)
+			fmt.Printf("Lookup Field: %s\n", req.LookupField)
+			fmt.Printf("File Size: %d bytes\n", len(fileData))
+			return nil
+		}
+
+		_, c, err := SetupWithSafety(safety.OperationUpdate)
+		if err != nil {
+			return err
+		}
+
+		handler := lookup.NewHandler(c)
+
+		existing, err := handler.Metadata(path)
+		if err != nil {
+			return err
+		}
+		if !cmd.Flags().Changed("display-name") {
+			req.DisplayName = existing.DisplayName
+		}
+		if !cmd.Flags().Changed("description") {
+			req.Description = existing.Description
+		}
+
+		var result *lookup.UploadResponse
+		if appendRows {
+			result, err = handler.Append(path, req)
+		} else {
+			result, err = handler.Update(path, req)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update lookup table: %w", err)
+		}
+
+		output.PrintSuccess("Lookup table %q updated", path)
+		output.PrintInfo("  Records: %d -> %d", existing.Records, result.Records)
+		output.PrintInfo("  File Size: %d bytes", result.FileSize)
+		if result.DiscardedDuplicates > 0 {
+			output.PrintInfo("  Note: %d duplicate records were discarded", result.DiscardedDuplicates)
+		}
+		return nil
+	},
+}
+
+func init() {
+	updateCmd.AddCommand(updateLookupCmd)
+
+	updateLookupCmd.Flags().StringP("file", "f", "", "path to the data file (required)")
+	updateLookupCmd.Flags().Bool("append", false, "add the file's CSV rows to the existing records")
+	updateLookupCmd.Flags().Bool("replace", false, "replace the existing records with the file's content (default)")
+	updateLookupCmd.Flags().String("lookup-field", "", "name of the lookup key field")
+	updateLookupCmd.Flags().String("display-name", "", "new display name (default: keep current)")
+	updateLookupCmd.Flags().String("description", "", "new description (default: keep current)")
+	updateLookupCmd.Flags().String("parse-pattern", "", "custom DPL parse pattern (auto-detected for CSV; not with --append)")
+	updateLookupCmd.Flags().Int("skip-records", 0, "number of records to skip (e.g., 1 for CSV headers)")
+	updateLookupCmd.Flags().String("timezone", "UTC", "timezone for parsing time/date fields")
+	updateLookupCmd.Flags().String("locale", "en_US", "locale for parsing locale-specific data")
+}
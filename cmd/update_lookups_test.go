@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+)
+
+func TestUpdateLookupCmd_Append(t *testing.T) {
+	var uploaded string
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/storage/query/v1/query:execute": func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Query string `json:"query"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			w.Header().Set("Content-Type", "application/json")
+			if strings.HasPrefix(body.Query, "fetch dt.system.files") {
+				_, _ = w.Write([]byte(`{"state":"SUCCEEDED","result":{"records":[{"name":"/lookups/codes","display_name":"Codes","records":"1"}]}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"state":"SUCCEEDED","result":{"records":[{"code":"E1","message":"old"}]}}`))
+		},
+		"/platform/storage/resource-store/v1/files/tabular/lookup:upload": func(w http.ResponseWriter, r *http.Request) {
+			data, _ := io.ReadAll(r.Body)
+			uploaded = string(data)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"records":2}`))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile := cfgFile
+	defer func() {
+		testutil.ResetCommandFlags(updateLookupCmd)
+		cfgFile = origCfgFile
+	}()
+	cfgFile = configPath
+
+	file := filepath.Join(t.TempDir(), "new.csv")
+	if err := os.WriteFile(file, []byte("code,message\nE2,added\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.ResetCommandFlags(updateLookupCmd)
+	_ = updateLookupCmd.Flags().Set("file", file)
+	_ = updateLookupCmd.Flags().Set("lookup-field", "code")
+	_ = updateLookupCmd.Flags().Set("append", "true")
+	if err := updateLookupCmd.RunE(updateLookupCmd, []string{"/lookups/codes"}); err != nil {
+		t.Fatalf("RunE() error = %v", err)
+	}
+	for _, want := range []string{"E1,old\nE2,added", `"displayName":"Codes"`, `"overwrite":true`} {
+		if !strings.Contains(uploaded, want) {
+			t.Errorf("upload missing %q:\n%s", want, uploaded)
+		}
+	}
+}
+
+func TestUpdateLookupCmd_FlagValidation(t *testing.T) {
+	defer testutil.ResetCommandFlags(updateLookupCmd)
+
+	tests := []struct {
+		name    string
+		flags   map[string]string
+		wantErr string
+	}{
+		{"no file", map[string]string{"lookup-field": "code"}, "--file is required"},
+		{"append and replace", map[string]string{"file": "x.csv", "lookup-field": "code", "append": "true", "replace": "true"}, "cannot be used together"},
+		{"no lookup field", map[string]string{"file": "x.csv"}, "--lookup-field is required"},
+		{"append with pattern", map[string]string{"file": "x.csv", "lookup-field": "code", "append": "true", "parse-pattern": "LD:code"}, "--parse-pattern cannot be used with --append"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.ResetCommandFlags(updateLookupCmd)
+			for k, v := range tt.flags {
+				_ = updateLookupCmd.Flags().Set(k, v)
+			}
+			err := updateLookupCmd.RunE(updateLookupCmd, []string{"/lookups/codes"})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
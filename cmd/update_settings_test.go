@@ -1,8 +1,12 @@
 package cmd
 
 import (
+	"encoding/json"
+	"net/http"
 	"strings"
 	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
 )
 
 func TestUpdateSettingsRedirectsToApply(t *testing.T) {
@@ -32,3 +36,76 @@ func TestUpdateSettingsRedirectsToApply(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateSettingsPatch(t *testing.T) {
+	var putBody map[string]interface{}
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/classic/environment-api/v2/settings/objects/obj-1": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.Method {
+			case http.MethodGet:
+				_, _ = w.Write([]byte(`{"objectId":"obj-1","schemaId":"builtin:alerting.profile","scope":"environment","version":"v1",` +
+					`"value":{"name":"Default","enabled":true,"filters":{"tags":["a"],"severity":"high"}}}`))
+			case http.MethodPut:
+				_ = json.NewDecoder(r.Body).Decode(&putBody)
+				_, _ = w.Write([]byte(`{"objectId":"obj-1"}`))
+			default:
+				t.Errorf("unexpected method %s", r.Method)
+			}
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile := cfgFile
+	origPlain := plainMode
+	defer func() {
+		testutil.ResetCommandFlags(updateSettingsCmd)
+		cfgFile = origCfgFile
+		plainMode = origPlain
+	}()
+
+	testutil.ResetCommandFlags(updateSettingsCmd)
+	cfgFile = configPath
+	plainMode = true
+	_ = updateSettingsCmd.Flags().Set("patch", `{"value":{"enabled":false,"filters":{"severity":null}}}`)
+
+	if err := updateSettingsCmd.RunE(updateSettingsCmd, []string{"obj-1"}); err != nil {
+		t.Fatalf("RunE() error = %v", err)
+	}
+
+	value, _ := putBody["value"].(map[string]interface{})
+	if value["name"] != "Default" || value["enabled"] != false {
+		t.Errorf("expected patched value to keep name and disable, got %v", value)
+	}
+	filters, _ := value["filters"].(map[string]interface{})
+	if _, ok := filters["severity"]; ok || filters["tags"] == nil {
+		t.Errorf("expected severity removed and tags kept, got %v", filters)
+	}
+}
+
+func TestUpdateSettingsPatch_InvalidPatch(t *testing.T) {
+	defer testutil.ResetCommandFlags(updateSettingsCmd)
+
+	tests := []struct {
+		name  string
+		patch string
+		want  string
+	}{
+		{"not json", `{enabled:false}`, "must be a JSON object"},
+		{"other top-level key", `{"scope":"environment"}`, `only "value" can be patched`},
+		{"value not object", `{"value":true}`, `"value" must be a JSON object`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.ResetCommandFlags(updateSettingsCmd)
+			_ = updateSettingsCmd.Flags().Set("patch", tt.patch)
+			err := updateSettingsCmd.RunE(updateSettingsCmd, []string{"obj-1"})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
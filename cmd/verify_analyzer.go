@@ -116,7 +116,7 @@ func getAnalyzerValidateExitCode(result *analyzer.ValidationResult, err error) i
 
 // formatAnalyzerValidateHuman prints the validation verdict in human-readable form.
 func formatAnalyzerValidateHuman(name string, result *analyzer.ValidationResult) {
-	useColor := isStderrTerminal()
+	useColor := output.StderrColorEnabled()
 	if result != nil && result.Valid {
 		if useColor {
 			fmt.Fprintf(os.Stderr, "%s✔%s Input is valid for %s\n", colorGreen, colorReset, name)
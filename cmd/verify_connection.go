@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dynatrace-oss/dtctl/pkg/apply"
+	"github.com/dynatrace-oss/dtctl/pkg/output"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/azureconnection"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/gcpconnection"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/settings"
+)
+
+// ConnectionVerifyResult is the structured output of `verify connection`.
+type ConnectionVerifyResult struct {
+	ObjectID string `json:"objectId" yaml:"objectId"`
+	Provider string `json:"provider" yaml:"provider"`
+	Name     string `json:"name,omitempty" yaml:"name,omitempty"`
+	Type     string `json:"type,omitempty" yaml:"type,omitempty"`
+	Valid    bool   `json:"valid" yaml:"valid"`
+	Error    string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// verifyConnectionCmd checks that a stored Azure or GCP connection works
+var verifyConnectionCmd = &cobra.Command{
+	Use:   "connection <object-id>",
+	Short: "Verify Azure or GCP connection credentials",
+	Long: `Verify that a stored Azure or GCP connection has working credentials.
+
+The connection's current value is re-validated by the Settings API
+(validateOnly), which runs the same provider check that rejects broken
+credentials on update, without changing the connection. For Azure federated
+identity connections with incomplete setup, the Azure CLI steps to finish it
+are printed.
+
+Examples:
+  # Verify a connection by object ID
+  dtctl verify connection vu9U3hXa3q0AAAABAC...
+
+  # Structured result for CI
+  dtctl verify connection vu9U3hXa3q0AAAABAC... -o json
+
+Exit Codes:
+  0 - Connection verified
+  1 - Verification failed or the connection could not be read
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		objectID := args[0]
+
+		if !isSupportedVerifyOutputFormat(outputFormat) {
+			return fmt.Errorf("unsupported output format %q for verify connection (supported: json, yaml, toon)", outputFormat)
+		}
+
+		_, c, err := SetupClient()
+		if err != nil {
+			return err
+		}
+
+		obj, err := settings.NewHandler(c).Get(objectID)
+		if err != nil {
+			return err
+		}
+
+		result := ConnectionVerifyResult{ObjectID: objectID}
+		var verifyErr error
+		var hint func() // printed to stdout, so only for human output
+		switch obj.SchemaID {
+		case azureconnection.SchemaID:
+			result.Provider = "azure"
+			conn, err := azureconnection.NewHandler(c).Verify(objectID)
+			if conn != nil {
+				result.Name, result.Type = conn.Name, conn.Type
+			}
+			if err != nil && conn != nil {
+				if hinted := apply.ExplainAzureConnectionError(c.BaseURL(), objectID, conn.Value, "", err); hinted != nil {
+					err = hinted
+				}
+			}
+			verifyErr = err
+		case gcpconnection.SchemaID:
+			result.Provider = "gcp"
+			handler := gcpconnection.NewHandler(c)
+			conn, err := handler.Verify(objectID)
+			if conn != nil {
+				result.Name, result.Type = conn.Name, conn.Type
+			}
+			if errors.Is(err, gcpconnection.ErrServiceAccountMissing) {
+				hint = func() { printGCPPrincipalHint(handler, "") }
+			}
+			verifyErr = err
+		default:
+			return fmt.Errorf("object %s is not an Azure or GCP connection (schema %s)", objectID, obj.SchemaID)
+		}
+
+		result.Valid = verifyErr == nil
+		if verifyErr != nil {
+			result.Error = verifyErr.Error()
+		}
+
+		switch outputFormat {
+		case "json", "yaml", "yml", "toon":
+			if err := NewPrinter().Print(result); err != nil {
+				return err
+			}
+		default:
+			if result.Valid {
+				output.PrintSuccess("%s connection %q verified", result.Provider, result.Name)
+			} else if hint != nil {
+				hint()
+			}
+		}
+
+		if verifyErr != nil {
+			return fmt.Errorf("%s connection %q failed verification: %w", result.Provider, result.Name, verifyErr)
+		}
+		return nil
+	},
+}
+
+func init() {
+	verifyCmd.AddCommand(verifyConnectionCmd)
+}
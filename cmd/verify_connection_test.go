@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+	"github.com/dynatrace-oss/dtctl/pkg/resources/gcpconnection"
+)
+
+func TestVerifyConnection_GCP(t *testing.T) {
+	const objectID = "gcp-obj-1"
+	stored := `{"objectId":"` + objectID + `","schemaId":"` + gcpconnection.SchemaID + `","schemaVersion":"0.1",` +
+		`"value":{"name":"prod-gcp","type":"serviceAccountImpersonation","serviceAccountImpersonation":{"serviceAccountId":"sa@p.iam.gserviceaccount.com","consumers":["SVC:com.dynatrace.da"]}}}`
+
+	var validateOnly string
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/classic/environment-api/v2/settings/objects/" + objectID: func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.Method == http.MethodPut {
+				validateOnly = r.URL.Query().Get("validateOnly")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":{"message":"permission iam.serviceAccounts.getAccessToken denied"}}`))
+				return
+			}
+			_, _ = w.Write([]byte(stored))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile := cfgFile
+	origOutput := outputFormat
+	origAgent := agentMode
+	defer func() {
+		testutil.ResetCommandFlags(verifyConnectionCmd)
+		cfgFile = origCfgFile
+		outputFormat = origOutput
+		agentMode = origAgent
+	}()
+
+	testutil.ResetCommandFlags(verifyConnectionCmd)
+	cfgFile = configPath
+	outputFormat = "json"
+	agentMode = false
+
+	var runErr error
+	out := captureStdout(t, func() {
+		runErr = verifyConnectionCmd.RunE(verifyConnectionCmd, []string{objectID})
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "getAccessToken denied") {
+		t.Fatalf("expected verification failure, got %v", runErr)
+	}
+	if validateOnly != "true" {
+		t.Errorf("expected a validateOnly PUT, got validateOnly=%q", validateOnly)
+	}
+
+	var result ConnectionVerifyResult
+	if err := json.Unmarshal([]byte(out), &result); err != nil {
+		t.Fatalf("stdout is not JSON: %v\n%s", err, out)
+	}
+	if result.Provider != "gcp" || result.Name != "prod-gcp" || result.Valid {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
@@ -235,9 +235,37 @@ Examples:
 	},
 }
 
+// explainQuery verifies query with the canonical form requested and prints
+// the result without executing it (query --explain). Structured formats print
+// the full verify response; anything else prints the human-readable summary.
+// An invalid query is returned as an error so scripts get a non-zero exit.
+func explainQuery(executor *exec.DQLExecutor, query string, opts exec.DQLVerifyOptions, format string) error {
+	opts.GenerateCanonicalQuery = true
+	result, err := executor.VerifyQuery(query, opts)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case "json", "yaml", "yml", "toon":
+		if err := output.NewPrinter(format).Print(result); err != nil {
+			return fmt.Errorf("failed to print output: %w", err)
+		}
+	default:
+		if err := formatVerifyResultHuman(result, query, true); err != nil {
+			return fmt.Errorf("failed to format output: %w", err)
+		}
+	}
+
+	if !result.Valid {
+		return fmt.Errorf("query is invalid")
+	}
+	return nil
+}
+
 // formatVerifyResultHuman prints verification results in human-readable format
 func formatVerifyResultHuman(result *exec.DQLVerifyResponse, query string, showCanonical bool) error {
-	useColor := isStderrTerminal()
+	useColor := output.StderrColorEnabled()
 
 	// Print validation status
 	if result.Valid {
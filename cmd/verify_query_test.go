@@ -2,10 +2,13 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
+	"net/http"
 	"os"
 	"strings"
 	"testing"
 
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
 	"github.com/dynatrace-oss/dtctl/pkg/exec"
 	"github.com/dynatrace-oss/dtctl/pkg/output"
 )
@@ -383,3 +386,51 @@ func TestVerifyQuery_StructuredOutputFormats(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryExplain_DoesNotExecute(t *testing.T) {
+	var verifyBody map[string]interface{}
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		"/platform/storage/query/v1/query:verify": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&verifyBody)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"valid":false,"canonicalQuery":"fetch logs\n| limitt 10","notifications":[` +
+				`{"severity":"ERROR","notificationType":"UNKNOWN_COMMAND","message":"unknown command limitt",` +
+				`"syntaxPosition":{"start":{"line":1,"column":14},"end":{"line":1,"column":19}}}]}`))
+		},
+		"/platform/storage/query/v1/query:execute": func(w http.ResponseWriter, r *http.Request) {
+			t.Error("query must not be executed with --explain")
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile := cfgFile
+	origOutput := outputFormat
+	defer func() {
+		testutil.ResetCommandFlags(queryCmd)
+		cfgFile = origCfgFile
+		outputFormat = origOutput
+	}()
+
+	testutil.ResetCommandFlags(queryCmd)
+	cfgFile = configPath
+	outputFormat = "json"
+	_ = queryCmd.Flags().Set("explain", "true")
+
+	var runErr error
+	out := captureStdout(t, func() {
+		runErr = queryCmd.RunE(queryCmd, []string{"fetch logs | limitt 10"})
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "invalid") {
+		t.Errorf("expected invalid query error, got %v", runErr)
+	}
+	if verifyBody["generateCanonicalQuery"] != true {
+		t.Errorf("expected canonical query to be requested, got %v", verifyBody)
+	}
+	if !strings.Contains(out, "canonicalQuery") || !strings.Contains(out, "UNKNOWN_COMMAND") {
+		t.Errorf("expected verify response on stdout, got %q", out)
+	}
+}
@@ -2,24 +2,128 @@ package cmd
 
 import (
 	"fmt"
+	"runtime"
 
 	"github.com/spf13/cobra"
 
 	"github.com/dynatrace-oss/dtctl/pkg/version"
 )
 
+// clusterVersionPath reports the platform version of a Dynatrace environment.
+const clusterVersionPath = "/platform/classic/environment-api/v1/config/clusterversion"
+
+// VersionInfo is the structured output of `dtctl version`.
+type VersionInfo struct {
+	Version   string             `json:"version" yaml:"version"`
+	Commit    string             `json:"commit" yaml:"commit"`
+	BuildDate string             `json:"buildDate" yaml:"buildDate"`
+	GoVersion string             `json:"goVersion" yaml:"goVersion"`
+	Platform  string             `json:"platform" yaml:"platform"`
+	Server    *ServerVersionInfo `json:"server,omitempty" yaml:"server,omitempty"`
+}
+
+// ServerVersionInfo describes the environment of the current context.
+type ServerVersionInfo struct {
+	Environment string `json:"environment" yaml:"environment"`
+	Version     string `json:"version,omitempty" yaml:"version,omitempty"`
+	Error       string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
 // versionCmd represents the version command
 var versionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "Print version information",
-	Long:  `Print the version, commit, and build date of dtctl.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		fmt.Printf("dtctl version %s\n", version.Version)
-		fmt.Printf("commit: %s\n", version.Commit)
-		fmt.Printf("built: %s\n", version.Date)
+	Long: `Print the version, commit, build date, and Go version of dtctl, and the
+platform version of the environment in the current context.
+
+The environment lookup is best-effort: if no context is configured or the
+request fails, the client version is still printed. Use --client-only to skip
+it entirely (e.g. in CI).
+
+Examples:
+  # Client and environment version
+  dtctl version
+
+  # Client version only, as JSON
+  dtctl version --client-only -o json
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		info := clientVersionInfo()
+		if clientOnly, _ := cmd.Flags().GetBool("client-only"); !clientOnly {
+			info.Server = serverVersionInfo()
+		}
+
+		switch outputFormat {
+		case "", "table", "wide":
+			printVersionInfo(info)
+			return nil
+		default:
+			return NewPrinter().Print(info)
+		}
 	},
 }
 
+func clientVersionInfo() VersionInfo {
+	return VersionInfo{
+		Version:   version.Version,
+		Commit:    version.Commit,
+		BuildDate: version.Date,
+		GoVersion: runtime.Version(),
+		Platform:  runtime.GOOS + "/" + runtime.GOARCH,
+	}
+}
+
+// serverVersionInfo asks the current context's environment for its platform
+// version. It returns nil when no context is configured.
+func serverVersionInfo() *ServerVersionInfo {
+	cfg, err := LoadConfig()
+	if err != nil || cfg.CurrentContext == "" {
+		return nil
+	}
+	ctx, err := cfg.CurrentContextObj()
+	if err != nil {
+		return nil
+	}
+
+	server := &ServerVersionInfo{Environment: ctx.Environment}
+	c, err := NewClientFromConfig(cfg)
+	if err != nil {
+		server.Error = err.Error()
+		return server
+	}
+
+	var result struct {
+		Version string `json:"version"`
+	}
+	resp, err := c.HTTP().R().SetResult(&result).Get(clusterVersionPath)
+	switch {
+	case err != nil:
+		server.Error = err.Error()
+	case resp.IsError():
+		server.Error = fmt.Sprintf("failed to get platform version: %s", resp.Status())
+	default:
+		server.Version = result.Version
+	}
+	return server
+}
+
+func printVersionInfo(info VersionInfo) {
+	fmt.Printf("dtctl version %s\n", info.Version)
+	fmt.Printf("commit: %s\n", info.Commit)
+	fmt.Printf("built: %s\n", info.BuildDate)
+	fmt.Printf("go: %s (%s)\n", info.GoVersion, info.Platform)
+	if info.Server == nil {
+		return
+	}
+	fmt.Printf("environment: %s\n", info.Server.Environment)
+	if info.Server.Error != "" {
+		fmt.Printf("platform version: unavailable (%s)\n", info.Server.Error)
+		return
+	}
+	fmt.Printf("platform version: %s\n", info.Server.Version)
+}
+
 func init() {
 	rootCmd.AddCommand(versionCmd)
+	versionCmd.Flags().Bool("client-only", false, "print the client version only, without contacting the environment")
 }
@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"runtime"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/cmd/testutil"
+)
+
+func TestVersion_JSON(t *testing.T) {
+	ms := testutil.NewMockServer(t, map[string]http.HandlerFunc{
+		clusterVersionPath: func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"version":"1.320.0.20260901-120000"}`))
+		},
+	})
+	defer ms.Close()
+
+	configPath, cleanup := testutil.SetupTestConfig(t, ms.URL)
+	defer cleanup()
+
+	origCfgFile := cfgFile
+	origOutput := outputFormat
+	origAgent := agentMode
+	defer func() {
+		testutil.ResetCommandFlags(versionCmd)
+		cfgFile = origCfgFile
+		outputFormat = origOutput
+		agentMode = origAgent
+	}()
+
+	tests := []struct {
+		name       string
+		clientOnly bool
+		wantServer string
+	}{
+		{"with environment", false, "1.320.0.20260901-120000"},
+		{"client only", true, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testutil.ResetCommandFlags(versionCmd)
+			cfgFile = configPath
+			outputFormat = "json"
+			agentMode = false
+			if tt.clientOnly {
+				_ = versionCmd.Flags().Set("client-only", "true")
+			}
+
+			out := captureStdout(t, func() {
+				if err := versionCmd.RunE(versionCmd, nil); err != nil {
+					t.Fatalf("RunE() error = %v", err)
+				}
+			})
+
+			var info VersionInfo
+			if err := json.Unmarshal([]byte(out), &info); err != nil {
+				t.Fatalf("stdout is not JSON: %v\n%s", err, out)
+			}
+			if info.GoVersion != runtime.Version() || info.Version == "" {
+				t.Errorf("unexpected client info: %+v", info)
+			}
+			if tt.wantServer == "" {
+				if info.Server != nil {
+					t.Errorf("expected no server info, got %+v", info.Server)
+				}
+				return
+			}
+			if info.Server == nil || info.Server.Version != tt.wantServer {
+				t.Errorf("server = %+v, want version %q", info.Server, tt.wantServer)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/dynatrace-oss/dtctl/pkg/config"
+)
+
+// resolveView expands 'get --view <name>' into the get invocation saved under
+// that name in the config's views section. Flags given on the command line
+// are appended after the view's own, so they take precedence. Returns nil when
+// the args do not use --view.
+func resolveView(args []string, cfg *config.Config) ([]string, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	// Locate the verb, skipping leading global flags and their values.
+	verb := -1
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") {
+			verb = i
+			break
+		}
+		if (flagsTakingValues[arg] || shortFlagsTakingValues[arg]) && i+1 < len(args) {
+			i++
+		}
+	}
+	if verb < 0 || args[verb] != "get" {
+		return nil, nil
+	}
+
+	name := ""
+	found := false
+	rest := make([]string, 0, len(args))
+	for i := verb + 1; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--":
+			rest = append(rest, args[i:]...)
+			i = len(args)
+		case arg == "--view":
+			if i+1 >= len(args) {
+				return nil, fmt.Errorf("--view requires a view name")
+			}
+			name, found = args[i+1], true
+			i++
+		case strings.HasPrefix(arg, "--view="):
+			name, found = strings.TrimPrefix(arg, "--view="), true
+		default:
+			rest = append(rest, arg)
+		}
+	}
+	if !found {
+		return nil, nil
+	}
+
+	view, ok := cfg.GetView(name)
+	if !ok {
+		if names := cfg.ViewNames(); len(names) > 0 {
+			return nil, fmt.Errorf("view %q not found (available: %s)", name, strings.Join(names, ", "))
+		}
+		return nil, fmt.Errorf("view %q not found; create one with 'dtctl config set-view'", name)
+	}
+
+	expanded := append([]string{}, args[:verb]...)
+	expanded = append(expanded, view.Args()...)
+	return append(expanded, rest...), nil
+}
+
+// completeViewNames completes the names of saved views.
+func completeViewNames(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	var names []string
+	if cfg, err := config.Load(); err == nil {
+		names = cfg.ViewNames()
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
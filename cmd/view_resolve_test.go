@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dynatrace-oss/dtctl/pkg/config"
+)
+
+func TestResolveView(t *testing.T) {
+	cfg := config.NewConfig()
+	require.NoError(t, cfg.SetView("prod-wf", config.View{Resource: "workflows", Selector: "env=prod"}))
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantArgs []string
+		wantErr  string
+	}{
+		{
+			name:     "expands view",
+			args:     []string{"get", "--view", "prod-wf"},
+			wantArgs: []string{"get", "workflows", "--selector", "env=prod"},
+		},
+		{
+			name:     "inline value and extra flags",
+			args:     []string{"get", "--view=prod-wf", "-o", "json"},
+			wantArgs: []string{"get", "workflows", "--selector", "env=prod", "-o", "json"},
+		},
+		{
+			name:     "keeps leading global flags",
+			args:     []string{"--context", "prod", "get", "--view", "prod-wf"},
+			wantArgs: []string{"--context", "prod", "get", "workflows", "--selector", "env=prod"},
+		},
+		{
+			name: "no view flag",
+			args: []string{"get", "workflows"},
+		},
+		{
+			name: "other verb",
+			args: []string{"describe", "--view", "prod-wf"},
+		},
+		{
+			name:    "unknown view",
+			args:    []string{"get", "--view", "nope"},
+			wantErr: `view "nope" not found (available: prod-wf)`,
+		},
+		{
+			name:    "missing name",
+			args:    []string{"get", "--view"},
+			wantErr: "--view requires a view name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveView(tt.args, cfg)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantArgs, got)
+		})
+	}
+}
+
+func TestValidateView(t *testing.T) {
+	require.NoError(t, validateView(config.View{Resource: "wf", Filter: "deploy", Selector: "env=prod"}))
+	require.NoError(t, validateView(config.View{Resource: "dashboards", Sort: "name"}))
+	require.ErrorContains(t, validateView(config.View{Resource: "nope"}), "unknown resource type")
+	require.ErrorContains(t, validateView(config.View{Resource: "workflows", Sort: "title"}), "does not support --sort")
+}
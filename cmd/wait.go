@@ -169,6 +169,10 @@ Examples:
 		fetchTimeoutSeconds, _ := cmd.Flags().GetInt32("fetch-timeout-seconds")
 		defaultTimeframeStart, _ := cmd.Flags().GetString("default-timeframe-start")
 		defaultTimeframeEnd, _ := cmd.Flags().GetString("default-timeframe-end")
+		defaultTimeframeStart, defaultTimeframeEnd, err = resolveDefaultTimeframe(defaultTimeframeStart, defaultTimeframeEnd)
+		if err != nil {
+			return err
+		}
 		locale, _ := cmd.Flags().GetString("locale")
 		timezone, _ := cmd.Flags().GetString("timezone")
 
@@ -264,8 +268,8 @@ func init() {
 	waitQueryCmd.Flags().Float64("default-scan-limit-gbytes", 0, "scan limit in gigabytes")
 	waitQueryCmd.Flags().Float64("default-sampling-ratio", 0, "default sampling ratio")
 	waitQueryCmd.Flags().Int32("fetch-timeout-seconds", 0, "time limit for fetching data in seconds")
-	waitQueryCmd.Flags().String("default-timeframe-start", "", "query timeframe start (ISO-8601/RFC3339)")
-	waitQueryCmd.Flags().String("default-timeframe-end", "", "query timeframe end (ISO-8601/RFC3339)")
+	waitQueryCmd.Flags().String("default-timeframe-start", "", "query timeframe start (ISO-8601/RFC3339, now-2h, or a now()-based DQL expression)")
+	waitQueryCmd.Flags().String("default-timeframe-end", "", "query timeframe end (ISO-8601/RFC3339, now-1h, or a now()-based DQL expression)")
 	waitQueryCmd.Flags().String("locale", "", "query locale (e.g., 'en_US', 'de_DE')")
 	waitQueryCmd.Flags().String("timezone", "", "query timezone (e.g., 'UTC', 'Europe/Paris')")
 }
@@ -8,9 +8,13 @@ import (
 	"io"
 	"os"
 	"regexp"
+	"strings"
+	"time"
 
 	"github.com/dynatrace-oss/dtctl/pkg/client"
 	"github.com/dynatrace-oss/dtctl/pkg/hook"
+	"github.com/dynatrace-oss/dtctl/pkg/labels"
+	"github.com/dynatrace-oss/dtctl/pkg/output"
 	"github.com/dynatrace-oss/dtctl/pkg/resources/anomalydetector"
 	"github.com/dynatrace-oss/dtctl/pkg/resources/azureconnection"
 	"github.com/dynatrace-oss/dtctl/pkg/resources/gcpconnection"
@@ -23,16 +27,22 @@ import (
 var uuidRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
 
 // stderrWarn writes a note to stderr and appends it to the warnings slice.
+// With structured warnings the note is emitted as a warning object instead.
 func stderrWarn(warnings *[]string, format string, args ...interface{}) {
 	msg := fmt.Sprintf(format, args...)
-	fmt.Fprintf(os.Stderr, "Note: %s\n", msg)
+	if output.StructuredWarnings() {
+		output.PrintWarning("%s", msg)
+	} else {
+		fmt.Fprintf(os.Stderr, "Note: %s\n", msg)
+	}
 	if warnings != nil {
 		*warnings = append(*warnings, msg)
 	}
 }
 
-// isUUID checks if a string is a UUID format
-func isUUID(s string) bool {
+// IsUUID reports whether s is UUID-formatted. The Documents API rejects such
+// IDs on create, so exported documents are created with a new ID instead.
+func IsUUID(s string) bool {
 	return uuidRegex.MatchString(s)
 }
 
@@ -140,6 +150,33 @@ type ApplyOptions struct {
 	NoHooks      bool   // skip pre-apply hooks
 	OverrideID   string // override or inject resource ID (from --id flag)
 	WriteID      bool   // write created resource ID back into the source file (from --write-id flag)
+	Strict       bool   // fail instead of warning on stale dashboard variable references (from --strict flag)
+	ServerDryRun bool   // with DryRun, have the API validate the change without persisting it (--dry-run=server)
+	Merge        bool   // merge the file into the existing settings value / document content as a JSON merge patch (--merge)
+	BaseDir      string // directory of the manifest; valueFrom.file references resolve inside it
+	Verify       bool   // re-validate Azure/GCP connections server-side after applying them (--verify)
+
+	// ForceConflicts retries document and SLO updates that fail with a
+	// version conflict, re-fetching the current version each time (from
+	// --force-conflicts).
+	ForceConflicts bool
+
+	// Labels, when set, are stamped together with the dtctl managed-by
+	// marker into the description of workflows, dashboards, and notebooks
+	// (from --label).
+	Labels labels.Labels
+
+	// Wait blocks until asynchronously provisioned resources (buckets) are
+	// ready, for at most WaitTimeout (from --wait / --wait-timeout).
+	Wait        bool
+	WaitTimeout time.Duration
+
+	// ResourceType, when set, bypasses detectResourceType (from --type).
+	ResourceType ResourceType
+
+	// InputFormat, when set, parses the file as JSON or YAML instead of
+	// detecting the format from its content (from --input-format).
+	InputFormat format.Format
 }
 
 // ResourceType represents the type of resource
@@ -160,34 +197,80 @@ const (
 	ResourceExtensionConfig       ResourceType = "extension_config"
 	ResourceSegment               ResourceType = "segment"
 	ResourceAnomalyDetector       ResourceType = "anomaly_detector"
+	ResourceEdgeConnect           ResourceType = "edgeconnect"
 	ResourceUnknown               ResourceType = "unknown"
 )
 
+// ResourceTypes lists the resource types apply can be told to use with --type.
+var ResourceTypes = []ResourceType{
+	ResourceWorkflow,
+	ResourceDashboard,
+	ResourceNotebook,
+	ResourceSLO,
+	ResourceBucket,
+	ResourceSettings,
+	ResourceAWSMonitoringConfig,
+	ResourceAzureConnection,
+	ResourceAzureMonitoringConfig,
+	ResourceGCPConnection,
+	ResourceGCPMonitoringConfig,
+	ResourceExtensionConfig,
+	ResourceSegment,
+	ResourceAnomalyDetector,
+	ResourceEdgeConnect,
+}
+
+// ParseResourceType resolves a --type value. Hyphens may be used in place of
+// underscores ("anomaly-detector").
+func ParseResourceType(s string) (ResourceType, error) {
+	name := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(s)), "-", "_")
+	for _, t := range ResourceTypes {
+		if string(t) == name {
+			return t, nil
+		}
+	}
+	valid := make([]string, len(ResourceTypes))
+	for i, t := range ResourceTypes {
+		valid[i] = string(t)
+	}
+	return ResourceUnknown, fmt.Errorf("unknown resource type %q (valid types: %s)", s, strings.Join(valid, ", "))
+}
+
 // Apply applies a resource configuration from file.
 // Returns a slice of results (most resource types return a single-element slice;
 // connection resources may return multiple results when applying a list).
 func (a *Applier) Apply(fileData []byte, opts ApplyOptions) ([]ApplyResult, error) {
 	// Convert to JSON if needed
-	jsonData, err := format.ValidateAndConvert(fileData)
+	jsonData, err := format.ConvertAs(fileData, opts.InputFormat)
 	if err != nil {
 		return nil, fmt.Errorf("invalid file format: %w", err)
 	}
 
 	// Apply template rendering if variables provided
 	if len(opts.TemplateVars) > 0 {
-		rendered, err := template.RenderTemplate(string(jsonData), opts.TemplateVars)
+		rendered, err := template.RenderJSONTemplate(string(jsonData), opts.TemplateVars)
 		if err != nil {
 			return nil, fmt.Errorf("template rendering failed: %w", err)
 		}
 		jsonData = []byte(rendered)
 	}
 
-	// Detect resource type
-	resourceType, isArray, err := detectResourceType(jsonData)
+	// Pull secrets referenced as valueFrom.file into the document
+	jsonData, err = ResolveValueFrom(jsonData, opts.BaseDir)
 	if err != nil {
 		return nil, err
 	}
 
+	// Detect resource type, unless --type named it
+	var resourceType ResourceType
+	var isArray bool
+	if opts.ResourceType != "" {
+		resourceType = opts.ResourceType
+		isArray = bytes.HasPrefix(bytes.TrimSpace(jsonData), []byte("["))
+	} else if resourceType, isArray, err = detectResourceType(jsonData); err != nil {
+		return nil, err
+	}
+
 	// Array input: split into individual elements and apply each one
 	if isArray {
 		return a.applyList(resourceType, jsonData, opts)
@@ -232,7 +315,7 @@ func (a *Applier) Apply(fileData []byte, opts ApplyOptions) ([]ApplyResult, erro
 	}
 
 	if opts.DryRun {
-		result, err := a.dryRun(resourceType, jsonData)
+		result, err := a.dryRun(resourceType, jsonData, opts)
 		if err != nil {
 			return nil, err
 		}
@@ -308,9 +391,9 @@ func (a *Applier) applySingle(resourceType ResourceType, jsonData []byte, opts A
 	// Connection resources can return multiple results
 	switch resourceType {
 	case ResourceAzureConnection:
-		return a.applyAzureConnection(jsonData)
+		return a.applyAzureConnection(jsonData, opts)
 	case ResourceGCPConnection:
-		return a.applyGCPConnection(jsonData)
+		return a.applyGCPConnection(jsonData, opts)
 	default:
 		// All other resource types return a single result
 	}
@@ -324,11 +407,11 @@ func (a *Applier) applySingle(resourceType ResourceType, jsonData []byte, opts A
 	case ResourceNotebook:
 		result, err = a.applyDocument(jsonData, "notebook", opts)
 	case ResourceSLO:
-		result, err = a.applySLO(jsonData)
+		result, err = a.applySLO(jsonData, opts)
 	case ResourceBucket:
-		result, err = a.applyBucket(jsonData)
+		result, err = a.applyBucket(jsonData, opts)
 	case ResourceSettings:
-		result, err = a.applySettings(jsonData)
+		result, err = a.applySettings(jsonData, opts)
 	case ResourceAWSMonitoringConfig:
 		result, err = a.applyAWSMonitoringConfig(jsonData)
 	case ResourceAzureMonitoringConfig:
@@ -341,6 +424,8 @@ func (a *Applier) applySingle(resourceType ResourceType, jsonData []byte, opts A
 		result, err = a.applySegment(jsonData)
 	case ResourceAnomalyDetector:
 		result, err = a.applyAnomalyDetector(jsonData)
+	case ResourceEdgeConnect:
+		result, err = a.applyEdgeConnect(jsonData)
 	default:
 		return nil, fmt.Errorf("unsupported resource type: %s", resourceType)
 	}
@@ -397,7 +482,7 @@ func (a *Applier) applyList(resourceType ResourceType, data []byte, opts ApplyOp
 
 	for i, elem := range elements {
 		if opts.DryRun {
-			r, err := a.dryRun(resourceType, elem)
+			r, err := a.dryRun(resourceType, elem, opts)
 			if err != nil {
 				errors = append(errors, fmt.Sprintf("item %d: %s", i+1, err))
 				continue
@@ -484,6 +569,12 @@ func detectResourceType(data []byte) (ResourceType, bool, error) {
 		return ResourceAWSMonitoringConfig, false, nil
 	}
 
+	// EdgeConnects have "hostPatterns". Checked before the document heuristics
+	// because `get edgeconnect -o yaml` output also carries "metadata".
+	if _, hasHostPatterns := raw["hostPatterns"]; hasHostPatterns {
+		return ResourceEdgeConnect, false, nil
+	}
+
 	// Check for explicit type field
 	if typeField, ok := raw["type"].(string); ok {
 		switch typeField {
@@ -627,12 +718,16 @@ func detectResourceType(data []byte) (ResourceType, bool, error) {
 		}
 	}
 
-	return ResourceUnknown, false, fmt.Errorf("could not detect resource type from file content")
+	return ResourceUnknown, false, &DetectionError{}
 }
 
 // dryRun validates what would be applied without actually applying.
 // Returns a DryRunResult with structured information about the planned operation.
-func (a *Applier) dryRun(resourceType ResourceType, data []byte) (ApplyResult, error) {
+func (a *Applier) dryRun(resourceType ResourceType, data []byte, opts ApplyOptions) (ApplyResult, error) {
+	if opts.ServerDryRun {
+		return a.serverDryRun(resourceType, data, opts)
+	}
+
 	var doc map[string]interface{}
 	if err := json.Unmarshal(data, &doc); err != nil {
 		return nil, fmt.Errorf("invalid JSON: %w", err)
@@ -640,7 +735,7 @@ func (a *Applier) dryRun(resourceType ResourceType, data []byte) (ApplyResult, e
 
 	// For documents, check if it would be create or update
 	if resourceType == ResourceDashboard || resourceType == ResourceNotebook {
-		return a.dryRunDocument(resourceType, doc)
+		return a.dryRunDocument(resourceType, doc, opts)
 	}
 
 	// Extension monitoring configs have specific fields
@@ -681,6 +776,19 @@ func (a *Applier) dryRun(resourceType ResourceType, data []byte) (ApplyResult, e
 	}, nil
 }
 
+// serverDryRun has the API validate a change without persisting it. Only
+// resource types whose API offers a validate-only mode are supported, which
+// today means settings: the SLO and bucket APIs have none, so those are
+// rejected rather than quietly previewed client-side.
+func (a *Applier) serverDryRun(resourceType ResourceType, data []byte, opts ApplyOptions) (ApplyResult, error) {
+	switch resourceType {
+	case ResourceSettings:
+		return a.validateSettings(data, opts)
+	default:
+		return nil, fmt.Errorf("server-side dry run is not supported for %s: its API has no validate-only mode (use --dry-run=client)", resourceType)
+	}
+}
+
 // capitalize capitalizes the first letter of a string
 func capitalize(s string) string {
 	if len(s) == 0 {
@@ -3,6 +3,7 @@ package apply
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"io"
 	"os"
 	"strings"
@@ -54,6 +55,16 @@ func TestDetectResourceType(t *testing.T) {
 			expected: ResourceDashboard,
 			wantErr:  false,
 		},
+		{
+			name: "edgeconnect from get output",
+			input: `{
+				"name": "my-edge",
+				"hostPatterns": ["*.internal.example.com"],
+				"metadata": {"version": "1"}
+			}`,
+			expected: ResourceEdgeConnect,
+			wantErr:  false,
+		},
 		{
 			name: "dashboard with metadata",
 			input: `{
@@ -291,9 +302,9 @@ func TestIsUUID(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := isUUID(tt.input)
+			result := IsUUID(tt.input)
 			if result != tt.expected {
-				t.Errorf("isUUID(%q) = %v, want %v", tt.input, result, tt.expected)
+				t.Errorf("IsUUID(%q) = %v, want %v", tt.input, result, tt.expected)
 			}
 		})
 	}
@@ -521,6 +532,43 @@ func TestCountDocumentItems(t *testing.T) {
 	}
 }
 
+func TestCheckDashboardVariables(t *testing.T) {
+	content := []byte(`{
+		"version": 15,
+		"variables": [{"key": "Host", "type": "query"}],
+		"tiles": {
+			"1": {"type": "data", "query": "fetch logs | filter host.name == $Host"},
+			"2": {"type": "data", "query": "fetch logs | filter k8s.namespace.name == $Namespace and dt.host_group.id == $Group:noquote"},
+			"3": {"type": "markdown", "content": "From $dt_timeframe_from to $dt_timeframe_to"}
+		}
+	}`)
+
+	warnings, err := checkDashboardVariables(content, "dashboard", false)
+	if err != nil {
+		t.Fatalf("checkDashboardVariables() error = %v", err)
+	}
+	want := []string{
+		"tile 2 references undefined variable $Namespace",
+		"tile 2 references undefined variable $Group",
+	}
+	if len(warnings) != len(want) {
+		t.Fatalf("got warnings %v, want %v", warnings, want)
+	}
+	for i := range want {
+		if warnings[i] != want[i] {
+			t.Errorf("warning[%d] = %q, want %q", i, warnings[i], want[i])
+		}
+	}
+
+	if _, err := checkDashboardVariables(content, "dashboard", true); err == nil {
+		t.Error("expected error in strict mode")
+	}
+
+	if warnings, err := checkDashboardVariables(content, "notebook", true); err != nil || warnings != nil {
+		t.Errorf("notebooks should not be checked, got %v, %v", warnings, err)
+	}
+}
+
 func TestItemName(t *testing.T) {
 	tests := []struct {
 		docType  string
@@ -1105,3 +1153,104 @@ func TestWorkflowManualTriggerRoundTrip(t *testing.T) {
 		}
 	})
 }
+
+// TestDetectResourceTypeAmbiguousShapes locks in how shapes that match more
+// than one heuristic are classified. Use --type (ApplyOptions.ResourceType)
+// to override them.
+func TestDetectResourceTypeAmbiguousShapes(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected ResourceType
+		wantErr  bool
+	}{
+		{
+			name:     "criteria and name without customSli is an SLO",
+			input:    `{"name": "Availability", "criteria": [{"target": 99}]}`,
+			expected: ResourceSLO,
+		},
+		{
+			name:     "root-level criteria wins over a settings envelope",
+			input:    `{"schemaId": "builtin:alerting.profile", "scope": "environment", "value": {}, "name": "x", "criteria": []}`,
+			expected: ResourceSLO,
+		},
+		{
+			name:     "criteria inside a settings value stays settings",
+			input:    `{"schemaId": "builtin:alerting.profile", "scope": "environment", "value": {"name": "x", "criteria": []}}`,
+			expected: ResourceSettings,
+		},
+		{
+			name:     "tasks win over criteria and name",
+			input:    `{"name": "x", "criteria": [], "tasks": {}}`,
+			expected: ResourceWorkflow,
+		},
+		{
+			name:     "includes and name with criteria is not a segment",
+			input:    `{"name": "x", "includes": [], "criteria": []}`,
+			expected: ResourceSLO,
+		},
+		{
+			name:    "criteria without name is undetectable",
+			input:   `{"criteria": []}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := detectResourceType([]byte(tt.input))
+			if tt.wantErr {
+				var detErr *DetectionError
+				if !errors.As(err, &detErr) {
+					t.Fatalf("error = %v, want *DetectionError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("detectResourceType() error = %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("detectResourceType() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDetectionError_ListsHeuristics(t *testing.T) {
+	_, _, err := detectResourceType([]byte(`{"foo": "bar"}`))
+	if err == nil {
+		t.Fatal("expected detection error")
+	}
+	msg := err.Error()
+	for _, want := range []string{"tried:", "criteria + name (slo)", "tasks (workflow)", "--type"} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("error message missing %q:\n%s", want, msg)
+		}
+	}
+}
+
+func TestParseResourceType(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    ResourceType
+		wantErr bool
+	}{
+		{input: "slo", want: ResourceSLO},
+		{input: "Settings", want: ResourceSettings},
+		{input: "anomaly-detector", want: ResourceAnomalyDetector},
+		{input: "aws_monitoring_config", want: ResourceAWSMonitoringConfig},
+		{input: "unknown", wantErr: true},
+		{input: "pipeline", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseResourceType(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseResourceType(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("ParseResourceType(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
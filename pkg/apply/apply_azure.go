@@ -2,6 +2,7 @@ package apply
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"os"
@@ -13,7 +14,7 @@ import (
 )
 
 // applyAzureConnection applies Azure connection (credential)
-func (a *Applier) applyAzureConnection(data []byte) ([]ApplyResult, error) {
+func (a *Applier) applyAzureConnection(data []byte, opts ApplyOptions) ([]ApplyResult, error) {
 	// Azure connection input might be a single object or a list of setting objects
 	var items []map[string]interface{}
 
@@ -98,6 +99,12 @@ func (a *Applier) applyAzureConnection(data []byte) ([]ApplyResult, error) {
 				printFederatedInstructions(a.baseURL, res.ObjectID, issuerOverride, &resultWarnings)
 			}
 
+			if opts.Verify {
+				if err := a.verifyAzureConnection(handler, res.ObjectID, issuerOverride); err != nil {
+					return nil, err
+				}
+			}
+
 			results = append(results, &ConnectionApplyResult{
 				ApplyResultBase: ApplyResultBase{
 					Action:       ActionCreated,
@@ -112,29 +119,16 @@ func (a *Applier) applyAzureConnection(data []byte) ([]ApplyResult, error) {
 			// Update
 			_, err := handler.Update(objectID, value)
 			if err != nil {
-				errMsg := err.Error()
-
-				// Catch generic validation error that happens when Azure side is not ready/configured
-				// "was unable to be validated with validator .../azureConfiguration"
-				if strings.Contains(errMsg, "azureConfiguration") && strings.Contains(errMsg, "unable to be validated") {
-					// Check if we have incomplete configuration (missing app/directory ID)
-					if value.Type == "federatedIdentityCredential" {
-						fedCred := value.FederatedIdentityCredential
-						if fedCred == nil || fedCred.ApplicationID == "" || fedCred.DirectoryID == "" {
-							printFederatedCompleteInstructions(a.baseURL, objectID, value.Name, issuerOverride)
-							return nil, fmt.Errorf("azure connection requires additional configuration: %w", err)
-						}
-					}
+				if hinted := ExplainAzureConnectionError(a.baseURL, objectID, value, issuerOverride, err); hinted != nil {
+					return nil, hinted
 				}
+				return nil, fmt.Errorf("failed to update Azure connection %s: %w", objectID, err)
+			}
 
-				// Check for Federated Identity error (AADSTS70025 or AADSTS700213)
-				if strings.Contains(errMsg, "AADSTS70025") || strings.Contains(errMsg, "AADSTS700213") {
-					if value.FederatedIdentityCredential != nil && value.FederatedIdentityCredential.ApplicationID != "" {
-						printFederatedErrorSnippet(a.baseURL, objectID, value.FederatedIdentityCredential.ApplicationID, issuerOverride)
-						return nil, fmt.Errorf("azure connection requires federation setup on Azure side: %w", err)
-					}
+			if opts.Verify {
+				if err := a.verifyAzureConnection(handler, objectID, issuerOverride); err != nil {
+					return nil, err
 				}
-				return nil, fmt.Errorf("failed to update Azure connection %s: %w", objectID, err)
 			}
 
 			results = append(results, &ConnectionApplyResult{
@@ -160,6 +154,51 @@ func (a *Applier) applyAzureConnection(data []byte) ([]ApplyResult, error) {
 	return results, nil
 }
 
+// verifyAzureConnection runs the server-side connection check after apply
+// (--verify) and prints federation setup hints when it fails.
+func (a *Applier) verifyAzureConnection(handler *azureconnection.Handler, objectID, issuerOverride string) error {
+	conn, err := handler.Verify(objectID)
+	if err == nil {
+		return nil
+	}
+	if conn != nil {
+		if hinted := ExplainAzureConnectionError(a.baseURL, objectID, conn.Value, issuerOverride, err); hinted != nil {
+			return hinted
+		}
+	}
+	return fmt.Errorf("azure connection %s failed verification: %w", objectID, err)
+}
+
+// ExplainAzureConnectionError recognizes Azure connection validation failures
+// caused by incomplete federated identity setup, prints the matching setup
+// instructions to stderr, and returns a wrapped error. It returns nil for
+// errors it does not recognize.
+func ExplainAzureConnectionError(baseURL, objectID string, value azureconnection.Value, issuerOverride string, err error) error {
+	errMsg := err.Error()
+	fedCred := value.FederatedIdentityCredential
+
+	// Catch generic validation error that happens when Azure side is not ready/configured
+	// "was unable to be validated with validator .../azureConfiguration"
+	incomplete := errors.Is(err, azureconnection.ErrFederationIncomplete) ||
+		(strings.Contains(errMsg, "azureConfiguration") && strings.Contains(errMsg, "unable to be validated"))
+	if incomplete && value.Type == "federatedIdentityCredential" {
+		// Check if we have incomplete configuration (missing app/directory ID)
+		if fedCred == nil || fedCred.ApplicationID == "" || fedCred.DirectoryID == "" {
+			printFederatedCompleteInstructions(baseURL, objectID, value.Name, issuerOverride)
+			return fmt.Errorf("azure connection requires additional configuration: %w", err)
+		}
+	}
+
+	// Check for Federated Identity error (AADSTS70025 or AADSTS700213)
+	if strings.Contains(errMsg, "AADSTS70025") || strings.Contains(errMsg, "AADSTS700213") {
+		if fedCred != nil && fedCred.ApplicationID != "" {
+			printFederatedErrorSnippet(baseURL, objectID, fedCred.ApplicationID, issuerOverride)
+			return fmt.Errorf("azure connection requires federation setup on Azure side: %w", err)
+		}
+	}
+	return nil
+}
+
 // applyAzureMonitoringConfig applies Azure monitoring configuration
 func (a *Applier) applyAzureMonitoringConfig(data []byte) (ApplyResult, error) {
 	handler := azuremonitoringconfig.NewHandler(a.client)
@@ -8,7 +8,7 @@ import (
 )
 
 // applyBucket applies a bucket resource
-func (a *Applier) applyBucket(data []byte) (ApplyResult, error) {
+func (a *Applier) applyBucket(data []byte, opts ApplyOptions) (ApplyResult, error) {
 	var b bucket.BucketCreate
 	if err := json.Unmarshal(data, &b); err != nil {
 		return nil, fmt.Errorf("failed to parse bucket JSON: %w", err)
@@ -25,7 +25,16 @@ func (a *Applier) applyBucket(data []byte) (ApplyResult, error) {
 			return nil, fmt.Errorf("failed to create bucket: %w", err)
 		}
 		var warnings []string
-		stderrWarn(&warnings, "Bucket creation can take up to 1 minute to complete")
+		status := result.Status
+		if opts.Wait {
+			ready, err := handler.WaitActive(result.BucketName, opts.WaitTimeout)
+			if err != nil {
+				return nil, fmt.Errorf("bucket created but not ready: %w", err)
+			}
+			status = ready.Status
+		} else {
+			stderrWarn(&warnings, "Bucket creation can take up to 1 minute to complete")
+		}
 		return &BucketApplyResult{
 			ApplyResultBase: ApplyResultBase{
 				Action:       ActionCreated,
@@ -34,7 +43,7 @@ func (a *Applier) applyBucket(data []byte) (ApplyResult, error) {
 				Name:         result.BucketName,
 				Warnings:     warnings,
 			},
-			Status: result.Status,
+			Status: status,
 		}, nil
 	}
 
@@ -48,6 +57,15 @@ func (a *Applier) applyBucket(data []byte) (ApplyResult, error) {
 		return nil, fmt.Errorf("failed to update bucket: %w", err)
 	}
 
+	status := existing.Status
+	if opts.Wait {
+		ready, err := handler.WaitActive(b.BucketName, opts.WaitTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("bucket updated but not ready: %w", err)
+		}
+		status = ready.Status
+	}
+
 	return &BucketApplyResult{
 		ApplyResultBase: ApplyResultBase{
 			Action:       ActionUpdated,
@@ -55,6 +73,6 @@ func (a *Applier) applyBucket(data []byte) (ApplyResult, error) {
 			ID:           b.BucketName,
 			Name:         b.BucketName,
 		},
-		Status: existing.Status,
+		Status: status,
 	}, nil
 }
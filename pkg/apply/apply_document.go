@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/dynatrace-oss/dtctl/pkg/resources/document"
@@ -22,6 +24,12 @@ func (a *Applier) applyDocument(data []byte, docType string, opts ApplyOptions)
 	// Extract and validate content - handle round-trippable format from 'get' command
 	contentData, name, description, validationWarnings := extractDocumentContent(doc, docType)
 
+	varWarnings, err := checkDashboardVariables(contentData, docType, opts.Strict)
+	if err != nil {
+		return nil, err
+	}
+	validationWarnings = append(validationWarnings, varWarnings...)
+
 	// Show validation warnings on stderr and collect for result
 	var resultWarnings []string
 	for _, w := range validationWarnings {
@@ -44,6 +52,7 @@ func (a *Applier) applyDocument(data []byte, docType string, opts ApplyOptions)
 		if name == "" {
 			name = fmt.Sprintf("Untitled %s", docType)
 		}
+		description = stampLabels(description, "", opts.Labels)
 
 		result, err := handler.Create(document.CreateRequest{
 			Name:        name,
@@ -89,10 +98,11 @@ func (a *Applier) applyDocument(data []byte, docType string, opts ApplyOptions)
 		// The Documents API rejects UUID-formatted IDs during creation.
 		// If the ID is a UUID (e.g., from an export), create without it and let the API generate a new ID.
 		createID := id
-		if isUUID(id) {
+		if IsUUID(id) {
 			createID = ""
 			stderrWarn(&resultWarnings, "Creating new %s (UUID IDs cannot be reused across tenants)", docType)
 		}
+		description = stampLabels(description, "", opts.Labels)
 
 		result, err := handler.Create(document.CreateRequest{
 			ID:          createID,
@@ -118,7 +128,7 @@ func (a *Applier) applyDocument(data []byte, docType string, opts ApplyOptions)
 		// For the UUID case the API generated a fresh id — stamp it if requested.
 		// For the non-UUID case the file already carries the id field, so neither
 		// the write-back nor the hint is needed (applyWriteBack treats it as a no-op).
-		fileAlreadyHasID := !isUUID(id) // non-UUID id was in the file and is preserved
+		fileAlreadyHasID := !IsUUID(id) // non-UUID id was in the file and is preserved
 		applyWriteBack(a.sourceFile, resultID, docType, opts.WriteID, fileAlreadyHasID, &resultWarnings)
 
 		return a.buildDocumentResult(ActionCreated, docType, resultID, resultName, tileCount, resultWarnings), nil
@@ -130,18 +140,46 @@ func (a *Applier) applyDocument(data []byte, docType string, opts ApplyOptions)
 		return nil, err
 	}
 
-	// Show diff if requested
-	if opts.ShowDiff {
-		existingDoc, err := handler.Get(id)
-		if err == nil && len(existingDoc.Content) > 0 {
-			showJSONDiff(existingDoc.Content, contentData, docType)
+	fileContent := contentData
+	var result *document.Document
+	for attempt := 0; ; attempt++ {
+		// With --merge the file is a JSON merge patch over the current content
+		contentData = fileContent
+		if opts.Merge {
+			existingDoc, err := handler.Get(id)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch %s for merge: %w", docType, err)
+			}
+			if contentData, err = mergeJSON(existingDoc.Content, fileContent); err != nil {
+				return nil, fmt.Errorf("failed to merge %s content: %w", docType, err)
+			}
+			tileCount = countDocumentItems(contentData, docType)
 		}
-	}
 
-	// Update the existing document (including metadata if name or description provided)
-	result, err := handler.UpdateWithMetadata(id, metadata.Version, contentData, "application/json", name, description)
-	if err != nil {
-		return nil, fmt.Errorf("failed to apply %s: %w", docType, err)
+		// Show diff if requested
+		if opts.ShowDiff {
+			existingDoc, err := handler.Get(id)
+			if err == nil && len(existingDoc.Content) > 0 {
+				showJSONDiff(existingDoc.Content, contentData, docType)
+			}
+		}
+
+		updateDescription := stampLabels(description, metadata.Description, opts.Labels)
+
+		// Update the existing document (including metadata if name or description provided)
+		result, err = handler.UpdateWithMetadata(id, metadata.Version, contentData, "application/json", name, updateDescription)
+		if err == nil {
+			break
+		}
+		if !retryOnConflict(err, opts, attempt) {
+			return nil, fmt.Errorf("failed to apply %s: %w", docType, conflictHint(err, opts))
+		}
+
+		// Someone else updated the document: re-read its version and retry.
+		stderrWarn(&resultWarnings, "%s %q changed remotely (version %d); retrying against the current version", docType, id, metadata.Version)
+		if metadata, err = handler.GetMetadata(id); err != nil {
+			return nil, fmt.Errorf("failed to re-fetch %s after version conflict: %w", docType, err)
+		}
 	}
 
 	// Use name from input/metadata if result doesn't have it
@@ -266,6 +304,111 @@ func countDocumentItems(contentData []byte, docType string) int {
 	return 0
 }
 
+// variableRefRegex matches dashboard variable references such as $Host or
+// $Host:noquote inside tile queries and markdown.
+var variableRefRegex = regexp.MustCompile(`\$([A-Za-z][A-Za-z0-9_]*)`)
+
+// checkDashboardVariables returns a warning for every dashboard tile that
+// references a variable not defined in the content's 'variables' section -
+// typically left behind when a variable was deleted or a tile was copied from
+// another dashboard. With strict set, such references are an error instead.
+func checkDashboardVariables(contentData []byte, docType string, strict bool) ([]string, error) {
+	if docType != "dashboard" {
+		return nil, nil
+	}
+	warnings := undefinedVariableWarnings(contentData)
+	if strict && len(warnings) > 0 {
+		return nil, fmt.Errorf("dashboard has stale variable references (--strict): %s", strings.Join(warnings, "; "))
+	}
+	return warnings, nil
+}
+
+// undefinedVariableWarnings scans dashboard tiles for $variable references
+// that have no matching entry in the 'variables' section. Built-in dt_
+// variables (e.g. $dt_timeframe_from) are always defined.
+func undefinedVariableWarnings(contentData []byte) []string {
+	var content map[string]interface{}
+	if err := json.Unmarshal(contentData, &content); err != nil {
+		return nil
+	}
+
+	defined := make(map[string]bool)
+	if vars, ok := content["variables"].([]interface{}); ok {
+		for _, v := range vars {
+			if vm, ok := v.(map[string]interface{}); ok {
+				if key, ok := vm["key"].(string); ok {
+					defined[key] = true
+				}
+			}
+		}
+	}
+
+	// Tiles are a map keyed by tile ID in current dashboards, and a list in
+	// some older exports.
+	tiles := make(map[string]interface{})
+	switch t := content["tiles"].(type) {
+	case map[string]interface{}:
+		tiles = t
+	case []interface{}:
+		for i, tile := range t {
+			tiles[fmt.Sprintf("%d", i)] = tile
+		}
+	}
+
+	tileIDs := make([]string, 0, len(tiles))
+	for id := range tiles {
+		tileIDs = append(tileIDs, id)
+	}
+	sort.Strings(tileIDs)
+
+	var warnings []string
+	for _, id := range tileIDs {
+		seen := make(map[string]bool)
+		var refs []string
+		for _, s := range collectStrings(tiles[id]) {
+			for _, m := range variableRefRegex.FindAllStringSubmatch(s, -1) {
+				name := m[1]
+				if defined[name] || seen[name] || strings.HasPrefix(name, "dt_") {
+					continue
+				}
+				seen[name] = true
+				refs = append(refs, name)
+			}
+		}
+		for _, name := range refs {
+			warnings = append(warnings, fmt.Sprintf("tile %s references undefined variable $%s", id, name))
+		}
+	}
+	return warnings
+}
+
+// collectStrings returns all string values nested in v. Map keys are visited
+// in sorted order so the result is deterministic.
+func collectStrings(v interface{}) []string {
+	switch val := v.(type) {
+	case string:
+		return []string{val}
+	case []interface{}:
+		var out []string
+		for _, item := range val {
+			out = append(out, collectStrings(item)...)
+		}
+		return out
+	case map[string]interface{}:
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		var out []string
+		for _, k := range keys {
+			out = append(out, collectStrings(val[k])...)
+		}
+		return out
+	}
+	return nil
+}
+
 // itemName returns the item name for a document type (tiles for dashboards, sections for notebooks)
 func itemName(docType string) string {
 	if docType == "dashboard" {
@@ -338,12 +481,17 @@ func (a *Applier) documentURL(docType, id string) string {
 }
 
 // dryRunDocument performs dry-run validation for dashboard/notebook documents
-func (a *Applier) dryRunDocument(resourceType ResourceType, doc map[string]interface{}) (ApplyResult, error) {
+func (a *Applier) dryRunDocument(resourceType ResourceType, doc map[string]interface{}, opts ApplyOptions) (ApplyResult, error) {
 	docType := string(resourceType)
 	id, _ := doc["id"].(string)
 
 	// Use the same extraction/validation logic as apply
 	contentData, name, _, warnings := extractDocumentContent(doc, docType)
+	varWarnings, err := checkDashboardVariables(contentData, docType, opts.Strict)
+	if err != nil {
+		return nil, err
+	}
+	warnings = append(warnings, varWarnings...)
 	if name == "" {
 		name = fmt.Sprintf("Untitled %s", docType)
 	}
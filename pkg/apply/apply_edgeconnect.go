@@ -0,0 +1,95 @@
+package apply
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/dynatrace-oss/dtctl/pkg/resources/edgeconnect"
+	"github.com/dynatrace-oss/dtctl/pkg/safety"
+)
+
+// applyEdgeConnect applies an EdgeConnect configuration. An existing
+// EdgeConnect (matched by "id", or by name when no ID is given) is updated in
+// place, which keeps its OAuth client; otherwise a new one is created.
+func (a *Applier) applyEdgeConnect(data []byte) (ApplyResult, error) {
+	var ec edgeconnect.EdgeConnect
+	if err := json.Unmarshal(data, &ec); err != nil {
+		return nil, fmt.Errorf("failed to parse EdgeConnect JSON: %w", err)
+	}
+	if ec.Name == "" {
+		return nil, fmt.Errorf("EdgeConnect name is required")
+	}
+
+	handler := edgeconnect.NewHandler(a.client)
+
+	var existing *edgeconnect.EdgeConnect
+	if ec.ID != "" {
+		found, err := handler.Get(ec.ID)
+		if err != nil && !edgeconnect.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to check EdgeConnect existence: %w", err)
+		}
+		existing = found
+	} else {
+		list, err := handler.List()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list EdgeConnects: %w", err)
+		}
+		for i := range list.EdgeConnects {
+			if list.EdgeConnects[i].Name == ec.Name {
+				existing = &list.EdgeConnects[i]
+				break
+			}
+		}
+	}
+
+	if existing == nil {
+		if err := a.checkSafety(safety.OperationCreate, safety.OwnershipUnknown); err != nil {
+			return nil, err
+		}
+		result, err := handler.Create(edgeconnect.EdgeConnectCreate{
+			Name:          ec.Name,
+			HostPatterns:  ec.HostPatterns,
+			OAuthClientID: ec.OAuthClientID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create EdgeConnect: %w", err)
+		}
+		return &EdgeConnectApplyResult{
+			ApplyResultBase: ApplyResultBase{
+				Action:       ActionCreated,
+				ResourceType: string(ResourceEdgeConnect),
+				ID:           result.ID,
+				Name:         result.Name,
+			},
+			OAuthClientID:     result.OAuthClientID,
+			OAuthClientSecret: result.OAuthClientSecret,
+		}, nil
+	}
+
+	if err := a.checkSafety(safety.OperationUpdate, safety.OwnershipUnknown); err != nil {
+		return nil, err
+	}
+
+	// Keep the current OAuth client unless the file names one: an update
+	// without it would make the service issue new credentials.
+	id := existing.ID
+	if ec.OAuthClientID == "" {
+		ec.OAuthClientID = existing.OAuthClientID
+	}
+	// Server-managed and write-once fields are not part of the update payload.
+	ec.ID = id
+	ec.OAuthClientSecret = ""
+	ec.ModificationInfo = nil
+	ec.Metadata = nil
+	if err := handler.Update(id, ec); err != nil {
+		return nil, fmt.Errorf("failed to update EdgeConnect: %w", err)
+	}
+	return &EdgeConnectApplyResult{
+		ApplyResultBase: ApplyResultBase{
+			Action:       ActionUpdated,
+			ResourceType: string(ResourceEdgeConnect),
+			ID:           id,
+			Name:         ec.Name,
+		},
+	}, nil
+}
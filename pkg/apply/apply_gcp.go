@@ -9,7 +9,7 @@ import (
 )
 
 // applyGCPConnection applies GCP connection configuration
-func (a *Applier) applyGCPConnection(data []byte) ([]ApplyResult, error) {
+func (a *Applier) applyGCPConnection(data []byte, opts ApplyOptions) ([]ApplyResult, error) {
 	var items []map[string]interface{}
 
 	if err := json.Unmarshal(data, &items); err != nil {
@@ -75,6 +75,12 @@ func (a *Applier) applyGCPConnection(data []byte) ([]ApplyResult, error) {
 				return nil, fmt.Errorf("failed to create GCP connection: %w", err)
 			}
 
+			if opts.Verify {
+				if _, err := handler.Verify(res.ObjectID); err != nil {
+					return nil, fmt.Errorf("GCP connection %s failed verification: %w", res.ObjectID, err)
+				}
+			}
+
 			results = append(results, &ConnectionApplyResult{
 				ApplyResultBase: ApplyResultBase{
 					Action:       ActionCreated,
@@ -91,6 +97,12 @@ func (a *Applier) applyGCPConnection(data []byte) ([]ApplyResult, error) {
 				return nil, fmt.Errorf("failed to update GCP connection %s: %w", objectID, err)
 			}
 
+			if opts.Verify {
+				if _, err := handler.Verify(objectID); err != nil {
+					return nil, fmt.Errorf("GCP connection %s failed verification: %w", objectID, err)
+				}
+			}
+
 			results = append(results, &ConnectionApplyResult{
 				ApplyResultBase: ApplyResultBase{
 					Action:       ActionUpdated,
@@ -12,6 +12,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dynatrace-oss/dtctl/pkg/client"
 )
@@ -395,6 +396,49 @@ func TestApply_SLOCreate(t *testing.T) {
 	}
 }
 
+// --- Apply: EdgeConnect update in place ---
+
+func TestApply_EdgeConnectUpdate_ByName(t *testing.T) {
+	var putBody map[string]interface{}
+	srv, c := newApplyTestServer(t, map[string]http.HandlerFunc{
+		"/platform/app-engine/edge-connect/v1/edge-connects": func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				t.Errorf("expected GET (no create), got %s", r.Method)
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"edgeConnects": []map[string]interface{}{
+					{"id": "ec-1", "name": "my-edge", "hostPatterns": []string{"old.example.com"}, "oauthClientId": "client-1"},
+				},
+				"totalCount": 1,
+			})
+		},
+		"/platform/app-engine/edge-connect/v1/edge-connects/ec-1": func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut {
+				t.Errorf("expected PUT, got %s", r.Method)
+			}
+			_ = json.NewDecoder(r.Body).Decode(&putBody)
+			w.WriteHeader(http.StatusOK)
+		},
+	})
+	defer srv.Close()
+
+	ecYAML := "name: my-edge\nhostPatterns:\n  - new.example.com\nmetadata:\n  version: \"1\"\n"
+	results, err := NewApplier(c).Apply([]byte(ecYAML), ApplyOptions{})
+	if err != nil {
+		t.Fatalf("Apply() EdgeConnect error = %v", err)
+	}
+	result := results[0].(*EdgeConnectApplyResult)
+	if result.Action != ActionUpdated || result.ID != "ec-1" {
+		t.Errorf("got action %q id %q, want updated ec-1", result.Action, result.ID)
+	}
+	if putBody["oauthClientId"] != "client-1" {
+		t.Errorf("PUT oauthClientId = %v, want the existing client kept", putBody["oauthClientId"])
+	}
+	if _, ok := putBody["metadata"]; ok {
+		t.Error("PUT carried server-managed metadata")
+	}
+}
+
 // --- Apply: bucket create ---
 
 func TestApply_BucketCreate(t *testing.T) {
@@ -431,6 +475,42 @@ func TestApply_BucketCreate(t *testing.T) {
 	}
 }
 
+func TestApply_BucketCreate_Wait(t *testing.T) {
+	gets := 0
+	srv, c := newApplyTestServer(t, map[string]http.HandlerFunc{
+		"/platform/storage/management/v1/bucket-definitions": func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(map[string]interface{}{"bucketName": "my-logs", "table": "logs", "status": "creating"})
+		},
+		"/platform/storage/management/v1/bucket-definitions/my-logs": func(w http.ResponseWriter, r *http.Request) {
+			gets++
+			if gets == 1 {
+				// Existence check before creation
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"bucketName": "my-logs", "table": "logs", "status": "active"})
+		},
+		"/platform/metadata/v1/user": func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		},
+	})
+	defer srv.Close()
+
+	bucketJSON := `{"bucketName":"my-logs","table":"logs","retentionDays":35}`
+	results, err := NewApplier(c).Apply([]byte(bucketJSON), ApplyOptions{Wait: true, WaitTimeout: time.Minute})
+	if err != nil {
+		t.Fatalf("Apply() bucket error = %v", err)
+	}
+	result := results[0].(*BucketApplyResult)
+	if result.Status != "active" {
+		t.Errorf("Status = %q, want active", result.Status)
+	}
+	if len(result.Warnings) != 0 {
+		t.Errorf("Warnings = %v, want none after waiting", result.Warnings)
+	}
+}
+
 // --- Apply: dryRun workflow ---
 
 func TestApply_DryRun_Workflow(t *testing.T) {
@@ -483,6 +563,95 @@ func TestApply_SettingsCreate(t *testing.T) {
 	}
 }
 
+func TestApply_SettingsUpdate_Merge(t *testing.T) {
+	var putBody map[string]interface{}
+	srv, c := newApplyTestServer(t, map[string]http.HandlerFunc{
+		"/platform/classic/environment-api/v2/settings/objects/obj-1": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch r.Method {
+			case http.MethodGet:
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"objectId": "obj-1",
+					"schemaId": "builtin:alerting.profile",
+					"scope":    "environment",
+					"version":  "v1",
+					"value":    map[string]interface{}{"name": "Test", "enabled": true, "severity": "high"},
+				})
+			case http.MethodPut:
+				json.NewDecoder(r.Body).Decode(&putBody)
+				json.NewEncoder(w).Encode(map[string]interface{}{"objectId": "obj-1"})
+			}
+		},
+		"/platform/metadata/v1/user": func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		},
+	})
+	defer srv.Close()
+	a := NewApplier(c)
+
+	settingsJSON := `{"objectId":"obj-1","schemaId":"builtin:alerting.profile","scope":"environment","value":{"enabled":false,"severity":null}}`
+	if _, err := a.Apply([]byte(settingsJSON), ApplyOptions{Merge: true}); err != nil {
+		t.Fatalf("Apply() settings merge error = %v", err)
+	}
+
+	value, _ := putBody["value"].(map[string]interface{})
+	if value["name"] != "Test" || value["enabled"] != false {
+		t.Errorf("expected merged value to keep name and disable, got %v", value)
+	}
+	if _, ok := value["severity"]; ok {
+		t.Errorf("expected severity to be removed by null, got %v", value)
+	}
+}
+
+func TestApply_ServerDryRun_Settings(t *testing.T) {
+	srv, c := newApplyTestServer(t, map[string]http.HandlerFunc{
+		"/platform/classic/environment-api/v2/settings/objects": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("validateOnly") != "true" {
+				t.Error("expected validateOnly=true query param")
+			}
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode([]map[string]interface{}{{"code": 200}})
+		},
+		"/platform/metadata/v1/user": func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		},
+	})
+	defer srv.Close()
+	a := NewApplier(c)
+
+	settingsJSON := `{"schemaId":"builtin:alerting.profile","scope":"environment","value":{"name":"Test"}}`
+	results, err := a.Apply([]byte(settingsJSON), ApplyOptions{DryRun: true, ServerDryRun: true})
+	if err != nil {
+		t.Fatalf("Apply() server dry run error = %v", err)
+	}
+	dr, ok := results[0].(*DryRunResult)
+	if !ok {
+		t.Fatalf("expected *DryRunResult, got %T", results[0])
+	}
+	if !dr.ServerValidated || dr.Action != ActionCreated {
+		t.Errorf("got action %q, serverValidated %v", dr.Action, dr.ServerValidated)
+	}
+}
+
+func TestApply_ServerDryRun_UnsupportedType(t *testing.T) {
+	srv, c := newApplyTestServer(t, map[string]http.HandlerFunc{})
+	defer srv.Close()
+	a := NewApplier(c)
+
+	// SLO and bucket APIs have no validate-only mode either, so they are
+	// rejected instead of silently falling back to a client preview.
+	for name, doc := range map[string]string{
+		"workflow": `{"title":"My Workflow","tasks":{},"trigger":{}}`,
+		"slo":      `{"name":"My SLO","criteria":{"pass":[{"criteria":[{"metric":"<100","steps":600}]}]},"target":99.0,"timeframe":"now-7d","metricExpression":"100*..."}`,
+		"bucket":   `{"bucketName":"my-logs","table":"logs","displayName":"My Logs","retentionDays":35}`,
+	} {
+		_, err := a.Apply([]byte(doc), ApplyOptions{DryRun: true, ServerDryRun: true})
+		if err == nil || !strings.Contains(err.Error(), "--dry-run=client") {
+			t.Errorf("%s: expected unsupported server dry run error, got %v", name, err)
+		}
+	}
+}
+
 // --- stderrWarn ---
 
 func TestStderrWarn_AppendsToSlice(t *testing.T) {
@@ -532,6 +701,39 @@ func TestApply_WithTemplateVars(t *testing.T) {
 	fmt.Println("template vars test passed:", results[0].(*WorkflowApplyResult).Name)
 }
 
+func TestApply_WithTypedTemplateVars(t *testing.T) {
+	var owners interface{}
+	srv, c := newApplyTestServer(t, map[string]http.HandlerFunc{
+		"/platform/automation/v1/workflows": func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			owners = body["labels"]
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":    "wf-typed",
+				"title": body["title"],
+			})
+		},
+		"/platform/metadata/v1/user": func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		},
+	})
+	defer srv.Close()
+	a := NewApplier(c)
+
+	wfTemplate := `{"title":"typed","labels":"{{ .owners }}","tasks":{},"trigger":{}}`
+	_, err := a.Apply([]byte(wfTemplate), ApplyOptions{
+		TemplateVars: map[string]interface{}{"owners": []interface{}{"sre", "web"}},
+	})
+	if err != nil {
+		t.Fatalf("Apply() template error = %v", err)
+	}
+	list, ok := owners.([]interface{})
+	if !ok || len(list) != 2 || list[0] != "sre" || list[1] != "web" {
+		t.Errorf("labels = %#v, want [sre web] as a JSON array", owners)
+	}
+}
+
 // --- Apply: Azure Connection ---
 
 func TestApply_AzureConnection_Create(t *testing.T) {
@@ -737,6 +939,138 @@ func TestApply_SLOUpdate_Exists(t *testing.T) {
 	}
 }
 
+// --- Apply: SLO update version conflict (--force-conflicts) ---
+
+// newSLOConflictServer serves an SLO whose version advances on every GET and
+// whose first `conflicts` PUTs fail with 409. It records the locking versions
+// sent with each PUT.
+func newSLOConflictServer(t *testing.T, conflicts int, putVersions *[]string) (*httptest.Server, *client.Client) {
+	t.Helper()
+	gets := 0
+	return newApplyTestServer(t, map[string]http.HandlerFunc{
+		"/platform/slo/v1/slos/slo-existing": func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				gets++
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"id":      "slo-existing",
+					"name":    "My SLO",
+					"version": fmt.Sprintf("%d", gets),
+				})
+			case http.MethodPut:
+				*putVersions = append(*putVersions, r.URL.Query().Get("optimistic-locking-version"))
+				if len(*putVersions) <= conflicts {
+					w.WriteHeader(http.StatusConflict)
+					w.Write([]byte(`{"error":{"code":409,"message":"version conflict"}}`))
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}
+		},
+	})
+}
+
+func TestApply_SLOUpdate_ForceConflicts(t *testing.T) {
+	var putVersions []string
+	srv, c := newSLOConflictServer(t, 1, &putVersions)
+	defer srv.Close()
+	a := NewApplier(c)
+
+	sloJSON := `{"id":"slo-existing","name":"My SLO","criteria":{"pass":[{"criteria":[{"metric":"<100","steps":600}]}]}}`
+	results, err := a.Apply([]byte(sloJSON), ApplyOptions{ForceConflicts: true})
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if got := results[0].(*SLOApplyResult).Action; got != ActionUpdated {
+		t.Errorf("expected 'updated', got %q", got)
+	}
+	if want := []string{"1", "2"}; !reflect.DeepEqual(putVersions, want) {
+		t.Errorf("PUT versions = %v, want %v (retry must use the re-fetched version)", putVersions, want)
+	}
+}
+
+func TestApply_SLOUpdate_ConflictWithoutForce(t *testing.T) {
+	var putVersions []string
+	srv, c := newSLOConflictServer(t, 1, &putVersions)
+	defer srv.Close()
+	a := NewApplier(c)
+
+	_, err := a.Apply([]byte(`{"id":"slo-existing","name":"My SLO","criteria":{"pass":[{"criteria":[{"metric":"<100","steps":600}]}]}}`), ApplyOptions{})
+	if err == nil {
+		t.Fatal("expected a version conflict error")
+	}
+	if !strings.Contains(err.Error(), "--force-conflicts") {
+		t.Errorf("expected a --force-conflicts hint, got: %v", err)
+	}
+	if len(putVersions) != 1 {
+		t.Errorf("expected no retry without --force-conflicts, got %d PUTs", len(putVersions))
+	}
+}
+
+func TestApply_SLOUpdate_ForceConflictsBounded(t *testing.T) {
+	var putVersions []string
+	srv, c := newSLOConflictServer(t, 100, &putVersions)
+	defer srv.Close()
+	a := NewApplier(c)
+
+	_, err := a.Apply([]byte(`{"id":"slo-existing","name":"My SLO","criteria":{"pass":[{"criteria":[{"metric":"<100","steps":600}]}]}}`), ApplyOptions{ForceConflicts: true})
+	if err == nil {
+		t.Fatal("expected an error once retries are exhausted")
+	}
+	if len(putVersions) != MaxConflictRetries+1 {
+		t.Errorf("expected %d PUTs, got %d", MaxConflictRetries+1, len(putVersions))
+	}
+}
+
+// --- Apply: dashboard update version conflict (--force-conflicts) ---
+
+func TestApply_DashboardUpdate_ForceConflicts(t *testing.T) {
+	metadataGets := 0
+	var patchVersions []string
+	srv, c := newApplyTestServer(t, map[string]http.HandlerFunc{
+		"/platform/document/v1/documents/dash-123/metadata": func(w http.ResponseWriter, r *http.Request) {
+			metadataGets++
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":      "dash-123",
+				"name":    "Existing Dashboard",
+				"type":    "dashboard",
+				"version": 4 + metadataGets,
+			})
+		},
+		"/platform/document/v1/documents/dash-123": func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPatch {
+				t.Errorf("unexpected method %s", r.Method)
+				return
+			}
+			patchVersions = append(patchVersions, r.URL.Query().Get("optimistic-locking-version"))
+			if len(patchVersions) == 1 {
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]interface{}{"id": "dash-123", "name": "Existing Dashboard", "version": 7})
+		},
+		"/platform/metadata/v1/user": func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		},
+	})
+	defer srv.Close()
+	a := NewApplier(c)
+
+	dashJSON := `{"type":"dashboard","id":"dash-123","content":{"version":1,"tiles":{}}}`
+	results, err := a.Apply([]byte(dashJSON), ApplyOptions{ForceConflicts: true})
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	if want := []string{"5", "6"}; !reflect.DeepEqual(patchVersions, want) {
+		t.Errorf("PATCH versions = %v, want %v", patchVersions, want)
+	}
+}
+
 // --- Apply: dryRun dashboard (checks document existence) ---
 
 func TestApply_DryRun_Dashboard(t *testing.T) {
@@ -2441,3 +2775,34 @@ func TestApply_Settings_DryRun_WithoutObjectID_ReportsCreated(t *testing.T) {
 		t.Errorf("expected action 'created' without objectId, got %q", dr.Action)
 	}
 }
+
+func TestApply_ResourceTypeOverride(t *testing.T) {
+	srv, c := newApplyTestServer(t, nil)
+	defer srv.Close()
+	a := NewApplier(c)
+
+	// Detected as an SLO (criteria + name); --type settings overrides it.
+	data := []byte(`{"name": "x", "criteria": [], "schemaId": "builtin:custom", "scope": "environment", "value": {}}`)
+	results, err := a.Apply(data, ApplyOptions{DryRun: true, ResourceType: ResourceSettings})
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("got %d results, want 1", len(results))
+	}
+	dr, ok := results[0].(*DryRunResult)
+	if !ok {
+		t.Fatalf("result type = %T, want *DryRunResult", results[0])
+	}
+	if dr.ResourceType != string(ResourceSettings) {
+		t.Errorf("ResourceType = %q, want %q", dr.ResourceType, ResourceSettings)
+	}
+
+	// An undetectable file applies once its type is given.
+	if _, err := a.Apply([]byte(`{"foo": "bar"}`), ApplyOptions{DryRun: true}); err == nil {
+		t.Fatal("expected detection error without --type")
+	}
+	if _, err := a.Apply([]byte(`{"title": "wf"}`), ApplyOptions{DryRun: true, ResourceType: ResourceWorkflow}); err != nil {
+		t.Errorf("Apply() with ResourceType error = %v", err)
+	}
+}
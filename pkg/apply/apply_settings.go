@@ -8,20 +8,19 @@ import (
 	"github.com/dynatrace-oss/dtctl/pkg/safety"
 )
 
-// applySettings applies a settings object resource
-func (a *Applier) applySettings(data []byte) (ApplyResult, error) {
+// settingsFields extracts the identifying fields and value of a settings
+// object resource.
+func settingsFields(data []byte) (objectID, schemaID, scope string, value map[string]interface{}, err error) {
 	var setting map[string]interface{}
 	if err := json.Unmarshal(data, &setting); err != nil {
-		return nil, fmt.Errorf("failed to parse settings JSON: %w", err)
+		return "", "", "", nil, fmt.Errorf("failed to parse settings JSON: %w", err)
 	}
 
-	handler := settings.NewHandler(a.client)
-
 	// Extract fields - handle both camelCase (API format) and lowercase (YAML keys).
 	// Also accept "id" as a final fallback so that the --id CLI flag (which injects
 	// doc["id"] via injectID) works for settings objects the same way it does for
 	// other resource types. See https://github.com/dynatrace-oss/dtctl/issues/255
-	objectID, _ := setting["objectId"].(string)
+	objectID, _ = setting["objectId"].(string)
 	if objectID == "" {
 		objectID, _ = setting["objectid"].(string)
 	}
@@ -29,18 +28,29 @@ func (a *Applier) applySettings(data []byte) (ApplyResult, error) {
 		objectID, _ = setting["id"].(string)
 	}
 
-	schemaID, _ := setting["schemaId"].(string)
+	schemaID, _ = setting["schemaId"].(string)
 	if schemaID == "" {
 		schemaID, _ = setting["schemaid"].(string)
 	}
 
-	scope, _ := setting["scope"].(string)
+	scope, _ = setting["scope"].(string)
 
 	value, ok := setting["value"].(map[string]interface{})
 	if !ok {
-		return nil, fmt.Errorf("settings object missing 'value' field or value is not an object")
+		return "", "", "", nil, fmt.Errorf("settings object missing 'value' field or value is not an object")
+	}
+	return objectID, schemaID, scope, value, nil
+}
+
+// applySettings applies a settings object resource
+func (a *Applier) applySettings(data []byte, opts ApplyOptions) (ApplyResult, error) {
+	objectID, schemaID, scope, value, err := settingsFields(data)
+	if err != nil {
+		return nil, err
 	}
 
+	handler := settings.NewHandler(a.client)
+
 	// If no objectID, create new settings object
 	if objectID == "" {
 		if schemaID == "" {
@@ -77,7 +87,7 @@ func (a *Applier) applySettings(data []byte) (ApplyResult, error) {
 	}
 
 	// Check if settings object exists
-	_, err := handler.Get(objectID)
+	existing, err := handler.Get(objectID)
 	if err != nil {
 		// Doesn't exist - try to create it
 		if schemaID == "" {
@@ -118,6 +128,10 @@ func (a *Applier) applySettings(data []byte) (ApplyResult, error) {
 		return nil, err
 	}
 
+	if opts.Merge {
+		value = mergeSettingsValue(existing.Value, value)
+	}
+
 	updated, err := handler.Update(objectID, value)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update settings object: %w", err)
@@ -135,3 +149,64 @@ func (a *Applier) applySettings(data []byte) (ApplyResult, error) {
 		Summary:  updated.Summary,
 	}, nil
 }
+
+// validateSettings sends the create or update that applySettings would make
+// with validateOnly set, so the server checks the value against its schema
+// and the caller's permissions without persisting anything.
+func (a *Applier) validateSettings(data []byte, opts ApplyOptions) (ApplyResult, error) {
+	objectID, schemaID, scope, value, err := settingsFields(data)
+	if err != nil {
+		return nil, err
+	}
+
+	handler := settings.NewHandler(a.client)
+
+	if objectID != "" {
+		if existing, err := handler.Get(objectID); err == nil {
+			if opts.Merge {
+				value = mergeSettingsValue(existing.Value, value)
+			}
+			if err := handler.ValidateUpdate(objectID, value); err != nil {
+				return nil, fmt.Errorf("server validation failed: %w", err)
+			}
+			return &DryRunResult{
+				ApplyResultBase: ApplyResultBase{
+					Action:       ActionUpdated,
+					ResourceType: string(ResourceSettings),
+					ID:           objectID,
+				},
+				Scope:           scope,
+				ServerValidated: true,
+			}, nil
+		}
+	}
+
+	if schemaID == "" {
+		return nil, fmt.Errorf("schemaId is required to create a settings object")
+	}
+	if scope == "" {
+		return nil, fmt.Errorf("scope is required to create a settings object")
+	}
+	req := settings.SettingsObjectCreate{
+		SchemaID: schemaID,
+		Scope:    scope,
+		Value:    value,
+	}
+	if err := handler.ValidateCreate(req); err != nil {
+		return nil, fmt.Errorf("server validation failed: %w", err)
+	}
+	return &DryRunResult{
+		ApplyResultBase: ApplyResultBase{
+			Action:       ActionCreated,
+			ResourceType: string(ResourceSettings),
+		},
+		Scope:           scope,
+		ServerValidated: true,
+	}, nil
+}
+
+// mergeSettingsValue applies patch to a settings value as a JSON merge patch.
+func mergeSettingsValue(current, patch map[string]interface{}) map[string]interface{} {
+	merged, _ := MergePatch(current, patch).(map[string]interface{})
+	return merged
+}
@@ -8,7 +8,7 @@ import (
 )
 
 // applySLO applies an SLO resource
-func (a *Applier) applySLO(data []byte) (ApplyResult, error) {
+func (a *Applier) applySLO(data []byte, opts ApplyOptions) (ApplyResult, error) {
 	// Parse to check for ID
 	var s map[string]interface{}
 	if err := json.Unmarshal(data, &s); err != nil {
@@ -52,9 +52,19 @@ func (a *Applier) applySLO(data []byte) (ApplyResult, error) {
 		}, nil
 	}
 
-	// Update existing SLO
-	if err := handler.Update(id, existing.Version, data); err != nil {
-		return nil, fmt.Errorf("failed to update SLO: %w", err)
+	// Update existing SLO, retrying version conflicts with --force-conflicts
+	for attempt := 0; ; attempt++ {
+		err := handler.Update(id, existing.Version, data)
+		if err == nil {
+			break
+		}
+		if !retryOnConflict(err, opts, attempt) {
+			return nil, fmt.Errorf("failed to update SLO: %w", conflictHint(err, opts))
+		}
+		stderrWarn(nil, "SLO %q changed remotely (version %s); retrying against the current version", id, existing.Version)
+		if existing, err = handler.Get(id); err != nil {
+			return nil, fmt.Errorf("failed to re-fetch SLO after version conflict: %w", err)
+		}
 	}
 
 	name, _ := s["name"].(string)
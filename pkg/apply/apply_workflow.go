@@ -16,6 +16,11 @@ func (a *Applier) applyWorkflow(data []byte, opts ApplyOptions) (ApplyResult, er
 		return nil, fmt.Errorf("failed to parse workflow JSON: %w", err)
 	}
 
+	data, err := stampWorkflowLabels(data, opts.Labels)
+	if err != nil {
+		return nil, err
+	}
+
 	handler := workflow.NewHandler(a.client)
 
 	id, hasID := wf["id"].(string)
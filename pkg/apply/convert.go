@@ -0,0 +1,138 @@
+package apply
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/dynatrace-oss/dtctl/pkg/resources/anomalydetector"
+)
+
+// documentMetadataKeys are the keys of a document definition that apply reads
+// outside the content. Everything else at the root of a 'get' export (owner,
+// version, shareInfo, ...) is server-managed and dropped by ToApplyFormat.
+var documentMetadataKeys = []string{"id", "name", "description"}
+
+// ToApplyFormat rewrites a resource definition (JSON, single object or array)
+// from the shape 'dtctl get -o json|yaml' exports into the shape apply is
+// written against, without contacting the API:
+//
+//   - Dashboards and notebooks exported with nested content ({..., content:
+//     {tiles: ...}}), including the double-nested .content.content case, are
+//     flattened to id, name and description plus the content fields at the
+//     root. Server-managed metadata is dropped.
+//   - Anomaly detectors in raw Settings format are converted to the flattened
+//     authoring format, keeping the objectId.
+//
+// Other resources are returned unchanged. The returned warnings describe what
+// was changed or could not be recognized.
+func ToApplyFormat(data []byte) ([]byte, []string, error) {
+	_, isList, err := detectResourceType(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if isList {
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return nil, nil, fmt.Errorf("failed to parse JSON array: %w", err)
+		}
+		var warnings []string
+		out := make([]map[string]interface{}, 0, len(items))
+		for i, item := range items {
+			converted, itemWarnings, err := toApplyFormatObject(item)
+			if err != nil {
+				return nil, nil, fmt.Errorf("item %d: %w", i, err)
+			}
+			for _, w := range itemWarnings {
+				warnings = append(warnings, fmt.Sprintf("item %d: %s", i, w))
+			}
+			out = append(out, converted)
+		}
+		result, err := json.Marshal(out)
+		return result, warnings, err
+	}
+
+	converted, warnings, err := toApplyFormatObject(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	result, err := json.Marshal(converted)
+	return result, warnings, err
+}
+
+func toApplyFormatObject(data []byte) (map[string]interface{}, []string, error) {
+	resourceType, _, err := detectResourceType(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+
+	switch resourceType {
+	case ResourceDashboard, ResourceNotebook:
+		flat, warnings := flattenDocument(doc, string(resourceType))
+		return flat, warnings, nil
+	case ResourceAnomalyDetector:
+		if _, isRaw := doc["schemaId"]; !isRaw {
+			return doc, nil, nil
+		}
+		value, _ := doc["value"].(map[string]interface{})
+		flat := anomalydetector.ToFlattenedYAML(value)
+		if objectID, ok := doc["objectId"].(string); ok && objectID != "" {
+			flat["objectId"] = objectID
+		}
+		return flat, []string{"converted raw Settings format to flattened anomaly detector format"}, nil
+	}
+	return doc, nil, nil
+}
+
+// flattenDocument lifts a document's content to the root next to its id, name
+// and description. A document already in direct format is returned unchanged.
+func flattenDocument(doc map[string]interface{}, docType string) (map[string]interface{}, []string) {
+	content, ok := doc["content"].(map[string]interface{})
+	if !ok {
+		return doc, nil
+	}
+
+	var warnings []string
+	if inner, ok := content["content"].(map[string]interface{}); ok {
+		warnings = append(warnings, "detected double-nested content (.content.content) - using inner content")
+		content = inner
+	}
+
+	flat := make(map[string]interface{}, len(content)+len(documentMetadataKeys))
+	for k, v := range content {
+		flat[k] = v
+	}
+	for _, k := range documentMetadataKeys {
+		if v, ok := doc[k]; ok && v != "" {
+			flat[k] = v
+		}
+	}
+
+	// apply recognizes direct-format documents by their tiles/sections; keep
+	// the type for content that has neither so the file is still detected.
+	_, hasTiles := content["tiles"]
+	_, hasSections := content["sections"]
+	if !hasTiles && !hasSections {
+		flat["type"] = docType
+		warnings = append(warnings, fmt.Sprintf("%s content has no tiles or sections", docType))
+	}
+
+	var dropped []string
+	for k := range doc {
+		if k != "content" && k != "type" && !slices.Contains(documentMetadataKeys, k) {
+			dropped = append(dropped, k)
+		}
+	}
+	if len(dropped) > 0 {
+		sort.Strings(dropped)
+		warnings = append(warnings, "dropped server-managed fields: "+strings.Join(dropped, ", "))
+	}
+	return flat, warnings
+}
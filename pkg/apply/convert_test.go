@@ -0,0 +1,96 @@
+package apply
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestToApplyFormat(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		want         string
+		wantWarnings int
+	}{
+		{
+			name:         "get export of a dashboard",
+			input:        `{"id":"abc","name":"Prod","type":"dashboard","owner":"u1","version":7,"isPrivate":false,"content":{"version":21,"tiles":{"0":{"type":"markdown"}}}}`,
+			want:         `{"id":"abc","name":"Prod","version":21,"tiles":{"0":{"type":"markdown"}}}`,
+			wantWarnings: 1,
+		},
+		{
+			name:         "double-nested content",
+			input:        `{"name":"Prod","type":"dashboard","content":{"content":{"version":21,"tiles":{}}}}`,
+			want:         `{"name":"Prod","version":21,"tiles":{}}`,
+			wantWarnings: 1,
+		},
+		{
+			name:  "notebook keeps description",
+			input: `{"name":"NB","description":"d","type":"notebook","content":{"sections":[]}}`,
+			want:  `{"name":"NB","description":"d","sections":[]}`,
+		},
+		{
+			name:  "direct format is unchanged",
+			input: `{"name":"Prod","tiles":{}}`,
+			want:  `{"name":"Prod","tiles":{}}`,
+		},
+		{
+			name:         "content without tiles keeps the type",
+			input:        `{"name":"Empty","type":"dashboard","content":{"version":21}}`,
+			want:         `{"name":"Empty","type":"dashboard","version":21}`,
+			wantWarnings: 1,
+		},
+		{
+			name:         "raw anomaly detector",
+			input:        `{"schemaId":"builtin:davis.anomaly-detectors","scope":"environment","objectId":"obj-1","value":{"title":"CPU","enabled":true,"analyzer":{"name":"static","input":[{"key":"threshold","value":"90"}]},"eventTemplate":{"properties":[{"key":"event.type","value":"CUSTOM_ALERT"}]}}}`,
+			want:         `{"objectId":"obj-1","title":"CPU","enabled":true,"analyzer":{"name":"static","input":{"threshold":"90"}},"eventTemplate":{"event.type":"CUSTOM_ALERT"}}`,
+			wantWarnings: 1,
+		},
+		{
+			name:  "other resources are unchanged",
+			input: `{"title":"wf","tasks":{}}`,
+			want:  `{"title":"wf","tasks":{}}`,
+		},
+		{
+			name:         "list",
+			input:        `[{"name":"A","type":"dashboard","content":{"tiles":{}}},{"name":"B","type":"dashboard","owner":"u","content":{"tiles":{}}}]`,
+			want:         `[{"name":"A","tiles":{}},{"name":"B","tiles":{}}]`,
+			wantWarnings: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, warnings, err := ToApplyFormat([]byte(tt.input))
+			if err != nil {
+				t.Fatalf("ToApplyFormat() error = %v", err)
+			}
+			assertJSONEqual(t, tt.want, string(got))
+			if len(warnings) != tt.wantWarnings {
+				t.Errorf("warnings = %q, want %d", warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestToApplyFormat_Undetectable(t *testing.T) {
+	if _, _, err := ToApplyFormat([]byte(`{"foo":"bar"}`)); err == nil {
+		t.Fatal("expected error for an unrecognized resource")
+	}
+}
+
+func assertJSONEqual(t *testing.T, want, got string) {
+	t.Helper()
+	var w, g interface{}
+	if err := json.Unmarshal([]byte(want), &w); err != nil {
+		t.Fatalf("invalid want JSON: %v", err)
+	}
+	if err := json.Unmarshal([]byte(got), &g); err != nil {
+		t.Fatalf("invalid result JSON %s: %v", got, err)
+	}
+	wb, _ := json.Marshal(w)
+	gb, _ := json.Marshal(g)
+	if string(wb) != string(gb) {
+		t.Errorf("got %s\nwant %s", gb, wb)
+	}
+}
@@ -1,6 +1,12 @@
 package apply
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dynatrace-oss/dtctl/pkg/resources/document"
+	"github.com/dynatrace-oss/dtctl/sdk/httpclient"
+)
 
 // HookRejectedError is returned when a pre-apply hook exits with a non-zero
 // exit code, indicating the resource was rejected by the hook.
@@ -15,6 +21,33 @@ func (e *HookRejectedError) Error() string {
 	return fmt.Sprintf("pre-apply hook rejected the resource\nHook command: %s\nExit code: %d", e.Command, e.ExitCode)
 }
 
+// detectionHeuristics describes, in order, the shapes detectResourceType
+// recognizes. Keep in sync with detectResourceType.
+var detectionHeuristics = []string{
+	"schemaId of a known schema (anomaly detector, Azure/GCP connection) or schemaId + scope + value (settings)",
+	"scope integration-aws, integration-azure or integration-gcp (monitoring config)",
+	"hostPatterns (edgeconnect)",
+	"type: dashboard, notebook or extension_monitoring_config",
+	"tasks (workflow)",
+	"metadata, tiles, sections, or content with tiles/sections (dashboard, notebook)",
+	"criteria + name (slo)",
+	"bucketName + table (bucket)",
+	"analyzer.name + eventTemplate (anomaly detector)",
+	"includes + isPublic, or includes + name (segment)",
+}
+
+// DetectionError is returned when apply cannot tell the resource type of a
+// file. Its message lists the heuristics that were tried.
+type DetectionError struct{}
+
+func (e *DetectionError) Error() string {
+	msg := "could not detect resource type from file content; tried:"
+	for _, h := range detectionHeuristics {
+		msg += "\n  - " + h
+	}
+	return msg + "\nuse --type to set the resource type explicitly"
+}
+
 // ListApplyError is returned when some items in a batch apply fail.
 // It includes results for successful items alongside the error details.
 type ListApplyError struct {
@@ -30,3 +63,29 @@ func (e *ListApplyError) Error() string {
 	}
 	return msg
 }
+
+// MaxConflictRetries bounds how often --force-conflicts re-fetches a resource
+// and retries an update that lost an optimistic-locking race.
+const MaxConflictRetries = 3
+
+// isVersionConflict reports whether err is an optimistic-locking conflict
+// (HTTP 409: the remote version changed since it was read).
+func isVersionConflict(err error) bool {
+	return errors.Is(err, httpclient.ErrConflict) || errors.Is(err, document.ErrVersionConflict)
+}
+
+// retryOnConflict reports whether a failed update should be retried against
+// a freshly fetched version. Retrying is opt-in (--force-conflicts) because it
+// overwrites whatever the concurrent editor changed.
+func retryOnConflict(err error, opts ApplyOptions, attempt int) bool {
+	return opts.ForceConflicts && attempt < MaxConflictRetries && isVersionConflict(err)
+}
+
+// conflictHint points at --force-conflicts when an update failed on a version
+// conflict and retrying was not requested.
+func conflictHint(err error, opts ApplyOptions) error {
+	if opts.ForceConflicts || !isVersionConflict(err) {
+		return err
+	}
+	return fmt.Errorf("%w (the resource changed remotely; re-run to pick up the new version, or pass --force-conflicts to retry automatically)", err)
+}
@@ -0,0 +1,42 @@
+package apply
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/dynatrace-oss/dtctl/pkg/labels"
+)
+
+// stampLabels returns the description to write for a labelled resource: the
+// user text with a label sidecar carrying the labels already in the manifest,
+// the dtctl managed-by marker, and the --label pairs (later ones win). When
+// the manifest has no description text, current supplies it, so applying a
+// manifest without a description does not wipe one set in the UI.
+//
+// Without --label the description is forwarded untouched: labelling is opt-in
+// so a plain apply sends exactly what the manifest contains.
+func stampLabels(description, current string, extra labels.Labels) string {
+	if len(extra) == 0 {
+		return description
+	}
+	text, l := labels.FromDescription(description)
+	if text == "" {
+		text, _ = labels.FromDescription(current)
+	}
+	l = l.Merge(labels.Labels{labels.ManagedByKey: labels.ManagedByValue}).Merge(extra)
+	return labels.InDescription(text, l)
+}
+
+// stampWorkflowLabels stamps the label sidecar into a workflow's description.
+func stampWorkflowLabels(data []byte, extra labels.Labels) ([]byte, error) {
+	if len(extra) == 0 {
+		return data, nil
+	}
+	var wf map[string]interface{}
+	if err := json.Unmarshal(data, &wf); err != nil {
+		return nil, fmt.Errorf("failed to parse workflow JSON: %w", err)
+	}
+	description, _ := wf["description"].(string)
+	wf["description"] = stampLabels(description, "", extra)
+	return json.Marshal(wf)
+}
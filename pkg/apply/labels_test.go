@@ -0,0 +1,57 @@
+package apply
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/pkg/labels"
+)
+
+func TestStampLabels(t *testing.T) {
+	extra := labels.Labels{"team": "checkout"}
+
+	if got := stampLabels("as is", "", nil); got != "as is" {
+		t.Errorf("without --label description must be untouched, got %q", got)
+	}
+
+	got := stampLabels("Checkout overview", "", extra)
+	text, l := labels.FromDescription(got)
+	if text != "Checkout overview" || l["team"] != "checkout" || l[labels.ManagedByKey] != labels.ManagedByValue {
+		t.Errorf("stampLabels() = %q", got)
+	}
+
+	// Labels already in the manifest are kept; --label wins on conflicts.
+	manifest := labels.InDescription("Checkout overview", labels.Labels{"team": "old", "tier": "1"})
+	_, l = labels.FromDescription(stampLabels(manifest, "", extra))
+	if l["team"] != "checkout" || l["tier"] != "1" {
+		t.Errorf("merged labels = %v", l)
+	}
+
+	// A manifest without description text keeps the current one.
+	current := labels.InDescription("Set in the UI", labels.Labels{"x": "y"})
+	if text, _ := labels.FromDescription(stampLabels("", current, extra)); text != "Set in the UI" {
+		t.Errorf("current description text not preserved, got %q", text)
+	}
+}
+
+func TestStampWorkflowLabels(t *testing.T) {
+	data := []byte(`{"title":"Nightly","description":"Runs nightly"}`)
+
+	unchanged, err := stampWorkflowLabels(data, nil)
+	if err != nil || string(unchanged) != string(data) {
+		t.Fatalf("stampWorkflowLabels(nil) = %s, %v; want input unchanged", unchanged, err)
+	}
+
+	stamped, err := stampWorkflowLabels(data, labels.Labels{"team": "sre"})
+	if err != nil {
+		t.Fatalf("stampWorkflowLabels() error = %v", err)
+	}
+	var wf map[string]interface{}
+	if err := json.Unmarshal(stamped, &wf); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	sel, _ := labels.ParseSelector("dtctl.io/managed-by=dtctl,team=sre")
+	if !sel.MatchesDescription(wf["description"].(string)) || wf["title"] != "Nightly" {
+		t.Errorf("stamped workflow = %v", wf)
+	}
+}
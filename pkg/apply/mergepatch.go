@@ -0,0 +1,45 @@
+package apply
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MergePatch applies a JSON merge patch (RFC 7386) to target and returns the
+// result. Objects are merged recursively, a null in the patch removes the
+// key, and any other value (including arrays) replaces the target's value.
+// target is not modified.
+func MergePatch(target, patch interface{}) interface{} {
+	patchObj, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObj, ok := target.(map[string]interface{})
+	result := make(map[string]interface{}, len(targetObj)+len(patchObj))
+	if ok {
+		for k, v := range targetObj {
+			result[k] = v
+		}
+	}
+	for k, v := range patchObj {
+		if v == nil {
+			delete(result, k)
+			continue
+		}
+		result[k] = MergePatch(result[k], v)
+	}
+	return result
+}
+
+// mergeJSON applies patch to target, both given as JSON documents.
+func mergeJSON(target, patch []byte) ([]byte, error) {
+	var t, p interface{}
+	if err := json.Unmarshal(target, &t); err != nil {
+		return nil, fmt.Errorf("failed to parse current content: %w", err)
+	}
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return nil, fmt.Errorf("failed to parse patch: %w", err)
+	}
+	return json.Marshal(MergePatch(t, p))
+}
@@ -0,0 +1,46 @@
+package apply
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergePatch(t *testing.T) {
+	target := map[string]interface{}{
+		"enabled":     true,
+		"description": "old",
+		"rules":       []interface{}{"a", "b"},
+		"nested":      map[string]interface{}{"x": 1.0, "y": 2.0},
+	}
+	patch := map[string]interface{}{
+		"enabled":     false,
+		"description": nil,
+		"rules":       []interface{}{"c"},
+		"nested":      map[string]interface{}{"y": nil, "z": 3.0},
+	}
+
+	got := MergePatch(target, patch)
+	want := map[string]interface{}{
+		"enabled": false,
+		"rules":   []interface{}{"c"},
+		"nested":  map[string]interface{}{"x": 1.0, "z": 3.0},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MergePatch() = %v, want %v", got, want)
+	}
+
+	// The target must be left untouched.
+	if target["description"] != "old" || target["nested"].(map[string]interface{})["y"] != 2.0 {
+		t.Errorf("MergePatch() mutated target: %v", target)
+	}
+}
+
+func TestMergePatch_NonObjectPatchReplaces(t *testing.T) {
+	if got := MergePatch(map[string]interface{}{"a": 1.0}, "scalar"); got != "scalar" {
+		t.Errorf("MergePatch() = %v, want scalar", got)
+	}
+	got := MergePatch("scalar", map[string]interface{}{"a": 1.0})
+	if !reflect.DeepEqual(got, map[string]interface{}{"a": 1.0}) {
+		t.Errorf("MergePatch() = %v", got)
+	}
+}
@@ -0,0 +1,64 @@
+package apply
+
+import (
+	"fmt"
+
+	"github.com/dynatrace-oss/dtctl/pkg/util/format"
+)
+
+// DefaultOrder is the order in which a multi-file apply processes resource
+// types: connections first, then the settings and monitoring configurations
+// that reference them, and workflows and documents last.
+var DefaultOrder = []ResourceType{
+	ResourceAzureConnection,
+	ResourceGCPConnection,
+	ResourceEdgeConnect,
+	ResourceBucket,
+	ResourceSettings,
+	ResourceSegment,
+	ResourceAnomalyDetector,
+	ResourceSLO,
+	ResourceExtensionConfig,
+	ResourceAWSMonitoringConfig,
+	ResourceAzureMonitoringConfig,
+	ResourceGCPMonitoringConfig,
+	ResourceWorkflow,
+	ResourceDashboard,
+	ResourceNotebook,
+}
+
+// ParseOrder resolves an --order list of resource types into a full apply
+// order: the listed types come first, in the given order, followed by the
+// remaining types in DefaultOrder.
+func ParseOrder(names []string) ([]ResourceType, error) {
+	order := make([]ResourceType, 0, len(DefaultOrder))
+	listed := make(map[ResourceType]bool)
+	for _, name := range names {
+		t, err := ParseResourceType(name)
+		if err != nil {
+			return nil, err
+		}
+		if listed[t] {
+			return nil, fmt.Errorf("resource type %q is listed more than once", name)
+		}
+		listed[t] = true
+		order = append(order, t)
+	}
+	for _, t := range DefaultOrder {
+		if !listed[t] {
+			order = append(order, t)
+		}
+	}
+	return order, nil
+}
+
+// DetectType returns the resource type of a manifest without applying it. An
+// array manifest reports the type of its elements.
+func DetectType(fileData []byte) (ResourceType, error) {
+	jsonData, err := format.ValidateAndConvert(fileData)
+	if err != nil {
+		return ResourceUnknown, fmt.Errorf("invalid file format: %w", err)
+	}
+	t, _, err := detectResourceType(jsonData)
+	return t, err
+}
@@ -0,0 +1,41 @@
+package apply
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseOrder(t *testing.T) {
+	order, err := ParseOrder(nil)
+	if err != nil {
+		t.Fatalf("ParseOrder(nil) error = %v", err)
+	}
+	if !reflect.DeepEqual(order, DefaultOrder) {
+		t.Errorf("ParseOrder(nil) = %v, want DefaultOrder", order)
+	}
+
+	order, err = ParseOrder([]string{"workflow", "azure-connection"})
+	if err != nil {
+		t.Fatalf("ParseOrder() error = %v", err)
+	}
+	if len(order) != len(DefaultOrder) || order[0] != ResourceWorkflow || order[1] != ResourceAzureConnection || order[2] != ResourceGCPConnection {
+		t.Errorf("ParseOrder() = %v, want workflow, azure_connection, then the default order", order)
+	}
+
+	if _, err := ParseOrder([]string{"settings", "settings"}); err == nil {
+		t.Error("expected error for a duplicate type")
+	}
+	if _, err := ParseOrder([]string{"nope"}); err == nil {
+		t.Error("expected error for an unknown type")
+	}
+}
+
+func TestDetectType(t *testing.T) {
+	got, err := DetectType([]byte("bucketName: logs\ntable: logs\nretentionDays: 35\n"))
+	if err != nil || got != ResourceBucket {
+		t.Errorf("DetectType() = %v, %v, want bucket", got, err)
+	}
+	if _, err := DetectType([]byte("{")); err == nil {
+		t.Error("expected error for invalid input")
+	}
+}
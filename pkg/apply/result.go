@@ -93,6 +93,14 @@ type AnomalyDetectorApplyResult struct {
 	ApplyResultBase `yaml:",inline"`
 }
 
+// EdgeConnectApplyResult is the result of applying an EdgeConnect. The OAuth
+// client secret is only set on create, when the service returns it once.
+type EdgeConnectApplyResult struct {
+	ApplyResultBase   `yaml:",inline"`
+	OAuthClientID     string `json:"oauthClientId,omitempty"     yaml:"oauthClientId,omitempty"     table:"-"`
+	OAuthClientSecret string `json:"oauthClientSecret,omitempty" yaml:"oauthClientSecret,omitempty" table:"-"`
+}
+
 // DryRunResult is the result of a dry-run apply operation.
 // It reports what would happen without actually modifying anything.
 type DryRunResult struct {
@@ -104,4 +112,6 @@ type DryRunResult struct {
 	ExtensionName   string   `json:"extensionName,omitempty" yaml:"extensionName,omitempty" table:"EXTENSION"`
 	Scope           string   `json:"scope,omitempty"      yaml:"scope,omitempty"      table:"SCOPE"`
 	ValidationWarns []string `json:"validationWarnings,omitempty" yaml:"validationWarnings,omitempty" table:"-"`
+	// ServerValidated is true when the API checked the change (--dry-run=server).
+	ServerValidated bool `json:"serverValidated,omitempty" yaml:"serverValidated,omitempty" table:"-"`
 }
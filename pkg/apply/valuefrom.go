@@ -0,0 +1,144 @@
+package apply
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ResolveValueFrom replaces every {"valueFrom": {"file": "<path>"}} object in
+// a JSON document with the contents of that file, so secrets can live next to
+// the manifest instead of inside it:
+//
+//	value:
+//	  clientSecret:
+//	    valueFrom:
+//	      file: secrets/client-secret.txt
+//
+// Paths are resolved relative to baseDir (the manifest's directory) and must
+// stay inside it; absolute paths and symlinks that escape it are rejected.
+// Trailing newlines are trimmed from the file contents. Documents without a
+// valueFrom key are returned unchanged.
+func ResolveValueFrom(data []byte, baseDir string) ([]byte, error) {
+	if !bytes.Contains(data, []byte(`"valueFrom"`)) {
+		return data, nil
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+
+	resolved, changed, err := resolveValueFrom(doc, baseDir)
+	if err != nil {
+		return nil, err
+	}
+	if !changed {
+		return data, nil
+	}
+	return json.Marshal(resolved)
+}
+
+func resolveValueFrom(v interface{}, baseDir string) (interface{}, bool, error) {
+	switch node := v.(type) {
+	case map[string]interface{}:
+		if ref, ok := valueFromFile(node); ok {
+			content, err := readValueFile(ref, baseDir)
+			if err != nil {
+				return nil, false, err
+			}
+			return content, true, nil
+		}
+		changed := false
+		for k, child := range node {
+			r, c, err := resolveValueFrom(child, baseDir)
+			if err != nil {
+				return nil, false, err
+			}
+			if c {
+				node[k] = r
+				changed = true
+			}
+		}
+		return node, changed, nil
+	case []interface{}:
+		changed := false
+		for i, child := range node {
+			r, c, err := resolveValueFrom(child, baseDir)
+			if err != nil {
+				return nil, false, err
+			}
+			if c {
+				node[i] = r
+				changed = true
+			}
+		}
+		return node, changed, nil
+	default:
+		return v, false, nil
+	}
+}
+
+// valueFromFile reports whether m is exactly {"valueFrom": {"file": path}}.
+func valueFromFile(m map[string]interface{}) (string, bool) {
+	if len(m) != 1 {
+		return "", false
+	}
+	src, ok := m["valueFrom"].(map[string]interface{})
+	if !ok || len(src) != 1 {
+		return "", false
+	}
+	path, ok := src["file"].(string)
+	return path, ok
+}
+
+// readValueFile reads path relative to baseDir, refusing anything that
+// resolves outside baseDir.
+func readValueFile(path, baseDir string) (string, error) {
+	if baseDir == "" {
+		return "", fmt.Errorf("valueFrom.file %q: file references are only supported when applying from a file", path)
+	}
+	if path == "" || filepath.IsAbs(path) {
+		return "", fmt.Errorf("valueFrom.file %q: path must be relative to the manifest", path)
+	}
+
+	base, err := filepath.Abs(baseDir)
+	if err != nil {
+		return "", fmt.Errorf("valueFrom.file %q: %w", path, err)
+	}
+	target := filepath.Join(base, path)
+	if !withinDir(base, target) {
+		return "", fmt.Errorf("valueFrom.file %q: path escapes the manifest directory", path)
+	}
+
+	// Re-check after resolving symlinks so a link inside the directory cannot
+	// point at a file outside it.
+	realBase, err := filepath.EvalSymlinks(base)
+	if err != nil {
+		return "", fmt.Errorf("valueFrom.file %q: %w", path, err)
+	}
+	realTarget, err := filepath.EvalSymlinks(target)
+	if err != nil {
+		return "", fmt.Errorf("valueFrom.file %q: %w", path, err)
+	}
+	if !withinDir(realBase, realTarget) {
+		return "", fmt.Errorf("valueFrom.file %q: path escapes the manifest directory", path)
+	}
+
+	content, err := os.ReadFile(realTarget)
+	if err != nil {
+		return "", fmt.Errorf("valueFrom.file %q: %w", path, err)
+	}
+	return strings.TrimRight(string(content), "\r\n"), nil
+}
+
+func withinDir(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,88 @@
+package apply
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestResolveValueFrom(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "secrets"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "secrets", "key.txt"), []byte("s3cr3t\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	in := `{"schemaId":"builtin:x","value":{"name":"conn","key":{"valueFrom":{"file":"secrets/key.txt"}},` +
+		`"list":[{"valueFrom":{"file":"./secrets/key.txt"}}],"other":{"valueFrom":{"file":"a"},"extra":1}}}`
+	out, err := ResolveValueFrom([]byte(in), dir)
+	if err != nil {
+		t.Fatalf("ResolveValueFrom() error = %v", err)
+	}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal(out, &doc); err != nil {
+		t.Fatal(err)
+	}
+	value := doc["value"].(map[string]interface{})
+	if value["key"] != "s3cr3t" {
+		t.Errorf("key = %v, want s3cr3t", value["key"])
+	}
+	if list := value["list"].([]interface{}); list[0] != "s3cr3t" {
+		t.Errorf("list[0] = %v, want s3cr3t", list[0])
+	}
+	// Objects with other keys next to valueFrom are left alone.
+	if _, ok := value["other"].(map[string]interface{}); !ok {
+		t.Errorf("other = %v, want untouched object", value["other"])
+	}
+}
+
+func TestResolveValueFrom_NoReferences(t *testing.T) {
+	in := []byte(`{"value":{"name":"x"}}`)
+	out, err := ResolveValueFrom(in, "")
+	if err != nil || string(out) != string(in) {
+		t.Errorf("ResolveValueFrom() = %s, %v; want input unchanged", out, err)
+	}
+}
+
+func TestResolveValueFrom_Guards(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "manifests")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	outside := filepath.Join(root, "outside.txt")
+	if err := os.WriteFile(outside, []byte("nope"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(outside, filepath.Join(dir, "link.txt")); err != nil {
+		t.Skipf("symlinks unsupported: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		baseDir string
+		want    string
+	}{
+		{"parent traversal", "../outside.txt", dir, "escapes"},
+		{"absolute path", outside, dir, "must be relative"},
+		{"symlink escape", "link.txt", dir, "escapes"},
+		{"missing file", "missing.txt", dir, "missing.txt"},
+		{"no base dir", "secret.txt", "", "only supported when applying from a file"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathJSON, _ := json.Marshal(tt.path)
+			in := `{"value":{"key":{"valueFrom":{"file":` + string(pathJSON) + `}}}}`
+			_, err := ResolveValueFrom([]byte(in), tt.baseDir)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -74,11 +74,14 @@ var ResourceScopes = map[string]AccessScopes{
 	"dashboard": {Read: []string{"document:documents:read"}, Write: []string{"document:documents:write"}, Delete: []string{"document:documents:delete"}},
 	"notebook":  {Read: []string{"document:documents:read"}, Write: []string{"document:documents:write"}, Delete: []string{"document:documents:delete"}},
 	"trash":     {Read: []string{"document:trash.documents:read"}, Write: []string{"document:trash.documents:restore"}, Delete: []string{"document:trash.documents:delete"}},
+	// import grafana-dashboard converts offline and creates a dashboard document.
+	"grafana-dashboard": {Write: []string{"document:documents:write"}},
 
 	// Grail storage. Buckets are managed via the bucket data scopes (delete
 	// folds into write); lookups and segments are stored as files / filter
 	// segments respectively.
 	"bucket":  {Read: []string{"storage:buckets:read"}, Write: []string{"storage:buckets:write"}},
+	"record":  {Read: []string{"storage:buckets:read"}, Delete: []string{"storage:records:delete"}},
 	"lookup":  {Read: []string{"storage:files:read"}, Write: []string{"storage:files:write"}, Delete: []string{"storage:files:delete"}},
 	"segment": {Read: []string{"storage:filter-segments:read"}, Write: []string{"storage:filter-segments:write"}, Delete: []string{"storage:filter-segments:delete"}},
 
@@ -145,6 +148,11 @@ var ResourceScopes = map[string]AccessScopes{
 	"aws":   {Read: []string{"settings:objects:read", "extensions:configurations:read"}, Write: []string{"settings:objects:write", "extensions:configurations:write"}},
 	"azure": {Read: []string{"settings:objects:read", "extensions:configurations:read"}, Write: []string{"settings:objects:write", "extensions:configurations:write"}},
 	"gcp":   {Read: []string{"settings:objects:read", "extensions:configurations:read"}, Write: []string{"settings:objects:write", "extensions:configurations:write"}},
+
+	// `verify connection` re-validates a stored Azure/GCP connection with a
+	// validateOnly Settings PUT, which needs the write scope even though
+	// nothing is persisted.
+	"connection": {Read: []string{"settings:objects:read", "settings:objects:write"}},
 }
 
 // localResources are catalog subcommands that operate entirely on the local
@@ -155,8 +163,8 @@ var localResources = map[string]bool{
 	// config / context management
 	"current-context": true, "delete-context": true, "describe-context": true,
 	"get-contexts": true, "use-context": true, "set-context": true,
-	"set-credentials": true, "migrate-tokens": true, "init": true,
-	"view": true, "current": true, "set": true,
+	"set-credentials": true, "set-token": true, "migrate-tokens": true, "init": true,
+	"view": true, "current": true, "set": true, "set-view": true, "delete-view": true,
 	// ctx aliases
 	"describe": true, "delete": true, "token": true, "discover-account": true,
 	// auth (local token storage / introspection)
@@ -165,6 +173,8 @@ var localResources = map[string]bool{
 	"export": true, "import": true, "list": true, "create": true,
 	// skills (local install)
 	"install": true, "uninstall": true,
+	// completion cache
+	"clear": true,
 }
 
 // QueryScopes are the Grail read scopes required by DQL (`query`, `verify`,
@@ -363,7 +373,7 @@ func (s *scopeSet) addUnrestricted() {
 	s.addResource("lookup", AccessWrite, AccessDelete)
 	s.addResource("segment", AccessWrite)
 	s.add("storage:filter-segments:share", "storage:filter-segments:delete", "storage:filter-segments:admin")
-	s.add("storage:records:delete")
+	s.addResource("record", AccessDelete)
 	s.add("iam:service-users:use")
 	s.addResource("analyzer", AccessRun)
 	s.add("davis-copilot:conversations:execute", "davis-copilot:nl2dql:execute", "davis-copilot:dql2nl:execute", "davis-copilot:document-search:execute")
@@ -403,6 +413,32 @@ func safetyLevelScopes(level config.SafetyLevel) []string {
 	return s.list
 }
 
+// MinimumSafetyLevel returns the lowest safety level whose OAuth login
+// requests every scope in scopes, checking levels from readonly upward. It
+// returns false when no level grants them all (or scopes is empty).
+func MinimumSafetyLevel(scopes []string) (config.SafetyLevel, bool) {
+	if len(scopes) == 0 {
+		return "", false
+	}
+	for _, level := range config.ValidSafetyLevels() {
+		granted := make(map[string]bool)
+		for _, s := range safetyLevelScopes(level) {
+			granted[s] = true
+		}
+		all := true
+		for _, s := range scopes {
+			if !granted[s] {
+				all = false
+				break
+			}
+		}
+		if all {
+			return level, true
+		}
+	}
+	return "", false
+}
+
 // AccessForVerb derives the access level a verb needs from its name and safety
 // operation. exec maps to run; OperationDelete maps to delete; any other
 // mutating operation maps to write; everything else is read. safetyOp is the
@@ -356,3 +356,28 @@ func TestSafetyLevelNoDuplicateScopes(t *testing.T) {
 		})
 	}
 }
+
+func TestMinimumSafetyLevel(t *testing.T) {
+	tests := []struct {
+		name   string
+		scopes []string
+		want   config.SafetyLevel
+		wantOK bool
+	}{
+		{"read", []string{"storage:buckets:read"}, config.SafetyLevelReadOnly, true},
+		{"workflow write", []string{"automation:workflows:write"}, config.SafetyLevelReadWriteMine, true},
+		{"bucket write", []string{"storage:buckets:write"}, config.SafetyLevelReadWriteAll, true},
+		{"record delete", []string{"storage:records:delete"}, config.SafetyLevelDangerouslyUnrestricted, true},
+		{"mixed takes the highest", []string{"storage:buckets:read", "storage:buckets:write"}, config.SafetyLevelReadWriteAll, true},
+		{"unknown scope", []string{"no:such:scope"}, "", false},
+		{"empty", nil, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := MinimumSafetyLevel(tt.scopes)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("MinimumSafetyLevel(%v) = %q, %v; want %q, %v", tt.scopes, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
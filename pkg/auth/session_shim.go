@@ -35,14 +35,18 @@ const (
 	EnvironmentDev  = session.EnvironmentDev
 	EnvironmentHard = session.EnvironmentHard
 
-	OAuthTokenPrefix   = session.OAuthTokenPrefix
-	TokenRefreshBuffer = session.TokenRefreshBuffer
+	OAuthTokenPrefix    = session.OAuthTokenPrefix
+	TokenRefreshBuffer  = session.TokenRefreshBuffer
+	DefaultCallbackPort = session.DefaultCallbackPort
 )
 
 // ErrOAuthSessionRevoked mirrors session.ErrOAuthSessionRevoked (same value,
 // so errors.Is works across both names).
 var ErrOAuthSessionRevoked = session.ErrOAuthSessionRevoked
 
+// ErrCallbackPortInUse mirrors session.ErrCallbackPortInUse.
+var ErrCallbackPortInUse = session.ErrCallbackPortInUse
+
 func NewTokenManager(oauthConfig *OAuthConfig) (*TokenManager, error) {
 	return session.NewTokenManager(oauthConfig)
 }
@@ -34,14 +34,25 @@ func dtctlIdentity() session.ClientOption {
 }
 
 // NewFromConfig creates a client for the config's current context with
-// OAuth-aware token resolution and re-resolution on 401.
+// OAuth-aware token resolution and re-resolution on 401. Requests are paced
+// by the shared rate limiter when one is set (see SetRateLimit).
 func NewFromConfig(cfg *config.Config) (*Client, error) {
-	return session.NewClientFromConfig(cfg, dtctlIdentity())
+	c, err := session.NewClientFromConfig(cfg, dtctlIdentity())
+	if err != nil {
+		return nil, err
+	}
+	applyRateLimit(c)
+	return c, nil
 }
 
 // New creates a new client with base URL and token.
 func New(baseURL, token string) (*Client, error) {
-	return session.NewClient(baseURL, token, dtctlIdentity())
+	c, err := session.NewClient(baseURL, token, dtctlIdentity())
+	if err != nil {
+		return nil, err
+	}
+	applyRateLimit(c)
+	return c, nil
 }
 
 // NewForTesting creates a client with retries disabled, suitable for unit tests
@@ -0,0 +1,101 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// RateLimiter is a token bucket that paces requests to a fixed rate. The
+// bucket holds a single token, so requests are spread evenly instead of
+// bursting: one request may start every 1/rps seconds. It is safe for
+// concurrent use.
+type RateLimiter struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time // earliest start time of the next request
+}
+
+// NewRateLimiter returns a limiter that allows rps requests per second.
+func NewRateLimiter(rps float64) (*RateLimiter, error) {
+	if rps <= 0 {
+		return nil, fmt.Errorf("rate limit must be greater than 0, got %g", rps)
+	}
+	return &RateLimiter{interval: time.Duration(float64(time.Second) / rps)}, nil
+}
+
+// Wait blocks until the caller may send a request or ctx is done. A caller
+// whose context ends while waiting gives up its slot only in the sense that
+// the request is not sent; the slot itself stays taken.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	slot := l.next
+	if slot.Before(now) {
+		slot = now
+	}
+	l.next = slot.Add(l.interval)
+	l.mu.Unlock()
+
+	delay := time.Until(slot)
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// sharedLimiter paces every client created by this package (--rate-limit).
+// One limiter for the whole process bounds the total request rate no matter
+// how many clients or worker goroutines send requests.
+var (
+	sharedLimiterMu sync.Mutex
+	sharedLimiter   *RateLimiter
+)
+
+// SetRateLimit limits all clients created afterwards to rps requests per
+// second in total. Zero turns rate limiting off.
+func SetRateLimit(rps float64) error {
+	var l *RateLimiter
+	if rps != 0 {
+		var err error
+		if l, err = NewRateLimiter(rps); err != nil {
+			return err
+		}
+	}
+	sharedLimiterMu.Lock()
+	sharedLimiter = l
+	sharedLimiterMu.Unlock()
+	return nil
+}
+
+// applyRateLimit makes c wait for the shared limiter before every request,
+// retries included.
+func applyRateLimit(c *Client) {
+	ApplyRateLimit(c.HTTP())
+}
+
+// ApplyRateLimit paces r with the shared limiter, like every client this
+// package creates. Use it for HTTP clients built elsewhere, such as the
+// account-plane client, so --rate-limit covers them too.
+func ApplyRateLimit(r *resty.Client) {
+	sharedLimiterMu.Lock()
+	l := sharedLimiter
+	sharedLimiterMu.Unlock()
+	if l == nil {
+		return
+	}
+	r.OnBeforeRequest(func(_ *resty.Client, req *resty.Request) error {
+		return l.Wait(req.Context())
+	})
+}
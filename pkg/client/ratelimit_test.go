@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func TestNewRateLimiter_RejectsNonPositive(t *testing.T) {
+	for _, rps := range []float64{0, -1} {
+		if _, err := NewRateLimiter(rps); err == nil {
+			t.Errorf("NewRateLimiter(%g): expected error", rps)
+		}
+	}
+}
+
+func TestRateLimiter_PacesConcurrentCallers(t *testing.T) {
+	l, err := NewRateLimiter(50) // one slot every 20ms
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := l.Wait(context.Background()); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// The first caller goes immediately, the other five wait 20ms each.
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("6 requests at 50 rps took %v, want at least 100ms", elapsed)
+	}
+}
+
+func TestRateLimiter_WaitHonorsContext(t *testing.T) {
+	l, err := NewRateLimiter(0.1) // one slot every 10s
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx); err == nil {
+		t.Error("expected context error while waiting for a slot")
+	}
+}
+
+func TestSetRateLimit_SharedAcrossClients(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	if err := SetRateLimit(50); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = SetRateLimit(0) })
+
+	first, err := NewForTesting(server.URL, "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := NewForTesting(server.URL, "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		for _, c := range []*Client{first, second} {
+			if _, err := c.HTTP().R().Get("/"); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	// Six requests through one shared 50 rps limiter need five 20ms gaps.
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("6 requests across two clients took %v, want at least 100ms", elapsed)
+	}
+}
+
+func TestApplyRateLimit_ExternalClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	if err := SetRateLimit(50); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = SetRateLimit(0) })
+
+	r := resty.New().SetBaseURL(server.URL)
+	ApplyRateLimit(r)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := r.R().Get("/"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Three requests at 50 rps need two 20ms gaps.
+	if elapsed := time.Since(start); elapsed < 35*time.Millisecond {
+		t.Errorf("3 requests took %v, want at least 40ms", elapsed)
+	}
+}
+
+func TestSetRateLimit_Validation(t *testing.T) {
+	t.Cleanup(func() { _ = SetRateLimit(0) })
+	if err := SetRateLimit(-5); err == nil {
+		t.Error("expected error for negative rate limit")
+	}
+	if err := SetRateLimit(0); err != nil {
+		t.Errorf("SetRateLimit(0) should turn limiting off, got %v", err)
+	}
+}
@@ -111,6 +111,9 @@ var MutatingVerbs = map[string]string{
 	"exec":    "OperationCreate", // semantically mutating (runs workflows, functions)
 	"enable":  "OperationUpdate", // PUTs updated monitoring/credential config to the tenant
 	"disable": "OperationUpdate", // PUTs updated monitoring config with enabled=false
+	"api":     "OperationUpdate", // raw passthrough; only write methods are safety-checked
+	"cp":      "OperationCreate", // creates the copy in the target context
+	"import":  "OperationCreate", // creates the converted resource
 }
 
 // ResourceAliases are the standard resource aliases built into dtctl.
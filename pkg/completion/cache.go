@@ -0,0 +1,121 @@
+// Package completion caches resource-name candidates for shell completion so
+// that pressing TAB does not hit the API every time.
+//
+// Entries live under <CacheDir>/completion/<context>/<resource>.json, so each
+// context keeps its own names and switching contexts never serves another
+// environment's candidates.
+package completion
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// EnvNoCache disables the completion cache when set to a non-empty value.
+const EnvNoCache = "DTCTL_NO_CACHE"
+
+// DefaultTTL is how long cached candidates are reused when no TTL is configured.
+const DefaultTTL = 5 * time.Minute
+
+// Cache stores completion candidates per context and resource type.
+type Cache struct {
+	dir string
+	ttl time.Duration
+}
+
+type entry struct {
+	FetchedAt  time.Time `json:"fetchedAt"`
+	Candidates []string  `json:"candidates"`
+}
+
+// New returns a cache rooted at dir that reuses entries for ttl. A ttl of
+// zero or less, or DTCTL_NO_CACHE, disables reads and writes.
+func New(dir string, ttl time.Duration) *Cache {
+	return &Cache{dir: dir, ttl: ttl}
+}
+
+// Enabled reports whether entries are read and written.
+func (c *Cache) Enabled() bool {
+	return c.ttl > 0 && os.Getenv(EnvNoCache) == ""
+}
+
+// TTL returns how long entries are reused.
+func (c *Cache) TTL() time.Duration { return c.ttl }
+
+func (c *Cache) contextDir(contextName string) string {
+	return filepath.Join(c.dir, url.PathEscape(contextName))
+}
+
+func (c *Cache) path(contextName, resource string) string {
+	return filepath.Join(c.contextDir(contextName), url.PathEscape(resource)+".json")
+}
+
+// Get returns the cached candidates for resource in contextName, if present
+// and younger than the TTL.
+func (c *Cache) Get(contextName, resource string) ([]string, bool) {
+	if !c.Enabled() {
+		return nil, false
+	}
+	data, err := os.ReadFile(c.path(contextName, resource))
+	if err != nil {
+		return nil, false
+	}
+	var e entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, false
+	}
+	if time.Since(e.FetchedAt) > c.ttl {
+		return nil, false
+	}
+	return e.Candidates, true
+}
+
+// Put stores candidates for resource in contextName.
+func (c *Cache) Put(contextName, resource string, candidates []string) error {
+	if !c.Enabled() {
+		return nil
+	}
+	dir := c.contextDir(contextName)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create completion cache: %w", err)
+	}
+	data, err := json.Marshal(entry{FetchedAt: time.Now(), Candidates: candidates})
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(c.path(contextName, resource), data, 0o600); err != nil {
+		return fmt.Errorf("failed to write completion cache: %w", err)
+	}
+	return nil
+}
+
+// Candidates returns cached candidates, calling fetch and caching its result
+// on a miss. With the cache disabled it always calls fetch.
+func (c *Cache) Candidates(contextName, resource string, fetch func() ([]string, error)) ([]string, error) {
+	if cached, ok := c.Get(contextName, resource); ok {
+		return cached, nil
+	}
+	candidates, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	_ = c.Put(contextName, resource, candidates)
+	return candidates, nil
+}
+
+// Clear removes the cached entries of contextName, or of every context when
+// contextName is empty.
+func (c *Cache) Clear(contextName string) error {
+	dir := c.dir
+	if contextName != "" {
+		dir = c.contextDir(contextName)
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("failed to clear completion cache: %w", err)
+	}
+	return nil
+}
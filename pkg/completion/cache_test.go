@@ -0,0 +1,88 @@
+package completion
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCache_PerContext(t *testing.T) {
+	t.Setenv(EnvNoCache, "")
+	c := New(t.TempDir(), time.Minute)
+
+	if err := c.Put("prod", "workflows", []string{"wf-1"}); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if got, ok := c.Get("prod", "workflows"); !ok || len(got) != 1 || got[0] != "wf-1" {
+		t.Errorf("Get(prod) = %v, %v", got, ok)
+	}
+	if _, ok := c.Get("staging", "workflows"); ok {
+		t.Error("Get(staging) served another context's entry")
+	}
+	if _, ok := c.Get("prod", "dashboards"); ok {
+		t.Error("Get(dashboards) served another resource's entry")
+	}
+}
+
+func TestCache_Candidates(t *testing.T) {
+	t.Setenv(EnvNoCache, "")
+	c := New(t.TempDir(), time.Minute)
+
+	calls := 0
+	fetch := func() ([]string, error) {
+		calls++
+		return []string{"a", "b"}, nil
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := c.Candidates("prod", "buckets", fetch); err != nil {
+			t.Fatalf("Candidates() error = %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("fetch called %d times, want 1", calls)
+	}
+
+	if err := c.Clear("prod"); err != nil {
+		t.Fatalf("Clear() error = %v", err)
+	}
+	if _, err := c.Candidates("prod", "buckets", fetch); err != nil {
+		t.Fatalf("Candidates() error = %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("fetch called %d times after Clear, want 2", calls)
+	}
+
+	_, err := c.Candidates("prod", "slos", func() ([]string, error) { return nil, errors.New("boom") })
+	if err == nil {
+		t.Error("expected fetch error to be returned")
+	}
+}
+
+func TestCache_Expired(t *testing.T) {
+	t.Setenv(EnvNoCache, "")
+	c := New(t.TempDir(), time.Nanosecond)
+	_ = c.Put("prod", "workflows", []string{"wf-1"})
+	time.Sleep(time.Millisecond)
+	if _, ok := c.Get("prod", "workflows"); ok {
+		t.Error("Get() returned an expired entry")
+	}
+}
+
+func TestCache_Disabled(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Setenv(EnvNoCache, "1")
+	c := New(dir, time.Minute)
+	if c.Enabled() {
+		t.Error("Enabled() = true with DTCTL_NO_CACHE set")
+	}
+	_ = c.Put("prod", "workflows", []string{"wf-1"})
+
+	t.Setenv(EnvNoCache, "")
+	if _, ok := c.Get("prod", "workflows"); ok {
+		t.Error("Put() wrote while disabled")
+	}
+	if New(dir, 0).Enabled() {
+		t.Error("Enabled() = true with zero TTL")
+	}
+}
@@ -25,6 +25,7 @@ type (
 	SafetyLevel    = session.SafetyLevel
 	AliasEntry     = session.AliasEntry
 	AliasFile      = session.AliasFile
+	View           = session.View
 )
 
 // Safety levels — the shared semantics of a context's safety-level field.
@@ -42,6 +43,9 @@ const (
 	LocalConfigName   = session.LocalConfigName
 )
 
+// AuthTypeToken marks a context authenticated with a static token.
+const AuthTypeToken = session.AuthTypeToken
+
 // Credential-store constants.
 const (
 	KeyringService         = session.KeyringService
@@ -67,6 +71,7 @@ func LoadFrom(path string) (*Config, error)              { return session.LoadFr
 func LoadFromWithoutExpansion(p string) (*Config, error) { return session.LoadFromWithoutExpansion(p) }
 func LoadWithoutExpansion() (*Config, error)             { return session.LoadWithoutExpansion() }
 func NewConfig() *Config                                 { return session.NewConfig() }
+func LoadFromEnv() (*Config, bool)                       { return session.LoadFromEnv() }
 
 // Alias name validation (alias data lives in the shared schema; alias
 // execution stays in cmd/).
@@ -76,8 +81,18 @@ func ValidateAliasName(name string) error { return session.ValidateAliasName(nam
 type (
 	TokenStore     = session.TokenStore
 	OAuthFileStore = session.OAuthFileStore
+	KeyringMode    = session.KeyringMode
+)
+
+// Token storage modes — see sdk/session.KeyringMode.
+const (
+	KeyringModeAuto = session.KeyringModeAuto
+	KeyringModeOS   = session.KeyringModeOS
+	KeyringModeFile = session.KeyringModeFile
 )
 
+func ParseKeyringMode(s string) (KeyringMode, error) { return session.ParseKeyringMode(s) }
+
 func NewTokenStore() *TokenStore         { return session.NewTokenStore() }
 func NewOAuthFileStore() *OAuthFileStore { return session.NewOAuthFileStore() }
 func NewOAuthFileStoreWithDir(dir string) *OAuthFileStore {
@@ -101,6 +116,14 @@ func EnsureKeyringCollection(ctx context.Context) error {
 // Explicit-config environment variable — see sdk/session.EnvConfig.
 const EnvConfig = session.EnvConfig
 
+// Config-less environment variables — see sdk/session.LoadFromEnv.
+const (
+	EnvEnvironmentURL = session.EnvEnvironmentURL
+	EnvToken          = session.EnvToken
+	EnvAPIToken       = session.EnvAPIToken
+	EnvContextName    = session.EnvContextName
+)
+
 // Command profiles (default-deny allowlists of commands) — the schema and
 // resolution live with the Config type in sdk/session; profile *enforcement*
 // stays in cmd/.
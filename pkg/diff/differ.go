@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"sort"
 
 	"gopkg.in/yaml.v3"
 )
@@ -180,8 +181,14 @@ func computeDiff(path string, left, right interface{}) []Change {
 		for k := range rightMap {
 			allKeys[k] = true
 		}
-
+		// Walk keys in order so the same inputs always print the same diff.
+		keys := make([]string, 0, len(allKeys))
 		for k := range allKeys {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
 			newPath := k
 			if path != "" {
 				newPath = path + "." + k
@@ -124,6 +124,26 @@ func TestDiffer_CompareWithIgnoreMetadata(t *testing.T) {
 	}
 }
 
+func TestDiffer_CompareSortsKeys(t *testing.T) {
+	left := map[string]interface{}{"b": 1, "d": 1, "a": 1}
+	right := map[string]interface{}{"b": 2, "c": 1, "a": 2}
+
+	differ := NewDiffer(DiffOptions{Format: DiffFormatSemantic})
+	for i := 0; i < 10; i++ {
+		result, err := differ.Compare(left, right, "left", "right")
+		if err != nil {
+			t.Fatalf("Compare() error = %v", err)
+		}
+		var paths []string
+		for _, c := range result.Changes {
+			paths = append(paths, c.Path)
+		}
+		if got := fmt.Sprint(paths); got != "[a b c d]" {
+			t.Fatalf("changes not in key order: %s", got)
+		}
+	}
+}
+
 func TestDiffer_CompareWithIgnoreOrder(t *testing.T) {
 	left := map[string]interface{}{
 		"items": []interface{}{
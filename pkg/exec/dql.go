@@ -47,6 +47,7 @@ type DQLExecutor struct {
 	client         *client.Client
 	sdk            *sdkquery.Handler
 	tokenRefresher func() (string, error)
+	lastQueryFile  string
 }
 
 // NewDQLExecutor creates a new DQL executor
@@ -110,6 +111,8 @@ type DQLExecuteOptions struct {
 	Width        int        // Chart width (0 = default)
 	Height       int        // Chart height (0 = default)
 	Fullscreen   bool       // Use terminal dimensions for chart
+	NoHeaders    bool       // Omit the header row in table, wide, and csv output
+	Columns      []string   // Columns to print, in order, in markdown output
 
 	// Query limit options
 	MaxResultRecords       int64   // Maximum number of result records (0 = use default)
@@ -286,6 +289,101 @@ func (e *DQLExecutor) ExecuteQueryWithContext(ctx context.Context, query string,
 	req := buildExecuteRequest(query, opts)
 	handler := e.sdkHandler(opts.ClientContext)
 
+	var record *LastQuery
+	result, err := e.runPolled(ctx, opts, func(pollOpts sdkquery.ExecuteAndPollOptions) (*DQLQueryResponse, error) {
+		if e.lastQueryFile != "" {
+			pollOpts.OnRequestToken = func(token string) {
+				record = &LastQuery{
+					Query:                query,
+					RequestToken:         token,
+					State:                LastQueryRunning,
+					TimeframeStart:       opts.DefaultTimeframeStart,
+					TimeframeEnd:         opts.DefaultTimeframeEnd,
+					Context:              opts.ContextName,
+					EnrichMetricMetadata: req.EnrichMetricMetadata,
+					StartedAt:            time.Now().UTC(),
+				}
+				e.recordLastQuery(record)
+			}
+		}
+		return handler.ExecuteAndPollWithOptions(ctx, req, pollOpts)
+	})
+	e.finishLastQuery(record, result, err)
+	return result, err
+}
+
+// ResumeWithContext polls a query started earlier and prints its results.
+// An empty requestToken resumes the query recorded by WithLastQueryFile.
+func (e *DQLExecutor) ResumeWithContext(ctx context.Context, requestToken string, opts DQLExecuteOptions) error {
+	query := ""
+	enrich := false
+	var record *LastQuery
+	if requestToken == "" {
+		if e.lastQueryFile == "" {
+			return fmt.Errorf("no request token given and no last-query record available")
+		}
+		lq, err := LoadLastQuery(e.lastQueryFile)
+		if err != nil {
+			return err
+		}
+		switch lq.State {
+		case LastQuerySucceeded:
+			return fmt.Errorf("the last query (started %s) already completed and its results were delivered; re-run it instead",
+				lq.StartedAt.Local().Format(time.RFC3339))
+		case LastQueryCancelled:
+			return fmt.Errorf("the last query (started %s) was cancelled; re-run it instead",
+				lq.StartedAt.Local().Format(time.RFC3339))
+		}
+		// The request token is only valid in the environment that issued it,
+		// so polling it from another context fails with an unhelpful error.
+		if lq.Context != "" && opts.ContextName != "" && lq.Context != opts.ContextName {
+			return fmt.Errorf("the last query (started %s) ran in context %q, not the current context %q; resume it there with: dtctl query --resume --context %s",
+				lq.StartedAt.Local().Format(time.RFC3339), lq.Context, opts.ContextName, lq.Context)
+		}
+		record = lq
+		requestToken, query, enrich = lq.RequestToken, lq.Query, lq.EnrichMetricMetadata
+		fmt.Fprintf(os.Stderr, "Resuming query started %s: %s\n", lq.StartedAt.Local().Format(time.RFC3339), strings.TrimSpace(lq.Query))
+	}
+
+	handler := e.sdkHandler(opts.ClientContext)
+	result, err := e.runPolled(ctx, opts, func(pollOpts sdkquery.ExecuteAndPollOptions) (*DQLQueryResponse, error) {
+		return handler.Resume(ctx, requestToken, enrich, pollOpts)
+	})
+	e.finishLastQuery(record, result, err)
+	if err != nil {
+		return err
+	}
+	if result == nil {
+		return nil // context was cancelled; message already printed to stderr
+	}
+	return e.printResults(query, result, opts)
+}
+
+// finishLastQuery records the outcome of a recorded query. A client-side poll
+// timeout leaves the query running on the server, so the record stays
+// resumable and the user is told how to pick it up.
+func (e *DQLExecutor) finishLastQuery(record *LastQuery, result *DQLQueryResponse, err error) {
+	if record == nil {
+		return
+	}
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		output.PrintHint("The query is still running on the server. Resume it with: dtctl query --resume")
+		return
+	case err != nil:
+		record.State = LastQueryFailed
+	case result == nil:
+		record.State = LastQueryCancelled
+	default:
+		record.State = LastQuerySucceeded
+	}
+	e.recordLastQuery(record)
+}
+
+// runPolled runs an execute-or-resume poll with the shared token refresh,
+// progress reporting, and error handling. run receives the poll options to
+// pass to the SDK. It returns (nil, nil) when ctx was cancelled.
+func (e *DQLExecutor) runPolled(ctx context.Context, opts DQLExecuteOptions, run func(sdkquery.ExecuteAndPollOptions) (*DQLQueryResponse, error)) (*DQLQueryResponse, error) {
 	// Build the token refresher callback for the SDK. The SDK's ExecuteAndPoll will
 	// call this on 401; we refresh the token and update the underlying HTTP client.
 	var onUnauthorized func() (string, error)
@@ -311,7 +409,7 @@ func (e *DQLExecutor) ExecuteQueryWithContext(ctx context.Context, query string,
 	// to them if the terminal result metadata omits scannedBytes/Records. The
 	// closure runs synchronously in this goroutine, so these need no locking.
 	var lastScannedBytes, lastScannedRecords int64
-	result, err := handler.ExecuteAndPollWithOptions(ctx, req, sdkquery.ExecuteAndPollOptions{
+	result, err := run(sdkquery.ExecuteAndPollOptions{
 		OnUnauthorized: onUnauthorized,
 		OnUpdate: func(u sdkquery.PollUpdate) {
 			state := output.ProgressState{
@@ -697,10 +795,7 @@ func (e *DQLExecutor) PrintNotifications(notifications []QueryNotification) {
 			severity = "INFO"
 		}
 		if severity == "WARNING" || severity == "WARN" {
-			output.PrintWarning("%s", n.Message)
-			if hint := getHintForNotification(n.NotificationType, n.Message); hint != "" {
-				output.PrintHint("%s", hint)
-			}
+			output.PrintWarningWithHint(getHintForNotification(n.NotificationType, n.Message), "%s", n.Message)
 		} else if severity == "ERROR" {
 			output.PrintHumanError("%s", n.Message)
 			if hint := getHintForNotification(n.NotificationType, n.Message); hint != "" {
@@ -731,7 +826,7 @@ func (e *DQLExecutor) printResults(query string, result *DQLQueryResponse, opts
 		records = output.DecodeSnapshotRecords(records, simplify)
 
 		switch effectiveFormat {
-		case "", "table", "wide", "csv":
+		case "", "table", "wide", "csv", "markdown", "md":
 			records = output.SummarizeSnapshotForTable(records)
 		}
 	}
@@ -780,6 +875,8 @@ func (e *DQLExecutor) printResults(query string, result *DQLQueryResponse, opts
 		Width:      opts.Width,
 		Height:     opts.Height,
 		Fullscreen: opts.Fullscreen,
+		NoHeaders:  opts.NoHeaders,
+		Columns:    opts.Columns,
 		Types:      colTypes,
 	})
 
@@ -811,6 +908,15 @@ func (e *DQLExecutor) printResults(query string, result *DQLQueryResponse, opts
 		}
 		return printer.PrintList(records)
 
+	case "markdown", "md":
+		if len(records) == 0 {
+			return nil
+		}
+		if meta != nil {
+			fmt.Fprint(os.Stderr, output.FormatMetadataFooter(meta, opts.MetadataFields))
+		}
+		return printer.PrintList(records)
+
 	case "jsonl":
 		// An empty JSONL file (zero lines) is valid output, so skip on no records.
 		if len(records) == 0 {
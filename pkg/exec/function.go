@@ -34,6 +34,9 @@ type FunctionExecuteOptions struct {
 	// For ad-hoc execution
 	SourceCode     string
 	SourceCodeFile string
+	// SDKVersion pins the function executor SDK. When empty, the version
+	// the service marks as default (or the latest) is used.
+	SDKVersion string
 
 	// For deferred execution
 	Defer bool
@@ -78,7 +81,16 @@ func (e *FunctionExecutor) executeCode(opts FunctionExecuteOptions) (*appengine.
 		payload = content
 	}
 
-	return e.handler.ExecuteCode(sourceCode, payload)
+	sdkVersion := opts.SDKVersion
+	if sdkVersion == "" {
+		// Resolve the default up front so the version that ran is explicit;
+		// if the lookup fails the service still falls back to its own default.
+		if versions, err := e.handler.GetSDKVersions(); err == nil {
+			sdkVersion = versions.DefaultVersion()
+		}
+	}
+
+	return e.handler.ExecuteCode(sourceCode, payload, sdkVersion)
 }
 
 // executeAppFunction executes a function from an installed app
@@ -94,6 +106,10 @@ func (e *FunctionExecutor) executeAppFunction(opts FunctionExecuteOptions) (inte
 		functionName = parts[1]
 	}
 
+	if opts.SDKVersion != "" {
+		return nil, fmt.Errorf("--sdk applies only to ad-hoc code (--code or -f); app functions run on the SDK they were built with")
+	}
+
 	if appID == "" || functionName == "" {
 		return nil, fmt.Errorf("app ID and function name are required (use 'app-id/function-name' or separate arguments)")
 	}
@@ -1,6 +1,7 @@
 package exec
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -169,6 +170,11 @@ func TestFunctionExecutor_Execute_ValidationErrors(t *testing.T) {
 			opts:        FunctionExecuteOptions{SourceCode: ""},
 			errContains: "app ID and function name are required",
 		},
+		{
+			name:        "sdk version with app function",
+			opts:        FunctionExecuteOptions{FunctionName: "my.app/fn", SDKVersion: "1.0.0"},
+			errContains: "--sdk applies only to ad-hoc code",
+		},
 	}
 
 	for _, tt := range tests {
@@ -191,3 +197,70 @@ func TestFunctionExecutor_Execute_ValidationErrors(t *testing.T) {
 		})
 	}
 }
+
+// TestFunctionExecutor_Execute_SDKVersion tests that ad-hoc execution sends the
+// pinned SDK version, or resolves the service default when none is pinned.
+func TestFunctionExecutor_Execute_SDKVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		pinned   string
+		versions string
+		want     string
+	}{
+		{
+			name:     "pinned version",
+			pinned:   "1.2.0",
+			versions: `{"versions":[{"version":"1.3.0","default":true}]}`,
+			want:     "1.2.0",
+		},
+		{
+			name:     "marked default",
+			versions: `{"versions":[{"version":"1.10.0"},{"version":"1.3.0","default":true}]}`,
+			want:     "1.3.0",
+		},
+		{
+			name:     "latest when none marked",
+			versions: `{"versions":[{"version":"1.9.0"},{"version":"1.10.0"},{"version":"1.2.5"}]}`,
+			want:     "1.10.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case strings.HasSuffix(r.URL.Path, "/sdk-versions"):
+					_, _ = w.Write([]byte(tt.versions))
+				case strings.HasSuffix(r.URL.Path, "/executions"):
+					var body struct {
+						SDKVersion string `json:"sdkVersion"`
+					}
+					_ = json.NewDecoder(r.Body).Decode(&body)
+					got = body.SDKVersion
+					_, _ = w.Write([]byte(`{"result":"ok","logs":""}`))
+				default:
+					t.Errorf("unexpected path: %s", r.URL.Path)
+				}
+			}))
+			defer server.Close()
+
+			c, err := client.NewForTesting(server.URL, "test-token")
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+
+			executor := NewFunctionExecutor(c)
+			if _, err := executor.Execute(FunctionExecuteOptions{
+				SourceCode: "export default async function() { return 1 }",
+				SDKVersion: tt.pinned,
+			}); err != nil {
+				t.Fatalf("Execute() unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("sdkVersion = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package exec
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dynatrace-oss/dtctl/pkg/output"
+)
+
+// States recorded for the last query.
+const (
+	LastQueryRunning   = "RUNNING"
+	LastQuerySucceeded = "SUCCEEDED"
+	LastQueryFailed    = "FAILED"
+	LastQueryCancelled = "CANCELLED"
+)
+
+// LastQuery is the record of the most recent asynchronous query, persisted so
+// a query whose client timed out or exited can be resumed by its request token.
+type LastQuery struct {
+	Query                string    `json:"query"`
+	RequestToken         string    `json:"requestToken"`
+	State                string    `json:"state"`
+	TimeframeStart       string    `json:"timeframeStart,omitempty"`
+	TimeframeEnd         string    `json:"timeframeEnd,omitempty"`
+	Context              string    `json:"context,omitempty"`
+	EnrichMetricMetadata bool      `json:"enrichMetricMetadata,omitempty"`
+	StartedAt            time.Time `json:"startedAt"`
+}
+
+// ErrNoLastQuery is returned by LoadLastQuery when no query has been recorded.
+var ErrNoLastQuery = errors.New("no previous query recorded")
+
+// LoadLastQuery reads the last-query record from path.
+func LoadLastQuery(path string) (*LastQuery, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNoLastQuery
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read last query: %w", err)
+	}
+	var lq LastQuery
+	if err := json.Unmarshal(data, &lq); err != nil {
+		return nil, fmt.Errorf("failed to parse last query %s: %w", path, err)
+	}
+	return &lq, nil
+}
+
+// SaveLastQuery writes the last-query record to path. The request token
+// grants access to the query's results, so the file is private to the user.
+func SaveLastQuery(path string, lq *LastQuery) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	data, err := json.MarshalIndent(lq, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write last query: %w", err)
+	}
+	return nil
+}
+
+// WithLastQueryFile makes the executor record asynchronous queries in path so
+// they can be resumed with ResumeWithContext. Only the user-facing query
+// commands set it; internal sub-queries are not recorded.
+func (e *DQLExecutor) WithLastQueryFile(path string) *DQLExecutor {
+	e.lastQueryFile = path
+	return e
+}
+
+// recordLastQuery saves lq when recording is enabled. Failures only warn: the
+// record is a convenience and must never fail the query itself.
+func (e *DQLExecutor) recordLastQuery(lq *LastQuery) {
+	if e.lastQueryFile == "" || lq == nil {
+		return
+	}
+	if err := SaveLastQuery(e.lastQueryFile, lq); err != nil {
+		output.PrintWarning("%v", err)
+	}
+}
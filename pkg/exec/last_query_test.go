@@ -0,0 +1,135 @@
+package exec
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dynatrace-oss/dtctl/pkg/client"
+)
+
+func TestLastQuery_SaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache", "last-query.json")
+
+	if _, err := LoadLastQuery(path); !errors.Is(err, ErrNoLastQuery) {
+		t.Fatalf("LoadLastQuery() on missing file error = %v, want ErrNoLastQuery", err)
+	}
+
+	want := &LastQuery{
+		Query:          "fetch logs",
+		RequestToken:   "tok-1",
+		State:          LastQueryRunning,
+		TimeframeStart: "2026-01-01T00:00:00Z",
+		StartedAt:      time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	if err := SaveLastQuery(path, want); err != nil {
+		t.Fatalf("SaveLastQuery() error = %v", err)
+	}
+	got, err := LoadLastQuery(path)
+	if err != nil {
+		t.Fatalf("LoadLastQuery() error = %v", err)
+	}
+	if *got != *want {
+		t.Errorf("LoadLastQuery() = %+v, want %+v", got, want)
+	}
+}
+
+// newAsyncQueryServer answers execute with a RUNNING state and token tok-1,
+// and polls with a single record.
+func newAsyncQueryServer(t *testing.T) (*httptest.Server, *int) {
+	t.Helper()
+	executes := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/platform/storage/query/v1/query:execute":
+			executes++
+			_ = json.NewEncoder(w).Encode(DQLQueryResponse{State: "RUNNING", RequestToken: "tok-1"})
+		case "/platform/storage/query/v1/query:poll":
+			if got := r.URL.Query().Get("request-token"); got != "tok-1" {
+				t.Errorf("request-token = %q, want tok-1", got)
+			}
+			_ = json.NewEncoder(w).Encode(DQLQueryResponse{
+				State:  "SUCCEEDED",
+				Result: &DQLResult{Records: []map[string]interface{}{{"status": "ERROR"}}},
+			})
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &executes
+}
+
+func TestDQLExecutor_RecordsLastQuery(t *testing.T) {
+	server, _ := newAsyncQueryServer(t)
+	c, err := client.NewForTesting(server.URL, "test-token")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "last-query.json")
+	executor := NewDQLExecutor(c).WithLastQueryFile(path)
+
+	_, err = executor.ExecuteQueryWithOptions("fetch logs", DQLExecuteOptions{DefaultTimeframeStart: "now-2h"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lq, err := LoadLastQuery(path)
+	if err != nil {
+		t.Fatalf("LoadLastQuery() error = %v", err)
+	}
+	if lq.Query != "fetch logs" || lq.RequestToken != "tok-1" || lq.TimeframeStart != "now-2h" {
+		t.Errorf("unexpected record: %+v", lq)
+	}
+	if lq.State != LastQuerySucceeded {
+		t.Errorf("State = %q, want %q", lq.State, LastQuerySucceeded)
+	}
+}
+
+func TestDQLExecutor_ResumeWithContext(t *testing.T) {
+	server, executes := newAsyncQueryServer(t)
+	c, err := client.NewForTesting(server.URL, "test-token")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "last-query.json")
+	executor := NewDQLExecutor(c).WithLastQueryFile(path)
+
+	if err := executor.ResumeWithContext(context.Background(), "", DQLExecuteOptions{}); !errors.Is(err, ErrNoLastQuery) {
+		t.Fatalf("expected ErrNoLastQuery without a record, got %v", err)
+	}
+
+	if err := SaveLastQuery(path, &LastQuery{Query: "fetch logs", RequestToken: "tok-1", State: LastQueryRunning, Context: "prod"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := executor.ResumeWithContext(context.Background(), "", DQLExecuteOptions{ContextName: "dev"}); err == nil || !strings.Contains(err.Error(), `ran in context "prod"`) {
+		t.Fatalf("expected context mismatch error, got %v", err)
+	}
+	out := captureStdout(t, func() {
+		if err := executor.ResumeWithContext(context.Background(), "", DQLExecuteOptions{OutputFormat: "json", ContextName: "prod"}); err != nil {
+			t.Fatalf("ResumeWithContext() error = %v", err)
+		}
+	})
+	if !strings.Contains(string(out), "ERROR") {
+		t.Errorf("expected resumed records in output, got:\n%s", out)
+	}
+	if *executes != 0 {
+		t.Errorf("resume re-executed the query %d time(s)", *executes)
+	}
+
+	lq, err := LoadLastQuery(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lq.State != LastQuerySucceeded {
+		t.Errorf("State = %q after resume, want %q", lq.State, LastQuerySucceeded)
+	}
+	if err := executor.ResumeWithContext(context.Background(), "", DQLExecuteOptions{}); err == nil || !strings.Contains(err.Error(), "already completed") {
+		t.Errorf("expected already-completed error, got %v", err)
+	}
+}
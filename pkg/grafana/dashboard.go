@@ -0,0 +1,354 @@
+// Package grafana converts Grafana dashboard exports into Dynatrace dashboards.
+//
+// The conversion is best effort: text, time series, and table panels become
+// markdown and data tiles at the same grid position, and every other panel
+// becomes a markdown placeholder that quotes the original panel definition.
+// Grafana queries (PromQL, SQL, ...) cannot be translated to DQL, so data
+// tiles keep them as DQL comments to be rewritten by hand.
+package grafana
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// contentVersion is the dashboard content schema version of converted dashboards.
+const contentVersion = 15
+
+// Default tile size for panels without a gridPos, in Grafana grid units.
+const (
+	defaultWidth  = 12
+	defaultHeight = 8
+	gridColumns   = 24
+)
+
+// queryFields are the target fields Grafana data sources store their query in,
+// in order of preference.
+var queryFields = []string{"query", "queryText", "expr", "rawSql", "rawQuery", "expression"}
+
+// Dashboard is a Grafana dashboard converted to Dynatrace dashboard content.
+type Dashboard struct {
+	Name        string
+	Description string
+	// Content is the dashboard document content (version, tiles, layouts).
+	Content map[string]interface{}
+	// Tiles is the number of tiles created, placeholders included.
+	Tiles int
+	// Placeholders is the number of panels that could not be converted.
+	Placeholders int
+	// Warnings describe everything that was not converted faithfully.
+	Warnings []string
+}
+
+type export struct {
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Panels      []json.RawMessage `json:"panels"`
+	Rows        []struct {
+		Title  string            `json:"title"`
+		Panels []json.RawMessage `json:"panels"`
+	} `json:"rows"`
+	Templating struct {
+		List []struct {
+			Name string `json:"name"`
+		} `json:"list"`
+	} `json:"templating"`
+}
+
+type panel struct {
+	Type       string                   `json:"type"`
+	Title      string                   `json:"title"`
+	GridPos    *gridPos                 `json:"gridPos"`
+	Datasource json.RawMessage          `json:"datasource"`
+	Targets    []map[string]interface{} `json:"targets"`
+	Options    map[string]interface{}   `json:"options"`
+	Content    string                   `json:"content"`
+	Mode       string                   `json:"mode"`
+	Panels     []json.RawMessage        `json:"panels"`
+}
+
+type gridPos struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+	W int `json:"w"`
+	H int `json:"h"`
+}
+
+// converter accumulates tiles and layouts while panels are walked.
+type converter struct {
+	tiles        map[string]interface{}
+	layouts      map[string]interface{}
+	bottom       int
+	placeholders int
+	warnings     []string
+}
+
+// ConvertDashboard converts a Grafana dashboard JSON export. Both the bare
+// dashboard model and the API export wrapping it in {"dashboard": ...} are
+// accepted.
+func ConvertDashboard(data []byte) (*Dashboard, error) {
+	var wrapper struct {
+		Dashboard json.RawMessage `json:"dashboard"`
+	}
+	if err := json.Unmarshal(data, &wrapper); err != nil {
+		return nil, fmt.Errorf("failed to parse Grafana dashboard: %w", err)
+	}
+	if len(wrapper.Dashboard) > 0 && string(wrapper.Dashboard) != "null" {
+		data = wrapper.Dashboard
+	}
+
+	var src export
+	if err := json.Unmarshal(data, &src); err != nil {
+		return nil, fmt.Errorf("failed to parse Grafana dashboard: %w", err)
+	}
+	if src.Panels == nil && src.Rows == nil {
+		return nil, fmt.Errorf("not a Grafana dashboard export: no panels or rows found")
+	}
+
+	c := &converter{
+		tiles:   make(map[string]interface{}),
+		layouts: make(map[string]interface{}),
+	}
+	for _, raw := range src.Panels {
+		if err := c.addPanel(raw); err != nil {
+			return nil, err
+		}
+	}
+	// Dashboards from Grafana before 5.0 group panels in rows without grid
+	// positions; each row becomes a heading followed by its panels.
+	for _, row := range src.Rows {
+		if row.Title != "" {
+			c.addTile(markdownTile("## "+row.Title), &gridPos{W: gridColumns, H: 1})
+		}
+		for _, raw := range row.Panels {
+			if err := c.addPanel(raw); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if n := len(src.Templating.List); n > 0 {
+		names := make([]string, 0, n)
+		for _, v := range src.Templating.List {
+			names = append(names, "$"+v.Name)
+		}
+		c.warnings = append(c.warnings, fmt.Sprintf("%d dashboard variable(s) not converted: %s", n, strings.Join(names, ", ")))
+	}
+
+	name := src.Title
+	if name == "" {
+		name = "Imported Grafana dashboard"
+	}
+	return &Dashboard{
+		Name:        name,
+		Description: src.Description,
+		Content: map[string]interface{}{
+			"version":   contentVersion,
+			"variables": []interface{}{},
+			"tiles":     c.tiles,
+			"layouts":   c.layouts,
+		},
+		Tiles:        len(c.tiles),
+		Placeholders: c.placeholders,
+		Warnings:     c.warnings,
+	}, nil
+}
+
+// addPanel converts one panel, and the panels nested in a collapsed row.
+func (c *converter) addPanel(raw json.RawMessage) error {
+	var p panel
+	if err := json.Unmarshal(raw, &p); err != nil {
+		return fmt.Errorf("failed to parse Grafana panel: %w", err)
+	}
+
+	switch p.Type {
+	case "row":
+		if p.Title != "" {
+			pos := p.GridPos
+			if pos == nil {
+				pos = &gridPos{W: gridColumns, H: 1}
+			}
+			c.addTile(markdownTile("## "+p.Title), pos)
+		}
+		for _, child := range p.Panels {
+			if err := c.addPanel(child); err != nil {
+				return err
+			}
+		}
+	case "text":
+		c.addTile(c.textTile(p), p.GridPos)
+	case "timeseries", "graph":
+		c.addTile(c.dataTile(p, "lineChart"), p.GridPos)
+	case "table", "table-old":
+		c.addTile(c.dataTile(p, "table"), p.GridPos)
+	default:
+		c.addTile(placeholderTile(p, raw), p.GridPos)
+		c.placeholders++
+		c.warnings = append(c.warnings, fmt.Sprintf("%s: %q panels are not supported; added a markdown placeholder", panelLabel(p), p.Type))
+	}
+	return nil
+}
+
+// addTile stores tile under the next tile ID. Panels without a grid position
+// are stacked below everything placed so far.
+func (c *converter) addTile(tile map[string]interface{}, pos *gridPos) {
+	if pos == nil {
+		pos = &gridPos{X: 0, Y: c.bottom, W: defaultWidth, H: defaultHeight}
+	}
+	w, h := pos.W, pos.H
+	if w <= 0 {
+		w = defaultWidth
+	}
+	if h <= 0 {
+		h = defaultHeight
+	}
+	if bottom := pos.Y + h; bottom > c.bottom {
+		c.bottom = bottom
+	}
+
+	id := strconv.Itoa(len(c.tiles))
+	c.tiles[id] = tile
+	c.layouts[id] = map[string]interface{}{"x": pos.X, "y": pos.Y, "w": w, "h": h}
+}
+
+func (c *converter) textTile(p panel) map[string]interface{} {
+	content := p.Content
+	mode := p.Mode
+	if v, ok := p.Options["content"].(string); ok {
+		content = v
+	}
+	if v, ok := p.Options["mode"].(string); ok {
+		mode = v
+	}
+	if mode == "html" {
+		c.warnings = append(c.warnings, fmt.Sprintf("%s: HTML content is shown as markdown", panelLabel(p)))
+	}
+	if p.Title != "" {
+		content = "## " + p.Title + "\n\n" + content
+	}
+	return markdownTile(content)
+}
+
+func (c *converter) dataTile(p panel, visualization string) map[string]interface{} {
+	type targetQuery struct{ refID, datasource, text string }
+
+	panelType := datasourceType(p.Datasource)
+	var queries []targetQuery
+	for _, t := range p.Targets {
+		if hidden, _ := t["hide"].(bool); hidden {
+			continue
+		}
+		q := queryText(t)
+		if q == "" {
+			continue
+		}
+		dsType := datasourceType(t["datasource"])
+		if dsType == "" {
+			dsType = panelType
+		}
+		refID, _ := t["refId"].(string)
+		queries = append(queries, targetQuery{refID, dsType, q})
+	}
+
+	var query string
+	switch {
+	case len(queries) == 0:
+		query = "// The Grafana panel has no query; write one in DQL."
+		c.warnings = append(c.warnings, fmt.Sprintf("%s: no query found", panelLabel(p)))
+	case len(queries) == 1 && strings.Contains(queries[0].datasource, "dynatrace"):
+		// Queries of the Dynatrace data source are DQL already.
+		query = queries[0].text
+	default:
+		var b strings.Builder
+		b.WriteString("// Converted from Grafana; rewrite in DQL.\n")
+		for _, q := range queries {
+			label := q.datasource
+			if label == "" {
+				label = "query"
+			}
+			if q.refID != "" {
+				label = q.refID + " (" + label + ")"
+			}
+			b.WriteString("// " + label + ":\n")
+			for _, line := range strings.Split(q.text, "\n") {
+				b.WriteString("//   " + line + "\n")
+			}
+		}
+		query = strings.TrimSuffix(b.String(), "\n")
+		c.warnings = append(c.warnings, fmt.Sprintf("%s: query kept as a comment; rewrite it in DQL", panelLabel(p)))
+	}
+
+	return map[string]interface{}{
+		"type":          "data",
+		"title":         p.Title,
+		"query":         query,
+		"visualization": visualization,
+	}
+}
+
+func markdownTile(content string) map[string]interface{} {
+	return map[string]interface{}{"type": "markdown", "content": content}
+}
+
+// placeholderTile quotes an unsupported panel's definition in a markdown tile.
+func placeholderTile(p panel, raw json.RawMessage) map[string]interface{} {
+	var original interface{}
+	_ = json.Unmarshal(raw, &original)
+	if m, ok := original.(map[string]interface{}); ok {
+		// The tile layout already carries the grid position.
+		delete(m, "gridPos")
+	}
+	pretty, err := json.MarshalIndent(original, "", "  ")
+	if err != nil {
+		pretty = raw
+	}
+
+	title := p.Title
+	if title == "" {
+		title = "Untitled panel"
+	}
+	content := fmt.Sprintf("## %s\n\nThe Grafana `%s` panel could not be converted. Original definition:\n\n```json\n%s\n```", title, p.Type, pretty)
+	return markdownTile(content)
+}
+
+// queryText returns the query text of a Grafana panel target.
+func queryText(t map[string]interface{}) string {
+	for _, field := range queryFields {
+		if q, ok := t[field].(string); ok && strings.TrimSpace(q) != "" {
+			return strings.TrimSpace(q)
+		}
+	}
+	return ""
+}
+
+// datasourceType returns the plugin type of a datasource reference, which is
+// an object with a type in current exports and a bare name in older ones.
+func datasourceType(v interface{}) string {
+	switch ds := v.(type) {
+	case json.RawMessage:
+		if len(ds) == 0 {
+			return ""
+		}
+		var decoded interface{}
+		if err := json.Unmarshal(ds, &decoded); err != nil {
+			return ""
+		}
+		return datasourceType(decoded)
+	case map[string]interface{}:
+		if t, ok := ds["type"].(string); ok {
+			return t
+		}
+	case string:
+		return ds
+	}
+	return ""
+}
+
+func panelLabel(p panel) string {
+	if p.Title != "" {
+		return fmt.Sprintf("panel %q", p.Title)
+	}
+	return fmt.Sprintf("untitled %s panel", p.Type)
+}
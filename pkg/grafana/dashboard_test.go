@@ -0,0 +1,127 @@
+package grafana
+
+import (
+	"strings"
+	"testing"
+)
+
+const sampleExport = `{
+  "meta": {"slug": "service-overview"},
+  "dashboard": {
+    "title": "Service Overview",
+    "description": "Golden signals",
+    "templating": {"list": [{"name": "env"}]},
+    "panels": [
+      {"type": "text", "title": "About", "gridPos": {"x": 0, "y": 0, "w": 24, "h": 3},
+       "options": {"mode": "markdown", "content": "Owned by **checkout**."}},
+      {"type": "timeseries", "title": "Requests", "gridPos": {"x": 0, "y": 3, "w": 12, "h": 8},
+       "datasource": {"type": "prometheus", "uid": "prom"},
+       "targets": [{"refId": "A", "expr": "sum(rate(http_requests_total[5m]))"},
+                   {"refId": "B", "expr": "up", "hide": true}]},
+      {"type": "table", "title": "Slow requests", "gridPos": {"x": 12, "y": 3, "w": 12, "h": 8},
+       "datasource": {"type": "dynatrace-datasource"},
+       "targets": [{"refId": "A", "queryText": "fetch spans | sort duration desc | limit 10"}]},
+      {"type": "row", "title": "Details", "collapsed": true, "gridPos": {"x": 0, "y": 11, "w": 24, "h": 1},
+       "panels": [
+         {"type": "gauge", "title": "Saturation", "gridPos": {"x": 0, "y": 12, "w": 6, "h": 6},
+          "options": {"showThresholdMarkers": true}}
+       ]}
+    ]
+  }
+}`
+
+func TestConvertDashboard(t *testing.T) {
+	d, err := ConvertDashboard([]byte(sampleExport))
+	if err != nil {
+		t.Fatalf("ConvertDashboard() error = %v", err)
+	}
+
+	if d.Name != "Service Overview" || d.Description != "Golden signals" {
+		t.Errorf("Name, Description = %q, %q", d.Name, d.Description)
+	}
+	if d.Tiles != 5 || d.Placeholders != 1 {
+		t.Errorf("Tiles, Placeholders = %d, %d, want 5, 1", d.Tiles, d.Placeholders)
+	}
+	if d.Content["version"] != contentVersion {
+		t.Errorf("version = %v", d.Content["version"])
+	}
+
+	tiles := d.Content["tiles"].(map[string]interface{})
+	layouts := d.Content["layouts"].(map[string]interface{})
+
+	text := tiles["0"].(map[string]interface{})
+	if text["type"] != "markdown" || text["content"] != "## About\n\nOwned by **checkout**." {
+		t.Errorf("text tile = %v", text)
+	}
+
+	chart := tiles["1"].(map[string]interface{})
+	if chart["type"] != "data" || chart["visualization"] != "lineChart" || chart["title"] != "Requests" {
+		t.Errorf("timeseries tile = %v", chart)
+	}
+	query := chart["query"].(string)
+	if !strings.Contains(query, "// A (prometheus):\n//   sum(rate(http_requests_total[5m]))") {
+		t.Errorf("query = %q, want the PromQL kept as a comment", query)
+	}
+	if strings.Contains(query, "// B") {
+		t.Errorf("query = %q, hidden target should be skipped", query)
+	}
+	if got := layouts["1"].(map[string]interface{}); got["x"] != 0 || got["y"] != 3 || got["w"] != 12 || got["h"] != 8 {
+		t.Errorf("layout = %v", got)
+	}
+
+	table := tiles["2"].(map[string]interface{})
+	if table["visualization"] != "table" || table["query"] != "fetch spans | sort duration desc | limit 10" {
+		t.Errorf("table tile = %v, want the Dynatrace DQL kept as is", table)
+	}
+
+	if row := tiles["3"].(map[string]interface{}); row["content"] != "## Details" {
+		t.Errorf("row tile = %v", row)
+	}
+
+	placeholder := tiles["4"].(map[string]interface{})
+	content := placeholder["content"].(string)
+	if placeholder["type"] != "markdown" || !strings.Contains(content, "`gauge` panel could not be converted") ||
+		!strings.Contains(content, `"showThresholdMarkers": true`) || strings.Contains(content, "gridPos") {
+		t.Errorf("placeholder content = %q", content)
+	}
+
+	warnings := strings.Join(d.Warnings, "\n")
+	for _, want := range []string{
+		`panel "Requests": query kept as a comment`,
+		`panel "Saturation": "gauge" panels are not supported`,
+		"1 dashboard variable(s) not converted: $env",
+	} {
+		if !strings.Contains(warnings, want) {
+			t.Errorf("warnings = %q, want %q", warnings, want)
+		}
+	}
+}
+
+func TestConvertDashboard_LegacyRows(t *testing.T) {
+	data := `{"title": "Old", "rows": [{"title": "Main", "panels": [
+	  {"type": "graph", "title": "CPU", "targets": []},
+	  {"type": "text", "content": "notes"}]}]}`
+
+	d, err := ConvertDashboard([]byte(data))
+	if err != nil {
+		t.Fatalf("ConvertDashboard() error = %v", err)
+	}
+	layouts := d.Content["layouts"].(map[string]interface{})
+	if got := layouts["1"].(map[string]interface{}); got["y"] != 1 {
+		t.Errorf("graph layout = %v, want it stacked below the row heading", got)
+	}
+	if got := layouts["2"].(map[string]interface{}); got["y"] != 1+defaultHeight {
+		t.Errorf("text layout = %v, want it stacked below the graph", got)
+	}
+	if !strings.Contains(strings.Join(d.Warnings, "\n"), `panel "CPU": no query found`) {
+		t.Errorf("warnings = %v", d.Warnings)
+	}
+}
+
+func TestConvertDashboard_Invalid(t *testing.T) {
+	for _, data := range []string{`not json`, `{"title": "x"}`} {
+		if _, err := ConvertDashboard([]byte(data)); err == nil {
+			t.Errorf("ConvertDashboard(%s) error = nil", data)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package labels
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDescriptionRoundTrip(t *testing.T) {
+	l := Labels{ManagedByKey: ManagedByValue, "team": "checkout"}
+
+	desc := InDescription("Nightly health checks.", l)
+	want := "Nightly health checks.\n\ndtctl.io/labels: {\"dtctl.io/managed-by\":\"dtctl\",\"team\":\"checkout\"}"
+	if desc != want {
+		t.Fatalf("InDescription() = %q, want %q", desc, want)
+	}
+
+	text, got := FromDescription(desc)
+	if text != "Nightly health checks." || !reflect.DeepEqual(got, l) {
+		t.Errorf("FromDescription() = %q, %v", text, got)
+	}
+
+	// Re-stamping replaces the sidecar instead of appending a second one.
+	restamped := InDescription(desc, Labels{"team": "payments"})
+	if _, got := FromDescription(restamped); !reflect.DeepEqual(got, Labels{"team": "payments"}) {
+		t.Errorf("restamped labels = %v", got)
+	}
+	if InDescription(desc, nil) != "Nightly health checks." {
+		t.Errorf("empty labels should remove the sidecar")
+	}
+	if InDescription("", l) != "dtctl.io/labels: {\"dtctl.io/managed-by\":\"dtctl\",\"team\":\"checkout\"}" {
+		t.Errorf("sidecar on empty description = %q", InDescription("", l))
+	}
+}
+
+func TestFromDescription_NoSidecar(t *testing.T) {
+	for _, desc := range []string{
+		"",
+		"plain text",
+		"mentions dtctl.io/labels: inline, not on its own line",
+		"dtctl.io/labels: not-json",
+	} {
+		text, l := FromDescription(desc)
+		if text != desc || len(l) != 0 {
+			t.Errorf("FromDescription(%q) = %q, %v; want unchanged with no labels", desc, text, l)
+		}
+	}
+}
+
+func TestParsePairs(t *testing.T) {
+	l, err := ParsePairs([]string{"team=checkout", "env = prod", "empty="})
+	if err != nil {
+		t.Fatalf("ParsePairs() error = %v", err)
+	}
+	if !reflect.DeepEqual(l, Labels{"team": "checkout", "env": "prod", "empty": ""}) {
+		t.Errorf("ParsePairs() = %v", l)
+	}
+	if _, err := ParsePairs([]string{"novalue"}); err == nil {
+		t.Error("expected error for pair without =")
+	}
+	if _, err := ParsePairs([]string{"=x"}); err == nil {
+		t.Error("expected error for empty key")
+	}
+}
+
+func TestSelector(t *testing.T) {
+	l := Labels{ManagedByKey: ManagedByValue, "team": "checkout"}
+	tests := []struct {
+		selector string
+		want     bool
+	}{
+		{"team=checkout", true},
+		{"team==checkout", true},
+		{"team=payments", false},
+		{"team!=payments", true},
+		{"team!=checkout", false},
+		{"team", true},
+		{"owner", false},
+		{"!owner", true},
+		{"!team", false},
+		{"dtctl.io/managed-by=dtctl, team=checkout", true},
+		{"dtctl.io/managed-by=dtctl,team=payments", false},
+	}
+	for _, tt := range tests {
+		sel, err := ParseSelector(tt.selector)
+		if err != nil {
+			t.Fatalf("ParseSelector(%q) error = %v", tt.selector, err)
+		}
+		if got := sel.Matches(l); got != tt.want {
+			t.Errorf("%q.Matches(%v) = %v, want %v", tt.selector, l, got, tt.want)
+		}
+	}
+
+	for _, bad := range []string{"", ",", "=x", "!"} {
+		if _, err := ParseSelector(bad); err == nil {
+			t.Errorf("ParseSelector(%q) expected error", bad)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package labels
+
+import (
+	"fmt"
+	"strings"
+)
+
+type operator int
+
+const (
+	opEquals operator = iota
+	opNotEquals
+	opExists
+	opNotExists
+)
+
+type requirement struct {
+	key   string
+	op    operator
+	value string
+}
+
+// Selector filters objects by their labels. All requirements must match.
+type Selector []requirement
+
+// ParseSelector parses a kubectl-style equality selector: comma-separated
+// requirements of the form key=value, key==value, key!=value, key (the
+// label is set) and !key (the label is not set).
+func ParseSelector(s string) (Selector, error) {
+	var sel Selector
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		var r requirement
+		switch {
+		case strings.Contains(part, "!="):
+			key, value, _ := strings.Cut(part, "!=")
+			r = requirement{key: strings.TrimSpace(key), op: opNotEquals, value: strings.TrimSpace(value)}
+		case strings.Contains(part, "="):
+			key, value, _ := strings.Cut(part, "=")
+			r = requirement{key: strings.TrimSpace(key), op: opEquals, value: strings.TrimSpace(strings.TrimPrefix(value, "="))}
+		case strings.HasPrefix(part, "!"):
+			r = requirement{key: strings.TrimSpace(part[1:]), op: opNotExists}
+		default:
+			r = requirement{key: part, op: opExists}
+		}
+		if r.key == "" {
+			return nil, fmt.Errorf("invalid selector %q: empty label key in %q", s, part)
+		}
+		sel = append(sel, r)
+	}
+	if len(sel) == 0 {
+		return nil, fmt.Errorf("invalid selector %q: no requirements", s)
+	}
+	return sel, nil
+}
+
+// Matches reports whether l satisfies every requirement of the selector.
+func (s Selector) Matches(l Labels) bool {
+	for _, r := range s {
+		value, ok := l[r.key]
+		switch r.op {
+		case opEquals:
+			if !ok || value != r.value {
+				return false
+			}
+		case opNotEquals:
+			if ok && value == r.value {
+				return false
+			}
+		case opExists:
+			if !ok {
+				return false
+			}
+		case opNotExists:
+			if ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MatchesDescription reports whether the labels stored in description
+// satisfy the selector.
+func (s Selector) MatchesDescription(description string) bool {
+	_, l := FromDescription(description)
+	return s.Matches(l)
+}
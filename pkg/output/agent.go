@@ -122,6 +122,7 @@ type AgentPrinter struct {
 	writer       io.Writer
 	ctx          *ResponseContext
 	resultFormat string // "json" (default) or "toon"
+	toonWidth    int    // truncate TOON string values to this many runes (0 = unlimited)
 	jqFilter     string
 }
 
@@ -150,6 +151,13 @@ func (p *AgentPrinter) SetResultFormat(format string) {
 	}
 }
 
+// SetToonWidth sets the maximum rune width of string values in a TOON-encoded
+// result. Longer strings are truncated with an ellipsis; 0 disables truncation.
+// It has no effect when the result format is "json".
+func (p *AgentPrinter) SetToonWidth(width int) {
+	p.toonWidth = width
+}
+
 // Print writes a single result wrapped in the agent envelope.
 func (p *AgentPrinter) Print(data interface{}) error {
 	transformed, err := ApplyJQ(p.jqFilter, data)
@@ -189,6 +197,8 @@ func (p *AgentPrinter) encodeResult(data interface{}) (interface{}, error) {
 		return data, nil // fall back to raw data on conversion error
 	}
 
+	generic = truncateToonStrings(generic, p.toonWidth)
+
 	encoded, err := toon.MarshalString(generic, toon.WithLengthMarkers(true))
 	if err != nil {
 		p.addWarning(fmt.Sprintf("TOON encoding failed (marshal): %v; fell back to JSON", err))
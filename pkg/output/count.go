@@ -0,0 +1,60 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// CountPrinter prints only the number of listed objects (--count), for quick
+// inventories without piping a table through wc -l.
+type CountPrinter struct {
+	writer io.Writer
+	// next, when set, receives {"count": n} instead of the bare number, so
+	// agent mode keeps its envelope.
+	next Printer
+	// total is a count reported by the server (e.g. a list's totalCount),
+	// which wins over the number of objects printed. Zero means unknown.
+	total int
+}
+
+// NewCountPrinter creates a count printer writing to w. A non-nil next
+// printer receives the count as an object instead.
+func NewCountPrinter(w io.Writer, next Printer) *CountPrinter {
+	return &CountPrinter{writer: w, next: next}
+}
+
+// SetTotal records the server-reported number of matching objects. Commands
+// call it when they skip fetching every page because only the count is needed.
+func (p *CountPrinter) SetTotal(n int) {
+	p.total = n
+}
+
+// Print counts a single object, or the elements of a slice
+func (p *CountPrinter) Print(obj interface{}) error {
+	v := indirectValue(reflect.ValueOf(obj))
+	if v.Kind() == reflect.Slice {
+		return p.write(v.Len())
+	}
+	return p.write(1)
+}
+
+// PrintList counts the elements of a slice
+func (p *CountPrinter) PrintList(obj interface{}) error {
+	v := indirectValue(reflect.ValueOf(obj))
+	if v.Kind() != reflect.Slice {
+		return fmt.Errorf("expected slice, got %s", v.Kind())
+	}
+	return p.write(v.Len())
+}
+
+func (p *CountPrinter) write(n int) error {
+	if p.total > n {
+		n = p.total
+	}
+	if p.next != nil {
+		return p.next.Print(map[string]int{"count": n})
+	}
+	_, err := fmt.Fprintln(p.writer, n)
+	return err
+}
@@ -0,0 +1,59 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestCountPrinter(t *testing.T) {
+	type item struct {
+		Name string `table:"NAME"`
+	}
+
+	var buf bytes.Buffer
+	p := NewCountPrinter(&buf, nil)
+	if err := p.PrintList([]item{{"a"}, {"b"}, {"c"}}); err != nil {
+		t.Fatalf("PrintList() error = %v", err)
+	}
+	if got := buf.String(); got != "3\n" {
+		t.Errorf("PrintList() = %q, want %q", got, "3\n")
+	}
+
+	buf.Reset()
+	if err := p.PrintList([]item{}); err != nil {
+		t.Fatalf("PrintList() error = %v", err)
+	}
+	if got := buf.String(); got != "0\n" {
+		t.Errorf("empty list = %q, want %q", got, "0\n")
+	}
+
+	buf.Reset()
+	if err := p.Print(&item{"a"}); err != nil {
+		t.Fatalf("Print() error = %v", err)
+	}
+	if got := buf.String(); got != "1\n" {
+		t.Errorf("Print() = %q, want %q", got, "1\n")
+	}
+
+	// A server-reported total wins over the objects of a truncated page.
+	buf.Reset()
+	p.SetTotal(250)
+	if err := p.PrintList([]item{{"a"}}); err != nil {
+		t.Fatalf("PrintList() error = %v", err)
+	}
+	if got := buf.String(); got != "250\n" {
+		t.Errorf("with total = %q, want %q", got, "250\n")
+	}
+}
+
+func TestCountPrinter_Next(t *testing.T) {
+	var buf bytes.Buffer
+	p := NewCountPrinter(nil, &JSONPrinter{writer: &buf})
+	if err := p.PrintList([]map[string]interface{}{{"name": "a"}, {"name": "b"}}); err != nil {
+		t.Fatalf("PrintList() error = %v", err)
+	}
+	if got := strings.Join(strings.Fields(buf.String()), ""); got != `{"count":2}` {
+		t.Errorf("next printer got %s, want {\"count\":2}", got)
+	}
+}
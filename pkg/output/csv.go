@@ -10,7 +10,8 @@ import (
 
 // CSVPrinter prints output as CSV
 type CSVPrinter struct {
-	writer io.Writer
+	writer    io.Writer
+	noHeaders bool
 }
 
 // Print prints a single object as CSV
@@ -54,8 +55,10 @@ func (p *CSVPrinter) PrintList(obj interface{}) error {
 	}
 
 	// For other types, print as single column
-	if err := writer.Write([]string{"Value"}); err != nil {
-		return err
+	if !p.noHeaders {
+		if err := writer.Write([]string{"Value"}); err != nil {
+			return err
+		}
 	}
 
 	for i := 0; i < v.Len(); i++ {
@@ -104,8 +107,10 @@ func (p *CSVPrinter) printMaps(v reflect.Value, writer *csv.Writer) error {
 	sort.Strings(keys)
 
 	// Write header
-	if err := writer.Write(keys); err != nil {
-		return err
+	if !p.noHeaders {
+		if err := writer.Write(keys); err != nil {
+			return err
+		}
 	}
 
 	// Write rows
@@ -138,8 +143,10 @@ func (p *CSVPrinter) printStructs(v reflect.Value, writer *csv.Writer) error {
 	for _, f := range fields {
 		headers = append(headers, f.name)
 	}
-	if err := writer.Write(headers); err != nil {
-		return err
+	if !p.noHeaders {
+		if err := writer.Write(headers); err != nil {
+			return err
+		}
 	}
 
 	// Write rows
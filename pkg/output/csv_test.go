@@ -177,3 +177,15 @@ func TestCSVPrinter_PrintList_NonSlice(t *testing.T) {
 		t.Errorf("expected 'expected slice' error, got: %v", err)
 	}
 }
+
+func TestCSVPrinter_NoHeaders(t *testing.T) {
+	var buf bytes.Buffer
+	p := NewPrinterWithOpts(PrinterOptions{Format: "csv", Writer: &buf, NoHeaders: true})
+	data := []map[string]interface{}{{"name": "John", "age": 30}}
+	if err := p.PrintList(data); err != nil {
+		t.Fatalf("PrintList failed: %v", err)
+	}
+	if got := buf.String(); got != "30,John\n" {
+		t.Errorf("got %q, want data row only", got)
+	}
+}
@@ -727,6 +727,50 @@ func TestGolden_GetDashboardWithContent(t *testing.T) {
 	}
 }
 
+// TestGolden_YAMLKeyOrder covers canonical YAML key order: struct fields
+// sorted regardless of declaration order, nested maps sorted, and the same
+// order when managed fields are stripped through the yaml.Node path.
+func TestGolden_YAMLKeyOrder(t *testing.T) {
+	wf := workflow.Workflow{
+		ID:          "wf-1",
+		Title:       "Nightly report",
+		Owner:       "user-a@example.invalid",
+		OwnerType:   "USER",
+		Description: "Sends the nightly report",
+		Tasks: map[string]interface{}{
+			"send": map[string]interface{}{"action": "dynatrace.email:send-email", "input": map[string]interface{}{"to": "ops@example.invalid", "subject": "Report"}},
+		},
+	}
+
+	for name, strip := range map[string]bool{"yaml": false, "unmanaged-yaml": true} {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			printer := NewPrinterWithOpts(PrinterOptions{Format: "yaml", Writer: &buf, StripManagedFields: strip})
+			if err := printer.Print(wf); err != nil {
+				t.Fatalf("Print failed: %v", err)
+			}
+			assertGolden(t, "get/key-order-"+name, buf.String())
+		})
+	}
+}
+
+// TestGolden_GetDashboardWithoutManagedFields is the default 'get dashboard
+// -o json|yaml' export: owner, version and modificationInfo are dropped.
+func TestGolden_GetDashboardWithoutManagedFields(t *testing.T) {
+	doc := dashboardWithContentFixture()
+
+	for _, format := range []string{"json", "yaml"} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			printer := NewPrinterWithOpts(PrinterOptions{Format: format, Writer: &buf, StripManagedFields: true})
+			if err := printer.Print(doc); err != nil {
+				t.Fatalf("Print failed: %v", err)
+			}
+			assertGolden(t, "get/dashboard-content-unmanaged-"+format, buf.String())
+		})
+	}
+}
+
 // analyzerDefinitionFixture models a single analyzer as returned by
 // `get analyzer <name>`. Input/Output are json.RawMessage ([]byte): without a
 // MarshalYAML they render as a list of raw byte values in YAML, and the
@@ -994,6 +1038,27 @@ func TestGolden_GetSettings(t *testing.T) {
 	}
 }
 
+// TestGolden_ToonWidth covers --toon-width on resources with nested objects
+// (settings values, workflow tasks) and long strings (descriptions, IDs).
+func TestGolden_ToonWidth(t *testing.T) {
+	resources := map[string]interface{}{
+		"workflows": workflowFixtures(),
+		"documents": documentFixtures(),
+		"settings":  settingsFixtures(),
+	}
+
+	for name, list := range resources {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			printer := NewPrinterWithOpts(PrinterOptions{Format: "toon", Writer: &buf, ToonWidth: 24})
+			if err := printer.PrintList(list); err != nil {
+				t.Fatalf("PrintList failed: %v", err)
+			}
+			assertGolden(t, "get/"+name+"-toon-width", buf.String())
+		})
+	}
+}
+
 func TestGolden_GetExtensions(t *testing.T) {
 	extensions := extensionFixtures()
 
@@ -0,0 +1,64 @@
+package output
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/dynatrace-oss/dtctl/pkg/labels"
+)
+
+// descriptionLabels returns the dtctl labels stored in the description of a
+// struct (its Description field) or map (its "description" key), see package
+// labels. ok is false when the value has no description to read labels from.
+func descriptionLabels(v reflect.Value) (l labels.Labels, ok bool) {
+	v = indirectValue(v)
+	var description interface{}
+	switch v.Kind() {
+	case reflect.Struct:
+		f := v.FieldByName("Description")
+		if !f.IsValid() || !f.CanInterface() {
+			return nil, false
+		}
+		description = f.Interface()
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, false
+		}
+		f := v.MapIndex(reflect.ValueOf("description").Convert(v.Type().Key()))
+		if !f.IsValid() {
+			return labels.Labels{}, true
+		}
+		description = f.Interface()
+	default:
+		return nil, false
+	}
+
+	switch d := description.(type) {
+	case string:
+		_, l = labels.FromDescription(d)
+	case *string:
+		if d != nil {
+			_, l = labels.FromDescription(*d)
+		}
+	default:
+		return nil, false
+	}
+	if l == nil {
+		l = labels.Labels{}
+	}
+	return l, true
+}
+
+// labelColumnValues returns the values of the label columns for one row.
+// Objects without the label carry an empty cell.
+func labelColumnValues(v reflect.Value, keys []string) ([]string, error) {
+	l, ok := descriptionLabels(v)
+	if !ok {
+		return nil, fmt.Errorf("--label-columns needs a description field to read labels from; %s has none", indirectValue(v).Type())
+	}
+	values := make([]string, len(keys))
+	for i, k := range keys {
+		values[i] = l[k]
+	}
+	return values, nil
+}
@@ -0,0 +1,83 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/pkg/labels"
+)
+
+type describedResource struct {
+	ID          string `table:"ID"`
+	Title       string `table:"TITLE"`
+	Description string `table:"DESCRIPTION,wide"`
+}
+
+func TestTablePrinter_LabelColumns(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	var buf bytes.Buffer
+	p := NewPrinterWithOpts(PrinterOptions{Format: "table", Writer: &buf, LabelColumns: []string{"team", "env"}})
+
+	items := []describedResource{
+		{ID: "wf-1", Title: "Nightly", Description: labels.InDescription("Checks", labels.Labels{"team": "checkout", "env": "prod"})},
+		{ID: "wf-2", Title: "Manual", Description: "No labels here"},
+	}
+	if err := p.PrintList(items); err != nil {
+		t.Fatalf("PrintList() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected header and 2 rows, got:\n%s", buf.String())
+	}
+	if fields := strings.Fields(lines[0]); strings.Join(fields, " ") != "ID TITLE TEAM ENV" {
+		t.Errorf("header = %q, want ID TITLE TEAM ENV", lines[0])
+	}
+	if fields := strings.Fields(lines[1]); strings.Join(fields, " ") != "wf-1 Nightly checkout prod" {
+		t.Errorf("row 1 = %q", lines[1])
+	}
+	if fields := strings.Fields(lines[2]); strings.Join(fields, " ") != "wf-2 Manual" {
+		t.Errorf("row 2 = %q, want empty label cells", lines[2])
+	}
+}
+
+func TestTablePrinter_LabelColumnsSingleAndMaps(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	var buf bytes.Buffer
+	p := NewPrinterWithOpts(PrinterOptions{Format: "table", Writer: &buf, LabelColumns: []string{"team"}})
+
+	if err := p.Print(&describedResource{ID: "wf-1", Description: labels.InDescription("", labels.Labels{"team": "payments"})}); err != nil {
+		t.Fatalf("Print() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), "TEAM") || !strings.Contains(buf.String(), "payments") {
+		t.Errorf("expected TEAM column with payments, got:\n%s", buf.String())
+	}
+
+	buf.Reset()
+	records := []map[string]interface{}{
+		{"id": "d-1", "description": labels.InDescription("", labels.Labels{"team": "search"})},
+	}
+	if err := p.PrintList(records); err != nil {
+		t.Fatalf("PrintList(maps) error = %v", err)
+	}
+	if !strings.Contains(buf.String(), "search") {
+		t.Errorf("expected label value from map description, got:\n%s", buf.String())
+	}
+}
+
+func TestTablePrinter_LabelColumnsWithoutDescription(t *testing.T) {
+	type plain struct {
+		ID string `table:"ID"`
+	}
+	var buf bytes.Buffer
+	p := NewPrinterWithOpts(PrinterOptions{Format: "table", Writer: &buf, LabelColumns: []string{"team"}})
+
+	err := p.PrintList([]plain{{ID: "x"}})
+	if err == nil || !strings.Contains(err.Error(), "description") {
+		t.Fatalf("error = %v, want missing-description error", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected no partial output, got %q", buf.String())
+	}
+}
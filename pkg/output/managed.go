@@ -0,0 +1,119 @@
+package output
+
+import (
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ManagedFieldsProvider is implemented by resource types whose API objects
+// carry server-managed fields (version, owner, modificationInfo, ...) that
+// cannot be written back. ManagedFields returns their JSON keys.
+//
+// With PrinterOptions.StripManagedFields the JSON and YAML printers drop these
+// keys from top-level objects, so 'get -o yaml' output can be re-applied as is.
+type ManagedFieldsProvider interface {
+	ManagedFields() []string
+}
+
+// managedFieldsOf returns the managed fields of every item of obj, which is a
+// single object or a slice, and reports whether any item declares them.
+func managedFieldsOf(obj interface{}) ([][]string, bool) {
+	v := reflect.ValueOf(obj)
+	if v.Kind() == reflect.Ptr && !v.IsNil() && v.Elem().Kind() == reflect.Slice {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Slice {
+		if p, ok := obj.(ManagedFieldsProvider); ok {
+			return [][]string{p.ManagedFields()}, true
+		}
+		return nil, false
+	}
+
+	fields := make([][]string, v.Len())
+	found := false
+	for i := range fields {
+		if p, ok := v.Index(i).Interface().(ManagedFieldsProvider); ok {
+			fields[i] = p.ManagedFields()
+			found = true
+		}
+	}
+	return fields, found
+}
+
+// stripManagedJSON returns the JSON form of obj without its managed fields,
+// or obj itself when it declares none.
+func stripManagedJSON(obj interface{}) (interface{}, error) {
+	fields, ok := managedFieldsOf(obj)
+	if !ok {
+		return obj, nil
+	}
+	generic, err := canonicalJSON(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	strip := func(item interface{}, keys []string) {
+		if m, ok := item.(map[string]interface{}); ok {
+			for _, key := range keys {
+				delete(m, key)
+			}
+		}
+	}
+	if list, ok := generic.([]interface{}); ok {
+		for i, item := range list {
+			strip(item, fields[i])
+		}
+	} else {
+		strip(generic, fields[0])
+	}
+	return generic, nil
+}
+
+// stripManagedYAML returns obj encoded as a YAML node without its managed
+// fields, or obj itself when it declares none. Working on the node keeps the
+// key order and naming of the YAML encoding; keys are matched
+// case-insensitively because yaml.v3 lowercases untagged struct fields.
+func stripManagedYAML(obj interface{}) (interface{}, error) {
+	fields, ok := managedFieldsOf(obj)
+	if !ok {
+		return obj, nil
+	}
+	var node yaml.Node
+	if err := node.Encode(obj); err != nil {
+		return nil, err
+	}
+
+	strip := func(n *yaml.Node, keys []string) {
+		if n.Kind != yaml.MappingNode || len(keys) == 0 {
+			return
+		}
+		kept := n.Content[:0]
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			if !containsFold(keys, n.Content[i].Value) {
+				kept = append(kept, n.Content[i], n.Content[i+1])
+			}
+		}
+		n.Content = kept
+	}
+	if node.Kind == yaml.SequenceNode {
+		for i, item := range node.Content {
+			if i < len(fields) {
+				strip(item, fields[i])
+			}
+		}
+	} else {
+		strip(&node, fields[0])
+	}
+	return &node, nil
+}
+
+func containsFold(keys []string, key string) bool {
+	for _, k := range keys {
+		if strings.EqualFold(k, key) {
+			return true
+		}
+	}
+	return false
+}
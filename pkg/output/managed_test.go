@@ -0,0 +1,74 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+type managedItem struct {
+	Name      string `json:"name" yaml:"name"`
+	Version   int    `json:"version" yaml:"version"`
+	OwnerType string `json:"ownerType"`
+	Big       int64  `json:"big"`
+}
+
+func (managedItem) ManagedFields() []string { return []string{"version", "ownerType"} }
+
+type plainItem struct {
+	Name    string `json:"name" yaml:"name"`
+	Version int    `json:"version" yaml:"version"`
+}
+
+func TestStripManagedFields(t *testing.T) {
+	item := managedItem{Name: "a", Version: 3, OwnerType: "USER", Big: 9007199254740993}
+
+	tests := []struct {
+		name    string
+		format  string
+		jq      string
+		obj     interface{}
+		want    []string
+		notWant []string
+	}{
+		{"json object", "json", "", item, []string{`"name": "a"`, `"big": 9007199254740993`}, []string{"version", "ownerType"}},
+		{"json list", "json", "", []managedItem{item, item}, []string{`"name": "a"`}, []string{"version"}},
+		{"json pointer", "json", "", &item, []string{`"name": "a"`}, []string{"version"}},
+		{"yaml object", "yaml", "", item, []string{"name: a"}, []string{"version", "ownertype"}},
+		{"yaml list", "yaml", "", []*managedItem{&item}, []string{"- big: 9007199254740993\n  name: a"}, []string{"version"}},
+		{"yaml with jq", "yaml", ".[0]", []managedItem{item}, []string{"name: a"}, []string{"version"}},
+		{"no provider", "json", "", plainItem{Name: "a", Version: 3}, []string{`"version": 3`}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			printer := NewPrinterWithOpts(PrinterOptions{Format: tt.format, Writer: &buf, JQFilter: tt.jq, StripManagedFields: true})
+			if err := printer.Print(tt.obj); err != nil {
+				t.Fatalf("Print() error = %v", err)
+			}
+			got := buf.String()
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("output = %q, want %q", got, want)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(got, notWant) {
+					t.Errorf("output = %q, should not contain %q", got, notWant)
+				}
+			}
+		})
+	}
+}
+
+func TestStripManagedFields_Disabled(t *testing.T) {
+	var buf bytes.Buffer
+	printer := NewPrinterWithOpts(PrinterOptions{Format: "yaml", Writer: &buf})
+	if err := printer.Print(managedItem{Name: "a", Version: 3}); err != nil {
+		t.Fatalf("Print() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), "version: 3") {
+		t.Errorf("output = %q, want managed fields kept by default", buf.String())
+	}
+}
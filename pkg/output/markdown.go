@@ -0,0 +1,220 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MarkdownPrinter prints output as a GitHub-flavored Markdown table, for
+// pasting results into tickets and pull requests.
+type MarkdownPrinter struct {
+	writer io.Writer
+	// columns selects and orders the columns to print (--columns). Map keys
+	// match exactly; struct columns match their table header case-insensitively.
+	columns []string
+}
+
+// Print prints a single object as a one-row Markdown table
+func (p *MarkdownPrinter) Print(obj interface{}) error {
+	return p.PrintList([]interface{}{obj})
+}
+
+// PrintList prints a list of objects as a Markdown table
+func (p *MarkdownPrinter) PrintList(obj interface{}) error {
+	v := reflect.ValueOf(obj)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Slice {
+		return fmt.Errorf("expected slice, got %s", v.Kind())
+	}
+	if v.Len() == 0 {
+		return nil
+	}
+
+	first := indirectValue(v.Index(0))
+	switch first.Kind() {
+	case reflect.Map:
+		return p.printMaps(v)
+	case reflect.Struct:
+		return p.printStructs(v, first.Type())
+	}
+
+	rows := make([][]string, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		rows = append(rows, []string{formatMarkdownValue(v.Index(i).Interface())})
+	}
+	return p.write([]string{"VALUE"}, rows)
+}
+
+func (p *MarkdownPrinter) printMaps(v reflect.Value) error {
+	keySet := make(map[string]bool)
+	var records []map[string]interface{}
+	for i := 0; i < v.Len(); i++ {
+		elem := indirectValue(v.Index(i))
+		if elem.Kind() != reflect.Map {
+			continue
+		}
+		record := make(map[string]interface{})
+		iter := elem.MapRange()
+		for iter.Next() {
+			key := fmt.Sprintf("%v", iter.Key().Interface())
+			keySet[key] = true
+			record[key] = iter.Value().Interface()
+		}
+		records = append(records, record)
+	}
+
+	keys := make([]string, 0, len(keySet))
+	for k := range keySet {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	if len(p.columns) > 0 {
+		for _, c := range p.columns {
+			if !keySet[c] {
+				return fmt.Errorf("unknown column %q (available: %s)", c, strings.Join(keys, ", "))
+			}
+		}
+		keys = p.columns
+	}
+
+	rows := make([][]string, 0, len(records))
+	for _, record := range records {
+		row := make([]string, 0, len(keys))
+		for _, k := range keys {
+			row = append(row, formatMarkdownValue(record[k]))
+		}
+		rows = append(rows, row)
+	}
+	return p.write(keys, rows)
+}
+
+func (p *MarkdownPrinter) printStructs(v reflect.Value, t reflect.Type) error {
+	fields := getTableFields(t, false)
+	if len(p.columns) > 0 {
+		all := getTableFields(t, true)
+		fields = fields[:0:0]
+		for _, c := range p.columns {
+			found := false
+			for _, f := range all {
+				if strings.EqualFold(f.name, c) {
+					fields = append(fields, f)
+					found = true
+					break
+				}
+			}
+			if !found {
+				names := make([]string, 0, len(all))
+				for _, f := range all {
+					names = append(names, f.name)
+				}
+				return fmt.Errorf("unknown column %q (available: %s)", c, strings.Join(names, ", "))
+			}
+		}
+	}
+
+	headers := make([]string, 0, len(fields))
+	for _, f := range fields {
+		headers = append(headers, f.name)
+	}
+	rows := make([][]string, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		elem := indirectValue(v.Index(i))
+		row := make([]string, 0, len(fields))
+		for _, f := range fields {
+			value := getFieldByPath(elem, f.indices)
+			if !value.IsValid() {
+				row = append(row, "")
+				continue
+			}
+			row = append(row, formatMarkdownValue(value.Interface()))
+		}
+		rows = append(rows, row)
+	}
+	return p.write(headers, rows)
+}
+
+// write renders the table.
+func (p *MarkdownPrinter) write(headers []string, rows [][]string) error {
+	header := make([]string, len(headers))
+	sep := make([]string, len(headers))
+	for i, h := range headers {
+		header[i] = escapeMarkdownCell(h)
+		sep[i] = "---"
+	}
+
+	var b strings.Builder
+	writeRow := func(cells []string) {
+		b.WriteString("| ")
+		b.WriteString(strings.Join(cells, " | "))
+		b.WriteString(" |\n")
+	}
+	writeRow(header)
+	writeRow(sep)
+	for _, row := range rows {
+		for i := range row {
+			row[i] = escapeMarkdownCell(row[i])
+		}
+		writeRow(row)
+	}
+	_, err := io.WriteString(p.writer, b.String())
+	return err
+}
+
+// escapeMarkdownCell escapes pipes and backslashes and turns line breaks into
+// <br> so a value stays inside its cell.
+func escapeMarkdownCell(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "\r\n", "<br>")
+	return strings.ReplaceAll(s, "\n", "<br>")
+}
+
+// formatMarkdownValue renders a cell value: nested maps and slices as compact
+// JSON, times like the table printer, nil as empty.
+func formatMarkdownValue(val interface{}) string {
+	if val == nil {
+		return ""
+	}
+	v := reflect.ValueOf(val)
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return ""
+		}
+		return formatMarkdownValue(v.Elem().Interface())
+	case reflect.Map, reflect.Slice:
+		if v.IsNil() || v.Len() == 0 {
+			return ""
+		}
+		data, err := json.Marshal(val)
+		if err != nil {
+			return fmt.Sprintf("%v", val)
+		}
+		return string(data)
+	}
+	if t, ok := val.(time.Time); ok {
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format("2006-01-02 15:04:05")
+	}
+	return fmt.Sprintf("%v", val)
+}
+
+// indirectValue unwraps interfaces and pointers.
+func indirectValue(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Interface || v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return v
+		}
+		v = v.Elem()
+	}
+	return v
+}
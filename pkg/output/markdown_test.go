@@ -0,0 +1,78 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestMarkdownPrinter_Maps(t *testing.T) {
+	var buf bytes.Buffer
+	p := NewPrinterWithOpts(PrinterOptions{Format: "markdown", Writer: &buf})
+
+	records := []map[string]interface{}{
+		{"host": "h-1", "content": "a|b\nsecond line", "count": 3},
+		{"host": "h-2", "tags": []string{"x", "y"}},
+	}
+	if err := p.PrintList(records); err != nil {
+		t.Fatalf("PrintList() error = %v", err)
+	}
+
+	want := strings.Join([]string{
+		"| content | count | host | tags |",
+		"| --- | --- | --- | --- |",
+		`| a\|b<br>second line | 3 | h-1 |  |`,
+		`|  |  | h-2 | ["x","y"] |`,
+		"",
+	}, "\n")
+	if got := buf.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestMarkdownPrinter_Columns(t *testing.T) {
+	var buf bytes.Buffer
+	p := NewPrinterWithOpts(PrinterOptions{Format: "md", Writer: &buf, Columns: []string{"host", "count"}})
+
+	records := []map[string]interface{}{{"host": "h-1", "content": "x", "count": 3}}
+	if err := p.PrintList(records); err != nil {
+		t.Fatalf("PrintList() error = %v", err)
+	}
+	want := "| host | count |\n| --- | --- |\n| h-1 | 3 |\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	p = NewPrinterWithOpts(PrinterOptions{Format: "markdown", Writer: &buf, Columns: []string{"missing"}})
+	if err := p.PrintList(records); err == nil || !strings.Contains(err.Error(), `unknown column "missing"`) {
+		t.Errorf("error = %v, want unknown column", err)
+	}
+}
+
+func TestMarkdownPrinter_Structs(t *testing.T) {
+	type row struct {
+		ID     string `table:"ID"`
+		Name   string `table:"NAME"`
+		Detail string `table:"DETAIL,wide"`
+	}
+	rows := []row{{ID: "1", Name: "first", Detail: "d"}}
+
+	var buf bytes.Buffer
+	p := NewPrinterWithOpts(PrinterOptions{Format: "markdown", Writer: &buf})
+	if err := p.PrintList(rows); err != nil {
+		t.Fatalf("PrintList() error = %v", err)
+	}
+	if want := "| ID | NAME |\n| --- | --- |\n| 1 | first |\n"; buf.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", buf.String(), want)
+	}
+
+	// --columns matches headers case-insensitively and can pick wide columns.
+	buf.Reset()
+	p = NewPrinterWithOpts(PrinterOptions{Format: "markdown", Writer: &buf, Columns: []string{"detail", "id"}})
+	if err := p.PrintList(rows); err != nil {
+		t.Fatalf("PrintList() error = %v", err)
+	}
+	if want := "| DETAIL | ID |\n| --- | --- |\n| d | 1 |\n"; buf.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
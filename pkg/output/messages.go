@@ -1,11 +1,35 @@
 package output
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 )
 
+// structuredWarnings switches warnings from "Warning: ..." lines to NDJSON
+// objects. It is enabled for json/yaml output so automation can tell
+// "succeeded with warnings" from a clean success without parsing prose.
+var structuredWarnings bool
+
+// SetStructuredWarnings enables or disables NDJSON warnings on stderr.
+func SetStructuredWarnings(enabled bool) {
+	structuredWarnings = enabled
+}
+
+// StructuredWarnings reports whether warnings are emitted as NDJSON objects.
+func StructuredWarnings() bool {
+	return structuredWarnings
+}
+
+// Warning is the structured form of a warning, written to stderr as one JSON
+// object per line when structured warnings are enabled.
+type Warning struct {
+	Type    string `json:"type"` // always "warning"
+	Message string `json:"message"`
+	Hint    string `json:"hint,omitempty"`
+}
+
 // PrintSuccess prints a success message with a green "OK" prefix.
 // Output goes to stderr so it doesn't interfere with structured stdout.
 func PrintSuccess(format string, args ...interface{}) {
@@ -28,10 +52,41 @@ func PrintWarning(format string, args ...interface{}) {
 // FprintWarning prints a warning message to the given writer.
 func FprintWarning(w io.Writer, format string, args ...interface{}) {
 	msg := fmt.Sprintf(format, args...)
+	if structuredWarnings {
+		fprintWarningObject(w, msg, "")
+		return
+	}
 	prefix := Colorize(Bold+Yellow, "Warning:")
 	fmt.Fprintf(w, "%s %s\n", prefix, msg)
 }
 
+// PrintWarningWithHint prints a warning followed by a hint to stderr. With
+// structured warnings the hint is carried in the same object.
+func PrintWarningWithHint(hint, format string, args ...interface{}) {
+	FprintWarningWithHint(os.Stderr, hint, format, args...)
+}
+
+// FprintWarningWithHint prints a warning and an optional hint to the given writer.
+func FprintWarningWithHint(w io.Writer, hint, format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	if structuredWarnings {
+		fprintWarningObject(w, msg, hint)
+		return
+	}
+	FprintWarning(w, "%s", msg)
+	if hint != "" {
+		FprintHint(w, "%s", hint)
+	}
+}
+
+func fprintWarningObject(w io.Writer, msg, hint string) {
+	data, err := json.Marshal(Warning{Type: "warning", Message: msg, Hint: hint})
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(w, "%s\n", data)
+}
+
 // PrintHumanError prints a human-readable error message to stderr.
 // Uses bold red for the "Error:" prefix. For agent/plain mode, use PrintError instead.
 func PrintHumanError(format string, args ...interface{}) {
@@ -2,6 +2,7 @@ package output
 
 import (
 	"bytes"
+	"encoding/json"
 	"os"
 	"strings"
 	"testing"
@@ -70,6 +71,50 @@ func TestFprintWarning_NoColor(t *testing.T) {
 	}
 }
 
+func TestFprintWarning_Structured(t *testing.T) {
+	SetStructuredWarnings(true)
+	defer SetStructuredWarnings(false)
+
+	var buf bytes.Buffer
+	FprintWarning(&buf, "resource %s is deprecated", "old-res")
+	FprintWarningWithHint(&buf, "use new-res", "resource %s is going away", "old-res")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 NDJSON lines, got %d: %q", len(lines), buf.String())
+	}
+	var first, second Warning
+	if err := json.Unmarshal([]byte(lines[0]), &first); err != nil {
+		t.Fatalf("first line is not JSON: %v", err)
+	}
+	if err := json.Unmarshal([]byte(lines[1]), &second); err != nil {
+		t.Fatalf("second line is not JSON: %v", err)
+	}
+	if first.Type != "warning" || first.Message != "resource old-res is deprecated" || first.Hint != "" {
+		t.Errorf("unexpected first warning: %+v", first)
+	}
+	if second.Message != "resource old-res is going away" || second.Hint != "use new-res" {
+		t.Errorf("unexpected second warning: %+v", second)
+	}
+}
+
+func TestFprintWarningWithHint_Text(t *testing.T) {
+	ResetColorCache()
+	t.Setenv("NO_COLOR", "1")
+	defer ResetColorCache()
+
+	var buf bytes.Buffer
+	FprintWarningWithHint(&buf, "narrow the timeframe", "scan limit reached")
+
+	got := buf.String()
+	if !strings.Contains(got, "Warning: scan limit reached\n") {
+		t.Errorf("expected warning line, got: %s", got)
+	}
+	if !strings.Contains(got, "Hint: narrow the timeframe") {
+		t.Errorf("expected hint line, got: %s", got)
+	}
+}
+
 func TestFprintWarning_WithColor(t *testing.T) {
 	ResetColorCache()
 	os.Unsetenv("NO_COLOR")
@@ -0,0 +1,88 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// nameColumns are the table headers that hold a resource's human-readable
+// name, in order of preference.
+var nameColumns = []string{"NAME", "TITLE", "DISPLAY NAME", "DISPLAY_NAME"}
+
+// nameKeys are the map keys checked for untyped resources, in order of
+// preference.
+var nameKeys = []string{"name", "title", "displayName"}
+
+// NamePrinter prints only the name of each resource, one per line (-o name),
+// for composing with other shell commands. The name is the struct field whose
+// table header is NAME or TITLE (see nameColumns); maps use the name or title
+// key.
+type NamePrinter struct {
+	writer io.Writer
+}
+
+// Print prints the name of a single object
+func (p *NamePrinter) Print(obj interface{}) error {
+	return p.PrintList([]interface{}{obj})
+}
+
+// PrintList prints the name of every object in a slice
+func (p *NamePrinter) PrintList(obj interface{}) error {
+	v := reflect.ValueOf(obj)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Slice {
+		return fmt.Errorf("expected slice, got %s", v.Kind())
+	}
+
+	var b strings.Builder
+	for i := 0; i < v.Len(); i++ {
+		name, err := resourceName(indirectValue(v.Index(i)))
+		if err != nil {
+			return err
+		}
+		b.WriteString(name)
+		b.WriteString("\n")
+	}
+	_, err := io.WriteString(p.writer, b.String())
+	return err
+}
+
+// resourceName returns the name field of a struct or map.
+func resourceName(v reflect.Value) (string, error) {
+	switch v.Kind() {
+	case reflect.Struct:
+		fields := getTableFields(v.Type(), true)
+		for _, column := range nameColumns {
+			for _, f := range fields {
+				if !strings.EqualFold(f.name, column) {
+					continue
+				}
+				value := getFieldByPath(v, f.indices)
+				if !value.IsValid() {
+					return "", nil
+				}
+				return formatMarkdownValue(value.Interface()), nil
+			}
+		}
+		return "", fmt.Errorf("-o name is not supported for %s: it has no NAME or TITLE column", v.Type().Name())
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			break
+		}
+		for _, key := range nameKeys {
+			value := v.MapIndex(reflect.ValueOf(key))
+			if value.IsValid() {
+				return formatMarkdownValue(value.Interface()), nil
+			}
+		}
+		return "", fmt.Errorf("-o name is not supported for this resource: it has no name or title field")
+	}
+	if !v.IsValid() {
+		return "", nil
+	}
+	return formatMarkdownValue(v.Interface()), nil
+}
@@ -0,0 +1,75 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+type namedResource struct {
+	ID    string `table:"ID"`
+	Title string `table:"TITLE"`
+	Name  string `table:"NAME,wide"`
+}
+
+type titledResource struct {
+	ID    string `table:"ID"`
+	Title string `table:"TITLE"`
+}
+
+func TestNamePrinter_Structs(t *testing.T) {
+	var buf bytes.Buffer
+	p := NewPrinterWithOpts(PrinterOptions{Format: "name", Writer: &buf})
+
+	// NAME wins over TITLE, even when it is a wide-only column.
+	items := []namedResource{
+		{ID: "1", Title: "First title", Name: "first"},
+		{ID: "2", Title: "Second title", Name: "second"},
+	}
+	if err := p.PrintList(items); err != nil {
+		t.Fatalf("PrintList() error = %v", err)
+	}
+	if got, want := buf.String(), "first\nsecond\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	buf.Reset()
+	if err := p.Print(&titledResource{ID: "3", Title: "Error budget"}); err != nil {
+		t.Fatalf("Print() error = %v", err)
+	}
+	if got, want := buf.String(), "Error budget\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestNamePrinter_Maps(t *testing.T) {
+	var buf bytes.Buffer
+	p := NewPrinterWithOpts(PrinterOptions{Format: "name", Writer: &buf})
+
+	records := []map[string]interface{}{
+		{"id": "a", "title": "Alpha"},
+		{"id": "b", "name": "beta", "title": "Beta"},
+	}
+	if err := p.PrintList(records); err != nil {
+		t.Fatalf("PrintList() error = %v", err)
+	}
+	if got, want := buf.String(), "Alpha\nbeta\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestNamePrinter_NoNameColumn(t *testing.T) {
+	type unnamed struct {
+		ID string `table:"ID"`
+	}
+	var buf bytes.Buffer
+	p := NewPrinterWithOpts(PrinterOptions{Format: "name", Writer: &buf})
+
+	err := p.PrintList([]unnamed{{ID: "x"}})
+	if err == nil || !strings.Contains(err.Error(), "no NAME or TITLE column") {
+		t.Fatalf("error = %v, want missing-column error", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected no output on error, got %q", buf.String())
+	}
+}
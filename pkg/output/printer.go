@@ -1,10 +1,12 @@
 package output
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"sort"
 
 	"gopkg.in/yaml.v3"
 )
@@ -22,12 +24,21 @@ type PrinterOptions struct {
 	PlainMode  bool
 	AgentMode  bool
 	JQFilter   string
-	Width      int  // Chart width (0 = default)
-	Height     int  // Chart height (0 = default)
-	Fullscreen bool // Use terminal dimensions
+	NoHeaders  bool     // Omit the header row in table, wide, and csv output
+	Columns    []string // Columns to print, in order, in markdown output (empty = all)
+	ToonWidth  int      // Max rune width of TOON string values before truncation (0 = unlimited)
+	Width      int      // Chart width (0 = default)
+	Height     int      // Chart height (0 = default)
+	Fullscreen bool     // Use terminal dimensions
 	// Types carries DQL column type info used by the Parquet printer to build a
 	// faithful schema. Ignored by other formats; nil falls back to inference.
 	Types []ColumnTypeMapping
+	// LabelColumns are dtctl label keys read from each object's description
+	// and shown as extra table/wide columns (see package labels).
+	LabelColumns []string
+	// StripManagedFields drops the server-managed fields declared by
+	// ManagedFieldsProvider types from json and yaml output.
+	StripManagedFields bool
 }
 
 // NewPrinter creates a new printer based on the format
@@ -82,19 +93,27 @@ func NewPrinterWithOpts(opts PrinterOptions) Printer {
 		)
 	}
 
+	if IsTemplateOutputFormat(format) {
+		return NewTemplatePrinter(writer, format)
+	}
+
 	switch format {
 	case "json":
-		return &JSONPrinter{writer: writer, jqFilter: effectiveJQFilter}
+		return &JSONPrinter{writer: writer, jqFilter: effectiveJQFilter, stripManaged: opts.StripManagedFields}
 	case "yaml", "yml":
-		return &YAMLPrinter{writer: writer, jqFilter: effectiveJQFilter}
+		return &YAMLPrinter{writer: writer, jqFilter: effectiveJQFilter, stripManaged: opts.StripManagedFields}
 	case "csv":
-		return &CSVPrinter{writer: writer}
+		return &CSVPrinter{writer: writer, noHeaders: opts.NoHeaders}
+	case "markdown", "md":
+		return &MarkdownPrinter{writer: writer, columns: opts.Columns}
 	case "jsonl":
 		return &JSONLPrinter{writer: writer}
+	case "name":
+		return &NamePrinter{writer: writer}
 	case "parquet":
 		return &ParquetPrinter{writer: writer, types: opts.Types}
 	case "toon":
-		return &ToonPrinter{writer: writer, jqFilter: effectiveJQFilter}
+		return &ToonPrinter{writer: writer, jqFilter: effectiveJQFilter, width: opts.ToonWidth}
 	case "chart":
 		if width > 0 || height > 0 {
 			return NewChartPrinterWithSize(writer, width, height)
@@ -125,28 +144,60 @@ func NewPrinterWithOpts(opts PrinterOptions) Printer {
 		}
 		return NewBrailleChartPrinter(writer)
 	case "table", "wide":
-		return &TablePrinter{writer: writer, wide: format == "wide"}
+		return &TablePrinter{writer: writer, wide: format == "wide", noHeaders: opts.NoHeaders, labelColumns: opts.LabelColumns}
 	default:
-		return &TablePrinter{writer: writer}
+		return &TablePrinter{writer: writer, noHeaders: opts.NoHeaders, labelColumns: opts.LabelColumns}
 	}
 }
 
 // JSONPrinter prints output as JSON
 type JSONPrinter struct {
-	writer   io.Writer
-	jqFilter string
+	writer       io.Writer
+	jqFilter     string
+	stripManaged bool
 }
 
 // Print prints a single object as JSON
 func (p *JSONPrinter) Print(obj interface{}) error {
+	if p.stripManaged {
+		stripped, err := stripManagedJSON(obj)
+		if err != nil {
+			return err
+		}
+		obj = stripped
+	}
 	transformed, err := ApplyJQ(p.jqFilter, obj)
 	if err != nil {
 		return err
 	}
 
+	canonical, err := canonicalJSON(transformed)
+	if err != nil {
+		return err
+	}
+
 	encoder := json.NewEncoder(p.writer)
 	encoder.SetIndent("", "  ")
-	return encoder.Encode(transformed)
+	return encoder.Encode(canonical)
+}
+
+// canonicalJSON round-trips v through a generic JSON value so that every
+// object's keys are emitted in sorted order, recursively — struct fields as
+// well as json.RawMessage payloads (dashboard content, analyzer schemas) that
+// would otherwise keep the server's key order. Re-exports then only diff on
+// real changes. Numbers are kept as json.Number so large integers survive.
+func canonicalJSON(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var out interface{}
+	if err := dec.Decode(&out); err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
 // PrintList prints a list of objects as JSON
@@ -156,20 +207,72 @@ func (p *JSONPrinter) PrintList(obj interface{}) error {
 
 // YAMLPrinter prints output as YAML
 type YAMLPrinter struct {
-	writer   io.Writer
-	jqFilter string
+	writer       io.Writer
+	jqFilter     string
+	stripManaged bool
 }
 
 // Print prints a single object as YAML
 func (p *YAMLPrinter) Print(obj interface{}) error {
+	if p.stripManaged {
+		// jq works on the JSON form, so strip that when a filter follows.
+		strip := stripManagedYAML
+		if p.jqFilter != "" {
+			strip = stripManagedJSON
+		}
+		stripped, err := strip(obj)
+		if err != nil {
+			return err
+		}
+		obj = stripped
+	}
 	transformed, err := ApplyJQ(p.jqFilter, obj)
 	if err != nil {
 		return err
 	}
 
+	canonical, err := canonicalYAML(transformed)
+	if err != nil {
+		return err
+	}
+
 	encoder := yaml.NewEncoder(p.writer)
 	encoder.SetIndent(2)
-	return encoder.Encode(transformed)
+	return encoder.Encode(canonical)
+}
+
+// canonicalYAML encodes v as a YAML node and sorts the keys of every mapping,
+// recursively, the YAML counterpart of canonicalJSON. Encoding to a node first
+// keeps each type's MarshalYAML and yaml tags, so only the key order changes:
+// struct fields no longer follow declaration order.
+func canonicalYAML(v interface{}) (*yaml.Node, error) {
+	node, ok := v.(*yaml.Node)
+	if !ok {
+		node = &yaml.Node{}
+		if err := node.Encode(v); err != nil {
+			return nil, err
+		}
+	}
+	sortYAMLKeys(node)
+	return node, nil
+}
+
+func sortYAMLKeys(n *yaml.Node) {
+	for _, child := range n.Content {
+		sortYAMLKeys(child)
+	}
+	if n.Kind != yaml.MappingNode {
+		return
+	}
+	pairs := make([][2]*yaml.Node, 0, len(n.Content)/2)
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		pairs = append(pairs, [2]*yaml.Node{n.Content[i], n.Content[i+1]})
+	}
+	sort.SliceStable(pairs, func(i, j int) bool { return pairs[i][0].Value < pairs[j][0].Value })
+	n.Content = n.Content[:0]
+	for _, pair := range pairs {
+		n.Content = append(n.Content, pair[0], pair[1])
+	}
 }
 
 // PrintList prints a list of objects as YAML
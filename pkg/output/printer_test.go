@@ -2,6 +2,7 @@ package output
 
 import (
 	"bytes"
+	"encoding/json"
 	"strings"
 	"testing"
 )
@@ -165,6 +166,72 @@ func TestJSONPrinter_Print(t *testing.T) {
 	}
 }
 
+func TestJSONPrinter_SortsKeys(t *testing.T) {
+	var buf bytes.Buffer
+	p := &JSONPrinter{writer: &buf}
+
+	input := struct {
+		Zeta    string          `json:"zeta"`
+		Alpha   int64           `json:"alpha"`
+		Content json.RawMessage `json:"content"`
+	}{
+		Zeta:    "z",
+		Alpha:   9007199254740993,
+		Content: json.RawMessage(`{"tiles":{"b":1,"a":2},"version":1}`),
+	}
+	if err := p.Print(input); err != nil {
+		t.Fatalf("Print failed: %v", err)
+	}
+
+	want := `{
+  "alpha": 9007199254740993,
+  "content": {
+    "tiles": {
+      "a": 2,
+      "b": 1
+    },
+    "version": 1
+  },
+  "zeta": "z"
+}
+`
+	if buf.String() != want {
+		t.Errorf("output =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestYAMLPrinter_SortsKeys(t *testing.T) {
+	type payload struct {
+		Zeta    string                 `yaml:"zeta"`
+		Alpha   int64                  `yaml:"alpha"`
+		Content map[string]interface{} `yaml:"content"`
+	}
+	input := payload{
+		Zeta:    "z",
+		Alpha:   9007199254740993,
+		Content: map[string]interface{}{"version": 1, "tiles": map[string]interface{}{"b": 1, "a": 2}},
+	}
+
+	want := `alpha: 9007199254740993
+content:
+  tiles:
+    a: 2
+    b: 1
+  version: 1
+zeta: z
+`
+	for _, obj := range []interface{}{input, &input} {
+		var buf bytes.Buffer
+		p := &YAMLPrinter{writer: &buf}
+		if err := p.Print(obj); err != nil {
+			t.Fatalf("Print failed: %v", err)
+		}
+		if buf.String() != want {
+			t.Errorf("Print() = %q, want %q", buf.String(), want)
+		}
+	}
+}
+
 func TestJSONPrinter_PrintList(t *testing.T) {
 	var buf bytes.Buffer
 	p := &JSONPrinter{writer: &buf}
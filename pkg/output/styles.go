@@ -12,21 +12,45 @@ import (
 // colorEnabled caches the result of the color detection logic.
 // It is computed once on first access via colorEnabledOnce.
 var (
-	colorEnabledOnce   sync.Once
-	colorEnabledResult bool
-	plainModeEnabled   bool
+	colorEnabledOnce         sync.Once
+	colorEnabledResult       bool
+	stderrColorEnabledOnce   sync.Once
+	stderrColorEnabledResult bool
+	plainModeEnabled         bool
+	forceColorEnabled        bool
 )
 
-// ColorEnabled returns whether color output should be used.
-// Respects NO_COLOR env var (https://no-color.org/), FORCE_COLOR env var,
-// and auto-detects non-TTY output.
+// ColorEnabled returns whether color output should be used on stdout.
+// Respects NO_COLOR env var (https://no-color.org/), --plain, --force-color,
+// FORCE_COLOR env var, and auto-detects non-TTY output.
 func ColorEnabled() bool {
 	colorEnabledOnce.Do(func() {
-		colorEnabledResult = detectColor()
+		colorEnabledResult = detectColor(os.Stdout)
 	})
 	return colorEnabledResult
 }
 
+// StderrColorEnabled is ColorEnabled for status messages written to stderr:
+// the same rules apply, but the TTY check looks at stderr so that piping
+// stdout does not strip color from messages that still reach the terminal.
+func StderrColorEnabled() bool {
+	stderrColorEnabledOnce.Do(func() {
+		stderrColorEnabledResult = detectColor(os.Stderr)
+	})
+	return stderrColorEnabledResult
+}
+
+// ColorAllowed reports whether color may be used at all, i.e. neither
+// --plain nor NO_COLOR disables it. Commands with their own explicit color
+// flag (diff --color) use it so NO_COLOR still wins over the flag.
+func ColorAllowed() bool {
+	if plainModeEnabled {
+		return false
+	}
+	_, noColor := os.LookupEnv("NO_COLOR")
+	return !noColor
+}
+
 // SetPlainMode disables color output when --plain is used.
 // Must be called before the first call to ColorEnabled() (e.g., during
 // command initialization) so the cached result reflects the flag.
@@ -34,28 +58,33 @@ func SetPlainMode(plain bool) {
 	plainModeEnabled = plain
 }
 
-// detectColor performs the actual color detection logic.
-// Color enabled = NOT (NO_COLOR is set) AND NOT (--plain flag) AND (stdout is a TTY OR FORCE_COLOR=1)
-func detectColor() bool {
-	// --plain flag disables color (and interactive prompts)
-	if plainModeEnabled {
-		return false
-	}
+// SetForceColor enables color even when the output is not a TTY
+// (--force-color), e.g. for CI logs that render ANSI codes. --plain and
+// NO_COLOR still win. Like SetPlainMode, it must be called before the first
+// call to ColorEnabled().
+func SetForceColor(force bool) {
+	forceColorEnabled = force
+}
 
+// detectColor performs the actual color detection logic.
+// Color enabled = NOT (NO_COLOR is set) AND NOT (--plain flag) AND
+// (f is a TTY OR --force-color OR FORCE_COLOR=1)
+func detectColor(f *os.File) bool {
+	// --plain flag disables color (and interactive prompts).
 	// NO_COLOR: any set value (including empty) disables color.
 	// This is intentionally stricter than no-color.org (which excludes empty strings).
 	// See https://no-color.org/
-	if _, exists := os.LookupEnv("NO_COLOR"); exists {
+	if !ColorAllowed() {
 		return false
 	}
 
-	// FORCE_COLOR=1 overrides TTY detection (useful for CI systems)
-	if os.Getenv("FORCE_COLOR") == "1" {
+	// --force-color and FORCE_COLOR=1 override TTY detection (useful for CI systems)
+	if forceColorEnabled || os.Getenv("FORCE_COLOR") == "1" {
 		return true
 	}
 
-	// Auto-detect: only use color when stdout is a TTY
-	if !term.IsTerminal(int(os.Stdout.Fd())) {
+	// Auto-detect: only use color when the output is a TTY
+	if !term.IsTerminal(int(f.Fd())) {
 		return false
 	}
 
@@ -70,7 +99,10 @@ func detectColor() bool {
 func ResetColorCache() {
 	colorEnabledOnce = sync.Once{}
 	colorEnabledResult = false
+	stderrColorEnabledOnce = sync.Once{}
+	stderrColorEnabledResult = false
 	plainModeEnabled = false
+	forceColorEnabled = false
 }
 
 // Colorize wraps text in ANSI color codes if color is enabled.
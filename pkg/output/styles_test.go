@@ -49,6 +49,37 @@ func TestColorEnabled_FORCECOLOROnlyWithValue1(t *testing.T) {
 	}
 }
 
+func TestColorEnabled_ForceColor(t *testing.T) {
+	defer ResetColorCache()
+
+	ResetColorCache()
+	os.Unsetenv("NO_COLOR")
+	os.Unsetenv("FORCE_COLOR")
+	SetForceColor(true)
+	// stdout and stderr are not TTYs in tests; --force-color overrides that.
+	if !ColorEnabled() || !StderrColorEnabled() {
+		t.Error("--force-color should enable color without a TTY")
+	}
+
+	ResetColorCache()
+	t.Setenv("NO_COLOR", "1")
+	SetForceColor(true)
+	if ColorEnabled() || StderrColorEnabled() {
+		t.Error("NO_COLOR should win over --force-color")
+	}
+
+	ResetColorCache()
+	os.Unsetenv("NO_COLOR")
+	SetForceColor(true)
+	SetPlainMode(true)
+	if ColorEnabled() {
+		t.Error("--plain should win over --force-color")
+	}
+	if ColorAllowed() {
+		t.Error("ColorAllowed() should be false under --plain")
+	}
+}
+
 func TestColorEnabled_Caching(t *testing.T) {
 	ResetColorCache()
 	t.Setenv("FORCE_COLOR", "1")
@@ -18,8 +18,12 @@ var uuidRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4
 
 // TablePrinter prints output as a table
 type TablePrinter struct {
-	writer io.Writer
-	wide   bool
+	writer    io.Writer
+	wide      bool
+	noHeaders bool
+	// labelColumns are dtctl label keys shown as extra columns, read from
+	// each object's description (--label-columns).
+	labelColumns []string
 }
 
 // tableFieldInfo holds metadata about a field for table display
@@ -226,6 +230,40 @@ var statusColors = map[string]string{
 	"RUNNING": BrightYellow, "IN_PROGRESS": BrightYellow, "WAITING": BrightYellow,
 }
 
+// stateColumns are the columns whose values are matched against stateColors
+// case-insensitively, so e.g. "Success" or "cancelled" in a STATE column is
+// colored even though it is not an exact statusColors key.
+var stateColumns = map[string]bool{
+	"STATE": true, "STATUS": true, "ENABLED": true, "DEPLOYED": true,
+}
+
+// stateColors maps upper-cased state values (workflow execution and task
+// states included) to colors for cells in stateColumns.
+var stateColors = map[string]string{
+	"SUCCESS": BrightGreen, "SUCCEEDED": BrightGreen, "COMPLETED": BrightGreen, "ACTIVE": BrightGreen,
+	"ENABLED": BrightGreen, "DEPLOYED": BrightGreen, "HEALTHY": BrightGreen, "TRUE": BrightGreen,
+
+	"ERROR": BrightRed, "FAILED": BrightRed, "DISABLED": BrightRed, "INACTIVE": BrightRed,
+	"CRITICAL": BrightRed, "FALSE": BrightRed,
+
+	"RUNNING": BrightYellow, "WAITING": BrightYellow, "DELAYED": BrightYellow, "IDLE": BrightYellow,
+	"PENDING": BrightYellow, "IN_PROGRESS": BrightYellow, "WARNING": BrightYellow, "WARN": BrightYellow,
+
+	"CANCELLED": Dim, "SKIPPED": Dim,
+}
+
+// colorizeColumnValue colors a cell of the given column. State columns get
+// case-insensitive state coloring; everything else goes through
+// colorizeTableValue.
+func colorizeColumnValue(column, value string) string {
+	if stateColumns[strings.ToUpper(column)] && ColorEnabled() {
+		if color, ok := stateColors[strings.ToUpper(value)]; ok {
+			return Colorize(color, value)
+		}
+	}
+	return colorizeTableValue(value)
+}
+
 // colorizeTableValue applies semantic coloring to a table cell value.
 // It dims UUIDs and colors known status values.
 func colorizeTableValue(value string) string {
@@ -272,10 +310,20 @@ func (p *TablePrinter) Print(obj interface{}) error {
 	for _, f := range fields {
 		headers = append(headers, f.name)
 		value := getFieldByPath(v, f.indices)
-		values = append(values, colorizeTableValue(formatValue(value)))
+		values = append(values, colorizeColumnValue(f.name, formatValue(value)))
+	}
+	if len(p.labelColumns) > 0 {
+		labelValues, err := labelColumnValues(v, p.labelColumns)
+		if err != nil {
+			return err
+		}
+		headers = append(headers, p.labelColumns...)
+		values = append(values, labelValues...)
 	}
 
-	table.Header(toAny(formatHeaders(headers))...)
+	if !p.noHeaders {
+		table.Header(toAny(formatHeaders(headers))...)
+	}
 	_ = table.Append(toAny(values)...)
 	_ = table.Render()
 
@@ -333,10 +381,11 @@ func (p *TablePrinter) PrintList(obj interface{}) error {
 	for _, f := range fields {
 		headers = append(headers, f.name)
 	}
+	headers = append(headers, p.labelColumns...)
 
-	table.Header(toAny(formatHeaders(headers))...)
-
-	// Add rows
+	// Build every row before writing the header so a missing description
+	// field fails without partial output.
+	var rows [][]string
 	for i := 0; i < v.Len(); i++ {
 		elem := v.Index(i)
 		if elem.Kind() == reflect.Ptr {
@@ -353,11 +402,24 @@ func (p *TablePrinter) PrintList(obj interface{}) error {
 		var row []string
 		for _, f := range fields {
 			value := getFieldByPath(elem, f.indices)
-			row = append(row, colorizeTableValue(formatValue(value)))
+			row = append(row, colorizeColumnValue(f.name, formatValue(value)))
 		}
-		_ = table.Append(toAny(row)...)
+		if len(p.labelColumns) > 0 {
+			labelValues, err := labelColumnValues(elem, p.labelColumns)
+			if err != nil {
+				return err
+			}
+			row = append(row, labelValues...)
+		}
+		rows = append(rows, row)
 	}
 
+	if !p.noHeaders {
+		table.Header(toAny(formatHeaders(headers))...)
+	}
+	for _, row := range rows {
+		_ = table.Append(toAny(row)...)
+	}
 	_ = table.Render()
 	return nil
 }
@@ -439,14 +501,24 @@ func (p *TablePrinter) printMaps(v reflect.Value, table *tablewriter.Table) erro
 
 	// Convert keys to headers (kubectl style: uppercase, bold)
 	headers := append([]string{}, keys...)
-	table.Header(toAny(formatHeaders(headers))...)
+	headers = append(headers, p.labelColumns...)
+	if !p.noHeaders {
+		table.Header(toAny(formatHeaders(headers))...)
+	}
 
 	// Add rows
 	for _, row := range rows {
 		var values []string
 		for _, key := range keys {
 			val := row[key]
-			values = append(values, colorizeTableValue(formatTableMapValue(val)))
+			values = append(values, colorizeColumnValue(key, formatTableMapValue(val)))
+		}
+		if len(p.labelColumns) > 0 {
+			labelValues, err := labelColumnValues(reflect.ValueOf(row), p.labelColumns)
+			if err != nil {
+				return err
+			}
+			values = append(values, labelValues...)
 		}
 		_ = table.Append(toAny(values)...)
 	}
@@ -170,6 +170,27 @@ func TestTablePrinter_PrintList_Structs(t *testing.T) {
 	}
 }
 
+func TestTablePrinter_NoHeaders(t *testing.T) {
+	resources := []TestResource{
+		{Name: "resource1", ID: "1", Status: "active"},
+		{Name: "resource2", ID: "2", Status: "pending"},
+	}
+
+	var buf bytes.Buffer
+	p := NewPrinterWithOpts(PrinterOptions{Format: "table", Writer: &buf, NoHeaders: true})
+	if err := p.PrintList(resources); err != nil {
+		t.Fatalf("PrintList failed: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 data lines and no header, got %d:\n%s", len(lines), buf.String())
+	}
+	if !strings.HasPrefix(lines[0], "resource1") || strings.Contains(buf.String(), "NAME") {
+		t.Errorf("unexpected output:\n%s", buf.String())
+	}
+}
+
 func TestTablePrinter_PrintList_EmptySlice(t *testing.T) {
 	var buf bytes.Buffer
 	p := &TablePrinter{writer: &buf}
@@ -476,6 +497,46 @@ func TestColorizeTableValue_WithColor(t *testing.T) {
 	}
 }
 
+func TestColorizeColumnValue(t *testing.T) {
+	ResetColorCache()
+	t.Setenv("FORCE_COLOR", "1")
+	defer ResetColorCache()
+
+	tests := []struct {
+		column string
+		value  string
+		color  string
+	}{
+		{"STATE", "SUCCESS", BrightGreen},
+		{"STATE", "Error", BrightRed},
+		{"STATE", "running", BrightYellow},
+		{"STATE", "CANCELLED", Dim},
+		{"DEPLOYED", "true", BrightGreen},
+		{"status", "Disabled", BrightRed},
+	}
+	for _, tc := range tests {
+		got := colorizeColumnValue(tc.column, tc.value)
+		if !strings.Contains(got, tc.color) {
+			t.Errorf("colorizeColumnValue(%q, %q) should contain color %q, got: %q", tc.column, tc.value, tc.color, got)
+		}
+	}
+
+	// Outside state columns only exact status values are colored
+	if got := colorizeColumnValue("TITLE", "Error"); got != "Error" {
+		t.Errorf("colorizeColumnValue(TITLE, Error) should be unchanged, got: %q", got)
+	}
+}
+
+func TestColorizeColumnValue_NoColor(t *testing.T) {
+	ResetColorCache()
+	t.Setenv("NO_COLOR", "")
+	defer ResetColorCache()
+
+	if got := colorizeColumnValue("STATE", "Success"); got != "Success" {
+		t.Errorf("colorizeColumnValue() with NO_COLOR = %q, want plain value", got)
+	}
+}
+
 func TestSetBoldHeaders_NoColor(t *testing.T) {
 	ResetColorCache()
 	t.Setenv("NO_COLOR", "1")
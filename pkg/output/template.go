@@ -0,0 +1,149 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// Output format prefixes that carry a Go template, as in
+// -o go-template='{{.name}}' and -o go-template-file=report.tmpl.
+const (
+	goTemplatePrefix     = "go-template="
+	goTemplateFilePrefix = "go-template-file="
+)
+
+// templateFuncs are available to every output template in addition to the
+// text/template builtins.
+var templateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	"join": func(sep string, items []interface{}) string {
+		parts := make([]string, len(items))
+		for i, item := range items {
+			parts[i] = fmt.Sprint(item)
+		}
+		return strings.Join(parts, sep)
+	},
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+// IsTemplateOutputFormat reports whether format is a go-template or
+// go-template-file output format.
+func IsTemplateOutputFormat(format string) bool {
+	return strings.HasPrefix(format, goTemplatePrefix) || strings.HasPrefix(format, goTemplateFilePrefix)
+}
+
+// ParseTemplateFormat parses the template of a go-template=TEMPLATE or
+// go-template-file=PATH output format. Template files are read from disk.
+func ParseTemplateFormat(format string) (*template.Template, error) {
+	var name, text string
+	switch {
+	case strings.HasPrefix(format, goTemplatePrefix):
+		name, text = "go-template", strings.TrimPrefix(format, goTemplatePrefix)
+	case strings.HasPrefix(format, goTemplateFilePrefix):
+		path := strings.TrimPrefix(format, goTemplateFilePrefix)
+		if path == "" {
+			return nil, fmt.Errorf("go-template-file requires a path, as in -o go-template-file=report.tmpl")
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read output template: %w", err)
+		}
+		name, text = path, string(data)
+	default:
+		return nil, fmt.Errorf("%q is not a go-template output format", format)
+	}
+	if text == "" {
+		return nil, fmt.Errorf("output template is empty")
+	}
+
+	tmpl, err := template.New(name).Funcs(templateFuncs).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid output template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// TemplatePrinter renders output with a Go template (-o go-template=... and
+// -o go-template-file=...). The dot context is the JSON form of what would be
+// printed with -o json: the object for Print and the list for PrintList, so
+// field names are the JSON keys.
+type TemplatePrinter struct {
+	writer io.Writer
+	tmpl   *template.Template
+	err    error
+}
+
+// NewTemplatePrinter creates a printer for a go-template or go-template-file
+// format. A template that fails to load is reported by Print and PrintList.
+func NewTemplatePrinter(writer io.Writer, format string) *TemplatePrinter {
+	tmpl, err := ParseTemplateFormat(format)
+	return &TemplatePrinter{writer: writer, tmpl: tmpl, err: err}
+}
+
+// Print renders the template with obj as the dot context
+func (p *TemplatePrinter) Print(obj interface{}) error {
+	if p.err != nil {
+		return p.err
+	}
+	data, err := templateData(obj)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := p.tmpl.Execute(&buf, data); err != nil {
+		return fmt.Errorf("failed to render output template: %w", err)
+	}
+	_, err = p.writer.Write(buf.Bytes())
+	return err
+}
+
+// PrintList renders the template once with the whole list as the dot context
+func (p *TemplatePrinter) PrintList(obj interface{}) error {
+	return p.Print(obj)
+}
+
+// templateData converts v to its JSON form. Whole numbers become int64 so
+// they print as written instead of in float notation (1e+06); other numbers
+// become float64.
+func templateData(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var data interface{}
+	if err := dec.Decode(&data); err != nil {
+		return nil, err
+	}
+	return convertNumbers(data), nil
+}
+
+func convertNumbers(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, item := range val {
+			val[k] = convertNumbers(item)
+		}
+	case []interface{}:
+		for i, item := range val {
+			val[i] = convertNumbers(item)
+		}
+	case json.Number:
+		if n, err := val.Int64(); err == nil {
+			return n
+		}
+		f, _ := val.Float64()
+		return f
+	}
+	return v
+}
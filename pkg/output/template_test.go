@@ -0,0 +1,91 @@
+package output
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestTemplatePrinter_Files(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		list     interface{}
+	}{
+		{"slo-summary", "slo-summary.md.tmpl", sloFixtures()},
+		{"workflow-list", "workflow-list.tmpl", workflowFixtures()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			path := filepath.Join("testdata", "templates", tt.template)
+			printer := NewPrinterWithOpts(PrinterOptions{Format: "go-template-file=" + path, Writer: &buf})
+			if err := printer.PrintList(tt.list); err != nil {
+				t.Fatalf("PrintList() error = %v", err)
+			}
+			assertGolden(t, "template/"+tt.name, buf.String())
+		})
+	}
+}
+
+func TestTemplatePrinter_Inline(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		obj      interface{}
+		want     string
+	}{
+		{"field", "{{.name}}", map[string]interface{}{"name": "API Availability"}, "API Availability"},
+		{"large number", "{{.count}}", map[string]interface{}{"count": 1500000}, "1500000"},
+		{"fraction", "{{.target}}", map[string]interface{}{"target": 99.9}, "99.9"},
+		{"compare", "{{if gt .count 10}}many{{end}}", map[string]interface{}{"count": 42}, "many"},
+		{"missing key", "[{{.nope}}]", map[string]interface{}{}, "[<no value>]"},
+		{"json", "{{json .tags}}", map[string]interface{}{"tags": []string{"a", "b"}}, `["a","b"]`},
+		{"join", `{{join "," .tags}}`, map[string]interface{}{"tags": []string{"a", "b"}}, "a,b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := NewTemplatePrinter(&buf, "go-template="+tt.template).Print(tt.obj); err != nil {
+				t.Fatalf("Print() error = %v", err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("output = %q, want %q", buf.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTemplateFormat_Errors(t *testing.T) {
+	dir := t.TempDir()
+	broken := filepath.Join(dir, "broken.tmpl")
+	if err := os.WriteFile(broken, []byte("{{ range . }"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"go-template=", "output template is empty"},
+		{"go-template-file=", "requires a path"},
+		{"go-template-file=" + filepath.Join(dir, "missing.tmpl"), "failed to read output template"},
+		{"go-template-file=" + broken, "invalid output template"},
+		{"json", "not a go-template output format"},
+	}
+	for _, tt := range tests {
+		_, err := ParseTemplateFormat(tt.format)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("ParseTemplateFormat(%q) error = %v, want %q", tt.format, err, tt.want)
+		}
+	}
+
+	// A printer for a broken template reports the error when printing.
+	if err := NewTemplatePrinter(&bytes.Buffer{}, "go-template-file="+broken).Print(nil); err == nil {
+		t.Error("Print() with a broken template returned nil error")
+	}
+}
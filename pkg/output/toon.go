@@ -13,9 +13,14 @@ import (
 // Because dtctl resource structs use `json` tags (not `toon` tags), the printer
 // round-trips through encoding/json to obtain a map[string]any representation
 // that preserves the json field names, then passes it to toon.Marshal.
+//
+// Long string values are truncated to width runes (see truncateToonStrings)
+// so that a single multi-kilobyte description or query cannot dominate the
+// output. A width of 0 disables truncation.
 type ToonPrinter struct {
 	writer   io.Writer
 	jqFilter string
+	width    int
 }
 
 // Print prints a single object as TOON.
@@ -40,6 +45,8 @@ func (p *ToonPrinter) marshal(obj interface{}) error {
 		return err
 	}
 
+	generic = truncateToonStrings(generic, p.width)
+
 	data, err := toon.Marshal(generic, toon.WithLengthMarkers(true))
 	if err != nil {
 		return err
@@ -69,3 +76,36 @@ func toGeneric(v interface{}) (interface{}, error) {
 	}
 	return generic, nil
 }
+
+// toonEllipsis marks a string value shortened by truncateToonStrings.
+const toonEllipsis = "…"
+
+// truncateToonStrings walks a generic value produced by toGeneric and shortens
+// every string longer than width runes to width runes, the last of which is
+// an ellipsis. Map keys, numbers and booleans are left untouched, and nested
+// maps and arrays are walked recursively. A width <= 0 returns v unchanged.
+func truncateToonStrings(v interface{}, width int) interface{} {
+	if width <= 0 {
+		return v
+	}
+	switch val := v.(type) {
+	case string:
+		runes := []rune(val)
+		if len(runes) <= width {
+			return val
+		}
+		return string(runes[:width-1]) + toonEllipsis
+	case map[string]interface{}:
+		for k, item := range val {
+			val[k] = truncateToonStrings(item, width)
+		}
+		return val
+	case []interface{}:
+		for i, item := range val {
+			val[i] = truncateToonStrings(item, width)
+		}
+		return val
+	default:
+		return v
+	}
+}
@@ -334,3 +334,81 @@ func TestAgentPrinter_NilDataStaysNil(t *testing.T) {
 		t.Errorf("expected null result for nil data, got %v", m["result"])
 	}
 }
+
+func TestTruncateToonStrings(t *testing.T) {
+	in := map[string]interface{}{
+		"short": "abc",
+		"long":  "abcdefghij",
+		"multi": "ääääääää",
+		"count": float64(1234567890),
+		"nested": map[string]interface{}{
+			"items": []interface{}{"0123456789", true, nil},
+		},
+	}
+
+	got := truncateToonStrings(in, 5).(map[string]interface{})
+
+	if got["short"] != "abc" {
+		t.Errorf("short = %q, want unchanged", got["short"])
+	}
+	if got["long"] != "abcd…" {
+		t.Errorf("long = %q, want %q", got["long"], "abcd…")
+	}
+	if got["multi"] != "ääää…" {
+		t.Errorf("multi = %q, want truncation by runes", got["multi"])
+	}
+	if got["count"] != float64(1234567890) {
+		t.Errorf("count = %v, want numbers untouched", got["count"])
+	}
+	items := got["nested"].(map[string]interface{})["items"].([]interface{})
+	if items[0] != "0123…" || items[1] != true || items[2] != nil {
+		t.Errorf("nested items = %v, want only the string truncated", items)
+	}
+}
+
+func TestTruncateToonStrings_ZeroWidthIsUnlimited(t *testing.T) {
+	long := strings.Repeat("x", 500)
+	if got := truncateToonStrings(long, 0); got != long {
+		t.Errorf("width 0 truncated the value to %d bytes", len(got.(string)))
+	}
+}
+
+func TestToonPrinter_Width(t *testing.T) {
+	var buf bytes.Buffer
+	p := NewPrinterWithOpts(PrinterOptions{Format: "toon", Writer: &buf, ToonWidth: 8})
+
+	data := []map[string]interface{}{
+		{"id": "a", "query": "fetch logs | filter status == \"ERROR\""},
+		{"id": "b", "query": "short"},
+	}
+	if err := p.PrintList(data); err != nil {
+		t.Fatalf("PrintList failed: %v", err)
+	}
+
+	out := buf.String()
+	if strings.Contains(out, "ERROR") {
+		t.Errorf("long value was not truncated:\n%s", out)
+	}
+	if !strings.Contains(out, "fetch l…") || !strings.Contains(out, "short") {
+		t.Errorf("unexpected truncated output:\n%s", out)
+	}
+}
+
+func TestAgentPrinter_ToonWidth(t *testing.T) {
+	var buf bytes.Buffer
+	p := NewAgentPrinter(&buf, &ResponseContext{})
+	p.SetResultFormat("toon")
+	p.SetToonWidth(4)
+
+	if err := p.Print(map[string]string{"title": "My Workflow"}); err != nil {
+		t.Fatalf("Print failed: %v", err)
+	}
+
+	var resp Response
+	if err := json.Unmarshal(buf.Bytes(), &resp); err != nil {
+		t.Fatalf("envelope is not valid JSON: %v", err)
+	}
+	if s, _ := resp.Result.(string); !strings.Contains(s, "My …") || strings.Contains(s, "Workflow") {
+		t.Errorf("expected truncated TOON result, got %q", resp.Result)
+	}
+}
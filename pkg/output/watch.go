@@ -5,6 +5,7 @@ import (
 	"io"
 	"os"
 	"reflect"
+	"time"
 )
 
 // ChangeType represents the type of change detected in watch mode
@@ -35,6 +36,8 @@ type WatchPrinter struct {
 	basePrinter Printer
 	writer      io.Writer
 	colorize    bool
+	timestamps  bool
+	now         func() time.Time
 }
 
 func NewWatchPrinter(basePrinter Printer) *WatchPrinter {
@@ -42,6 +45,7 @@ func NewWatchPrinter(basePrinter Printer) *WatchPrinter {
 		basePrinter: basePrinter,
 		writer:      os.Stdout,
 		colorize:    ColorEnabled(),
+		now:         time.Now,
 	}
 }
 
@@ -50,9 +54,17 @@ func NewWatchPrinterWithWriter(basePrinter Printer, writer io.Writer, colorize b
 		basePrinter: basePrinter,
 		writer:      writer,
 		colorize:    colorize,
+		now:         time.Now,
 	}
 }
 
+// WithTimestamps makes the printer stamp every change row with the time it
+// was detected, after the +/~/- marker (--watch-only-changes).
+func (p *WatchPrinter) WithTimestamps() *WatchPrinter {
+	p.timestamps = true
+	return p
+}
+
 func (p *WatchPrinter) Print(data interface{}) error {
 	return p.basePrinter.Print(data)
 }
@@ -129,13 +141,20 @@ func (p *WatchPrinter) printWithPrefix(resource interface{}, prefix string, colo
 	}
 
 	// For other formats, print the prefix and the resource
+	p.writePrefix(prefix, color)
+	return p.basePrinter.Print(resource)
+}
+
+// writePrefix writes the change marker and, when enabled, the timestamp.
+func (p *WatchPrinter) writePrefix(prefix string, color string) {
 	if p.colorize && color != "" {
 		fmt.Fprintf(p.writer, "%s%s%s ", color, prefix, ColorCode(Reset))
 	} else {
 		fmt.Fprintf(p.writer, "%s ", prefix)
 	}
-
-	return p.basePrinter.Print(resource)
+	if p.timestamps {
+		fmt.Fprintf(p.writer, "%s   ", p.now().Format(time.RFC3339))
+	}
 }
 
 func (p *WatchPrinter) printTableRow(resource interface{}, prefix string, color string, tablePrinter *TablePrinter) error {
@@ -147,11 +166,8 @@ func (p *WatchPrinter) printTableRow(resource interface{}, prefix string, color
 
 	if v.Kind() != reflect.Struct {
 		// Fallback for non-struct types
-		if p.colorize && color != "" {
-			fmt.Fprintf(p.writer, "%s%s%s %v\n", color, prefix, ColorCode(Reset), resource)
-		} else {
-			fmt.Fprintf(p.writer, "%s %v\n", prefix, resource)
-		}
+		p.writePrefix(prefix, color)
+		fmt.Fprintf(p.writer, "%v\n", resource)
 		return nil
 	}
 
@@ -166,11 +182,7 @@ func (p *WatchPrinter) printTableRow(resource interface{}, prefix string, color
 	}
 
 	// Print prefix and row values with proper spacing
-	if p.colorize && color != "" {
-		fmt.Fprintf(p.writer, "%s%s%s ", color, prefix, ColorCode(Reset))
-	} else {
-		fmt.Fprintf(p.writer, "%s ", prefix)
-	}
+	p.writePrefix(prefix, color)
 
 	// Print values with kubectl-style spacing (3 spaces between columns)
 	for i, val := range values {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"strings"
 	"testing"
+	"time"
 )
 
 type testResource struct {
@@ -352,3 +353,24 @@ func TestWatchPrinter_PrintChanges_WithColorize(t *testing.T) {
 		t.Errorf("PrintChanges() with colorize should include color codes or prefix, got %q", output)
 	}
 }
+
+func TestWatchPrinter_PrintChanges_WithTimestamps(t *testing.T) {
+	buf := &bytes.Buffer{}
+	tablePrinter := NewPrinterWithWriter("table", buf)
+	watchPrinter := NewWatchPrinterWithWriter(tablePrinter, buf, false).WithTimestamps()
+	watchPrinter.now = func() time.Time { return time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC) }
+
+	changes := []Change{
+		{Type: ChangeTypeModified, Resource: testResource{Name: "exec-1", Status: "SUCCESS", Age: 5}},
+		{Type: ChangeTypeDeleted, Resource: testResource{Name: "exec-2", Status: "FAILED", Age: 9}},
+	}
+	if err := watchPrinter.PrintChanges(changes); err != nil {
+		t.Fatalf("PrintChanges() error = %v", err)
+	}
+
+	want := "~ 2026-03-01T12:30:00Z   exec-1   SUCCESS   5\n" +
+		"- 2026-03-01T12:30:00Z   exec-2   FAILED   9\n"
+	if got := buf.String(); got != want {
+		t.Errorf("PrintChanges() =\n%q\nwant\n%q", got, want)
+	}
+}
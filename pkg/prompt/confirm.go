@@ -5,11 +5,70 @@ import (
 	"fmt"
 	"os"
 	"strings"
+
+	"github.com/dynatrace-oss/dtctl/pkg/output"
 )
 
-// Confirm prompts the user for yes/no confirmation
+// Target describes the environment confirmation prompts act on, so a
+// destructive operation shows where it will happen.
+type Target struct {
+	Context     string
+	Environment string
+	SafetyLevel string
+	// Label is the context's prompt hint (e.g. "PROD"), shown first.
+	Label string
+	// Color is the context's color hint; see Colors for the valid names.
+	Color string
+}
+
+// Colors maps the color names a context may use to ANSI codes.
+var Colors = map[string]string{
+	"red":     output.Red,
+	"yellow":  output.Yellow,
+	"green":   output.Green,
+	"blue":    output.Blue,
+	"magenta": output.Magenta,
+	"cyan":    output.Cyan,
+}
+
+// line renders the target, e.g. "PROD  prod (https://...) [readwrite-all]",
+// colored with the context's color hint. It is empty for a target without an
+// environment.
+func (t Target) line() string {
+	if t.Environment == "" {
+		return ""
+	}
+	var b strings.Builder
+	if t.Label != "" {
+		b.WriteString(t.Label)
+		b.WriteString("  ")
+	}
+	if t.Context != "" {
+		fmt.Fprintf(&b, "%s (%s)", t.Context, t.Environment)
+	} else {
+		b.WriteString(t.Environment)
+	}
+	if t.SafetyLevel != "" {
+		fmt.Fprintf(&b, " [%s]", t.SafetyLevel)
+	}
+	line := b.String()
+	if code, ok := Colors[strings.ToLower(t.Color)]; ok {
+		line = output.Colorize(output.Bold+code, line)
+	}
+	return line
+}
+
+// printTarget prints the target line, if any.
+func printTarget(t Target) {
+	if line := t.line(); line != "" {
+		fmt.Printf("Target: %s\n", line)
+	}
+}
+
+// Confirm prompts the user for yes/no confirmation of an operation on target
 // Returns true if user confirms, false otherwise
-func Confirm(message string) bool {
+func Confirm(target Target, message string) bool {
+	printTarget(target)
 	fmt.Printf("%s [y/N]: ", message)
 
 	reader := bufio.NewReader(os.Stdin)
@@ -24,22 +83,23 @@ func Confirm(message string) bool {
 
 // ConfirmDeletion prompts for confirmation of a destructive operation
 // Shows resource details and requires explicit confirmation
-func ConfirmDeletion(resourceType, name, id string) bool {
+func ConfirmDeletion(target Target, resourceType, name, id string) bool {
 	fmt.Printf("\nYou are about to delete the following %s:\n", resourceType)
 	fmt.Printf("  Name: %s\n", name)
 	fmt.Printf("  ID:   %s\n", id)
 	fmt.Println()
 
-	return Confirm("Are you sure you want to delete this resource?")
+	return Confirm(target, "Are you sure you want to delete this resource?")
 }
 
 // ConfirmDataDeletion prompts for confirmation of an irreversible data operation
 // Requires the user to type the resource name exactly to confirm
 // Returns true if confirmed, false otherwise
-func ConfirmDataDeletion(resourceType, name string) bool {
+func ConfirmDataDeletion(target Target, resourceType, name string) bool {
 	fmt.Printf("\n⚠️  WARNING: This operation is IRREVERSIBLE and will delete all data\n")
 	fmt.Printf("  Resource Type: %s\n", resourceType)
 	fmt.Printf("  Name:          %s\n", name)
+	printTarget(target)
 	fmt.Println()
 	fmt.Printf("Type the %s name '%s' to confirm: ", resourceType, name)
 
@@ -58,3 +118,45 @@ func ConfirmDataDeletion(resourceType, name string) bool {
 func ValidateConfirmFlag(confirmValue, resourceName string) bool {
 	return confirmValue == resourceName
 }
+
+// ConfirmBulkDeletion prompts for confirmation of a delete that removes every
+// object in a collection (e.g. all settings objects of a schema). It shows
+// the number of objects and requires the user to type the collection name
+// exactly to confirm.
+func ConfirmBulkDeletion(target Target, resourceType, collection string, count int) bool {
+	fmt.Printf("\n⚠️  WARNING: This operation will delete ALL %d %s(s) in %s\n", count, resourceType, collection)
+	printTarget(target)
+	fmt.Println()
+	fmt.Printf("Type '%s' to confirm: ", collection)
+
+	reader := bufio.NewReader(os.Stdin)
+	response, err := reader.ReadString('\n')
+	if err != nil {
+		return false
+	}
+
+	response = strings.TrimSpace(response)
+	return response == collection
+}
+
+// ConfirmRecordDeletion prompts for confirmation of a record-level deletion
+// from a bucket. It shows the predicate and the estimated number of affected
+// records and requires the user to type the bucket name exactly to confirm.
+func ConfirmRecordDeletion(target Target, bucketName, predicate, estimate string) bool {
+	fmt.Printf("\n⚠️  WARNING: This operation is IRREVERSIBLE and will delete matching records\n")
+	fmt.Printf("  Bucket:            %s\n", bucketName)
+	fmt.Printf("  Filter:            %s\n", predicate)
+	fmt.Printf("  Estimated records: %s\n", estimate)
+	printTarget(target)
+	fmt.Println()
+	fmt.Printf("Type the bucket name '%s' to confirm: ", bucketName)
+
+	reader := bufio.NewReader(os.Stdin)
+	response, err := reader.ReadString('\n')
+	if err != nil {
+		return false
+	}
+
+	response = strings.TrimSpace(response)
+	return response == bucketName
+}
@@ -88,7 +88,7 @@ func TestConfirm(t *testing.T) {
 			r, w, _ := os.Pipe()
 			os.Stdout = w
 
-			result := Confirm("Test message")
+			result := Confirm(Target{}, "Test message")
 
 			w.Close()
 			os.Stdout = oldStdout
@@ -147,7 +147,7 @@ func TestConfirmDeletion(t *testing.T) {
 			r, w, _ := os.Pipe()
 			os.Stdout = w
 
-			result := ConfirmDeletion(tt.resourceType, tt.resourceName, tt.resourceID)
+			result := ConfirmDeletion(Target{}, tt.resourceType, tt.resourceName, tt.resourceID)
 
 			w.Close()
 			os.Stdout = oldStdout
@@ -237,7 +237,7 @@ func TestConfirmDataDeletion(t *testing.T) {
 			r, w, _ := os.Pipe()
 			os.Stdout = w
 
-			result := ConfirmDataDeletion(tt.resourceType, tt.resourceName)
+			result := ConfirmDataDeletion(Target{}, tt.resourceType, tt.resourceName)
 
 			w.Close()
 			os.Stdout = oldStdout
@@ -332,3 +332,26 @@ func TestValidateConfirmFlag(t *testing.T) {
 		})
 	}
 }
+
+func TestConfirm_ShowsTarget(t *testing.T) {
+	target := Target{Context: "prod", Environment: "https://prod.example.com", SafetyLevel: "readwrite-all", Label: "PROD", Color: "red"}
+
+	cleanup := simulateInput("n\n")
+	defer cleanup()
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	ConfirmDeletion(target, "workflow", "Nightly", "wf-1")
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	var buf bytes.Buffer
+	io.Copy(&buf, r)
+	want := "Target: PROD  prod (https://prod.example.com) [readwrite-all]"
+	if !bytes.Contains(buf.Bytes(), []byte(want)) {
+		t.Errorf("output missing %q:\n%s", want, buf.String())
+	}
+}
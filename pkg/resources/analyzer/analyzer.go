@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/dynatrace-oss/dtctl/pkg/client"
 	"github.com/dynatrace-oss/dtctl/pkg/util/format"
@@ -144,7 +146,9 @@ func fromSDKExecuteResult(s *sdkana.ExecuteResult) *ExecuteResult {
 	return r
 }
 
-// ParseInputFromFile reads and parses analyzer input from a file.
+// ParseInputFromFile reads and parses analyzer input from a file. Files ending
+// in .yaml or .yml (such as a 'get analyzer --schema' skeleton) are parsed as
+// YAML, everything else as JSON.
 // This is a CLI-layer helper and intentionally not part of the SDK.
 func ParseInputFromFile(filename string) (map[string]interface{}, error) {
 	content, err := os.ReadFile(filename)
@@ -152,6 +156,13 @@ func ParseInputFromFile(filename string) (map[string]interface{}, error) {
 		return nil, err
 	}
 
+	if ext := strings.ToLower(filepath.Ext(filename)); ext == ".yaml" || ext == ".yml" {
+		content, err = format.YAMLToJSON(content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse input file: %w", err)
+		}
+	}
+
 	var input map[string]interface{}
 	if err := json.Unmarshal(content, &input); err != nil {
 		return nil, fmt.Errorf("failed to parse input file: %w", err)
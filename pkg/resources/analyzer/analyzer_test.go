@@ -778,6 +778,7 @@ func TestParseInputFromFile(t *testing.T) {
 		content  string
 		wantErr  bool
 		wantKeys []string
+		file     string
 	}{
 		{
 			name:     "valid JSON file",
@@ -796,13 +797,24 @@ func TestParseInputFromFile(t *testing.T) {
 			wantErr:  false,
 			wantKeys: []string{},
 		},
+		{
+			name:     "YAML file",
+			file:     "input.yaml",
+			content:  "# comment\nquery: timeseries avg(dt.host.cpu.usage)\nforecastHorizon: 100\n",
+			wantErr:  false,
+			wantKeys: []string{"query", "forecastHorizon"},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Create temp file
 			tmpDir := t.TempDir()
-			tmpFile := filepath.Join(tmpDir, "input.json")
+			name := tt.file
+			if name == "" {
+				name = "input.json"
+			}
+			tmpFile := filepath.Join(tmpDir, name)
 			if err := os.WriteFile(tmpFile, []byte(tt.content), 0644); err != nil {
 				t.Fatalf("failed to create temp file: %v", err)
 			}
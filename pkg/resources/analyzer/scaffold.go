@@ -0,0 +1,235 @@
+package analyzer
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// maxScaffoldDepth bounds nesting of objects and $refs so recursive schemas
+// still produce a finite skeleton.
+const maxScaffoldDepth = 6
+
+// Scaffold renders a commented YAML skeleton of an analyzer's input from its
+// input JSON Schema (as returned by GetInputSchema). Required fields get their
+// default or an empty value of their type, optional fields are included only
+// when they have a default, and the remaining optional fields are listed in a
+// trailing comment. Each field carries its description, type and allowed enum
+// values as a comment. The output is meant to be filled in and passed to
+// 'dtctl exec analyzer -f'.
+func Scaffold(name string, schema map[string]interface{}) ([]byte, error) {
+	s := scaffolder{root: schema}
+	root, optional := s.object(schema, 0)
+
+	header := fmt.Sprintf("Input for analyzer %s\n"+
+		"Run with: dtctl exec analyzer %s -f <file>", name, name)
+	doc := &yaml.Node{Kind: yaml.DocumentNode, HeadComment: header, Content: []*yaml.Node{root}}
+	if len(optional) > 0 {
+		doc.FootComment = "Optional inputs without a default:\n" + strings.Join(optional, "\n")
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return nil, fmt.Errorf("failed to render scaffold: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("failed to render scaffold: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+type scaffolder struct {
+	root map[string]interface{}
+}
+
+// object builds a YAML mapping for an object schema. Required properties come
+// first, then alphabetical, as in FlattenSchema. It also returns one line per
+// omitted optional property ("  name (type): description").
+func (s scaffolder) object(schema map[string]interface{}, depth int) (*yaml.Node, []string) {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	fields, ok := FlattenSchema(schema)
+	if !ok {
+		node.Style = yaml.FlowStyle
+		return node, nil
+	}
+	props := asMap(schema["properties"])
+
+	var optional []string
+	for _, f := range fields {
+		prop := s.resolve(asMap(props[f.Name]))
+		_, hasDefault := prop["default"]
+		if !f.Required && !hasDefault {
+			line := fmt.Sprintf("  %s (%s)", f.Name, f.Type)
+			if desc := firstLine(f.Description); desc != "" {
+				line += ": " + desc
+			}
+			optional = append(optional, line)
+			continue
+		}
+		key := &yaml.Node{Kind: yaml.ScalarNode, Value: f.Name, HeadComment: comment(prop, f)}
+		node.Content = append(node.Content, key, s.value(prop, depth))
+	}
+	if len(node.Content) == 0 {
+		node.Style = yaml.FlowStyle
+	}
+	return node, optional
+}
+
+// value returns the skeleton value for one property: its default, or an empty
+// value of its type.
+func (s scaffolder) value(prop map[string]interface{}, depth int) *yaml.Node {
+	if def, ok := prop["default"]; ok {
+		n := &yaml.Node{}
+		if err := n.Encode(def); err == nil {
+			return n
+		}
+	}
+	if values, _ := prop["enum"].([]interface{}); len(values) > 0 {
+		n := &yaml.Node{}
+		if err := n.Encode(values[0]); err == nil {
+			return n
+		}
+	}
+
+	switch primaryType(prop) {
+	case "boolean":
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: "false"}
+	case "integer":
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: "0"}
+	case "number":
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!float", Value: "0.0"}
+	case "object":
+		if depth >= maxScaffoldDepth {
+			return &yaml.Node{Kind: yaml.MappingNode, Style: yaml.FlowStyle}
+		}
+		// Optional nested fields are dropped silently; the top-level comment
+		// lists only the analyzer's own inputs.
+		node, _ := s.object(prop, depth+1)
+		return node
+	case "array":
+		list := &yaml.Node{Kind: yaml.SequenceNode, Style: yaml.FlowStyle}
+		items := s.resolve(asMap(prop["items"]))
+		if primaryType(items) == "object" && depth < maxScaffoldDepth {
+			item, _ := s.object(items, depth+1)
+			if len(item.Content) > 0 {
+				list.Style = 0
+				list.Content = append(list.Content, item)
+			}
+		}
+		return list
+	}
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: ""}
+}
+
+// resolve follows a local $ref ("#/definitions/X" or "#/$defs/X") and picks
+// the first alternative of a oneOf/anyOf, so the skeleton shows one concrete
+// shape. Keys set next to the $ref (description, default) are kept.
+func (s scaffolder) resolve(prop map[string]interface{}) map[string]interface{} {
+	for i := 0; i < maxScaffoldDepth; i++ {
+		var next map[string]interface{}
+		if ref, ok := prop["$ref"].(string); ok {
+			next = s.lookupRef(ref)
+		} else if alts := firstAlternative(prop); alts != nil {
+			next = alts
+		}
+		if next == nil {
+			return prop
+		}
+		merged := make(map[string]interface{}, len(next)+len(prop))
+		for k, v := range next {
+			merged[k] = v
+		}
+		for k, v := range prop {
+			if k != "$ref" && k != "oneOf" && k != "anyOf" {
+				merged[k] = v
+			}
+		}
+		prop = merged
+	}
+	return prop
+}
+
+func (s scaffolder) lookupRef(ref string) map[string]interface{} {
+	for _, prefix := range []string{"#/definitions/", "#/$defs/"} {
+		if name, ok := strings.CutPrefix(ref, prefix); ok {
+			return asMap(asMap(s.root[strings.TrimSuffix(prefix[2:], "/")])[name])
+		}
+	}
+	return nil
+}
+
+func firstAlternative(prop map[string]interface{}) map[string]interface{} {
+	for _, key := range []string{"oneOf", "anyOf"} {
+		if alts, ok := prop[key].([]interface{}); ok && len(alts) > 0 {
+			return asMap(alts[0])
+		}
+	}
+	return nil
+}
+
+// comment describes a property: description, type, requiredness and enum
+// values.
+func comment(prop map[string]interface{}, f SchemaField) string {
+	var lines []string
+	if desc := strings.TrimSpace(f.Description); desc != "" {
+		lines = append(lines, desc)
+	}
+
+	var attrs []string
+	if typ := schemaTypeString(prop); typ != "" {
+		attrs = append(attrs, typ)
+	}
+	if f.Required {
+		attrs = append(attrs, "required")
+	} else {
+		attrs = append(attrs, "optional")
+	}
+	lines = append(lines, "("+strings.Join(attrs, ", ")+")")
+
+	if values := enumValues(prop); len(values) > 0 {
+		lines = append(lines, "One of: "+strings.Join(values, ", "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// primaryType returns the first non-null JSON Schema type of prop, inferring
+// "object" from a properties block.
+func primaryType(prop map[string]interface{}) string {
+	switch t := prop["type"].(type) {
+	case string:
+		return t
+	case []interface{}:
+		for _, v := range t {
+			if s, ok := v.(string); ok && s != "null" {
+				return s
+			}
+		}
+	}
+	if _, ok := prop["properties"]; ok {
+		return "object"
+	}
+	return ""
+}
+
+func enumValues(prop map[string]interface{}) []string {
+	values, _ := prop["enum"].([]interface{})
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		out = append(out, fmt.Sprint(v))
+	}
+	return out
+}
+
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(s), "\n")
+	return line
+}
+
+func asMap(v interface{}) map[string]interface{} {
+	m, _ := v.(map[string]interface{})
+	return m
+}
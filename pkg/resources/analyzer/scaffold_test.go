@@ -0,0 +1,94 @@
+package analyzer
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestScaffold(t *testing.T) {
+	schema := map[string]interface{}{
+		"type":     "object",
+		"required": []interface{}{"timeSeriesData", "forecastHorizon", "method"},
+		"properties": map[string]interface{}{
+			"timeSeriesData": map[string]interface{}{
+				"$ref":        "#/definitions/Query",
+				"description": "Time series to forecast",
+			},
+			"forecastHorizon": map[string]interface{}{"type": "integer", "default": 100},
+			"method":          map[string]interface{}{"type": "string", "enum": []interface{}{"linear", "seasonal"}},
+			"coverage":        map[string]interface{}{"type": "number", "default": 0.9},
+			"nPaths":          map[string]interface{}{"type": "integer", "description": "Number of sample paths\nused internally"},
+			"tags":            map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}},
+		},
+		"definitions": map[string]interface{}{
+			"Query": map[string]interface{}{
+				"type":     "object",
+				"required": []interface{}{"expression"},
+				"properties": map[string]interface{}{
+					"expression": map[string]interface{}{"type": "string", "description": "DQL query"},
+					"timeframe":  map[string]interface{}{"type": "string"},
+				},
+			},
+		},
+	}
+
+	data, err := Scaffold("dt.statistics.GenericForecastAnalyzer", schema)
+	if err != nil {
+		t.Fatalf("Scaffold() error = %v", err)
+	}
+	out := string(data)
+
+	var parsed map[string]interface{}
+	if err := yaml.Unmarshal(data, &parsed); err != nil {
+		t.Fatalf("scaffold is not valid YAML: %v\n%s", err, out)
+	}
+	if parsed["forecastHorizon"] != 100 {
+		t.Errorf("forecastHorizon = %v, want default 100", parsed["forecastHorizon"])
+	}
+	if parsed["method"] != "linear" {
+		t.Errorf("method = %v, want first enum value", parsed["method"])
+	}
+	if parsed["coverage"] != 0.9 {
+		t.Errorf("coverage = %v, want default 0.9", parsed["coverage"])
+	}
+	query, ok := parsed["timeSeriesData"].(map[string]interface{})
+	if !ok || query["expression"] != "" {
+		t.Errorf("timeSeriesData = %#v, want resolved $ref with empty expression", parsed["timeSeriesData"])
+	}
+	if _, ok := query["timeframe"]; ok {
+		t.Error("optional nested field without default should be omitted")
+	}
+	for _, omitted := range []string{"nPaths", "tags"} {
+		if _, ok := parsed[omitted]; ok {
+			t.Errorf("optional field %s without default should be omitted", omitted)
+		}
+	}
+
+	for _, want := range []string{
+		"# Run with: dtctl exec analyzer dt.statistics.GenericForecastAnalyzer -f <file>",
+		"# Time series to forecast\n# (object, required)\ntimeSeriesData:",
+		"# One of: linear, seasonal",
+		"#   nPaths (integer): Number of sample paths\n",
+		"#   tags (array)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("scaffold missing %q:\n%s", want, out)
+		}
+	}
+	// Required fields come first.
+	if strings.Index(out, "coverage:") < strings.Index(out, "timeSeriesData:") {
+		t.Errorf("required fields should precede optional ones:\n%s", out)
+	}
+}
+
+func TestScaffold_NoProperties(t *testing.T) {
+	data, err := Scaffold("dt.x", map[string]interface{}{"type": "object"})
+	if err != nil {
+		t.Fatalf("Scaffold() error = %v", err)
+	}
+	if !strings.Contains(string(data), "{}") {
+		t.Errorf("expected empty mapping, got:\n%s", data)
+	}
+}
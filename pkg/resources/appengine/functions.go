@@ -7,6 +7,7 @@ import (
 	"os"
 
 	"github.com/dynatrace-oss/dtctl/pkg/client"
+	"github.com/dynatrace-oss/dtctl/pkg/util/versionutil"
 	sdkae "github.com/dynatrace-oss/dtctl/sdk/api/appengine"
 	"github.com/dynatrace-oss/dtctl/sdk/httpclient"
 )
@@ -36,6 +37,22 @@ type SDKVersionsResponse struct {
 	Versions []SDKVersion `json:"versions"`
 }
 
+// DefaultVersion returns the version the service marks as default, falling
+// back to the highest version when none is marked. It returns "" for an empty
+// list.
+func (r *SDKVersionsResponse) DefaultVersion() string {
+	latest := ""
+	for _, v := range r.Versions {
+		if v.Default {
+			return v.Version
+		}
+		if latest == "" || versionutil.Compare(v.Version, latest) > 0 {
+			latest = v.Version
+		}
+	}
+	return latest
+}
+
 // fromSDKDeferredExecutionResponse converts an SDK DeferredExecutionResponse to CLI.
 func fromSDKDeferredExecutionResponse(s *sdkae.DeferredExecutionResponse) *DeferredExecutionResponse {
 	return &DeferredExecutionResponse{ID: s.ID}
@@ -97,9 +114,14 @@ func (h *FunctionHandler) DeferExecution(req *DeferredExecutionRequest) (*Deferr
 	return fromSDKDeferredExecutionResponse(sdkResult), nil
 }
 
-// ExecuteCode executes ad-hoc JavaScript code using the function executor
-func (h *FunctionHandler) ExecuteCode(sourceCode, payload string) (*FunctionExecutorResponse, error) {
-	return h.sdk.ExecuteCode(context.Background(), sourceCode, payload)
+// ExecuteCode executes ad-hoc JavaScript code using the function executor.
+// An empty sdkVersion lets the service choose its default.
+func (h *FunctionHandler) ExecuteCode(sourceCode, payload, sdkVersion string) (*FunctionExecutorResponse, error) {
+	return h.sdk.ExecuteCodeWithOptions(context.Background(), &FunctionExecutorRequest{
+		SourceCode: sourceCode,
+		Payload:    payload,
+		SDKVersion: sdkVersion,
+	})
 }
 
 // GetSDKVersions lists available SDK versions
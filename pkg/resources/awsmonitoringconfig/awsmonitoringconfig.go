@@ -6,10 +6,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
-	"strconv"
-	"strings"
 
 	"github.com/dynatrace-oss/dtctl/pkg/client"
+	"github.com/dynatrace-oss/dtctl/pkg/util/versionutil"
 )
 
 const (
@@ -187,7 +186,7 @@ func (h *Handler) GetLatestVersion() (string, error) {
 	}
 
 	sort.Slice(versions, func(i, j int) bool {
-		return compareVersion(versions[i], versions[j]) > 0
+		return versionutil.Compare(versions[i], versions[j]) > 0
 	})
 	return versions[0], nil
 }
@@ -207,33 +206,6 @@ func (h *Handler) AddToEnvironment() error {
 	return nil
 }
 
-func compareVersion(a, b string) int {
-	aParts := strings.Split(a, ".")
-	bParts := strings.Split(b, ".")
-	maxLen := len(aParts)
-	if len(bParts) > maxLen {
-		maxLen = len(bParts)
-	}
-
-	for idx := 0; idx < maxLen; idx++ {
-		aVal := 0
-		if idx < len(aParts) {
-			aVal, _ = strconv.Atoi(aParts[idx])
-		}
-		bVal := 0
-		if idx < len(bParts) {
-			bVal, _ = strconv.Atoi(bParts[idx])
-		}
-		if aVal > bVal {
-			return 1
-		}
-		if aVal < bVal {
-			return -1
-		}
-	}
-	return 0
-}
-
 func (h *Handler) ListAvailableRegions() ([]Region, error) {
 	schema, err := h.fetchLatestSchema()
 	if err != nil {
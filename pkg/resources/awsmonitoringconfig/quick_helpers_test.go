@@ -53,22 +53,3 @@ func TestSplitCSV(t *testing.T) {
 		}
 	}
 }
-
-func TestCompareVersion(t *testing.T) {
-	cases := []struct {
-		a, b string
-		want int
-	}{
-		{"1.0.0", "1.0.0", 0},
-		{"1.0.0", "0.1.6", 1},
-		{"0.1.6", "1.0.0", -1},
-		{"1.10.0", "1.2.0", 1},
-		{"2.0", "1.99.99", 1},
-	}
-	for _, tc := range cases {
-		got := compareVersion(tc.a, tc.b)
-		if (got > 0 && tc.want <= 0) || (got < 0 && tc.want >= 0) || (got == 0 && tc.want != 0) {
-			t.Errorf("compareVersion(%q,%q) = %d want sign %d", tc.a, tc.b, got, tc.want)
-		}
-	}
-}
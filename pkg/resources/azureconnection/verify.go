@@ -0,0 +1,43 @@
+package azureconnection
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrFederationIncomplete is returned by Verify when a federated identity
+// connection has no applicationId or directoryId yet, i.e. the Azure side of
+// the setup has not been completed.
+var ErrFederationIncomplete = errors.New("federated identity credential is missing applicationId or directoryId")
+
+// Verify checks that a stored Azure connection is usable. It re-submits the
+// current value to the Settings API with validateOnly, which runs the
+// connection's server-side validator (the same check that rejects broken
+// credentials on update) without changing anything. The connection is
+// returned alongside any error so callers can print setup hints.
+func (h *Handler) Verify(objectID string) (*AzureConnection, error) {
+	conn, err := h.Get(objectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if conn.Value.Type == "federatedIdentityCredential" {
+		fed := conn.Value.FederatedIdentityCredential
+		if fed == nil || fed.ApplicationID == "" || fed.DirectoryID == "" {
+			return conn, ErrFederationIncomplete
+		}
+	}
+
+	resp, err := h.client.HTTP().R().
+		SetBody(map[string]interface{}{"value": conn.Value}).
+		SetHeader("If-Match", conn.SchemaVersion).
+		SetQueryParam("validateOnly", "true").
+		Put(fmt.Sprintf("%s/%s", SettingsAPI, objectID))
+	if err != nil {
+		return conn, fmt.Errorf("failed to verify azure_connection: %w", err)
+	}
+	if resp.IsError() {
+		return conn, fmt.Errorf("azure_connection %q failed verification: %s", objectID, resp.String())
+	}
+	return conn, nil
+}
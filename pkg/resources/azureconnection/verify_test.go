@@ -0,0 +1,75 @@
+package azureconnection
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/pkg/client"
+)
+
+func TestVerify(t *testing.T) {
+	const federated = `{"objectId":"obj-1","schemaVersion":"1.2","value":{"name":"prod","type":"federatedIdentityCredential",` +
+		`"federatedIdentityCredential":{"directoryId":"dir","applicationId":"app","consumers":["SVC:com.dynatrace.da"]}}}`
+	const incomplete = `{"objectId":"obj-1","schemaVersion":"1.2","value":{"name":"prod","type":"federatedIdentityCredential",` +
+		`"federatedIdentityCredential":{"consumers":["SVC:com.dynatrace.da"]}}}`
+
+	tests := []struct {
+		name        string
+		stored      string
+		validateErr string
+		wantErr     string
+		wantPut     bool
+	}{
+		{"valid", federated, "", "", true},
+		{"validator rejects", federated, `{"error":{"message":"AADSTS700213: No matching federated identity record found"}}`, "AADSTS700213", true},
+		{"incomplete federation", incomplete, "", ErrFederationIncomplete.Error(), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			put := false
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method == http.MethodPut {
+					put = true
+					if r.URL.Query().Get("validateOnly") != "true" || r.Header.Get("If-Match") != "1.2" {
+						t.Errorf("expected validateOnly PUT with If-Match, got %s %s", r.URL, r.Header.Get("If-Match"))
+					}
+					if tt.validateErr != "" {
+						w.WriteHeader(http.StatusBadRequest)
+						_, _ = w.Write([]byte(tt.validateErr))
+					}
+					return
+				}
+				_, _ = w.Write([]byte(tt.stored))
+			}))
+			defer srv.Close()
+
+			c, err := client.NewForTesting(srv.URL, "test-token")
+			if err != nil {
+				t.Fatal(err)
+			}
+			conn, err := NewHandler(c).Verify("obj-1")
+			if conn == nil || conn.Name != "prod" {
+				t.Errorf("expected connection to be returned, got %+v", conn)
+			}
+			if put != tt.wantPut {
+				t.Errorf("validateOnly PUT sent = %v, want %v", put, tt.wantPut)
+			}
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Verify() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Verify() error = %v, want containing %q", err, tt.wantErr)
+			}
+			if tt.name == "incomplete federation" && !errors.Is(err, ErrFederationIncomplete) {
+				t.Errorf("expected ErrFederationIncomplete, got %v", err)
+			}
+		})
+	}
+}
@@ -23,18 +23,6 @@ func newMonitoringHandler(t *testing.T, fn http.HandlerFunc) (*Handler, *httptes
 	return NewHandler(c), server
 }
 
-func TestCompareVersion(t *testing.T) {
-	if got := compareVersion("1.2.10", "1.2.3"); got <= 0 {
-		t.Fatalf("compareVersion expected positive, got %d", got)
-	}
-	if got := compareVersion("2.0", "2.0.0"); got != 0 {
-		t.Fatalf("compareVersion expected 0, got %d", got)
-	}
-	if got := compareVersion("1.0", "1.0.1"); got >= 0 {
-		t.Fatalf("compareVersion expected negative, got %d", got)
-	}
-}
-
 func TestGetLatestVersion(t *testing.T) {
 	t.Run("success chooses highest version", func(t *testing.T) {
 		h, server := newMonitoringHandler(t, func(w http.ResponseWriter, r *http.Request) {
@@ -3,6 +3,9 @@ package bucket
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
 
 	"github.com/dynatrace-oss/dtctl/pkg/client"
 	sdkbucket "github.com/dynatrace-oss/dtctl/sdk/api/bucket"
@@ -15,6 +18,21 @@ type (
 	BucketUpdate = sdkbucket.BucketUpdate
 )
 
+// Retention bounds accepted by the bucket management API, in days.
+const (
+	MinRetentionDays = 1
+	MaxRetentionDays = 3657
+)
+
+// ValidateRetentionDays reports whether days is a retention period the
+// bucket management API accepts.
+func ValidateRetentionDays(days int) error {
+	if days < MinRetentionDays || days > MaxRetentionDays {
+		return fmt.Errorf("retention must be between %d and %d days, got %d", MinRetentionDays, MaxRetentionDays, days)
+	}
+	return nil
+}
+
 // Bucket represents a Grail bucket definition (CLI version with table tags).
 type Bucket struct {
 	BucketName                 string `json:"bucketName" table:"NAME"`
@@ -30,11 +48,29 @@ type Bucket struct {
 	EstimatedUncompressedBytes *int64 `json:"estimatedUncompressedBytes,omitempty" table:"-"`
 }
 
+// ManagedFields lists the server-managed fields that 'get -o json|yaml' omits
+// unless --show-managed-fields is set.
+func (b Bucket) ManagedFields() []string {
+	return []string{"status", "version", "updatable", "records", "estimatedUncompressedBytes"}
+}
+
 // BucketList represents a list of bucket definitions.
 type BucketList struct {
 	Buckets []Bucket `json:"buckets"`
 }
 
+// BucketUsage is a bucket with its data volume (get buckets --usage).
+// Size is filled in by the caller from EstimatedUncompressedBytes.
+type BucketUsage struct {
+	BucketName                 string `json:"bucketName" table:"NAME"`
+	Table                      string `json:"table" table:"TABLE"`
+	Status                     string `json:"status" table:"STATUS"`
+	RetentionDays              int    `json:"retentionDays" table:"RETENTION_DAYS"`
+	Records                    *int64 `json:"records,omitempty" table:"RECORDS"`
+	EstimatedUncompressedBytes *int64 `json:"estimatedUncompressedBytes,omitempty" table:"-"`
+	Size                       string `json:"-" table:"SIZE"`
+}
+
 // fromSDKBucket converts an SDK Bucket to a CLI Bucket.
 func fromSDKBucket(s *sdkbucket.Bucket) Bucket {
 	return Bucket{
@@ -78,6 +114,72 @@ func (h *Handler) List() (*BucketList, error) {
 	return &BucketList{Buckets: buckets}, nil
 }
 
+// ListUsage lists all buckets with record counts and estimated size. The
+// list endpoint only returns record counts, so each bucket is fetched
+// individually with at most concurrency requests in flight. Fetches still
+// running after timeout are abandoned; those buckets keep the record count
+// from the list and are counted in the returned incomplete total.
+func (h *Handler) ListUsage(concurrency int, timeout time.Duration) ([]BucketUsage, int, error) {
+	list, err := h.List()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	usage := make([]BucketUsage, len(list.Buckets))
+	for i, b := range list.Buckets {
+		usage[i] = BucketUsage{
+			BucketName:    b.BucketName,
+			Table:         b.Table,
+			Status:        b.Status,
+			RetentionDays: b.RetentionDays,
+			Records:       b.Records,
+		}
+	}
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var (
+		wg         sync.WaitGroup
+		mu         sync.Mutex
+		incomplete int
+	)
+	sem := make(chan struct{}, concurrency)
+	for i := range usage {
+		wg.Add(1)
+		go func(u *BucketUsage) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				mu.Lock()
+				incomplete++
+				mu.Unlock()
+				return
+			}
+
+			detail, err := h.sdk.Get(ctx, u.BucketName)
+			if err != nil {
+				mu.Lock()
+				incomplete++
+				mu.Unlock()
+				return
+			}
+			if detail.Records != nil {
+				u.Records = detail.Records
+			}
+			u.EstimatedUncompressedBytes = detail.EstimatedUncompressedBytes
+		}(&usage[i])
+	}
+	wg.Wait()
+
+	return usage, incomplete, nil
+}
+
 // Get gets a specific bucket by name.
 func (h *Handler) Get(bucketName string) (*Bucket, error) {
 	sdkResult, err := h.sdk.Get(context.Background(), bucketName)
@@ -103,6 +205,21 @@ func (h *Handler) Update(bucketName string, version int, req BucketUpdate) error
 	return h.sdk.Update(context.Background(), bucketName, version, req)
 }
 
+// bucketPollInterval is how often WaitActive re-reads a bucket's status.
+var bucketPollInterval = 2 * time.Second
+
+// WaitActive blocks until the bucket's status is active or timeout elapses.
+func (h *Handler) WaitActive(bucketName string, timeout time.Duration) (*Bucket, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	sdkResult, err := h.sdk.WaitActive(ctx, bucketName, bucketPollInterval)
+	if err != nil {
+		return nil, err
+	}
+	b := fromSDKBucket(sdkResult)
+	return &b, nil
+}
+
 // Delete deletes a bucket.
 func (h *Handler) Delete(bucketName string) error {
 	return h.sdk.Delete(context.Background(), bucketName)
@@ -113,6 +230,25 @@ func (h *Handler) Truncate(bucketName string) error {
 	return h.sdk.Truncate(context.Background(), bucketName)
 }
 
+// RecordDeletion is the handle of an asynchronous record deletion task.
+type RecordDeletion = sdkbucket.RecordDeletion
+
+// RecordQuery builds the DQL that selects the records of a bucket matching
+// predicate within [from, to]. It is shared by the pre-count and the
+// deletion request so both address exactly the same records.
+func RecordQuery(table, bucketName, from, to, predicate string) string {
+	q := fmt.Sprintf("fetch %s, from:%s", table, from)
+	if to != "" {
+		q += ", to:" + to
+	}
+	return q + fmt.Sprintf("\n| filter dt.system.bucket == %q\n| filter %s", bucketName, predicate)
+}
+
+// DeleteRecords starts deleting the records matched by query (see RecordQuery).
+func (h *Handler) DeleteRecords(query string) (*RecordDeletion, error) {
+	return h.sdk.DeleteRecords(context.Background(), query)
+}
+
 // GetRaw gets a bucket as raw JSON bytes (for editing).
 func (h *Handler) GetRaw(bucketName string) ([]byte, error) {
 	bucket, err := h.sdk.Get(context.Background(), bucketName)
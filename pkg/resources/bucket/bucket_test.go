@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/dynatrace-oss/dtctl/pkg/client"
 )
@@ -139,6 +140,47 @@ func TestList(t *testing.T) {
 	}
 }
 
+func TestListUsage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/platform/storage/management/v1/bucket-definitions":
+			w.Write([]byte(`{"buckets":[{"bucketName":"custom_logs","table":"logs","status":"active","records":10},{"bucketName":"broken","table":"logs","status":"active"}]}`))
+		case "/platform/storage/management/v1/bucket-definitions/custom_logs":
+			w.Write([]byte(`{"bucketName":"custom_logs","table":"logs","status":"active","records":12,"estimatedUncompressedBytes":2048}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	c, err := client.NewForTesting(server.URL, "test-token")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	h := NewHandler(c)
+
+	usage, incomplete, err := h.ListUsage(2, 5*time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if incomplete != 1 {
+		t.Errorf("incomplete = %d, want 1", incomplete)
+	}
+	if len(usage) != 2 {
+		t.Fatalf("expected 2 buckets, got %d", len(usage))
+	}
+	if usage[0].Records == nil || *usage[0].Records != 12 {
+		t.Errorf("custom_logs records = %v, want 12 from the detail call", usage[0].Records)
+	}
+	if usage[0].EstimatedUncompressedBytes == nil || *usage[0].EstimatedUncompressedBytes != 2048 {
+		t.Errorf("custom_logs size = %v, want 2048", usage[0].EstimatedUncompressedBytes)
+	}
+	if usage[1].EstimatedUncompressedBytes != nil {
+		t.Errorf("broken size = %v, want nil", *usage[1].EstimatedUncompressedBytes)
+	}
+}
+
 func TestGet(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -686,3 +728,29 @@ func stringContains(s, substr string) bool {
 	}
 	return false
 }
+
+func TestRecordQuery(t *testing.T) {
+	got := RecordQuery("logs", "custom_logs", "now()-7d", "", `host.name == "web-1"`)
+	want := "fetch logs, from:now()-7d\n| filter dt.system.bucket == \"custom_logs\"\n| filter host.name == \"web-1\""
+	if got != want {
+		t.Errorf("RecordQuery() = %q, want %q", got, want)
+	}
+
+	got = RecordQuery("logs", "custom_logs", "now()-7d", "now()-1d", "true")
+	if !contains(got, "from:now()-7d, to:now()-1d") {
+		t.Errorf("RecordQuery() = %q, missing to bound", got)
+	}
+}
+
+func TestValidateRetentionDays(t *testing.T) {
+	for _, days := range []int{MinRetentionDays, 35, MaxRetentionDays} {
+		if err := ValidateRetentionDays(days); err != nil {
+			t.Errorf("ValidateRetentionDays(%d) error = %v", days, err)
+		}
+	}
+	for _, days := range []int{-1, 0, MaxRetentionDays + 1} {
+		if err := ValidateRetentionDays(days); err == nil {
+			t.Errorf("ValidateRetentionDays(%d) expected error", days)
+		}
+	}
+}
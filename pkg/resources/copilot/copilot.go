@@ -2,6 +2,7 @@ package copilot
 
 import (
 	"context"
+	"strings"
 
 	"github.com/dynatrace-oss/dtctl/pkg/client"
 	sdkcop "github.com/dynatrace-oss/dtctl/sdk/api/copilot"
@@ -22,6 +23,7 @@ type (
 	Dql2NlRequest         = sdkcop.Dql2NlRequest
 	DocumentSearchRequest = sdkcop.DocumentSearchRequest
 	DocumentMetadata      = sdkcop.DocumentMetadata
+	SkillParameter        = sdkcop.SkillParameter
 )
 
 // Skill represents an available CoPilot skill with CLI display fields.
@@ -34,6 +36,25 @@ type SkillList struct {
 	Skills []Skill
 }
 
+// SkillDetail is a single skill with its input parameters and the dtctl
+// command that invokes it (get copilot-skill <name>).
+type SkillDetail struct {
+	Name        string           `json:"name" yaml:"name" table:"NAME"`
+	Description string           `json:"description,omitempty" yaml:"description,omitempty" table:"DESCRIPTION"`
+	Endpoint    string           `json:"endpoint,omitempty" yaml:"endpoint,omitempty" table:"ENDPOINT,wide"`
+	Parameters  []SkillParameter `json:"parameters,omitempty" yaml:"parameters,omitempty" table:"-"`
+	Example     string           `json:"example,omitempty" yaml:"example,omitempty" table:"EXAMPLE"`
+}
+
+// skillExamples are dtctl invocations of the skills 'exec copilot' supports,
+// keyed by the SDK's normalized skill name.
+var skillExamples = map[string]string{
+	"conversation":   `dtctl exec copilot "What caused the CPU spike on host-123?"`,
+	"nl2dql":         `dtctl exec copilot nl2dql "show me error logs from the last hour"`,
+	"dql2nl":         `dtctl exec copilot dql2nl "fetch logs | filter status == 'ERROR' | limit 10"`,
+	"documentsearch": `dtctl exec copilot document-search "CPU analysis" --collections notebooks,dashboards`,
+}
+
 // ConversationResponse represents a response from the CoPilot conversation endpoint.
 type ConversationResponse struct {
 	Text  string             `json:"text" table:"RESPONSE"`
@@ -146,6 +167,23 @@ func (h *Handler) ListSkills() (*SkillList, error) {
 	return fromSDKSkillList(sdkResult), nil
 }
 
+// GetSkill retrieves the description and input parameters of one skill,
+// together with the dtctl command that invokes it.
+func (h *Handler) GetSkill(name string) (*SkillDetail, error) {
+	sdkResult, err := h.sdk.GetSkill(context.Background(), name)
+	if err != nil {
+		return nil, err
+	}
+	key := strings.ToLower(strings.NewReplacer("-", "", "_", "").Replace(sdkResult.Name))
+	return &SkillDetail{
+		Name:        sdkResult.Name,
+		Description: sdkResult.Description,
+		Endpoint:    sdkResult.Endpoint,
+		Parameters:  sdkResult.Parameters,
+		Example:     skillExamples[key],
+	}, nil
+}
+
 // Chat sends a message to CoPilot and returns the response
 func (h *Handler) Chat(text string, state *ConversationState, ctx []ConversationContext) (*ConversationResponse, error) {
 	sdkResult, err := h.sdk.Chat(context.Background(), text, state, ctx)
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/dynatrace-oss/dtctl/pkg/client"
@@ -648,3 +649,36 @@ func TestHandler_DocumentSearch(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_GetSkill(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(SkillsResponse{Skills: []string{"dql2nl", "futureSkill"}})
+	}))
+	defer server.Close()
+
+	c, err := client.NewForTesting(server.URL, "test-token")
+	if err != nil {
+		t.Fatalf("client.New() error = %v", err)
+	}
+	handler := NewHandler(c)
+
+	skill, err := handler.GetSkill("dql2nl")
+	if err != nil {
+		t.Fatalf("GetSkill() error = %v", err)
+	}
+	if !strings.HasPrefix(skill.Example, "dtctl exec copilot dql2nl") {
+		t.Errorf("Example = %q, want a dql2nl invocation", skill.Example)
+	}
+	if len(skill.Parameters) == 0 {
+		t.Error("expected dql2nl parameters")
+	}
+
+	skill, err = handler.GetSkill("futureSkill")
+	if err != nil {
+		t.Fatalf("GetSkill(futureSkill) error = %v", err)
+	}
+	if skill.Example != "" {
+		t.Errorf("unknown skill should have no example, got %q", skill.Example)
+	}
+}
@@ -34,6 +34,12 @@ type Document struct {
 	UserContext       *sdkdocument.UserContext `json:"userContext,omitempty" yaml:"userContext,omitempty" table:"-"`
 }
 
+// ManagedFields lists the server-managed fields that 'get -o json|yaml' omits
+// unless --show-managed-fields is set.
+func (d Document) ManagedFields() []string {
+	return []string{"owner", "version", "modificationInfo", "shareInfo", "userContext", "originAppId", "originExtensionId"}
+}
+
 // UnmarshalJSON delegates to the SDK Document unmarshaler to handle flexible version fields.
 func (d *Document) UnmarshalJSON(data []byte) error {
 	var sdk sdkdocument.Document
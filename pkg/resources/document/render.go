@@ -0,0 +1,78 @@
+package document
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// RenderNotebookMarkdown turns a notebook's content into a readable Markdown
+// document (get notebook --render-markdown): markdown sections are printed as
+// they are, DQL and code sections as fenced blocks under their title. Sections
+// without text (charts of other sections, empty inputs) are skipped.
+func RenderNotebookMarkdown(name string, content []byte) (string, error) {
+	var doc struct {
+		Sections []map[string]interface{} `json:"sections"`
+		Content  *struct {
+			Sections []map[string]interface{} `json:"sections"`
+		} `json:"content"`
+	}
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return "", fmt.Errorf("failed to parse notebook content: %w", err)
+	}
+	sections := doc.Sections
+	if sections == nil && doc.Content != nil {
+		sections = doc.Content.Sections
+	}
+
+	var blocks []string
+	if name != "" {
+		blocks = append(blocks, "# "+name)
+	}
+	for _, section := range sections {
+		typ, _ := section["type"].(string)
+		title, _ := section["title"].(string)
+		switch typ {
+		case "markdown":
+			if text, _ := section["markdown"].(string); strings.TrimSpace(text) != "" {
+				blocks = append(blocks, strings.TrimSpace(text))
+			}
+		case "dql", "function", "code":
+			code := sectionInput(section)
+			if strings.TrimSpace(code) == "" {
+				continue
+			}
+			lang := "dql"
+			if typ != "dql" {
+				lang = "javascript"
+			}
+			if title != "" {
+				blocks = append(blocks, "## "+title)
+			}
+			blocks = append(blocks, fence(lang, strings.TrimSpace(code)))
+		}
+	}
+	if len(blocks) == 0 {
+		return "", nil
+	}
+	return strings.Join(blocks, "\n\n") + "\n", nil
+}
+
+// sectionInput returns the query or code of a DQL or function section
+// (state.input.value).
+func sectionInput(section map[string]interface{}) string {
+	state, _ := section["state"].(map[string]interface{})
+	input, _ := state["input"].(map[string]interface{})
+	value, _ := input["value"].(string)
+	return value
+}
+
+// fence wraps code in a fenced code block, lengthening the fence when the code
+// itself contains backticks.
+func fence(lang, code string) string {
+	marker := "```"
+	for strings.Contains(code, marker) {
+		marker += "`"
+	}
+	return marker + lang + "\n" + code + "\n" + marker
+}
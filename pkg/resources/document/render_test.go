@@ -0,0 +1,44 @@
+package document
+
+import (
+	"testing"
+)
+
+func TestRenderNotebookMarkdown(t *testing.T) {
+	content := []byte(`{
+		"version": "7",
+		"sections": [
+			{"type": "markdown", "markdown": "## Overview\n\nWeekly error review.\n"},
+			{"type": "dql", "title": "Errors", "state": {"input": {"value": "fetch logs\n| filter loglevel == \"ERROR\""}}},
+			{"type": "function", "state": {"input": {"value": "return 1; // uses ` + "```" + ` fences"}}},
+			{"type": "dql", "state": {"input": {"value": "  "}}},
+			{"type": "markdown", "markdown": ""}
+		]
+	}`)
+
+	got, err := RenderNotebookMarkdown("Error Review", content)
+	if err != nil {
+		t.Fatalf("RenderNotebookMarkdown() error = %v", err)
+	}
+	want := "# Error Review\n\n" +
+		"## Overview\n\nWeekly error review.\n\n" +
+		"## Errors\n\n```dql\nfetch logs\n| filter loglevel == \"ERROR\"\n```\n\n" +
+		"````javascript\nreturn 1; // uses ``` fences\n````\n"
+	if got != want {
+		t.Errorf("RenderNotebookMarkdown() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestRenderNotebookMarkdown_WrappedContent(t *testing.T) {
+	got, err := RenderNotebookMarkdown("", []byte(`{"content": {"sections": [{"type": "markdown", "markdown": "Hello"}]}}`))
+	if err != nil {
+		t.Fatalf("RenderNotebookMarkdown() error = %v", err)
+	}
+	if got != "Hello\n" {
+		t.Errorf("RenderNotebookMarkdown() = %q, want %q", got, "Hello\n")
+	}
+
+	if _, err := RenderNotebookMarkdown("x", []byte("not json")); err == nil {
+		t.Error("expected error for invalid content")
+	}
+}
@@ -3,6 +3,7 @@ package edgeconnect
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	"github.com/dynatrace-oss/dtctl/pkg/client"
 	sdkedgeconnect "github.com/dynatrace-oss/dtctl/sdk/api/edgeconnect"
@@ -22,6 +23,12 @@ type EdgeConnect struct {
 	Metadata                   *Metadata         `json:"metadata,omitempty" table:"-"`
 }
 
+// ManagedFields lists the server-managed fields that 'get -o json|yaml' omits
+// unless --show-managed-fields is set.
+func (e EdgeConnect) ManagedFields() []string {
+	return []string{"modificationInfo", "metadata", "managedByDynatraceOperator"}
+}
+
 // fromSDKEdgeConnect converts an SDK EdgeConnect to the CLI EdgeConnect.
 func fromSDKEdgeConnect(e *sdkedgeconnect.EdgeConnect) *EdgeConnect {
 	return &EdgeConnect{
@@ -61,6 +68,7 @@ func fromSDKEdgeConnectList(l *sdkedgeconnect.EdgeConnectList) *EdgeConnectList
 type (
 	ModificationInfo = sdkedgeconnect.ModificationInfo
 	Metadata         = sdkedgeconnect.Metadata
+	Instance         = sdkedgeconnect.Instance
 )
 
 // EdgeConnectCreate represents the request body for creating an EdgeConnect.
@@ -130,6 +138,16 @@ func (h *Handler) Update(edgeConnectID string, req EdgeConnect) error {
 	})
 }
 
+// RotateSecret regenerates the OAuth client credentials of an EdgeConnect and
+// returns the configuration with the new client ID and secret.
+func (h *Handler) RotateSecret(edgeConnectID string) (*EdgeConnect, error) {
+	e, err := h.sdk.RotateSecret(context.Background(), edgeConnectID)
+	if err != nil {
+		return nil, err
+	}
+	return fromSDKEdgeConnect(e), nil
+}
+
 // Delete deletes an EdgeConnect.
 func (h *Handler) Delete(edgeConnectID string) error {
 	return h.sdk.Delete(context.Background(), edgeConnectID)
@@ -143,3 +161,8 @@ func (h *Handler) GetRaw(edgeConnectID string) ([]byte, error) {
 	}
 	return json.MarshalIndent(ec, "", "  ")
 }
+
+// IsNotFound returns true if the error indicates an EdgeConnect was not found (404).
+func IsNotFound(err error) bool {
+	return errors.Is(err, httpclient.ErrNotFound)
+}
@@ -0,0 +1,75 @@
+package edgeconnect
+
+import (
+	"context"
+	"time"
+)
+
+// Connectivity states reported by Status.
+const (
+	StatusOnline  = "ONLINE"
+	StatusOffline = "OFFLINE"
+)
+
+// Status summarizes the connectivity of an EdgeConnect configuration: how
+// many EdgeConnect hosts are currently registered and when one was last seen.
+type Status struct {
+	ID             string `json:"id" table:"ID"`
+	Name           string `json:"name" table:"NAME"`
+	Status         string `json:"status" table:"STATUS"`
+	ConnectedHosts int    `json:"connectedHosts" table:"HOSTS"`
+	LastSeen       string `json:"lastSeen,omitempty" table:"LAST_SEEN"`
+	Version        string `json:"version,omitempty" table:"VERSION,wide"`
+}
+
+// StatusOf derives the connectivity status of an EdgeConnect from the
+// instances listed in its metadata. The configuration is ONLINE when at least
+// one host is registered; LastSeen is the most recent instance heartbeat.
+func StatusOf(ec *EdgeConnect) Status {
+	s := Status{ID: ec.ID, Name: ec.Name, Status: StatusOffline}
+	if ec.Metadata == nil {
+		return s
+	}
+	s.ConnectedHosts = len(ec.Metadata.Instances)
+	if s.ConnectedHosts > 0 {
+		s.Status = StatusOnline
+	}
+
+	var latest time.Time
+	for _, inst := range ec.Metadata.Instances {
+		ts, err := time.Parse(time.RFC3339, inst.LastSeen)
+		if err != nil || !ts.After(latest) {
+			continue
+		}
+		latest = ts
+		s.LastSeen = inst.LastSeen
+		s.Version = inst.Version
+	}
+	if s.Version == "" && s.ConnectedHosts > 0 {
+		s.Version = ec.Metadata.Instances[0].Version
+	}
+	return s
+}
+
+// GetStatus gets the connectivity status of an EdgeConnect by ID.
+func (h *Handler) GetStatus(edgeConnectID string) (*Status, error) {
+	e, err := h.sdk.GetWithMetadata(context.Background(), edgeConnectID)
+	if err != nil {
+		return nil, err
+	}
+	s := StatusOf(fromSDKEdgeConnect(e))
+	return &s, nil
+}
+
+// ListStatus lists the connectivity status of all EdgeConnect configurations.
+func (h *Handler) ListStatus() ([]Status, error) {
+	list, err := h.List()
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]Status, len(list.EdgeConnects))
+	for i := range list.EdgeConnects {
+		statuses[i] = StatusOf(&list.EdgeConnects[i])
+	}
+	return statuses, nil
+}
@@ -0,0 +1,66 @@
+package edgeconnect
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/pkg/client"
+)
+
+func TestStatusOf(t *testing.T) {
+	offline := StatusOf(&EdgeConnect{ID: "ec-1", Name: "idle"})
+	if offline.Status != StatusOffline || offline.ConnectedHosts != 0 || offline.LastSeen != "" {
+		t.Errorf("StatusOf(no metadata) = %+v, want OFFLINE with no hosts", offline)
+	}
+
+	online := StatusOf(&EdgeConnect{
+		ID:   "ec-2",
+		Name: "prod",
+		Metadata: &Metadata{Instances: []Instance{
+			{InstanceID: "a", Version: "1.0.0", LastSeen: "2026-03-01T10:00:00Z"},
+			{InstanceID: "b", Version: "1.1.0", LastSeen: "2026-03-01T10:05:00Z"},
+		}},
+	})
+	if online.Status != StatusOnline || online.ConnectedHosts != 2 {
+		t.Errorf("StatusOf(two instances) = %+v, want ONLINE with 2 hosts", online)
+	}
+	if online.LastSeen != "2026-03-01T10:05:00Z" || online.Version != "1.1.0" {
+		t.Errorf("LastSeen/Version = %q/%q, want most recent instance", online.LastSeen, online.Version)
+	}
+}
+
+func TestGetStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/platform/app-engine/edge-connect/v1/edge-connects/ec-123" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("add-fields"); got != "modificationInfo,metadata" {
+			t.Errorf("add-fields = %q, want metadata requested", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(EdgeConnect{
+			ID:   "ec-123",
+			Name: "prod",
+			Metadata: &Metadata{Instances: []Instance{
+				{InstanceID: "host-1", Version: "1.2.0", LastSeen: "2026-03-01T10:00:00Z"},
+			}},
+		})
+	}))
+	defer server.Close()
+
+	c, err := client.NewForTesting(server.URL, "test-token")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	h := NewHandler(c)
+
+	status, err := h.GetStatus("ec-123")
+	if err != nil {
+		t.Fatalf("GetStatus() error = %v", err)
+	}
+	if status.Status != StatusOnline || status.ConnectedHosts != 1 || status.LastSeen != "2026-03-01T10:00:00Z" {
+		t.Errorf("GetStatus() = %+v", status)
+	}
+}
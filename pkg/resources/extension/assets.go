@@ -55,7 +55,9 @@ var validAssetTypes = map[string]struct{}{
 
 // extensionManifest is the relevant subset of extension.yaml.
 type extensionManifest struct {
-	Alerts []struct {
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+	Alerts  []struct {
 		Path string `yaml:"path"`
 	} `yaml:"alerts"`
 	Openpipeline struct {
@@ -137,6 +139,23 @@ func ParseAssets(zipData []byte, types []string, full bool) (*AssetResult, error
 	return result, nil
 }
 
+// PackageIdentity returns the extension name and version declared in the
+// extension.yaml of a (possibly nested) extension zip.
+func PackageIdentity(zipData []byte) (name, version string, err error) {
+	inner, err := extractInnerZip(zipData)
+	if err != nil {
+		return "", "", err
+	}
+	manifest, err := readManifest(inner)
+	if err != nil {
+		return "", "", err
+	}
+	if manifest.Name == "" {
+		return "", "", fmt.Errorf("extension.yaml does not declare a name")
+	}
+	return manifest.Name, manifest.Version, nil
+}
+
 // extractInnerZip opens the outer zip and returns a zip.Reader for the nested
 // extension.zip. If no nesting exists, the outer zip is returned as-is.
 func extractInnerZip(data []byte) (*zip.Reader, error) {
@@ -278,6 +278,23 @@ func TestParseAssets_FlatZip(t *testing.T) {
 	}
 }
 
+func TestPackageIdentity(t *testing.T) {
+	zipData := buildNestedZip(t, map[string]interface{}{
+		"extension.yaml": "name: custom:my.extension\nversion: 1.2.3\n",
+	})
+	name, version, err := PackageIdentity(zipData)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if name != "custom:my.extension" || version != "1.2.3" {
+		t.Errorf("PackageIdentity() = %q, %q; want custom:my.extension, 1.2.3", name, version)
+	}
+
+	if _, _, err := PackageIdentity(buildZip(t, map[string]interface{}{"extension.yaml": "version: 1.0.0\n"})); err == nil {
+		t.Error("expected error for a manifest without a name")
+	}
+}
+
 func TestParseAssets_ContentLeakWhenJSONHasContentKey(t *testing.T) {
 	// Alert JSON contains a "content" key — without the nil-reset fix, this leaks
 	// into the output even when full=false.
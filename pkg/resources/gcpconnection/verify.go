@@ -0,0 +1,41 @@
+package gcpconnection
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrServiceAccountMissing is returned by Verify when a service account
+// impersonation connection has no service account configured.
+var ErrServiceAccountMissing = errors.New("service account impersonation connection has no serviceAccountId")
+
+// Verify checks that a stored GCP connection is usable. It re-submits the
+// current value to the Settings API with validateOnly, which runs the
+// connection's server-side validator (including the impersonation check)
+// without changing anything.
+func (h *Handler) Verify(objectID string) (*GCPConnection, error) {
+	conn, err := h.Get(objectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if conn.Value.Type == "serviceAccountImpersonation" {
+		sai := conn.Value.ServiceAccountImpersonation
+		if sai == nil || sai.ServiceAccountID == "" {
+			return conn, ErrServiceAccountMissing
+		}
+	}
+
+	resp, err := h.client.HTTP().R().
+		SetBody(map[string]interface{}{"value": conn.Value}).
+		SetHeader("If-Match", conn.SchemaVersion).
+		SetQueryParam("validateOnly", "true").
+		Put(fmt.Sprintf("%s/%s", SettingsAPI, objectID))
+	if err != nil {
+		return conn, fmt.Errorf("failed to verify gcp_connection: %w", err)
+	}
+	if resp.IsError() {
+		return conn, fmt.Errorf("gcp_connection %q failed verification: %s", objectID, resp.String())
+	}
+	return conn, nil
+}
@@ -2,6 +2,7 @@ package iam
 
 import (
 	"context"
+	"strings"
 
 	"github.com/dynatrace-oss/dtctl/pkg/client"
 	sdkiam "github.com/dynatrace-oss/dtctl/sdk/api/iam"
@@ -38,6 +39,19 @@ type GroupListResponse struct {
 	TotalCount  int64   `json:"totalCount"`
 }
 
+// EffectivePermission is a permission a user holds through the policies bound
+// to them directly or through their groups (CLI version with table tags).
+type EffectivePermission struct {
+	Permission string      `json:"permission" table:"PERMISSION"`
+	Effect     string      `json:"effect" table:"EFFECT"`
+	Conditions []string    `json:"conditions,omitempty" table:"CONDITIONS"`
+	Policies   []PolicyRef `json:"policies,omitempty" table:"-"`
+	PolicyList string      `json:"-" table:"POLICIES"`
+}
+
+// PolicyRef identifies a policy that grants a permission.
+type PolicyRef = sdkiam.PolicyRef
+
 // fromSDKUser converts an SDK User to a CLI User.
 func fromSDKUser(s *sdkiam.User) User {
 	return User{
@@ -58,6 +72,21 @@ func fromSDKGroup(s *sdkiam.Group) Group {
 	}
 }
 
+// fromSDKEffectivePermission converts an SDK EffectivePermission to a CLI EffectivePermission.
+func fromSDKEffectivePermission(s *sdkiam.EffectivePermission) EffectivePermission {
+	names := make([]string, len(s.Policies))
+	for i, p := range s.Policies {
+		names[i] = p.Name
+	}
+	return EffectivePermission{
+		Permission: s.Permission,
+		Effect:     s.Effect,
+		Conditions: s.Conditions,
+		Policies:   s.Policies,
+		PolicyList: strings.Join(names, ", "),
+	}
+}
+
 // Handler handles IAM resources.
 // It delegates to the SDK handler.
 type Handler struct {
@@ -71,9 +100,15 @@ func NewHandler(c *client.Client) *Handler {
 	}
 }
 
-// ListUsers lists all users in the current environment with automatic pagination.
-func (h *Handler) ListUsers(partialString string, uuids []string, chunkSize int64) (*UserListResponse, error) {
-	sdkResult, err := h.sdk.ListUsers(context.Background(), partialString, uuids, chunkSize)
+// ListUsers lists users in the current environment with automatic pagination,
+// stopping once limit users are collected (0 = unlimited).
+func (h *Handler) ListUsers(partialString string, uuids []string, chunkSize, limit int64) (*UserListResponse, error) {
+	return h.ListUsersWithOptions(partialString, uuids, httpclient.ChunkPageOptions(chunkSize, limit))
+}
+
+// ListUsersWithOptions lists users, paging through results as described by opts.
+func (h *Handler) ListUsersWithOptions(partialString string, uuids []string, opts httpclient.PageOptions) (*UserListResponse, error) {
+	sdkResult, err := h.sdk.ListUsersWithOptions(context.Background(), partialString, uuids, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -97,9 +132,15 @@ func (h *Handler) GetUser(uuid string) (*User, error) {
 	return &u, nil
 }
 
-// ListGroups lists all groups in the current account with automatic pagination.
-func (h *Handler) ListGroups(partialGroupName string, uuids []string, chunkSize int64) (*GroupListResponse, error) {
-	sdkResult, err := h.sdk.ListGroups(context.Background(), partialGroupName, uuids, chunkSize)
+// ListGroups lists groups in the current account with automatic pagination,
+// stopping once limit groups are collected (0 = unlimited).
+func (h *Handler) ListGroups(partialGroupName string, uuids []string, chunkSize, limit int64) (*GroupListResponse, error) {
+	return h.ListGroupsWithOptions(partialGroupName, uuids, httpclient.ChunkPageOptions(chunkSize, limit))
+}
+
+// ListGroupsWithOptions lists groups, paging through results as described by opts.
+func (h *Handler) ListGroupsWithOptions(partialGroupName string, uuids []string, opts httpclient.PageOptions) (*GroupListResponse, error) {
+	sdkResult, err := h.sdk.ListGroupsWithOptions(context.Background(), partialGroupName, uuids, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -112,3 +153,43 @@ func (h *Handler) ListGroups(partialGroupName string, uuids []string, chunkSize
 		TotalCount: sdkResult.TotalCount,
 	}, nil
 }
+
+// GetUserGroups lists the groups a user is a member of.
+func (h *Handler) GetUserGroups(uuid string) ([]Group, error) {
+	sdkResult, err := h.sdk.GetUserGroups(context.Background(), uuid)
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]Group, len(sdkResult.Results))
+	for i := range sdkResult.Results {
+		groups[i] = fromSDKGroup(&sdkResult.Results[i])
+	}
+	return groups, nil
+}
+
+// ListGroupUsers lists the members of a group.
+func (h *Handler) ListGroupUsers(groupUUID string) ([]User, error) {
+	sdkResult, err := h.sdk.ListGroupUsers(context.Background(), groupUUID)
+	if err != nil {
+		return nil, err
+	}
+	users := make([]User, len(sdkResult.Results))
+	for i := range sdkResult.Results {
+		users[i] = fromSDKUser(&sdkResult.Results[i])
+	}
+	return users, nil
+}
+
+// GetUserEffectivePermissions resolves the permissions a user holds through
+// the policies bound to them and to their groups.
+func (h *Handler) GetUserEffectivePermissions(uuid string) ([]EffectivePermission, error) {
+	sdkResult, err := h.sdk.GetEffectivePermissions(context.Background(), "user", uuid)
+	if err != nil {
+		return nil, err
+	}
+	perms := make([]EffectivePermission, len(sdkResult.Results))
+	for i := range sdkResult.Results {
+		perms[i] = fromSDKEffectivePermission(&sdkResult.Results[i])
+	}
+	return perms, nil
+}
@@ -202,7 +202,7 @@ func TestListUsers(t *testing.T) {
 			}
 			handler := NewHandler(c)
 
-			result, err := handler.ListUsers(tt.partialString, tt.uuids, tt.chunkSize)
+			result, err := handler.ListUsers(tt.partialString, tt.uuids, tt.chunkSize, 0)
 
 			if (err != nil) != tt.expectError {
 				t.Errorf("ListUsers() error = %v, expectError %v", err, tt.expectError)
@@ -436,7 +436,7 @@ func TestListGroups(t *testing.T) {
 			}
 			handler := NewHandler(c)
 
-			result, err := handler.ListGroups(tt.partialGroupName, tt.uuids, tt.chunkSize)
+			result, err := handler.ListGroups(tt.partialGroupName, tt.uuids, tt.chunkSize, 0)
 
 			if (err != nil) != tt.expectError {
 				t.Errorf("ListGroups() error = %v, expectError %v", err, tt.expectError)
@@ -449,3 +449,88 @@ func TestListGroups(t *testing.T) {
 		})
 	}
 }
+
+func TestGetUserGroups(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/users/user-1/groups") {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"results":    []map[string]any{{"uuid": "g-1", "groupName": "Admins", "type": "LOCAL"}},
+			"totalCount": 1,
+		})
+	}))
+	defer server.Close()
+
+	c, err := client.NewForTesting(server.URL, "test-token")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	groups, err := NewHandler(c).GetUserGroups("user-1")
+	if err != nil {
+		t.Fatalf("GetUserGroups() error = %v", err)
+	}
+	if len(groups) != 1 || groups[0].GroupName != "Admins" {
+		t.Errorf("GetUserGroups() = %+v", groups)
+	}
+}
+
+func TestListGroupUsers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/groups/g-1/users") {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"results":    []map[string]any{{"uid": "user-1", "email": "a@example.invalid"}},
+			"totalCount": 1,
+		})
+	}))
+	defer server.Close()
+
+	c, err := client.NewForTesting(server.URL, "test-token")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	users, err := NewHandler(c).ListGroupUsers("g-1")
+	if err != nil {
+		t.Fatalf("ListGroupUsers() error = %v", err)
+	}
+	if len(users) != 1 || users[0].Email != "a@example.invalid" {
+		t.Errorf("ListGroupUsers() = %+v", users)
+	}
+}
+
+func TestGetUserEffectivePermissions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/effective-permissions") {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("entityType") != "user" || r.URL.Query().Get("entityId") != "user-1" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"results": []map[string]any{{
+				"permission": "storage:logs:read",
+				"effect":     "ALLOW",
+				"policies":   []map[string]any{{"uuid": "p-1", "name": "Log readers"}, {"uuid": "p-2", "name": "SRE"}},
+			}},
+			"totalCount": 1,
+		})
+	}))
+	defer server.Close()
+
+	c, err := client.NewForTesting(server.URL, "test-token")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	perms, err := NewHandler(c).GetUserEffectivePermissions("user-1")
+	if err != nil {
+		t.Fatalf("GetUserEffectivePermissions() error = %v", err)
+	}
+	if len(perms) != 1 || perms[0].Permission != "storage:logs:read" || perms[0].PolicyList != "Log readers, SRE" {
+		t.Errorf("GetUserEffectivePermissions() = %+v", perms)
+	}
+}
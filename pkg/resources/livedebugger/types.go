@@ -33,6 +33,7 @@ func fromSDKBreakpointRule(s *sdkld.BreakpointRule) BreakpointRule {
 // Re-export SDK helper functions.
 var (
 	ExtractWorkspaceID    = sdkld.ExtractWorkspaceID
+	ExtractCreatedRuleID  = sdkld.ExtractCreatedRuleID
 	ExtractDeletedRuleIDs = sdkld.ExtractDeletedRuleIDs
 	ExtractRuleStatuses   = sdkld.ExtractRuleStatuses
 	WorkspaceHasFilters   = sdkld.WorkspaceHasFilters
@@ -267,6 +267,113 @@ func TestUpdate_CallsCreateWithOverwrite(t *testing.T) {
 	}
 }
 
+func TestAppend_MergesExistingRecords(t *testing.T) {
+	var uploaded UploadRequest
+	var content []byte
+	mux := http.NewServeMux()
+	mux.HandleFunc("/platform/storage/query/v1/query:execute", appendQueryHandler(t, 2,
+		`{"state":"SUCCEEDED","result":{"records":[{"code":"E1","message":"old"},{"code":"E2","message":"kept"}]}}`))
+	mux.HandleFunc("/platform/storage/resource-store/v1/files/tabular/lookup:upload", func(w http.ResponseWriter, r *http.Request) {
+		uploaded, content = parseUploadRequest(t, r)
+		json.NewEncoder(w).Encode(UploadResponse{Records: 3})
+	})
+	h, cleanup := newLookupTestHandler(t, mux)
+	defer cleanup()
+
+	_, err := h.Append("/lookups/codes", CreateRequest{
+		LookupField: "code",
+		DataContent: []byte("code,message\nE1,new\nE3,added\n"),
+	})
+	if err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+	if !uploaded.Overwrite || uploaded.FilePath != "/lookups/codes" {
+		t.Errorf("upload request = %+v, want overwrite of /lookups/codes", uploaded)
+	}
+	want := "code,message\nE2,kept\nE1,new\nE3,added\n"
+	if string(content) != want {
+		t.Errorf("uploaded content = %q, want %q", content, want)
+	}
+}
+
+func TestAppend_RefusesPartialRead(t *testing.T) {
+	tests := []struct {
+		name     string
+		records  int
+		response string
+		wantErr  string
+	}{
+		{
+			name:     "result limit notification",
+			records:  2,
+			response: `{"state":"SUCCEEDED","result":{"records":[{"code":"E1"},{"code":"E2"}],"metadata":{"grail":{"notifications":[{"notificationType":"RESULT_LIMIT_BYTES","message":"Result has been limited"}]}}}}`,
+			wantErr:  "partial result",
+		},
+		{
+			name:     "row count differs from metadata",
+			records:  5,
+			response: `{"state":"SUCCEEDED","result":{"records":[{"code":"E1"},{"code":"E2"}]}}`,
+			wantErr:  "read 2 of its 5",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/platform/storage/query/v1/query:execute", appendQueryHandler(t, tt.records, tt.response))
+			mux.HandleFunc("/platform/storage/resource-store/v1/files/tabular/lookup:upload", func(w http.ResponseWriter, r *http.Request) {
+				t.Error("table must not be uploaded after a partial read")
+			})
+			h, cleanup := newLookupTestHandler(t, mux)
+			defer cleanup()
+
+			_, err := h.Append("/lookups/codes", CreateRequest{DataContent: []byte("code\nE3\n")})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Append() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// appendQueryHandler answers the metadata query Append issues with the given
+// record count and the load query with dataResponse.
+func appendQueryHandler(t *testing.T, records int, dataResponse string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query string `json:"query"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode query request: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasPrefix(body.Query, "fetch dt.system.files") {
+			fmt.Fprintf(w, `{"state":"SUCCEEDED","result":{"records":[{"name":"/lookups/codes","records":%d}]}}`, records)
+			return
+		}
+		fmt.Fprint(w, dataResponse)
+	}
+}
+
+func TestMergeCSV(t *testing.T) {
+	records := []map[string]interface{}{
+		{"id": float64(1), "name": "a, b", "extra": nil},
+	}
+
+	got, err := MergeCSV(records, []byte("id,name,extra\n2,c,x\n"), "")
+	if err != nil {
+		t.Fatalf("MergeCSV() error = %v", err)
+	}
+	if want := "id,name,extra\n1,\"a, b\",\n2,c,x\n"; string(got) != want {
+		t.Errorf("MergeCSV() = %q, want %q", got, want)
+	}
+
+	if _, err := MergeCSV(records, []byte("id,name\n2,c\n"), ""); err == nil || !strings.Contains(err.Error(), "extra") {
+		t.Errorf("missing column error = %v, want mention of extra", err)
+	}
+	if _, err := MergeCSV(records, []byte("id,name,extra\n"), "code"); err == nil {
+		t.Error("expected error for unknown lookup field")
+	}
+}
+
 // --- Delete ---
 
 func TestDelete_Success(t *testing.T) {
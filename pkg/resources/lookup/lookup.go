@@ -9,6 +9,8 @@ import (
 	"mime/multipart"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -150,6 +152,38 @@ func (h *Handler) List() ([]Lookup, error) {
 
 // Get retrieves a specific lookup table metadata and preview data
 func (h *Handler) Get(path string) (*Lookup, error) {
+	lookup, err := h.Metadata(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Use DQL to load the lookup and get schema
+	executor := exec.NewDQLExecutor(h.client)
+	query := fmt.Sprintf("load \"%s\" | limit 1", path)
+	result, err := executor.ExecuteQuery(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lookup table %q: %w", path, err)
+	}
+
+	// Extract column names from first record
+	records := result.Records
+	if result.Result != nil {
+		records = result.Result.Records
+	}
+
+	if len(records) > 0 {
+		// Extract column names
+		for col := range records[0] {
+			lookup.Columns = append(lookup.Columns, col)
+		}
+	}
+
+	return lookup, nil
+}
+
+// Metadata retrieves a lookup table's metadata (size, record count, ...) from
+// dt.system.files without loading any of its data.
+func (h *Handler) Metadata(path string) (*Lookup, error) {
 	// Validate path
 	if err := ValidatePath(path); err != nil {
 		return nil, err
@@ -208,26 +242,6 @@ func (h *Handler) Get(path string) (*Lookup, error) {
 		}
 	}
 
-	// Use DQL to load the lookup and get schema
-	query := fmt.Sprintf("load \"%s\" | limit 1", path)
-	result, err := executor.ExecuteQuery(query)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get lookup table %q: %w", path, err)
-	}
-
-	// Extract column names from first record
-	records := result.Records
-	if result.Result != nil {
-		records = result.Result.Records
-	}
-
-	if len(records) > 0 {
-		// Extract column names
-		for col := range records[0] {
-			lookup.Columns = append(lookup.Columns, col)
-		}
-	}
-
 	return lookup, nil
 }
 
@@ -417,6 +431,139 @@ func (h *Handler) Update(path string, req CreateRequest) (*UploadResponse, error
 	return h.Create(req)
 }
 
+// Append adds the CSV rows in req to an existing lookup table. The current
+// records are loaded, merged with the new rows and the table is re-uploaded
+// in place. Rows whose lookup field matches an existing record replace it.
+func (h *Handler) Append(path string, req CreateRequest) (*UploadResponse, error) {
+	if req.ParsePattern != "" {
+		return nil, fmt.Errorf("appending only supports CSV data (--parse-pattern cannot be used)")
+	}
+	if len(req.DataContent) == 0 {
+		return nil, fmt.Errorf("no data source specified")
+	}
+
+	// The table is rewritten with Overwrite, so every existing row must have
+	// been read; a partial read would silently drop the rest.
+	meta, err := h.Metadata(path)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := h.GetData(path, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range existing.Notifications {
+		if exec.ResultIsPartial(n) {
+			return nil, fmt.Errorf("cannot append to lookup table %q: reading its existing records returned a partial result: %s", path, n.Message)
+		}
+	}
+	if len(existing.Records) != meta.Records {
+		return nil, fmt.Errorf("cannot append to lookup table %q: read %d of its %d existing records", path, len(existing.Records), meta.Records)
+	}
+
+	merged, err := MergeCSV(existing.Records, req.DataContent, req.LookupField)
+	if err != nil {
+		return nil, err
+	}
+	req.DataContent = merged
+	req.DataSource = ""
+	return h.Update(path, req)
+}
+
+// MergeCSV appends CSV data to existing lookup records and returns the
+// combined table as CSV. The header of the new data defines the columns; every
+// column of the existing records must be present in it. When lookupField is
+// set, existing records whose key also appears in the new data are dropped.
+func MergeCSV(records []map[string]interface{}, data []byte, lookupField string) ([]byte, error) {
+	data = bytes.TrimPrefix(data, utf8BOM)
+	rows, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV data: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("CSV file has no columns")
+	}
+
+	headers := rows[0]
+	columns := make(map[string]bool, len(headers))
+	keyIndex := -1
+	for i, h := range headers {
+		headers[i] = strings.TrimSpace(h)
+		columns[headers[i]] = true
+		if headers[i] == lookupField {
+			keyIndex = i
+		}
+	}
+	if lookupField != "" && keyIndex < 0 {
+		return nil, fmt.Errorf("lookup field %q is not a column of the new data", lookupField)
+	}
+
+	var missing []string
+	for _, record := range records {
+		for col := range record {
+			if !columns[col] {
+				missing = append(missing, col)
+				columns[col] = true
+			}
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("new data is missing existing columns: %s", strings.Join(missing, ", "))
+	}
+
+	newKeys := make(map[string]bool)
+	if keyIndex >= 0 {
+		for _, row := range rows[1:] {
+			if keyIndex < len(row) {
+				newKeys[row[keyIndex]] = true
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(headers); err != nil {
+		return nil, fmt.Errorf("failed to write CSV: %w", err)
+	}
+	for _, record := range records {
+		if keyIndex >= 0 && newKeys[formatCSVValue(record[lookupField])] {
+			continue
+		}
+		row := make([]string, len(headers))
+		for i, h := range headers {
+			row[i] = formatCSVValue(record[h])
+		}
+		if err := w.Write(row); err != nil {
+			return nil, fmt.Errorf("failed to write CSV: %w", err)
+		}
+	}
+	if err := w.WriteAll(rows[1:]); err != nil {
+		return nil, fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// formatCSVValue renders a DQL record value as a CSV cell.
+func formatCSVValue(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(val)
+		if err != nil {
+			return fmt.Sprintf("%v", val)
+		}
+		return string(data)
+	default:
+		return fmt.Sprintf("%v", val)
+	}
+}
+
 // Delete deletes a lookup table
 func (h *Handler) Delete(path string) error {
 	// Validate path
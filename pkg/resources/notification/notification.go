@@ -15,6 +15,7 @@ type EventNotification struct {
 	Enabled          bool           `json:"enabled" table:"ENABLED"`
 	AppID            string         `json:"appId,omitempty" table:"APP_ID,wide"`
 	Owner            string         `json:"owner,omitempty" table:"OWNER,wide"`
+	Destination      string         `json:"destination,omitempty" table:"DESTINATION"`
 	TriggerConfig    map[string]any `json:"triggerConfig,omitempty" table:"-"`
 	ActionConfig     map[string]any `json:"actionConfig,omitempty" table:"-"`
 }
@@ -40,8 +41,9 @@ type ResourceNotificationList struct {
 }
 
 // fromSDKEventNotification converts an SDK EventNotification to CLI.
+// Destination is derived from the action configuration.
 func fromSDKEventNotification(s *sdknotification.EventNotification) EventNotification {
-	return EventNotification{
+	n := EventNotification{
 		ID:               s.ID,
 		NotificationType: s.NotificationType,
 		Enabled:          s.Enabled,
@@ -50,6 +52,8 @@ func fromSDKEventNotification(s *sdknotification.EventNotification) EventNotific
 		TriggerConfig:    s.TriggerConfig,
 		ActionConfig:     s.ActionConfig,
 	}
+	n.Destination = DestinationType(&n)
+	return n
 }
 
 // fromSDKResourceNotification converts an SDK ResourceNotification to CLI.
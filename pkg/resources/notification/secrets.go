@@ -0,0 +1,259 @@
+package notification
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// SecretMask replaces credentials in notification output.
+const SecretMask = "***"
+
+// Destination types reported in the DESTINATION column.
+const (
+	DestinationSlack     = "slack"
+	DestinationPagerDuty = "pagerduty"
+	DestinationTeams     = "msteams"
+	DestinationWebhook   = "webhook"
+	DestinationEmail     = "email"
+)
+
+// WebhookURL is a webhook URL found in a notification's configuration
+// (get notifications --output-webhook-urls).
+type WebhookURL struct {
+	ID          string `json:"id" table:"ID"`
+	Destination string `json:"destination" table:"DESTINATION"`
+	Path        string `json:"path" table:"FIELD"`
+	URL         string `json:"url" table:"URL"`
+}
+
+// isSecretField reports whether a config field or URL query parameter holds
+// a credential.
+func isSecretField(name string) bool {
+	lower := strings.ToLower(name)
+	return strings.Contains(lower, "secret") ||
+		strings.Contains(lower, "password") ||
+		strings.Contains(lower, "token") ||
+		strings.Contains(lower, "apikey") || strings.Contains(lower, "api_key") ||
+		strings.Contains(lower, "routingkey") || strings.Contains(lower, "routing_key") ||
+		strings.Contains(lower, "integrationkey") || strings.Contains(lower, "integration_key") ||
+		lower == "key" || lower == "code" || lower == "sig" || lower == "signature" || lower == "auth"
+}
+
+// MaskSecrets masks credentials in the trigger and action configuration of n
+// in place: values of secret-looking fields, secret query parameters of URLs,
+// and the token segments of Slack, Teams and PagerDuty webhook paths.
+func MaskSecrets(n *EventNotification) {
+	maskValue(n.TriggerConfig)
+	maskValue(n.ActionConfig)
+}
+
+// maskValue walks a decoded JSON value and masks secrets in place.
+func maskValue(v any) {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, item := range val {
+			if s, isString := item.(string); isString {
+				if isSecretField(k) && s != "" {
+					val[k] = SecretMask
+				} else {
+					val[k] = MaskURL(s)
+				}
+				continue
+			}
+			maskValue(item)
+		}
+	case []any:
+		for i, item := range val {
+			if s, isString := item.(string); isString {
+				val[i] = MaskURL(s)
+				continue
+			}
+			maskValue(item)
+		}
+	}
+}
+
+// MaskURL masks the credentials in an http(s) URL: the userinfo password,
+// secret query parameters, and token-bearing path segments of known webhook
+// providers. Anything that is not an absolute http(s) URL is returned as is.
+func MaskURL(raw string) string {
+	u, ok := parseWebURL(raw)
+	if !ok {
+		return raw
+	}
+
+	masked := false
+	if _, hasPassword := u.User.Password(); hasPassword {
+		u.User = url.UserPassword(u.User.Username(), SecretMask)
+		masked = true
+	}
+
+	if segments, changed := maskPath(u.Host, strings.Split(u.Path, "/")); changed {
+		u.Path = strings.Join(segments, "/")
+		u.RawPath = ""
+		masked = true
+	}
+
+	if u.RawQuery != "" {
+		query := u.Query()
+		queryMasked := false
+		for k := range query {
+			if isSecretField(k) {
+				query.Set(k, SecretMask)
+				queryMasked = true
+			}
+		}
+		if queryMasked {
+			u.RawQuery = query.Encode()
+			masked = true
+		}
+	}
+
+	if !masked {
+		return raw
+	}
+	// url.URL escapes the mask; keep it readable.
+	return strings.ReplaceAll(u.String(), url.QueryEscape(SecretMask), SecretMask)
+}
+
+// maskPath masks the token segments of webhook paths whose credentials are
+// part of the path rather than the query string.
+func maskPath(host string, segments []string) ([]string, bool) {
+	maskAfter := func(marker string) bool {
+		for i, s := range segments {
+			if s == marker && i+1 < len(segments) {
+				for j := i + 1; j < len(segments); j++ {
+					if segments[j] != "" {
+						segments[j] = SecretMask
+					}
+				}
+				return true
+			}
+		}
+		return false
+	}
+
+	host = strings.ToLower(host)
+	switch {
+	case strings.HasSuffix(host, "hooks.slack.com"):
+		// /services/T000/B000/XXXX and /triggers/T000/...
+		return segments, maskAfter("services") || maskAfter("triggers") || maskAfter("workflows")
+	case strings.Contains(host, "webhook.office.com"), strings.Contains(host, "logic.azure.com"):
+		return segments, maskAfter("webhookb2") || maskAfter("IncomingWebhook") || maskAfter("workflows")
+	case strings.HasSuffix(host, "discord.com"), strings.HasSuffix(host, "discordapp.com"):
+		return segments, maskAfter("webhooks")
+	case strings.HasSuffix(host, "pagerduty.com"):
+		return segments, maskAfter("integration")
+	}
+	return segments, false
+}
+
+// DestinationType infers where a notification delivers to from the URLs and
+// recipient fields in its action configuration. It returns "" when nothing
+// recognisable is configured.
+func DestinationType(n *EventNotification) string {
+	if urls := WebhookURLs(n); len(urls) > 0 {
+		return urls[0].Destination
+	}
+	if hasRecipients(n.ActionConfig) {
+		return DestinationEmail
+	}
+	return ""
+}
+
+// WebhookURLs returns the webhook URLs in the action configuration of n,
+// ordered by field path. URLs are returned as stored; call it after
+// MaskSecrets to list them masked.
+func WebhookURLs(n *EventNotification) []WebhookURL {
+	found := webhookURLs(n.ActionConfig, "")
+	sort.Slice(found, func(i, j int) bool { return found[i].path < found[j].path })
+
+	result := make([]WebhookURL, len(found))
+	for i, f := range found {
+		u, _ := parseWebURL(f.url)
+		result[i] = WebhookURL{ID: n.ID, Destination: destinationForHost(u.Host), Path: f.path, URL: f.url}
+	}
+	return result
+}
+
+type foundURL struct {
+	path, url string
+}
+
+// webhookURLs collects the http(s) URLs in a decoded JSON value together
+// with their dotted field path.
+func webhookURLs(v any, path string) []foundURL {
+	var found []foundURL
+	switch val := v.(type) {
+	case map[string]any:
+		for k, item := range val {
+			found = append(found, webhookURLs(item, joinPath(path, k))...)
+		}
+	case []any:
+		for _, item := range val {
+			found = append(found, webhookURLs(item, path)...)
+		}
+	case string:
+		if _, ok := parseWebURL(val); ok {
+			found = append(found, foundURL{path: path, url: val})
+		}
+	}
+	return found
+}
+
+func joinPath(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+// hasRecipients reports whether a config contains e-mail recipient fields.
+func hasRecipients(v any) bool {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, item := range val {
+			lower := strings.ToLower(k)
+			if lower == "recipients" || lower == "to" || lower == "cc" || lower == "email" || lower == "emails" {
+				return true
+			}
+			if hasRecipients(item) {
+				return true
+			}
+		}
+	case []any:
+		for _, item := range val {
+			if hasRecipients(item) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func destinationForHost(host string) string {
+	host = strings.ToLower(host)
+	switch {
+	case strings.HasSuffix(host, "slack.com"):
+		return DestinationSlack
+	case strings.HasSuffix(host, "pagerduty.com"):
+		return DestinationPagerDuty
+	case strings.Contains(host, "webhook.office.com"), strings.Contains(host, "logic.azure.com"):
+		return DestinationTeams
+	default:
+		return DestinationWebhook
+	}
+}
+
+// parseWebURL parses raw if it is an absolute http or https URL.
+func parseWebURL(raw string) (*url.URL, bool) {
+	if !strings.HasPrefix(raw, "http://") && !strings.HasPrefix(raw, "https://") {
+		return nil, false
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return nil, false
+	}
+	return u, true
+}
@@ -59,6 +59,25 @@ func (r *Resolver) ResolveID(resourceType ResourceType, identifier string) (stri
 	return "", r.ambiguousNameError(resourceType, identifier, matches)
 }
 
+// FindExact returns the resources whose name equals name (case-insensitive).
+// Unlike ResolveID it never treats the identifier as an ID and returns an
+// empty slice instead of an error when nothing matches, so callers can use it
+// as an existence check (e.g. create --if-not-exists).
+func (r *Resolver) FindExact(resourceType ResourceType, name string) ([]Resource, error) {
+	matches, err := r.searchByName(resourceType, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var exact []Resource
+	for _, m := range matches {
+		if strings.EqualFold(m.Name, name) {
+			exact = append(exact, m)
+		}
+	}
+	return exact, nil
+}
+
 // looksLikeID checks if a string looks like a resource ID
 func (r *Resolver) looksLikeID(str string, resourceType ResourceType) bool {
 	// Segments use short alphanumeric UIDs (e.g. "4lpVjcpcsjd") that are
@@ -825,3 +825,63 @@ func TestResolveID_SegmentUID_PriorityOverName(t *testing.T) {
 		t.Errorf("ResolveID() = %q, want %q (exact UID match should take priority)", id, "Stocks")
 	}
 }
+
+func TestFindExact_Workflow(t *testing.T) {
+	workflowList := workflow.WorkflowList{
+		Count: 2,
+		Results: []workflow.Workflow{
+			{ID: "workflow-id-1", Title: "Nightly Cleanup"},
+			{ID: "workflow-id-2", Title: "Nightly Cleanup (copy)"},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(workflowList)
+	}))
+	defer server.Close()
+
+	c, err := client.NewForTesting(server.URL, "test-token")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	res := NewResolver(c)
+
+	matches, err := res.FindExact(TypeWorkflow, "nightly cleanup")
+	if err != nil {
+		t.Fatalf("FindExact() error = %v", err)
+	}
+	if len(matches) != 1 || matches[0].ID != "workflow-id-1" {
+		t.Errorf("FindExact() = %+v, want only workflow-id-1", matches)
+	}
+
+	matches, err = res.FindExact(TypeWorkflow, "Nightly")
+	if err != nil {
+		t.Fatalf("FindExact() error = %v", err)
+	}
+	if len(matches) != 0 {
+		t.Errorf("FindExact() should not return substring matches, got %+v", matches)
+	}
+}
+
+func TestFindExact_Segment(t *testing.T) {
+	server := newSegmentMockServer(t, []segment.FilterSegment{
+		{UID: "4lpVjcpcsjd", Name: "Astroshop - Small"},
+		{UID: "WyAl8Sapu4Z", Name: "Astroshop"},
+	})
+	defer server.Close()
+
+	c, err := client.NewForTesting(server.URL, "test-token")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	res := NewResolver(c)
+
+	matches, err := res.FindExact(TypeSegment, "Astroshop")
+	if err != nil {
+		t.Fatalf("FindExact() error = %v", err)
+	}
+	if len(matches) != 1 || matches[0].ID != "WyAl8Sapu4Z" {
+		t.Errorf("FindExact() = %+v, want only WyAl8Sapu4Z", matches)
+	}
+}
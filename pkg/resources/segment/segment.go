@@ -42,6 +42,12 @@ type FilterSegment struct {
 	AllowedOperations []string   `json:"allowedOperations,omitempty" table:"-"`
 }
 
+// ManagedFields lists the server-managed fields that 'get -o json|yaml' omits
+// unless --show-managed-fields is set.
+func (s FilterSegment) ManagedFields() []string {
+	return []string{"owner", "version", "isReadyMade", "allowedOperations"}
+}
+
 // Include represents a single include rule within a segment.
 type Include = sdksegment.Include
 
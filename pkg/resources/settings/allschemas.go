@@ -0,0 +1,77 @@
+package settings
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/dynatrace-oss/dtctl/sdk/httpclient"
+)
+
+// SchemaError records a schema whose objects could not be listed during a
+// cross-schema sweep.
+type SchemaError struct {
+	SchemaID string
+	Err      error
+}
+
+// ListObjectsAllSchemas lists the objects of every schema whose ID matches
+// schemaPattern (a glob such as "builtin:openpipeline.*"; empty matches all),
+// querying up to concurrency schemas at a time. A schema that fails to list
+// does not abort the sweep; it is reported in the returned SchemaErrors.
+// Objects are returned grouped in schema list order. page controls how each
+// schema's objects are paged; its Limit is ignored.
+func (h *Handler) ListObjectsAllSchemas(schemaPattern, scope, filter string, page httpclient.PageOptions, concurrency int) ([]SettingsObject, []SchemaError, error) {
+	page.Limit = 0
+
+	if _, err := FilterSchemas(nil, schemaPattern); err != nil {
+		return nil, nil, err
+	}
+
+	schemas, err := h.ListSchemas()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list schemas: %w", err)
+	}
+	matched, _ := FilterSchemas(schemas.Items, schemaPattern)
+
+	// Objects are listed per schema ID, not per version.
+	var schemaIDs []string
+	for _, s := range LatestSchemaVersions(matched) {
+		schemaIDs = append(schemaIDs, s.SchemaID)
+	}
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([][]SettingsObject, len(schemaIDs))
+	errs := make([]error, len(schemaIDs))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i, schemaID := range schemaIDs {
+		wg.Add(1)
+		go func(i int, schemaID string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			list, err := h.ListObjectsWithOptions(schemaID, scope, filter, page)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			results[i] = list.Items
+		}(i, schemaID)
+	}
+	wg.Wait()
+
+	var items []SettingsObject
+	var failed []SchemaError
+	for i, schemaID := range schemaIDs {
+		if errs[i] != nil {
+			failed = append(failed, SchemaError{SchemaID: schemaID, Err: errs[i]})
+			continue
+		}
+		items = append(items, results[i]...)
+	}
+	return items, failed, nil
+}
@@ -0,0 +1,66 @@
+package settings
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/dynatrace-oss/dtctl/sdk/httpclient"
+)
+
+func TestListObjectsAllSchemas(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/platform/classic/environment-api/v2/settings/schemas", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(SchemaList{
+			Items: []Schema{
+				{SchemaID: "builtin:openpipeline.logs.pipelines"},
+				{SchemaID: "builtin:openpipeline.spans.pipelines"},
+				{SchemaID: "builtin:openpipeline.broken"},
+				{SchemaID: "builtin:alerting.profile"},
+			},
+			TotalCount: 4,
+		})
+	})
+	mux.HandleFunc("/platform/classic/environment-api/v2/settings/objects", func(w http.ResponseWriter, r *http.Request) {
+		schemaID := r.URL.Query().Get("schemaIds")
+		switch schemaID {
+		case "builtin:openpipeline.broken":
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		case "builtin:alerting.profile":
+			t.Errorf("schema %s should have been filtered out", schemaID)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		// The list API leaves schemaId empty; the handler fills it in.
+		json.NewEncoder(w).Encode(SettingsObjectsList{
+			Items:      []SettingsObject{{ObjectID: "obj-" + schemaID, Scope: "environment"}},
+			TotalCount: 1,
+		})
+	})
+	h, cleanup := newTestHandler(t, mux)
+	defer cleanup()
+
+	items, failed, err := h.ListObjectsAllSchemas("builtin:openpipeline.*", "", "", httpclient.PageOptions{FirstPageOnly: true}, 2)
+	if err != nil {
+		t.Fatalf("ListObjectsAllSchemas() error = %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("expected 2 objects, got %d: %+v", len(items), items)
+	}
+	if items[0].SchemaID != "builtin:openpipeline.logs.pipelines" || items[1].SchemaID != "builtin:openpipeline.spans.pipelines" {
+		t.Errorf("objects not in schema order or missing schema IDs: %+v", items)
+	}
+	if len(failed) != 1 || failed[0].SchemaID != "builtin:openpipeline.broken" {
+		t.Errorf("failed = %+v, want the broken schema", failed)
+	}
+}
+
+func TestListObjectsAllSchemas_InvalidPattern(t *testing.T) {
+	h, cleanup := newTestHandler(t, http.NewServeMux())
+	defer cleanup()
+
+	if _, _, err := h.ListObjectsAllSchemas("builtin:[", "", "", httpclient.PageOptions{}, 1); err == nil {
+		t.Fatal("expected error for invalid pattern")
+	}
+}
@@ -0,0 +1,88 @@
+package settings
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// containsTerm matches "<field> contains <value>", case-insensitively.
+var containsTerm = regexp.MustCompile(`(?i)^(\S+)\s+contains\s+(.+)$`)
+
+// FieldSelectorFilter translates a kubectl-style field selector into a
+// Settings 2.0 filter expression for ListObjectsFiltered. Terms are
+// comma-separated and combined with "and"; each is one of
+//
+//	field==value   (or field=value)
+//	field!=value
+//	field contains value
+//
+// Fields are paths into the object, e.g. value.enabled or scope. Values
+// true, false, null, and numbers are sent as-is; anything else is quoted.
+func FieldSelectorFilter(selector string) (string, error) {
+	var clauses []string
+	for _, term := range strings.Split(selector, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+		clause, err := fieldSelectorClause(term)
+		if err != nil {
+			return "", err
+		}
+		clauses = append(clauses, clause)
+	}
+	if len(clauses) == 0 {
+		return "", fmt.Errorf("field selector is empty")
+	}
+	return strings.Join(clauses, " and "), nil
+}
+
+func fieldSelectorClause(term string) (string, error) {
+	if m := containsTerm.FindStringSubmatch(term); m != nil {
+		return fmt.Sprintf("contains(%s, %s)", m[1], quoteFilterString(unquote(strings.TrimSpace(m[2])))), nil
+	}
+
+	for _, op := range []struct{ selector, filter string }{
+		{"!=", "!="},
+		{"==", "="},
+		{"=", "="},
+	} {
+		field, value, ok := strings.Cut(term, op.selector)
+		if !ok {
+			continue
+		}
+		field = strings.TrimSpace(field)
+		if field == "" {
+			return "", fmt.Errorf("invalid field selector %q: missing field name", term)
+		}
+		return fmt.Sprintf("%s %s %s", field, op.filter, filterValue(strings.TrimSpace(value))), nil
+	}
+	return "", fmt.Errorf("invalid field selector %q: expected field==value, field!=value, or field contains value", term)
+}
+
+// filterValue keeps booleans, null, and numbers bare and quotes the rest.
+func filterValue(v string) string {
+	switch v {
+	case "true", "false", "null":
+		return v
+	}
+	if _, err := strconv.ParseFloat(v, 64); err == nil {
+		return v
+	}
+	return quoteFilterString(unquote(v))
+}
+
+// unquote strips one pair of surrounding single or double quotes.
+func unquote(v string) string {
+	if len(v) >= 2 && (v[0] == '"' || v[0] == '\'') && v[len(v)-1] == v[0] {
+		return v[1 : len(v)-1]
+	}
+	return v
+}
+
+func quoteFilterString(v string) string {
+	v = strings.ReplaceAll(v, `\`, `\\`)
+	return "'" + strings.ReplaceAll(v, "'", `\'`) + "'"
+}
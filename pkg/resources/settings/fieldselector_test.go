@@ -0,0 +1,34 @@
+package settings
+
+import "testing"
+
+func TestFieldSelectorFilter(t *testing.T) {
+	tests := []struct {
+		selector string
+		want     string
+		wantErr  bool
+	}{
+		{selector: "value.enabled==true", want: "value.enabled = true"},
+		{selector: "value.enabled=false", want: "value.enabled = false"},
+		{selector: "value.priority!=3", want: "value.priority != 3"},
+		{selector: "value.name==prod logs", want: "value.name = 'prod logs'"},
+		{selector: `value.name=="it's"`, want: `value.name = 'it\'s'`},
+		{selector: "value.name contains prod", want: "contains(value.name, 'prod')"},
+		{selector: "value.enabled==true, value.name CONTAINS 'x'", want: "value.enabled = true and contains(value.name, 'x')"},
+		{selector: "", wantErr: true},
+		{selector: "value.enabled", wantErr: true},
+		{selector: "==true", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			got, err := FieldSelectorFilter(tt.selector)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FieldSelectorFilter(%q) error = %v, wantErr %v", tt.selector, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("FieldSelectorFilter(%q) = %q, want %q", tt.selector, got, tt.want)
+			}
+		})
+	}
+}
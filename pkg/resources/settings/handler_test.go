@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/dynatrace-oss/dtctl/pkg/client"
+	"github.com/dynatrace-oss/dtctl/sdk/httpclient"
 )
 
 // newTestHandler creates a handler backed by a test server running the given handler func.
@@ -149,7 +150,7 @@ func TestListObjects_Success(t *testing.T) {
 	h, cleanup := newTestHandler(t, mux)
 	defer cleanup()
 
-	result, err := h.ListObjects("builtin:alerting.profile", "", 0)
+	result, err := h.ListObjects("builtin:alerting.profile", "", 0, 0)
 	if err != nil {
 		t.Fatalf("ListObjects() error = %v", err)
 	}
@@ -196,7 +197,7 @@ func TestListObjects_Pagination(t *testing.T) {
 	h, cleanup := newTestHandler(t, mux)
 	defer cleanup()
 
-	result, err := h.ListObjects("", "", 10) // chunkSize>0 enables pagination
+	result, err := h.ListObjects("", "", 10, 0) // chunkSize>0 enables pagination
 	if err != nil {
 		t.Fatalf("ListObjects() error = %v", err)
 	}
@@ -228,7 +229,7 @@ func TestListObjects_ScopePopulatedFromAPI(t *testing.T) {
 	h, cleanup := newTestHandler(t, mux)
 	defer cleanup()
 
-	result, err := h.ListObjects("", "", 0)
+	result, err := h.ListObjects("", "", 0, 0)
 	if err != nil {
 		t.Fatalf("ListObjects() error = %v", err)
 	}
@@ -294,7 +295,7 @@ func TestListObjects_PaginationWithFilters(t *testing.T) {
 	h, cleanup := newTestHandler(t, mux)
 	defer cleanup()
 
-	result, err := h.ListObjects("builtin:alerting.profile", "environment", 10)
+	result, err := h.ListObjects("builtin:alerting.profile", "environment", 10, 0)
 	if err != nil {
 		t.Fatalf("ListObjects() error = %v", err)
 	}
@@ -306,6 +307,30 @@ func TestListObjects_PaginationWithFilters(t *testing.T) {
 	}
 }
 
+func TestListObjectsFiltered_SendsFilter(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/platform/classic/environment-api/v2/settings/objects", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("filter"); got != "value.enabled = true" {
+			t.Errorf("filter = %q, want %q", got, "value.enabled = true")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(SettingsObjectsList{
+			Items:      []SettingsObject{{ObjectID: "obj1", Scope: "environment"}},
+			TotalCount: 1,
+		})
+	})
+	h, cleanup := newTestHandler(t, mux)
+	defer cleanup()
+
+	result, err := h.ListObjectsFiltered("builtin:alerting.profile", "", "value.enabled = true", 10, 0)
+	if err != nil {
+		t.Fatalf("ListObjectsFiltered() error = %v", err)
+	}
+	if len(result.Items) != 1 {
+		t.Errorf("expected 1 item, got %d", len(result.Items))
+	}
+}
+
 func TestListObjects_NotFound(t *testing.T) {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/platform/classic/environment-api/v2/settings/objects", func(w http.ResponseWriter, r *http.Request) {
@@ -314,12 +339,61 @@ func TestListObjects_NotFound(t *testing.T) {
 	h, cleanup := newTestHandler(t, mux)
 	defer cleanup()
 
-	_, err := h.ListObjects("unknown-schema", "", 0)
+	_, err := h.ListObjects("unknown-schema", "", 0, 0)
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
 }
 
+// --- InsertAfterPosition ---
+
+func TestInsertAfterPosition_SecondPage(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/platform/classic/environment-api/v2/settings/objects", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("nextPageKey") == "" {
+			json.NewEncoder(w).Encode(SettingsObjectsList{
+				Items:       []SettingsObject{{ObjectID: "obj1"}, {ObjectID: "obj2"}},
+				TotalCount:  4,
+				NextPageKey: "page2",
+			})
+			return
+		}
+		json.NewEncoder(w).Encode(SettingsObjectsList{
+			Items:      []SettingsObject{{ObjectID: "obj3"}, {ObjectID: "obj4"}},
+			TotalCount: 4,
+		})
+	})
+	h, cleanup := newTestHandler(t, mux)
+	defer cleanup()
+
+	got, err := h.InsertAfterPosition("builtin:test", "environment", 4, httpclient.PageOptions{PageSize: 2, FirstPageOnly: true})
+	if err != nil {
+		t.Fatalf("InsertAfterPosition() error = %v", err)
+	}
+	if got == nil || *got != "obj3" {
+		t.Errorf("InsertAfterPosition() = %v, want obj3", got)
+	}
+}
+
+func TestInsertAfterPosition_IncompleteList(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/platform/classic/environment-api/v2/settings/objects", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(SettingsObjectsList{
+			Items:      []SettingsObject{{ObjectID: "obj1"}},
+			TotalCount: 5,
+		})
+	})
+	h, cleanup := newTestHandler(t, mux)
+	defer cleanup()
+
+	got, err := h.InsertAfterPosition("builtin:test", "environment", 4, httpclient.PageOptions{})
+	if err == nil {
+		t.Fatalf("InsertAfterPosition() = %v, want error instead of appending", got)
+	}
+}
+
 // --- getByObjectID (via Get) ---
 
 func TestGet_ByObjectID_Success(t *testing.T) {
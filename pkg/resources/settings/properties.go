@@ -0,0 +1,120 @@
+package settings
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SchemaProperty is one leaf property of a settings schema, with nested
+// objects flattened into a dotted path ("rules[].threshold").
+type SchemaProperty struct {
+	Path        string   `json:"path" table:"PATH"`
+	Type        string   `json:"type" table:"TYPE"`
+	Required    bool     `json:"required" table:"REQUIRED"`
+	Default     any      `json:"default,omitempty" table:"-"`
+	Enum        []string `json:"enum,omitempty" table:"-"`
+	DisplayName string   `json:"displayName,omitempty" table:"DISPLAY_NAME,wide"`
+
+	// Table-only renderings of Default and Enum.
+	DefaultText string `json:"-" table:"DEFAULT"`
+	EnumText    string `json:"-" table:"ENUM"`
+}
+
+// FlattenProperties walks a settings schema definition (as returned by
+// GetSchema) and returns one row per leaf property, sorted by path. Nested
+// types ({"$ref": "#/types/X"}) are expanded into dotted paths, and list/set
+// items are marked with "[]". Requiredness follows Scaffold: a property is
+// required when it is not nullable and has no default.
+func FlattenProperties(schema map[string]any) []SchemaProperty {
+	s := scaffolder{
+		types: asMap(schema["types"]),
+		enums: asMap(schema["enums"]),
+	}
+	var rows []SchemaProperty
+	s.flatten(asMap(schema["properties"]), "", 0, &rows)
+	return rows
+}
+
+func (s scaffolder) flatten(props map[string]any, prefix string, depth int, rows *[]SchemaProperty) {
+	keys := make([]string, 0, len(props))
+	for k := range props {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		prop := asMap(props[k])
+		path := prefix + k
+
+		// Nested object, or a list/set of nested objects: recurse.
+		nested, itemPath := "", path
+		if ref := refName(prop["type"]); ref != "" {
+			nested = ref
+		} else if typ, _ := prop["type"].(string); typ == "list" || typ == "set" {
+			if ref := refName(asMap(prop["items"])["type"]); ref != "" {
+				nested, itemPath = ref, path+"[]"
+			}
+		}
+		if nested != "" && depth < maxScaffoldDepth {
+			s.flatten(asMap(asMap(s.types[nested])["properties"]), itemPath+".", depth+1, rows)
+			continue
+		}
+
+		displayName, _ := prop["displayName"].(string)
+		row := SchemaProperty{
+			Path:        path,
+			Type:        propertyType(prop),
+			Required:    isRequired(prop),
+			Default:     prop["default"],
+			Enum:        s.enumValues(prop),
+			DisplayName: displayName,
+		}
+		if row.Default != nil {
+			row.DefaultText = formatDefault(row.Default)
+		}
+		row.EnumText = strings.Join(row.Enum, ", ")
+		*rows = append(*rows, row)
+	}
+}
+
+// propertyType renders a property type for display: "text", "enum",
+// "list<text>", or "object" for an unexpanded nested type.
+func propertyType(prop map[string]any) string {
+	if refName(prop["type"]) != "" {
+		return "object"
+	}
+	typ, _ := prop["type"].(string)
+	if typ == "list" || typ == "set" {
+		items := asMap(prop["items"])
+		if refName(items["type"]) != "" {
+			return typ + "<object>"
+		}
+		if itemType, ok := items["type"].(string); ok {
+			return typ + "<" + itemType + ">"
+		}
+	}
+	return typ
+}
+
+// isRequired reports whether a value must be supplied: the property is not
+// nullable and has no default.
+func isRequired(prop map[string]any) bool {
+	nullable, _ := prop["nullable"].(bool)
+	_, hasDefault := prop["default"]
+	return !nullable && !hasDefault
+}
+
+// formatDefault renders a default value compactly: strings as-is, everything
+// else as JSON.
+func formatDefault(v any) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
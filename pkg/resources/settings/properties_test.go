@@ -0,0 +1,109 @@
+package settings
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlattenProperties(t *testing.T) {
+	schema := map[string]any{
+		"properties": map[string]any{
+			"enabled": map[string]any{"type": "boolean", "displayName": "Enabled", "default": false},
+			"name":    map[string]any{"type": "text"},
+			"note":    map[string]any{"type": "text", "nullable": true},
+			"mode":    map[string]any{"type": "enum", "referencedType": "Mode", "default": "AUTO"},
+			"tags":    map[string]any{"type": "set", "items": map[string]any{"type": "text"}},
+			"scope": map[string]any{
+				"type": map[string]any{"$ref": "#/types/Scope"},
+			},
+			"rules": map[string]any{
+				"type":  "list",
+				"items": map[string]any{"type": map[string]any{"$ref": "#/types/Rule"}},
+			},
+		},
+		"types": map[string]any{
+			"Rule": map[string]any{
+				"properties": map[string]any{
+					"threshold": map[string]any{"type": "integer", "default": 5},
+					"severity":  map[string]any{"type": "enum", "referencedType": "Mode"},
+				},
+			},
+			"Scope": map[string]any{
+				"properties": map[string]any{
+					"entity": map[string]any{"type": "text"},
+				},
+			},
+		},
+		"enums": map[string]any{
+			"Mode": map[string]any{
+				"items": []any{
+					map[string]any{"value": "AUTO"},
+					map[string]any{"value": "MANUAL"},
+				},
+			},
+		},
+	}
+
+	rows := FlattenProperties(schema)
+
+	var paths []string
+	byPath := map[string]SchemaProperty{}
+	for _, r := range rows {
+		paths = append(paths, r.Path)
+		byPath[r.Path] = r
+	}
+	wantPaths := []string{"enabled", "mode", "name", "note", "rules[].severity", "rules[].threshold", "scope.entity", "tags"}
+	if !reflect.DeepEqual(paths, wantPaths) {
+		t.Fatalf("paths = %v, want %v", paths, wantPaths)
+	}
+
+	tests := []struct {
+		path     string
+		typ      string
+		required bool
+		def      string
+		enum     string
+	}{
+		{path: "enabled", typ: "boolean", def: "false"},
+		{path: "mode", typ: "enum", def: "AUTO", enum: "AUTO, MANUAL"},
+		{path: "name", typ: "text", required: true},
+		{path: "note", typ: "text"},
+		{path: "rules[].severity", typ: "enum", required: true, enum: "AUTO, MANUAL"},
+		{path: "rules[].threshold", typ: "integer", def: "5"},
+		{path: "scope.entity", typ: "text", required: true},
+		{path: "tags", typ: "set<text>", required: true},
+	}
+	for _, tt := range tests {
+		got := byPath[tt.path]
+		if got.Type != tt.typ || got.Required != tt.required || got.DefaultText != tt.def || got.EnumText != tt.enum {
+			t.Errorf("%s = {type:%q required:%v default:%q enum:%q}, want {type:%q required:%v default:%q enum:%q}",
+				tt.path, got.Type, got.Required, got.DefaultText, got.EnumText, tt.typ, tt.required, tt.def, tt.enum)
+		}
+	}
+	if byPath["enabled"].DisplayName != "Enabled" {
+		t.Errorf("enabled display name = %q, want Enabled", byPath["enabled"].DisplayName)
+	}
+}
+
+func TestFlattenProperties_SelfReferenceBounded(t *testing.T) {
+	schema := map[string]any{
+		"properties": map[string]any{
+			"node": map[string]any{"type": map[string]any{"$ref": "#/types/Node"}},
+		},
+		"types": map[string]any{
+			"Node": map[string]any{
+				"properties": map[string]any{
+					"child": map[string]any{"type": map[string]any{"$ref": "#/types/Node"}},
+				},
+			},
+		},
+	}
+
+	rows := FlattenProperties(schema)
+	if len(rows) != 1 {
+		t.Fatalf("expected a single truncated row, got %d: %+v", len(rows), rows)
+	}
+	if rows[0].Type != "object" {
+		t.Errorf("truncated row type = %q, want object", rows[0].Type)
+	}
+}
@@ -0,0 +1,185 @@
+package settings
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// maxScaffoldDepth bounds nesting of $ref types so self-referencing schemas
+// still produce a finite skeleton.
+const maxScaffoldDepth = 6
+
+// Scaffold renders a commented YAML skeleton of a settings value from a schema
+// definition (as returned by GetSchema). Fields with a default get it, required
+// fields get an empty value of their type, and optional fields without a
+// default are null. Each field carries its display name, description, and
+// allowed enum values as a comment. The output is meant to be edited and passed
+// to 'dtctl create settings -f'.
+func Scaffold(schema map[string]any) ([]byte, error) {
+	s := scaffolder{
+		types: asMap(schema["types"]),
+		enums: asMap(schema["enums"]),
+	}
+	root := s.mapping(asMap(schema["properties"]), 0)
+
+	schemaID, _ := schema["schemaId"].(string)
+	version, _ := schema["version"].(string)
+	header := fmt.Sprintf("Settings value for %s (schema version %s)\n"+
+		"Create with: dtctl create settings -f <file> --schema %s --scope <scope>", schemaID, version, schemaID)
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	doc := &yaml.Node{Kind: yaml.DocumentNode, HeadComment: header, Content: []*yaml.Node{root}}
+	if err := enc.Encode(doc); err != nil {
+		return nil, fmt.Errorf("failed to render scaffold: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("failed to render scaffold: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+type scaffolder struct {
+	types map[string]any
+	enums map[string]any
+}
+
+// mapping builds a YAML mapping for a properties block, keys sorted.
+func (s scaffolder) mapping(props map[string]any, depth int) *yaml.Node {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	keys := make([]string, 0, len(props))
+	for k := range props {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		prop := asMap(props[k])
+		key := &yaml.Node{Kind: yaml.ScalarNode, Value: k, HeadComment: s.comment(prop)}
+		node.Content = append(node.Content, key, s.value(prop, depth))
+	}
+	return node
+}
+
+// value returns the skeleton value for one property.
+func (s scaffolder) value(prop map[string]any, depth int) *yaml.Node {
+	if def, ok := prop["default"]; ok {
+		n := &yaml.Node{}
+		if err := n.Encode(def); err == nil {
+			return n
+		}
+	}
+	if nullable, _ := prop["nullable"].(bool); nullable {
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+	}
+	return s.zero(prop["type"], prop, depth)
+}
+
+// zero returns an empty value for a property type: a string type name,
+// or a {"$ref": "#/types/X"} reference to a nested type.
+func (s scaffolder) zero(typ any, prop map[string]any, depth int) *yaml.Node {
+	if ref := refName(typ); ref != "" {
+		if depth >= maxScaffoldDepth {
+			return &yaml.Node{Kind: yaml.MappingNode, Style: yaml.FlowStyle}
+		}
+		return s.mapping(asMap(asMap(s.types[ref])["properties"]), depth+1)
+	}
+
+	switch typ {
+	case "boolean":
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: "false"}
+	case "integer":
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: "0"}
+	case "float":
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!float", Value: "0.0"}
+	case "enum":
+		if values := s.enumValues(prop); len(values) > 0 {
+			return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: values[0]}
+		}
+	case "list", "set":
+		list := &yaml.Node{Kind: yaml.SequenceNode}
+		items := asMap(prop["items"])
+		if refName(items["type"]) != "" {
+			list.Content = append(list.Content, s.zero(items["type"], items, depth))
+		} else {
+			list.Style = yaml.FlowStyle
+		}
+		return list
+	}
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: ""}
+}
+
+// comment describes a property: display name, description, requiredness,
+// and enum values.
+func (s scaffolder) comment(prop map[string]any) string {
+	var lines []string
+	title, _ := prop["displayName"].(string)
+	desc, _ := prop["description"].(string)
+	desc = strings.TrimSpace(desc)
+	switch {
+	case title != "" && desc != "":
+		lines = append(lines, title+": "+desc)
+	case title != "":
+		lines = append(lines, title)
+	case desc != "":
+		lines = append(lines, desc)
+	}
+
+	var attrs []string
+	if typ, ok := prop["type"].(string); ok {
+		attrs = append(attrs, typ)
+	}
+	if isRequired(prop) {
+		attrs = append(attrs, "required")
+	} else {
+		attrs = append(attrs, "optional")
+	}
+	lines = append(lines, "("+strings.Join(attrs, ", ")+")")
+
+	if values := s.enumValues(prop); len(values) > 0 {
+		lines = append(lines, "One of: "+strings.Join(values, ", "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// enumValues returns the allowed values of an enum property (or of the items
+// of an enum list).
+func (s scaffolder) enumValues(prop map[string]any) []string {
+	typ, _ := prop["type"].(string)
+	if typ == "list" || typ == "set" {
+		prop = asMap(prop["items"])
+		typ, _ = prop["type"].(string)
+	}
+	if typ != "enum" {
+		return nil
+	}
+	ref, _ := prop["referencedType"].(string)
+	var values []string
+	for _, item := range asSlice(asMap(s.enums[ref])["items"]) {
+		if v, ok := asMap(item)["value"]; ok {
+			values = append(values, fmt.Sprint(v))
+		}
+	}
+	return values
+}
+
+// refName returns X for a {"$ref": "#/types/X"} type, or "".
+func refName(typ any) string {
+	ref, _ := asMap(typ)["$ref"].(string)
+	return strings.TrimPrefix(ref, "#/types/")
+}
+
+func asMap(v any) map[string]any {
+	m, _ := v.(map[string]any)
+	return m
+}
+
+func asSlice(v any) []any {
+	s, _ := v.([]any)
+	return s
+}
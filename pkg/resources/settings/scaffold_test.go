@@ -0,0 +1,83 @@
+package settings
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestScaffold(t *testing.T) {
+	schema := map[string]any{
+		"schemaId": "builtin:test.schema",
+		"version":  "1.2.3",
+		"properties": map[string]any{
+			"enabled": map[string]any{"type": "boolean", "displayName": "Enabled", "default": true},
+			"name":    map[string]any{"type": "text", "displayName": "Name", "description": "Rule name"},
+			"note":    map[string]any{"type": "text", "nullable": true},
+			"mode": map[string]any{
+				"type": "enum", "referencedType": "Mode",
+			},
+			"rules": map[string]any{
+				"type":  "list",
+				"items": map[string]any{"type": map[string]any{"$ref": "#/types/Rule"}},
+			},
+		},
+		"types": map[string]any{
+			"Rule": map[string]any{
+				"properties": map[string]any{
+					"threshold": map[string]any{"type": "integer", "default": 5},
+				},
+			},
+		},
+		"enums": map[string]any{
+			"Mode": map[string]any{
+				"items": []any{
+					map[string]any{"value": "AUTO"},
+					map[string]any{"value": "MANUAL"},
+				},
+			},
+		},
+	}
+
+	out, err := Scaffold(schema)
+	if err != nil {
+		t.Fatalf("Scaffold() error = %v", err)
+	}
+	text := string(out)
+
+	for _, want := range []string{
+		"--schema builtin:test.schema",
+		"# Name: Rule name",
+		"(text, required)",
+		"One of: AUTO, MANUAL",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("scaffold missing %q:\n%s", want, text)
+		}
+	}
+
+	var value map[string]any
+	if err := yaml.Unmarshal(out, &value); err != nil {
+		t.Fatalf("scaffold is not valid YAML: %v", err)
+	}
+	if value["enabled"] != true {
+		t.Errorf("enabled = %v, want default true", value["enabled"])
+	}
+	if value["name"] != "" {
+		t.Errorf("name = %v, want empty string", value["name"])
+	}
+	if value["note"] != nil {
+		t.Errorf("note = %v, want null for optional field", value["note"])
+	}
+	if value["mode"] != "AUTO" {
+		t.Errorf("mode = %v, want first enum value", value["mode"])
+	}
+	rules, ok := value["rules"].([]any)
+	if !ok || len(rules) != 1 {
+		t.Fatalf("rules = %v, want one scaffolded item", value["rules"])
+	}
+	if rule, _ := rules[0].(map[string]any); rule["threshold"] != 5 {
+		t.Errorf("rules[0].threshold = %v, want 5", rule["threshold"])
+	}
+}
@@ -0,0 +1,54 @@
+package settings
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/dynatrace-oss/dtctl/pkg/util/versionutil"
+)
+
+// LatestSchema is the highest version of a schema together with the number
+// of versions the environment offers (get settings-schemas
+// --latest-version-only).
+type LatestSchema struct {
+	Schema   `yaml:",inline"`
+	Versions int `json:"versions" yaml:"versions" table:"VERSIONS"`
+}
+
+// FilterSchemas keeps the schemas whose ID matches the glob pattern (e.g.
+// "builtin:openpipeline.*"). An empty pattern keeps all of them.
+func FilterSchemas(schemas []Schema, pattern string) ([]Schema, error) {
+	if pattern == "" {
+		return schemas, nil
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("invalid schema filter %q: %w", pattern, err)
+	}
+	kept := make([]Schema, 0, len(schemas))
+	for _, s := range schemas {
+		if ok, _ := path.Match(pattern, s.SchemaID); ok {
+			kept = append(kept, s)
+		}
+	}
+	return kept, nil
+}
+
+// LatestSchemaVersions groups schemas by ID and keeps the highest version of
+// each, in order of first appearance.
+func LatestSchemaVersions(schemas []Schema) []LatestSchema {
+	index := make(map[string]int)
+	var latest []LatestSchema
+	for _, s := range schemas {
+		i, seen := index[s.SchemaID]
+		if !seen {
+			index[s.SchemaID] = len(latest)
+			latest = append(latest, LatestSchema{Schema: s, Versions: 1})
+			continue
+		}
+		latest[i].Versions++
+		if versionutil.Compare(s.Version, latest[i].Version) > 0 {
+			latest[i].Schema = s
+		}
+	}
+	return latest
+}
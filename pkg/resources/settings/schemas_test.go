@@ -0,0 +1,48 @@
+package settings
+
+import (
+	"testing"
+)
+
+func TestLatestSchemaVersions(t *testing.T) {
+	schemas := []Schema{
+		{SchemaID: "builtin:alerting.profile", Version: "1.2.9"},
+		{SchemaID: "builtin:openpipeline.logs.pipelines", Version: "1.0"},
+		{SchemaID: "builtin:alerting.profile", Version: "1.2.10"},
+		{SchemaID: "builtin:alerting.profile", Version: "1.1"},
+	}
+
+	got := LatestSchemaVersions(schemas)
+	if len(got) != 2 {
+		t.Fatalf("got %d schemas, want 2", len(got))
+	}
+	if got[0].SchemaID != "builtin:alerting.profile" || got[0].Version != "1.2.10" || got[0].Versions != 3 {
+		t.Errorf("got[0] = %+v, want builtin:alerting.profile 1.2.10 with 3 versions", got[0])
+	}
+	if got[1].SchemaID != "builtin:openpipeline.logs.pipelines" || got[1].Versions != 1 {
+		t.Errorf("got[1] = %+v, want builtin:openpipeline.logs.pipelines with 1 version", got[1])
+	}
+}
+
+func TestFilterSchemas(t *testing.T) {
+	schemas := []Schema{
+		{SchemaID: "builtin:alerting.profile"},
+		{SchemaID: "builtin:openpipeline.logs.pipelines"},
+		{SchemaID: "builtin:openpipeline.spans.pipelines"},
+	}
+
+	got, err := FilterSchemas(schemas, "builtin:openpipeline.*")
+	if err != nil {
+		t.Fatalf("FilterSchemas() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Errorf("got %d schemas, want 2", len(got))
+	}
+
+	if got, _ := FilterSchemas(schemas, ""); len(got) != 3 {
+		t.Errorf("empty pattern kept %d schemas, want 3", len(got))
+	}
+	if _, err := FilterSchemas(schemas, "["); err == nil {
+		t.Error("expected error for invalid pattern")
+	}
+}
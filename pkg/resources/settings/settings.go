@@ -3,6 +3,7 @@ package settings
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strings"
 
 	"github.com/dynatrace-oss/dtctl/pkg/client"
@@ -53,6 +54,12 @@ type SettingsObject struct {
 	ScopeID       string `json:"-" yaml:"-" table:"SCOPE_ID,wide"`
 }
 
+// ManagedFields lists the server-managed fields that 'get -o json|yaml' omits
+// unless --show-managed-fields is set.
+func (s SettingsObject) ManagedFields() []string {
+	return []string{"modificationInfo"}
+}
+
 // populateDisplayFields computes ObjectIDShort from ObjectID and parses
 // ScopeType / ScopeID from the Scope field.
 // Scope format: "<TYPE>-<ID>" for entity scopes, bare type name for singletons.
@@ -141,9 +148,22 @@ func (h *Handler) GetSchema(schemaID string) (map[string]any, error) {
 	return h.sdk.GetSchema(context.Background(), schemaID)
 }
 
-// ListObjects lists settings objects for a schema with automatic pagination.
-func (h *Handler) ListObjects(schemaID, scope string, chunkSize int64) (*SettingsObjectsList, error) {
-	sdkResult, err := h.sdk.ListObjects(context.Background(), schemaID, scope, chunkSize)
+// ListObjects lists settings objects for a schema with automatic pagination,
+// stopping once limit objects are collected (0 = unlimited).
+func (h *Handler) ListObjects(schemaID, scope string, chunkSize, limit int64) (*SettingsObjectsList, error) {
+	return h.ListObjectsFiltered(schemaID, scope, "", chunkSize, limit)
+}
+
+// ListObjectsFiltered is ListObjects with a server-side Settings 2.0 filter
+// expression; see FieldSelectorFilter to build one from a field selector.
+func (h *Handler) ListObjectsFiltered(schemaID, scope, filter string, chunkSize, limit int64) (*SettingsObjectsList, error) {
+	return h.ListObjectsWithOptions(schemaID, scope, filter, httpclient.ChunkPageOptions(chunkSize, limit))
+}
+
+// ListObjectsWithOptions is ListObjectsFiltered, paging through results as
+// described by opts.
+func (h *Handler) ListObjectsWithOptions(schemaID, scope, filter string, opts httpclient.PageOptions) (*SettingsObjectsList, error) {
+	sdkResult, err := h.sdk.ListObjectsWithOptions(context.Background(), schemaID, scope, filter, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -184,6 +204,39 @@ func (h *Handler) Create(req SettingsObjectCreate) (*SettingsObjectResponse, err
 	return h.sdk.Create(context.Background(), req)
 }
 
+// maxPageSize is the maximum page size accepted by the Settings API.
+const maxPageSize = 500
+
+// InsertAfterPosition returns the InsertAfter value that places a new object
+// at the 1-based position among the existing objects of schemaID in scope.
+// Position 1 puts it first; a position past the end appends it (nil). opts
+// controls the page size; every page is followed up to the position.
+func (h *Handler) InsertAfterPosition(schemaID, scope string, position int, opts httpclient.PageOptions) (*string, error) {
+	if position < 1 {
+		return nil, fmt.Errorf("position must be 1 or greater, got %d", position)
+	}
+	if position == 1 {
+		first := ""
+		return &first, nil
+	}
+	opts.FirstPageOnly = false
+	opts.Limit = int64(position - 1)
+	if opts.PageSize <= 0 || opts.PageSize > maxPageSize {
+		opts.PageSize = maxPageSize
+	}
+	list, err := h.ListObjectsWithOptions(schemaID, scope, "", opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list existing objects to resolve position: %w", err)
+	}
+	if len(list.Items) < position-1 {
+		if list.TotalCount > len(list.Items) {
+			return nil, fmt.Errorf("failed to resolve position %d: listed %d of %d existing objects", position, len(list.Items), list.TotalCount)
+		}
+		return nil, nil
+	}
+	return &list.Items[position-2].ObjectID, nil
+}
+
 // ValidateUpdate validates a settings object update without applying it.
 // Auto-fetches the current schemaVersion for the If-Match header.
 func (h *Handler) ValidateUpdate(objectID string, value map[string]any) error {
@@ -229,6 +282,12 @@ func (h *Handler) Delete(objectID string) error {
 	return h.sdk.Delete(context.Background(), obj.ObjectID, obj.SchemaVersion)
 }
 
+// DeleteVersion deletes a settings object at a known schemaVersion, skipping
+// the lookup Delete does. Used for bulk deletes of listed objects.
+func (h *Handler) DeleteVersion(objectID, schemaVersion string) error {
+	return h.sdk.Delete(context.Background(), objectID, schemaVersion)
+}
+
 // GetRaw gets a settings object as raw JSON bytes (for editing).
 func (h *Handler) GetRaw(objectID string) ([]byte, error) {
 	obj, err := h.Get(objectID)
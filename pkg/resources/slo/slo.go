@@ -27,6 +27,12 @@ type SLO struct {
 	ExternalID  string                 `json:"externalId,omitempty" table:"-"`
 }
 
+// ManagedFields lists the server-managed fields that 'get -o json|yaml' omits
+// unless --show-managed-fields is set.
+func (s SLO) ManagedFields() []string {
+	return []string{"version"}
+}
+
 // SLOList represents a list of SLOs.
 type SLOList struct {
 	SLOs        []SLO  `json:"slos"`
@@ -165,9 +171,15 @@ func NewHandler(c *client.Client) *Handler {
 	}
 }
 
-// List lists all SLOs with automatic pagination
-func (h *Handler) List(filter string, chunkSize int64) (*SLOList, error) {
-	sdkResult, err := h.sdk.List(context.Background(), filter, chunkSize)
+// List lists SLOs with automatic pagination, stopping once limit SLOs are
+// collected (0 = unlimited).
+func (h *Handler) List(filter string, chunkSize, limit int64) (*SLOList, error) {
+	return h.ListWithOptions(filter, httpclient.ChunkPageOptions(chunkSize, limit))
+}
+
+// ListWithOptions lists SLOs, paging through results as described by opts.
+func (h *Handler) ListWithOptions(filter string, opts httpclient.PageOptions) (*SLOList, error) {
+	sdkResult, err := h.sdk.ListWithOptions(context.Background(), filter, opts)
 	if err != nil {
 		return nil, err
 	}
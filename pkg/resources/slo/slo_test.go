@@ -203,7 +203,7 @@ func TestList(t *testing.T) {
 			}
 			handler := NewHandler(c)
 
-			result, err := handler.List(tt.filter, tt.chunkSize)
+			result, err := handler.List(tt.filter, tt.chunkSize, 0)
 
 			if (err != nil) != tt.expectError {
 				t.Errorf("List() error = %v, expectError %v", err, tt.expectError)
@@ -0,0 +1,183 @@
+package slo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Status filter values accepted by FilterByStatus.
+const (
+	StatusBreaching = "breaching"
+	StatusWarning   = "warning"
+	StatusHealthy   = "healthy"
+	StatusUnknown   = "unknown"
+)
+
+// ValidStatusFilters lists the values accepted by FilterByStatus.
+var ValidStatusFilters = []string{StatusBreaching, StatusWarning, StatusHealthy}
+
+// pollInterval is the pause between evaluation polls that return no results yet.
+var pollInterval = time.Second
+
+// SLOStatus is an SLO together with the outcome of evaluating it. For SLOs
+// with several criteria the worst status and the smallest error budget win.
+type SLOStatus struct {
+	ID          string             `json:"id" table:"ID"`
+	Name        string             `json:"name" table:"NAME"`
+	Status      string             `json:"status" table:"STATUS"`
+	Value       *float64           `json:"value,omitempty" table:"VALUE"`
+	ErrorBudget *float64           `json:"errorBudget,omitempty" table:"ERROR_BUDGET"`
+	Error       string             `json:"error,omitempty" table:"ERROR,wide"`
+	Results     []EvaluationResult `json:"evaluationResults,omitempty" table:"-"`
+}
+
+// EvaluateStatus evaluates one SLO and waits for its results, giving up after
+// timeout.
+func (h *Handler) EvaluateStatus(id string, timeout time.Duration) (*EvaluationResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	started, err := h.sdk.Evaluate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	result := fromSDKEvaluationResponse(started)
+	for len(result.EvaluationResults) == 0 {
+		if result.EvaluationToken == "" {
+			return nil, fmt.Errorf("no evaluation token returned and no immediate results available")
+		}
+		deadline, _ := ctx.Deadline()
+		polled, err := h.sdk.PollEvaluation(ctx, result.EvaluationToken, int(time.Until(deadline).Milliseconds()))
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("timed out after %s waiting for SLO evaluation", timeout)
+			}
+			return nil, err
+		}
+		token := result.EvaluationToken
+		result = fromSDKEvaluationResponse(polled)
+		if result.EvaluationToken == "" {
+			result.EvaluationToken = token
+		}
+		if len(result.EvaluationResults) > 0 {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out after %s waiting for SLO evaluation", timeout)
+		case <-time.After(pollInterval):
+		}
+	}
+	return result, nil
+}
+
+// EvaluateAll evaluates slos with at most concurrency evaluations in flight,
+// each bounded by timeout. An SLO whose evaluation fails gets status
+// "unknown" and the error message rather than aborting the others. Rows are
+// returned in input order.
+func (h *Handler) EvaluateAll(slos []SLO, concurrency int, timeout time.Duration) []SLOStatus {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	rows := make([]SLOStatus, len(slos))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i, s := range slos {
+		wg.Add(1)
+		go func(i int, s SLO) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			row := SLOStatus{ID: s.ID, Name: s.Name, Status: StatusUnknown}
+			resp, err := h.EvaluateStatus(s.ID, timeout)
+			if err != nil {
+				row.Error = err.Error()
+			} else {
+				row.summarize(resp.EvaluationResults)
+			}
+			rows[i] = row
+		}(i, s)
+	}
+	wg.Wait()
+	return rows
+}
+
+// summarize folds per-criterion results into the row's status, value and
+// error budget.
+func (r *SLOStatus) summarize(results []EvaluationResult) {
+	r.Results = results
+	rank := -1
+	for i := range results {
+		res := &results[i]
+		if st := normalizeStatus(res.Status); statusRank(st) > rank {
+			rank = statusRank(st)
+			r.Status = st
+			r.Value = res.Value
+		}
+		if res.ErrorBudget != nil && (r.ErrorBudget == nil || *res.ErrorBudget < *r.ErrorBudget) {
+			r.ErrorBudget = res.ErrorBudget
+		}
+	}
+}
+
+// normalizeStatus maps an evaluation status (SUCCESS, WARNING, FAILURE) to
+// the filter vocabulary.
+func normalizeStatus(status string) string {
+	switch strings.ToUpper(status) {
+	case "FAILURE":
+		return StatusBreaching
+	case "WARNING":
+		return StatusWarning
+	case "SUCCESS":
+		return StatusHealthy
+	default:
+		return StatusUnknown
+	}
+}
+
+// statusRank orders statuses from best to worst.
+func statusRank(status string) int {
+	switch status {
+	case StatusHealthy:
+		return 0
+	case StatusUnknown:
+		return 1
+	case StatusWarning:
+		return 2
+	case StatusBreaching:
+		return 3
+	}
+	return -1
+}
+
+// FilterByStatus keeps the rows whose status is one of statuses.
+func FilterByStatus(rows []SLOStatus, statuses ...string) []SLOStatus {
+	var kept []SLOStatus
+	for _, r := range rows {
+		for _, st := range statuses {
+			if r.Status == st {
+				kept = append(kept, r)
+				break
+			}
+		}
+	}
+	return kept
+}
+
+// SortByErrorBudget orders rows by remaining error budget, smallest first.
+// Rows without an error budget go last.
+func SortByErrorBudget(rows []SLOStatus) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, b := rows[i].ErrorBudget, rows[j].ErrorBudget
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return *a < *b
+	})
+}
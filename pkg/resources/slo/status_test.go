@@ -0,0 +1,111 @@
+package slo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dynatrace-oss/dtctl/pkg/client"
+)
+
+func ptr(f float64) *float64 { return &f }
+
+func TestEvaluateAll(t *testing.T) {
+	// slo-ok answers immediately, slo-poll needs one poll, slo-slow never
+	// finishes and must be time-boxed.
+	var inFlight, maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/platform/slo/v1/slos/evaluation:start":
+			var body struct{ ID string }
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			switch body.ID {
+			case "slo-ok":
+				json.NewEncoder(w).Encode(map[string]any{"evaluationResults": []any{
+					map[string]any{"criteria": "a", "status": "SUCCESS", "value": 99.9, "errorBudget": 80.0},
+					map[string]any{"criteria": "b", "status": "WARNING", "value": 98.0, "errorBudget": 20.0},
+				}})
+			case "slo-poll":
+				json.NewEncoder(w).Encode(map[string]any{"evaluationToken": "tok-poll"})
+			default:
+				json.NewEncoder(w).Encode(map[string]any{"evaluationToken": "tok-slow"})
+			}
+		case "/platform/slo/v1/slos/evaluation:poll":
+			if r.URL.Query().Get("evaluation-token") == "tok-poll" {
+				json.NewEncoder(w).Encode(map[string]any{"evaluationResults": []any{
+					map[string]any{"criteria": "a", "status": "FAILURE", "value": 90.0, "errorBudget": -5.0},
+				}})
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]any{"evaluationToken": "tok-slow"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	origInterval := pollInterval
+	pollInterval = 10 * time.Millisecond
+	defer func() { pollInterval = origInterval }()
+
+	c, err := client.New(server.URL, "test-token")
+	if err != nil {
+		t.Fatalf("client.New: %v", err)
+	}
+	h := NewHandler(c)
+
+	slos := []SLO{{ID: "slo-ok", Name: "OK"}, {ID: "slo-poll", Name: "Poll"}, {ID: "slo-slow", Name: "Slow"}}
+	rows := h.EvaluateAll(slos, 2, 200*time.Millisecond)
+
+	if len(rows) != 3 {
+		t.Fatalf("got %d rows, want 3", len(rows))
+	}
+	if rows[0].Status != StatusWarning || *rows[0].ErrorBudget != 20 || *rows[0].Value != 98 {
+		t.Errorf("slo-ok: want worst criterion (warning, budget 20), got %+v", rows[0])
+	}
+	if rows[1].Status != StatusBreaching || *rows[1].ErrorBudget != -5 {
+		t.Errorf("slo-poll: want breaching after poll, got %+v", rows[1])
+	}
+	if rows[2].Status != StatusUnknown || !strings.Contains(rows[2].Error, "timed out") {
+		t.Errorf("slo-slow: want unknown with timeout error, got %+v", rows[2])
+	}
+	if got := maxInFlight.Load(); got > 2 {
+		t.Errorf("max concurrent requests = %d, want <= 2", got)
+	}
+}
+
+func TestFilterAndSortByErrorBudget(t *testing.T) {
+	rows := []SLOStatus{
+		{ID: "healthy", Status: StatusHealthy, ErrorBudget: ptr(90)},
+		{ID: "warn", Status: StatusWarning, ErrorBudget: ptr(15)},
+		{ID: "unknown", Status: StatusUnknown},
+		{ID: "breach", Status: StatusBreaching, ErrorBudget: ptr(-3)},
+		{ID: "breach-no-budget", Status: StatusBreaching},
+	}
+
+	got := FilterByStatus(rows, StatusBreaching, StatusWarning)
+	SortByErrorBudget(got)
+
+	var ids []string
+	for _, r := range got {
+		ids = append(ids, r.ID)
+	}
+	if want := "breach,warn,breach-no-budget"; strings.Join(ids, ",") != want {
+		t.Errorf("filtered+sorted = %v, want %s", ids, want)
+	}
+}
@@ -0,0 +1,82 @@
+package slo
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DefaultTemplateTimeframe is the evaluation window used when an SLO is
+// created from a template without an explicit timeframe.
+const DefaultTemplateTimeframe = "now-7d"
+
+// InstantiateOptions holds the user-supplied parts of an SLO created from a
+// template. Variables maps template variable names to their values.
+type InstantiateOptions struct {
+	Name      string
+	Target    float64
+	Warning   *float64
+	Timeframe string
+	Variables map[string]string
+}
+
+// Instantiate builds an SLO definition that references the template through
+// sliReference, filling every template variable from opts.Variables. It fails
+// if a template variable has no value or a value is given for a variable the
+// template does not declare, so typos surface before the API call.
+func (t *Template) Instantiate(opts InstantiateOptions) ([]byte, error) {
+	declared := make(map[string]bool, len(t.Variables))
+	var missing []string
+	variables := make([]map[string]string, 0, len(t.Variables))
+	for _, v := range t.Variables {
+		declared[v.Name] = true
+		value, ok := opts.Variables[v.Name]
+		if !ok || value == "" {
+			missing = append(missing, v.Name)
+			continue
+		}
+		variables = append(variables, map[string]string{"name": v.Name, "value": value})
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("template %q requires values for: %s (use --set name=value)", t.ID, strings.Join(missing, ", "))
+	}
+
+	var unknown []string
+	for name := range opts.Variables {
+		if !declared[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("template %q has no variables named: %s", t.ID, strings.Join(unknown, ", "))
+	}
+
+	name := opts.Name
+	if name == "" {
+		name = t.Name
+	}
+	timeframe := opts.Timeframe
+	if timeframe == "" {
+		timeframe = DefaultTemplateTimeframe
+	}
+
+	def := map[string]interface{}{
+		"name": name,
+		"sliReference": map[string]interface{}{
+			"templateId": t.ID,
+			"variables":  variables,
+		},
+		"criteria": []Criteria{{
+			TimeframeFrom: timeframe,
+			TimeframeTo:   "now",
+			Target:        opts.Target,
+			Warning:       opts.Warning,
+		}},
+	}
+	if t.Description != "" {
+		def["description"] = t.Description
+	}
+	return json.Marshal(def)
+}
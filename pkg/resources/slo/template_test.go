@@ -0,0 +1,73 @@
+package slo
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestTemplateInstantiate(t *testing.T) {
+	tmpl := &Template{
+		ID:          "template-123",
+		Name:        "Service availability",
+		Description: "Share of successful requests",
+		Variables:   []TemplateVariable{{Name: "services", Scope: "SERVICE"}},
+	}
+	warning := 99.5
+
+	data, err := tmpl.Instantiate(InstantiateOptions{
+		Target:    99,
+		Warning:   &warning,
+		Variables: map[string]string{"services": "SERVICE-1"},
+	})
+	if err != nil {
+		t.Fatalf("Instantiate() error = %v", err)
+	}
+
+	var def struct {
+		Name         string     `json:"name"`
+		Description  string     `json:"description"`
+		Criteria     []Criteria `json:"criteria"`
+		SliReference struct {
+			TemplateID string              `json:"templateId"`
+			Variables  []map[string]string `json:"variables"`
+		} `json:"sliReference"`
+	}
+	if err := json.Unmarshal(data, &def); err != nil {
+		t.Fatal(err)
+	}
+	if def.Name != tmpl.Name || def.Description != tmpl.Description {
+		t.Errorf("name/description = %q/%q, want template defaults", def.Name, def.Description)
+	}
+	if def.SliReference.TemplateID != "template-123" {
+		t.Errorf("templateId = %q", def.SliReference.TemplateID)
+	}
+	if len(def.SliReference.Variables) != 1 || def.SliReference.Variables[0]["value"] != "SERVICE-1" {
+		t.Errorf("variables = %v", def.SliReference.Variables)
+	}
+	if len(def.Criteria) != 1 || def.Criteria[0].Target != 99 || def.Criteria[0].TimeframeFrom != DefaultTemplateTimeframe ||
+		def.Criteria[0].Warning == nil || *def.Criteria[0].Warning != 99.5 {
+		t.Errorf("criteria = %+v", def.Criteria)
+	}
+}
+
+func TestTemplateInstantiate_VariableErrors(t *testing.T) {
+	tmpl := &Template{ID: "template-123", Variables: []TemplateVariable{{Name: "services"}}}
+
+	tests := []struct {
+		name string
+		vars map[string]string
+		want string
+	}{
+		{"missing", nil, "requires values for: services"},
+		{"unknown", map[string]string{"services": "S", "host": "H"}, "no variables named: host"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tmpl.Instantiate(InstantiateOptions{Target: 99, Variables: tt.vars})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -128,6 +128,11 @@ func (h *ExecutionHandler) Get(id string) (*Execution, error) {
 	return &e, nil
 }
 
+// GetInput retrieves the trigger input payload of an execution (get wfe <id> --raw-input)
+func (h *ExecutionHandler) GetInput(id string) (any, error) {
+	return h.sdk.GetInput(context.Background(), id)
+}
+
 // Cancel cancels an active execution
 func (h *ExecutionHandler) Cancel(id string) error {
 	return h.sdk.Cancel(context.Background(), id)
@@ -156,6 +161,49 @@ func (h *ExecutionHandler) GetTaskResult(executionID, taskName string) (any, err
 	return h.sdk.GetTaskResult(context.Background(), executionID, taskName)
 }
 
+// TaskLog is a task of an execution together with its log output and
+// result (get wfe <id> --logs).
+type TaskLog struct {
+	Name      string     `json:"name"`
+	State     string     `json:"state"`
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+	Runtime   int        `json:"runtime,omitempty"`
+	StateInfo *string    `json:"stateInfo,omitempty"`
+	Log       string     `json:"log"`
+	LogError  string     `json:"logError,omitempty"`
+	Result    any        `json:"result,omitempty"`
+}
+
+// GetTaskLogs retrieves the tasks of an execution in start order, each with
+// its log and result. A task whose log cannot be fetched carries the error in
+// LogError rather than failing the whole call.
+func (h *ExecutionHandler) GetTaskLogs(executionID string) ([]TaskLog, error) {
+	tasks, err := h.ListTasks(executionID)
+	if err != nil {
+		return nil, err
+	}
+	sortTasksByStartTime(tasks)
+
+	logs := make([]TaskLog, len(tasks))
+	for i, task := range tasks {
+		logs[i] = TaskLog{
+			Name:      task.Name,
+			State:     task.State,
+			StartedAt: task.StartedAt,
+			Runtime:   task.Runtime,
+			StateInfo: task.StateInfo,
+			Result:    task.Result,
+		}
+		log, err := h.sdk.GetTaskLog(context.Background(), executionID, task.Name)
+		if err != nil {
+			logs[i].LogError = err.Error()
+			continue
+		}
+		logs[i].Log = log
+	}
+	return logs, nil
+}
+
 // GetExecutionLog retrieves the combined log output of all tasks in an execution
 func (h *ExecutionHandler) GetExecutionLog(executionID string) (string, error) {
 	return h.sdk.GetExecutionLog(context.Background(), executionID)
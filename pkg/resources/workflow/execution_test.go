@@ -111,6 +111,42 @@ func TestExecutionGet_Success(t *testing.T) {
 	}
 }
 
+func TestExecutionGetInput(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/platform/automation/v1/executions/event-exec", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"event-exec","input":{"event.type":"PROBLEM_OPEN"}}`))
+	})
+	mux.HandleFunc("/platform/automation/v1/executions/legacy-exec", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"legacy-exec","params":{"env":"prod"}}`))
+	})
+	mux.HandleFunc("/platform/automation/v1/executions/empty-exec", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"empty-exec"}`))
+	})
+	h, cleanup := newExecTestHandler(t, mux)
+	defer cleanup()
+
+	tests := []struct {
+		id   string
+		want any
+	}{
+		{"event-exec", map[string]any{"event.type": "PROBLEM_OPEN"}},
+		{"legacy-exec", map[string]any{"env": "prod"}},
+		{"empty-exec", nil},
+	}
+	for _, tt := range tests {
+		got, err := h.GetInput(tt.id)
+		if err != nil {
+			t.Fatalf("GetInput(%s) error = %v", tt.id, err)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("GetInput(%s) = %v, want %v", tt.id, got, tt.want)
+		}
+	}
+}
+
 func TestExecutionGet_NotFound(t *testing.T) {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/platform/automation/v1/executions/missing", func(w http.ResponseWriter, r *http.Request) {
@@ -372,6 +408,43 @@ func TestGetFullExecutionLog_NoTasks(t *testing.T) {
 	}
 }
 
+// --- GetTaskLogs ---
+
+func TestGetTaskLogs(t *testing.T) {
+	t1 := time.Now()
+	t2 := t1.Add(time.Second)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/platform/automation/v1/executions/exec-logs/tasks", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(TaskExecutionMap{
+			"second": {ID: "t2", Name: "second", State: "ERROR", StartedAt: &t2},
+			"first":  {ID: "t1", Name: "first", State: "SUCCESS", StartedAt: &t1, Result: map[string]any{"count": 3}},
+		})
+	})
+	mux.HandleFunc("/platform/automation/v1/executions/exec-logs/tasks/first/log", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `""`)
+	})
+	mux.HandleFunc("/platform/automation/v1/executions/exec-logs/tasks/second/log", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	h, cleanup := newExecTestHandler(t, mux)
+	defer cleanup()
+
+	logs, err := h.GetTaskLogs("exec-logs")
+	if err != nil {
+		t.Fatalf("GetTaskLogs() error = %v", err)
+	}
+	if len(logs) != 2 || logs[0].Name != "first" || logs[1].Name != "second" {
+		t.Fatalf("expected tasks in start order, got %+v", logs)
+	}
+	if logs[0].Result == nil || logs[0].LogError != "" {
+		t.Errorf("first task = %+v, want result and no log error", logs[0])
+	}
+	if logs[1].LogError == "" {
+		t.Errorf("second task should carry the log fetch error, got %+v", logs[1])
+	}
+}
+
 // --- GetCompleteExecutionLog ---
 
 func TestGetCompleteExecutionLog_Success(t *testing.T) {
@@ -0,0 +1,117 @@
+package workflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// serverManagedFields are workflow fields that belong to one environment and
+// are dropped from a runnable export.
+var serverManagedFields = []string{"id", "owner", "ownerType", "actor", "result"}
+
+// credentialKeys are task input keys whose values reference environment-specific
+// connections or hold secrets. Matching is case-insensitive.
+var credentialKeys = map[string]bool{
+	"connection":   true,
+	"connectionid": true,
+	"credential":   true,
+	"credentialid": true,
+	"token":        true,
+	"apitoken":     true,
+	"secret":       true,
+	"clientsecret": true,
+	"password":     true,
+}
+
+// invalidVarChars matches characters that cannot appear in a template
+// variable name.
+var invalidVarChars = regexp.MustCompile(`[^A-Za-z0-9_]+`)
+
+// ExportRunnable turns a workflow into a body that can be applied to any
+// environment (get workflow --export-runnable): server-managed fields are
+// removed and credential references in task inputs are replaced with
+// "{{ .var }}" placeholders. It returns the document and the sorted names of
+// the variables to pass with 'dtctl apply --set' on re-apply.
+func ExportRunnable(wf *Workflow) (map[string]interface{}, []string, error) {
+	data, err := json.Marshal(wf)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode workflow: %w", err)
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode workflow: %w", err)
+	}
+	for _, field := range serverManagedFields {
+		delete(doc, field)
+	}
+
+	seen := make(map[string]bool)
+	var vars []string
+	tasks, _ := doc["tasks"].(map[string]interface{})
+	taskNames := make([]string, 0, len(tasks))
+	for name := range tasks {
+		taskNames = append(taskNames, name)
+	}
+	sort.Strings(taskNames)
+	for _, name := range taskNames {
+		task, ok := tasks[name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		input, ok := task["input"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		replaceCredentials(input, name, func(v string) string {
+			unique := v
+			for i := 2; seen[unique]; i++ {
+				unique = fmt.Sprintf("%s_%d", v, i)
+			}
+			seen[unique] = true
+			vars = append(vars, unique)
+			return unique
+		})
+	}
+	sort.Strings(vars)
+	return doc, vars, nil
+}
+
+// replaceCredentials walks a task input and replaces every non-empty string
+// stored under a credential key with a placeholder. Values that already are
+// expressions ({{ ... }}) are left alone.
+func replaceCredentials(node interface{}, prefix string, register func(string) string) {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			child := v[key]
+			s, isString := child.(string)
+			if isString && credentialKeys[strings.ToLower(key)] && s != "" && !strings.Contains(s, "{{") {
+				name := register(variableName(prefix + "_" + key))
+				v[key] = "{{ ." + name + " }}"
+				continue
+			}
+			replaceCredentials(child, prefix+"_"+key, register)
+		}
+	case []interface{}:
+		for i, child := range v {
+			replaceCredentials(child, fmt.Sprintf("%s_%d", prefix, i), register)
+		}
+	}
+}
+
+// variableName turns a task and key path into a valid template variable name.
+func variableName(s string) string {
+	name := strings.Trim(invalidVarChars.ReplaceAllString(s, "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "v_" + name
+	}
+	return name
+}
@@ -0,0 +1,72 @@
+package workflow
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExportRunnable(t *testing.T) {
+	wf := &Workflow{
+		ID:         "wf-1",
+		Title:      "Notify",
+		Owner:      "user-1",
+		OwnerType:  "USER",
+		Actor:      "user-1",
+		IsDeployed: true,
+		Tasks: map[string]interface{}{
+			"send-message": map[string]interface{}{
+				"action": "dynatrace.slack:slack-send-message",
+				"input": map[string]interface{}{
+					"connection": "vu9U3hXa3q0AAAABAB",
+					"channel":    "#alerts",
+				},
+			},
+			"call_api": map[string]interface{}{
+				"action": "dynatrace.automations:http-function",
+				"input": map[string]interface{}{
+					"headers": map[string]interface{}{"token": "secret-value"},
+					"url":     "https://example.com",
+				},
+			},
+			"run_js": map[string]interface{}{
+				"action": "dynatrace.automations:run-javascript",
+				"input": map[string]interface{}{
+					"connectionId": `{{ result("lookup").id }}`,
+				},
+			},
+		},
+	}
+
+	doc, vars, err := ExportRunnable(wf)
+	if err != nil {
+		t.Fatalf("ExportRunnable() error = %v", err)
+	}
+
+	for _, field := range []string{"id", "owner", "ownerType", "actor"} {
+		if _, ok := doc[field]; ok {
+			t.Errorf("export still contains %q", field)
+		}
+	}
+	if doc["title"] != "Notify" || doc["isDeployed"] != true {
+		t.Errorf("export lost workflow fields: %v", doc)
+	}
+
+	wantVars := []string{"call_api_headers_token", "send_message_connection"}
+	if !reflect.DeepEqual(vars, wantVars) {
+		t.Errorf("vars = %v, want %v", vars, wantVars)
+	}
+
+	tasks := doc["tasks"].(map[string]interface{})
+	slack := tasks["send-message"].(map[string]interface{})["input"].(map[string]interface{})
+	if slack["connection"] != "{{ .send_message_connection }}" || slack["channel"] != "#alerts" {
+		t.Errorf("slack input = %v", slack)
+	}
+	js := tasks["run_js"].(map[string]interface{})["input"].(map[string]interface{})
+	if js["connectionId"] != `{{ result("lookup").id }}` {
+		t.Errorf("expression was replaced: %v", js)
+	}
+
+	if wf.Tasks["send-message"].(map[string]interface{})["input"].(map[string]interface{})["connection"] != "vu9U3hXa3q0AAAABAB" {
+		t.Error("ExportRunnable modified the original workflow")
+	}
+}
@@ -0,0 +1,68 @@
+package workflow
+
+import (
+	"strings"
+	"time"
+)
+
+// logTimestampLayouts are the leading timestamp formats recognised on
+// execution and task log lines.
+var logTimestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+}
+
+// FilterLogLines keeps the log lines whose leading timestamp falls within
+// [since, until]. A zero since or until leaves that side unbounded. Lines
+// without a timestamp (continuations of multi-line messages) follow the
+// decision for the preceding timestamped line; section headers ("=== ...")
+// and any lines before the first timestamp are always kept.
+func FilterLogLines(logs string, since, until time.Time) string {
+	if since.IsZero() && until.IsZero() {
+		return logs
+	}
+
+	var b strings.Builder
+	keep := true
+	for _, line := range strings.SplitAfter(logs, "\n") {
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "=== ") {
+			b.WriteString(line)
+			keep = true
+			continue
+		}
+		if ts, ok := logLineTimestamp(line); ok {
+			keep = (since.IsZero() || !ts.Before(since)) && (until.IsZero() || !ts.After(until))
+		}
+		if keep {
+			b.WriteString(line)
+		}
+	}
+	return b.String()
+}
+
+// logLineTimestamp parses the timestamp at the start of a log line, either a
+// single RFC3339 token or a "date time" pair, optionally wrapped in brackets.
+func logLineTimestamp(line string) (time.Time, bool) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return time.Time{}, false
+	}
+	candidates := []string{fields[0]}
+	if len(fields) > 1 {
+		candidates = append(candidates, fields[0]+" "+fields[1])
+	}
+	for _, c := range candidates {
+		c = strings.Trim(c, "[]")
+		for _, layout := range logTimestampLayouts {
+			if ts, err := time.Parse(layout, c); err == nil {
+				return ts, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,55 @@
+package workflow
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFilterLogLines(t *testing.T) {
+	logs := "=== Task: fetch [SUCCESS] ===\n" +
+		"2026-03-01T10:00:00Z starting\n" +
+		"2026-03-01 10:05:00.123 [INFO] halfway\n" +
+		"  continuation of halfway\n" +
+		"[2026-03-01T10:10:00.000Z] done\n"
+
+	at := func(s string) time.Time {
+		ts, _ := time.Parse(time.RFC3339, s)
+		return ts
+	}
+
+	tests := []struct {
+		name         string
+		since, until time.Time
+		want         string
+	}{
+		{"unbounded", time.Time{}, time.Time{}, logs},
+		{
+			"since",
+			at("2026-03-01T10:01:00Z"), time.Time{},
+			"=== Task: fetch [SUCCESS] ===\n" +
+				"2026-03-01 10:05:00.123 [INFO] halfway\n" +
+				"  continuation of halfway\n" +
+				"[2026-03-01T10:10:00.000Z] done\n",
+		},
+		{
+			"until",
+			time.Time{}, at("2026-03-01T10:01:00Z"),
+			"=== Task: fetch [SUCCESS] ===\n" +
+				"2026-03-01T10:00:00Z starting\n",
+		},
+		{
+			"window",
+			at("2026-03-01T10:01:00Z"), at("2026-03-01T10:06:00Z"),
+			"=== Task: fetch [SUCCESS] ===\n" +
+				"2026-03-01 10:05:00.123 [INFO] halfway\n" +
+				"  continuation of halfway\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FilterLogLines(logs, tt.since, tt.until); got != tt.want {
+				t.Errorf("FilterLogLines() =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package workflow
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// WorkflowSchedule is a workflow row with its schedule trigger rendered for
+// humans (get workflows --format-cron).
+type WorkflowSchedule struct {
+	ID          string     `json:"id" yaml:"id" table:"ID"`
+	Title       string     `json:"title" yaml:"title" table:"TITLE"`
+	IsDeployed  bool       `json:"isDeployed" yaml:"isDeployed" table:"DEPLOYED"`
+	TriggerType string     `json:"triggerType,omitempty" yaml:"triggerType,omitempty" table:"TRIGGER"`
+	Schedule    string     `json:"schedule,omitempty" yaml:"schedule,omitempty" table:"SCHEDULE"`
+	NextRun     *time.Time `json:"nextRun,omitempty" yaml:"nextRun,omitempty" table:"NEXT_RUN"`
+}
+
+// ScheduleRows converts workflows to WorkflowSchedule rows, computing each
+// schedule's next fire time relative to now.
+func ScheduleRows(workflows []Workflow, now time.Time) []WorkflowSchedule {
+	rows := make([]WorkflowSchedule, 0, len(workflows))
+	for _, wf := range workflows {
+		row := WorkflowSchedule{
+			ID:          wf.ID,
+			Title:       wf.Title,
+			IsDeployed:  wf.IsDeployed,
+			TriggerType: wf.TriggerType,
+		}
+		row.Schedule, row.NextRun = DescribeSchedule(wf.Trigger, now)
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// DescribeSchedule renders a workflow's schedule trigger as a human-readable
+// description ("Every day at 02:00 UTC") and returns its next fire time after
+// now, in the schedule's timezone. It returns "" for workflows without a
+// schedule trigger, and a nil time when the next run cannot be derived
+// (interval triggers, inactive schedules, or unparsable expressions).
+func DescribeSchedule(trigger map[string]interface{}, now time.Time) (string, *time.Time) {
+	schedule, ok := trigger["schedule"].(map[string]interface{})
+	if !ok {
+		return "", nil
+	}
+	spec, _ := schedule["trigger"].(map[string]interface{})
+	tz, _ := schedule["timezone"].(string)
+	loc, err := time.LoadLocation(tz)
+	if err != nil || tz == "" {
+		loc, tz = time.UTC, "UTC"
+	}
+
+	var desc string
+	var next *time.Time
+	switch typ, _ := spec["type"].(string); typ {
+	case "cron":
+		expr, _ := spec["cron"].(string)
+		desc = describeCron(expr, tz)
+		if sched, err := cron.ParseStandard(expr); err == nil {
+			t := sched.Next(now.In(loc))
+			next = &t
+		}
+	case "time":
+		at, _ := spec["time"].(string)
+		hour, minute, ok := parseClock(at)
+		if !ok {
+			desc = "At " + at
+			break
+		}
+		desc = fmt.Sprintf("Every day at %02d:%02d %s", hour, minute, tz)
+		local := now.In(loc)
+		t := time.Date(local.Year(), local.Month(), local.Day(), hour, minute, 0, 0, loc)
+		if !t.After(local) {
+			t = t.AddDate(0, 0, 1)
+		}
+		next = &t
+	case "interval":
+		desc = describeInterval(spec, tz)
+	default:
+		return "", nil
+	}
+
+	if active, ok := schedule["isActive"].(bool); ok && !active {
+		return desc + " (inactive)", nil
+	}
+	return desc, next
+}
+
+// describeInterval renders an interval trigger: "Every 30 minutes", optionally
+// restricted to a daily window.
+func describeInterval(spec map[string]interface{}, tz string) string {
+	minutes, _ := spec["intervalMinutes"].(float64)
+	if minutes <= 0 {
+		if n, ok := spec["intervalMinutes"].(int); ok {
+			minutes = float64(n)
+		}
+	}
+	desc := "Every " + plural(int(minutes), "minute")
+	start, _ := spec["betweenStart"].(string)
+	end, _ := spec["betweenEnd"].(string)
+	if start != "" && end != "" {
+		desc += fmt.Sprintf(" between %s and %s %s", start, end, tz)
+	}
+	return desc
+}
+
+// describeCron renders the common shapes of a 5-field cron expression in
+// English and falls back to the raw expression for anything else.
+func describeCron(expr, tz string) string {
+	fallback := "Cron " + expr
+	fields := strings.Fields(expr)
+	if len(fields) != 5 || fields[3] != "*" {
+		return fallback
+	}
+	minute, hour, dom, dow := fields[0], fields[1], fields[2], fields[4]
+	m, minuteFixed := parseCronNumber(minute, 0, 59)
+	h, hourFixed := parseCronNumber(hour, 0, 23)
+
+	switch {
+	case minuteFixed && hourFixed:
+		at := fmt.Sprintf("at %02d:%02d %s", h, m, tz)
+		switch {
+		case dom == "*" && dow == "*":
+			return "Every day " + at
+		case dom == "*":
+			if days, ok := describeWeekdays(dow); ok {
+				return "Every " + days + " " + at
+			}
+		case dow == "*":
+			if d, ok := parseCronNumber(dom, 1, 31); ok {
+				return fmt.Sprintf("Day %d of every month %s", d, at)
+			}
+		}
+	case dom != "*" || dow != "*":
+		// Sub-daily schedules restricted to certain days are left raw.
+	case minute == "*" && hour == "*":
+		return "Every minute"
+	case strings.HasPrefix(minute, "*/") && hour == "*":
+		if n, ok := parseCronNumber(strings.TrimPrefix(minute, "*/"), 1, 59); ok {
+			return "Every " + plural(n, "minute")
+		}
+	case minuteFixed && hour == "*":
+		if m == 0 {
+			return "Every hour"
+		}
+		return fmt.Sprintf("Every hour at minute %d", m)
+	case minuteFixed && strings.HasPrefix(hour, "*/"):
+		if n, ok := parseCronNumber(strings.TrimPrefix(hour, "*/"), 1, 23); ok {
+			desc := "Every " + plural(n, "hour")
+			if m != 0 {
+				desc += fmt.Sprintf(" at minute %d", m)
+			}
+			return desc
+		}
+	}
+	return fallback
+}
+
+var weekdayNames = []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+
+// describeWeekdays renders a cron day-of-week field ("1-5", "MON,WED") as
+// "Mon-Fri" or "Mon, Wed".
+func describeWeekdays(field string) (string, bool) {
+	var parts []string
+	for _, item := range strings.Split(field, ",") {
+		lo, hi, isRange := strings.Cut(item, "-")
+		from, ok := parseWeekday(lo)
+		if !ok {
+			return "", false
+		}
+		if !isRange {
+			parts = append(parts, weekdayNames[from])
+			continue
+		}
+		to, ok := parseWeekday(hi)
+		if !ok {
+			return "", false
+		}
+		parts = append(parts, weekdayNames[from]+"-"+weekdayNames[to])
+	}
+	return strings.Join(parts, ", "), true
+}
+
+func parseWeekday(s string) (int, bool) {
+	for i, name := range weekdayNames {
+		if strings.EqualFold(s, name) {
+			return i, true
+		}
+	}
+	n, ok := parseCronNumber(s, 0, 7)
+	return n % 7, ok
+}
+
+func parseCronNumber(s string, lo, hi int) (int, bool) {
+	n, err := strconv.Atoi(s)
+	if err != nil || n < lo || n > hi {
+		return 0, false
+	}
+	return n, true
+}
+
+// parseClock parses an "HH:MM" or "HH:MM:SS" time of day.
+func parseClock(s string) (int, int, bool) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, 0, false
+	}
+	h, ok := parseCronNumber(parts[0], 0, 23)
+	if !ok {
+		return 0, 0, false
+	}
+	m, ok := parseCronNumber(parts[1], 0, 59)
+	return h, m, ok
+}
+
+func plural(n int, unit string) string {
+	if n == 1 {
+		return unit
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
@@ -0,0 +1,141 @@
+package workflow
+
+import (
+	"testing"
+	"time"
+)
+
+func scheduleTrigger(spec map[string]interface{}, tz string) map[string]interface{} {
+	return map[string]interface{}{
+		"schedule": map[string]interface{}{
+			"isActive": true,
+			"trigger":  spec,
+			"timezone": tz,
+		},
+	}
+}
+
+func TestDescribeSchedule(t *testing.T) {
+	now := time.Date(2026, 10, 17, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		trigger  map[string]interface{}
+		wantDesc string
+		wantNext string // RFC3339, "" for no next run
+	}{
+		{
+			name:     "daily cron",
+			trigger:  scheduleTrigger(map[string]interface{}{"type": "cron", "cron": "0 2 * * *"}, "UTC"),
+			wantDesc: "Every day at 02:00 UTC",
+			wantNext: "2026-10-18T02:00:00Z",
+		},
+		{
+			name:     "weekday cron in timezone",
+			trigger:  scheduleTrigger(map[string]interface{}{"type": "cron", "cron": "0 9 * * 1-5"}, "Europe/Vienna"),
+			wantDesc: "Every Mon-Fri at 09:00 Europe/Vienna",
+			wantNext: "2026-10-19T09:00:00+02:00",
+		},
+		{
+			name:     "minute step",
+			trigger:  scheduleTrigger(map[string]interface{}{"type": "cron", "cron": "*/15 * * * *"}, "UTC"),
+			wantDesc: "Every 15 minutes",
+			wantNext: "2026-10-17T10:45:00Z",
+		},
+		{
+			name:     "uncommon cron falls back to expression",
+			trigger:  scheduleTrigger(map[string]interface{}{"type": "cron", "cron": "0 0 1 1 *"}, "UTC"),
+			wantDesc: "Cron 0 0 1 1 *",
+			wantNext: "2027-01-01T00:00:00Z",
+		},
+		{
+			name:     "time trigger later today",
+			trigger:  scheduleTrigger(map[string]interface{}{"type": "time", "time": "17:45"}, ""),
+			wantDesc: "Every day at 17:45 UTC",
+			wantNext: "2026-10-17T17:45:00Z",
+		},
+		{
+			name:     "time trigger already passed",
+			trigger:  scheduleTrigger(map[string]interface{}{"type": "time", "time": "08:00"}, "UTC"),
+			wantDesc: "Every day at 08:00 UTC",
+			wantNext: "2026-10-18T08:00:00Z",
+		},
+		{
+			name: "interval with window",
+			trigger: scheduleTrigger(map[string]interface{}{
+				"type": "interval", "intervalMinutes": float64(30), "betweenStart": "08:00", "betweenEnd": "18:00",
+			}, "UTC"),
+			wantDesc: "Every 30 minutes between 08:00 and 18:00 UTC",
+		},
+		{
+			name:    "no schedule",
+			trigger: map[string]interface{}{"eventTrigger": map[string]interface{}{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desc, next := DescribeSchedule(tt.trigger, now)
+			if desc != tt.wantDesc {
+				t.Errorf("description = %q, want %q", desc, tt.wantDesc)
+			}
+			got := ""
+			if next != nil {
+				got = next.Format(time.RFC3339)
+			}
+			if got != tt.wantNext {
+				t.Errorf("next run = %q, want %q", got, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestDescribeSchedule_Inactive(t *testing.T) {
+	trigger := scheduleTrigger(map[string]interface{}{"type": "cron", "cron": "0 2 * * *"}, "UTC")
+	trigger["schedule"].(map[string]interface{})["isActive"] = false
+
+	desc, next := DescribeSchedule(trigger, time.Now())
+	if desc != "Every day at 02:00 UTC (inactive)" {
+		t.Errorf("description = %q", desc)
+	}
+	if next != nil {
+		t.Errorf("inactive schedule should have no next run, got %v", next)
+	}
+}
+
+func TestDescribeCron(t *testing.T) {
+	tests := map[string]string{
+		"* * * * *":      "Every minute",
+		"0 * * * *":      "Every hour",
+		"15 * * * *":     "Every hour at minute 15",
+		"0 */6 * * *":    "Every 6 hours",
+		"30 8 * * MON,3": "Every Mon, Wed at 08:30 UTC",
+		"0 6 15 * *":     "Day 15 of every month at 06:00 UTC",
+		"0 6 * * 7":      "Every Sun at 06:00 UTC",
+		"*/5 * * * 1":    "Cron */5 * * * 1",
+		"@daily":         "Cron @daily",
+	}
+	for expr, want := range tests {
+		if got := describeCron(expr, "UTC"); got != want {
+			t.Errorf("describeCron(%q) = %q, want %q", expr, got, want)
+		}
+	}
+}
+
+func TestScheduleRows(t *testing.T) {
+	now := time.Date(2026, 10, 17, 10, 30, 0, 0, time.UTC)
+	rows := ScheduleRows([]Workflow{
+		{ID: "wf-1", Title: "Nightly", TriggerType: "Schedule", Trigger: scheduleTrigger(map[string]interface{}{"type": "cron", "cron": "0 2 * * *"}, "UTC")},
+		{ID: "wf-2", Title: "Manual", TriggerType: "Manual"},
+	}, now)
+
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(rows))
+	}
+	if rows[0].Schedule != "Every day at 02:00 UTC" || rows[0].NextRun == nil {
+		t.Errorf("unexpected scheduled row: %+v", rows[0])
+	}
+	if rows[1].Schedule != "" || rows[1].NextRun != nil {
+		t.Errorf("manual workflow should have no schedule: %+v", rows[1])
+	}
+}
@@ -0,0 +1,75 @@
+package workflow
+
+import (
+	"math"
+	"sort"
+)
+
+// ExecutionSummary aggregates a set of executions into reliability stats
+// (get workflow-executions --summary). Runtimes are in seconds and only
+// consider executions that have finished.
+type ExecutionSummary struct {
+	Workflow    string         `json:"workflow,omitempty" table:"WORKFLOW"`
+	Total       int            `json:"total" table:"TOTAL"`
+	Success     int            `json:"success" table:"SUCCESS"`
+	Error       int            `json:"error" table:"ERROR"`
+	Cancelled   int            `json:"cancelled" table:"CANCELLED"`
+	Running     int            `json:"running" table:"RUNNING"`
+	States      map[string]int `json:"states" table:"-"`
+	SuccessRate float64        `json:"successRate" table:"SUCCESS %"`
+	AvgRuntime  int            `json:"avgRuntime" table:"AVG RUNTIME"`
+	P50Runtime  int            `json:"p50Runtime" table:"P50 RUNTIME"`
+	P95Runtime  int            `json:"p95Runtime" table:"P95 RUNTIME"`
+}
+
+// SummarizeExecutions counts executions per state and computes runtime
+// percentiles. SuccessRate is the percentage of finished executions that
+// succeeded, rounded to one decimal.
+func SummarizeExecutions(workflowID string, executions []Execution) ExecutionSummary {
+	summary := ExecutionSummary{
+		Workflow: workflowID,
+		Total:    len(executions),
+		States:   make(map[string]int),
+	}
+
+	var runtimes []int
+	for _, e := range executions {
+		summary.States[e.State]++
+		switch e.State {
+		case "SUCCESS":
+			summary.Success++
+		case "ERROR":
+			summary.Error++
+		case "CANCELLED":
+			summary.Cancelled++
+		case "RUNNING":
+			summary.Running++
+			continue
+		}
+		runtimes = append(runtimes, e.Runtime)
+	}
+
+	if finished := summary.Total - summary.Running; finished > 0 {
+		summary.SuccessRate = math.Round(float64(summary.Success)*1000/float64(finished)) / 10
+	}
+	if len(runtimes) > 0 {
+		sort.Ints(runtimes)
+		sum := 0
+		for _, r := range runtimes {
+			sum += r
+		}
+		summary.AvgRuntime = int(math.Round(float64(sum) / float64(len(runtimes))))
+		summary.P50Runtime = percentile(runtimes, 50)
+		summary.P95Runtime = percentile(runtimes, 95)
+	}
+	return summary
+}
+
+// percentile returns the nearest-rank percentile of sorted values.
+func percentile(sorted []int, p float64) int {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
@@ -0,0 +1,46 @@
+package workflow
+
+import "testing"
+
+func TestSummarizeExecutions(t *testing.T) {
+	var executions []Execution
+	for i := 1; i <= 18; i++ {
+		executions = append(executions, Execution{State: "SUCCESS", Runtime: i * 10})
+	}
+	executions = append(executions,
+		Execution{State: "ERROR", Runtime: 300},
+		Execution{State: "RUNNING", Runtime: 9999},
+	)
+
+	got := SummarizeExecutions("wf-1", executions)
+	if got.Workflow != "wf-1" || got.Total != 20 {
+		t.Errorf("workflow/total = %q/%d, want wf-1/20", got.Workflow, got.Total)
+	}
+	if got.Success != 18 || got.Error != 1 || got.Running != 1 || got.Cancelled != 0 {
+		t.Errorf("counts = %+v", got)
+	}
+	if got.States["SUCCESS"] != 18 || got.States["RUNNING"] != 1 {
+		t.Errorf("States = %v", got.States)
+	}
+	// 18 of 19 finished executions succeeded.
+	if got.SuccessRate != 94.7 {
+		t.Errorf("SuccessRate = %v, want 94.7", got.SuccessRate)
+	}
+	// Finished runtimes: 10..180 plus 300; the running one is ignored.
+	if got.P50Runtime != 100 {
+		t.Errorf("P50Runtime = %d, want 100", got.P50Runtime)
+	}
+	if got.P95Runtime != 300 {
+		t.Errorf("P95Runtime = %d, want 300", got.P95Runtime)
+	}
+	if got.AvgRuntime != 106 {
+		t.Errorf("AvgRuntime = %d, want 106", got.AvgRuntime)
+	}
+}
+
+func TestSummarizeExecutions_Empty(t *testing.T) {
+	got := SummarizeExecutions("", nil)
+	if got.Total != 0 || got.SuccessRate != 0 || got.P95Runtime != 0 {
+		t.Errorf("SummarizeExecutions(nil) = %+v, want zero stats", got)
+	}
+}
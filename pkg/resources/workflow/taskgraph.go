@@ -0,0 +1,145 @@
+package workflow
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// TaskNode is a workflow task with the edges that decide its execution order.
+type TaskNode struct {
+	Name   string
+	Action string
+	// Predecessors are the tasks that must finish first, sorted by name.
+	Predecessors []string
+	// States maps a predecessor to the state it must end in (conditions.states),
+	// e.g. "OK" or "ERROR". Predecessors without a condition are absent.
+	States map[string]string
+}
+
+// TaskGraph extracts the task nodes of a workflow's tasks map, sorted by name.
+// Predecessors come from both the "predecessors" list and the keys of
+// "conditions.states".
+func TaskGraph(tasks map[string]interface{}) []TaskNode {
+	nodes := make([]TaskNode, 0, len(tasks))
+	for name, raw := range tasks {
+		node := TaskNode{Name: name, States: map[string]string{}}
+		task, _ := raw.(map[string]interface{})
+
+		if a, ok := task["action"].(string); ok {
+			node.Action = a
+		}
+
+		preds := map[string]bool{}
+		if list, ok := task["predecessors"].([]interface{}); ok {
+			for _, p := range list {
+				if s, ok := p.(string); ok {
+					preds[s] = true
+				}
+			}
+		}
+		if conditions, ok := task["conditions"].(map[string]interface{}); ok {
+			if states, ok := conditions["states"].(map[string]interface{}); ok {
+				for pred, state := range states {
+					preds[pred] = true
+					node.States[pred] = fmt.Sprint(state)
+				}
+			}
+		}
+		for p := range preds {
+			node.Predecessors = append(node.Predecessors, p)
+		}
+		sort.Strings(node.Predecessors)
+
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+	return nodes
+}
+
+// RenderTaskTree writes the task dependency graph as an ASCII tree. Tasks
+// without (known) predecessors are roots; each task is listed under its
+// predecessors with the state it waits for. A task with several predecessors
+// is expanded under the first one and referenced as "(see above)" elsewhere.
+func RenderTaskTree(w io.Writer, tasks map[string]interface{}) {
+	nodes := TaskGraph(tasks)
+	byName := make(map[string]TaskNode, len(nodes))
+	for _, n := range nodes {
+		byName[n.Name] = n
+	}
+
+	children := map[string][]string{}
+	var roots []string
+	for _, n := range nodes {
+		hasKnownPred := false
+		for _, p := range n.Predecessors {
+			if _, ok := byName[p]; ok && p != n.Name {
+				children[p] = append(children[p], n.Name)
+				hasKnownPred = true
+			}
+		}
+		if !hasKnownPred {
+			roots = append(roots, n.Name)
+		}
+	}
+
+	expanded := map[string]bool{}
+	var walk func(name, parent, prefix string, last, root bool)
+	walk = func(name, parent, prefix string, last, root bool) {
+		node := byName[name]
+		branch, childPrefix := "├── ", prefix+"│   "
+		if last {
+			branch, childPrefix = "└── ", prefix+"    "
+		}
+		if root {
+			branch, childPrefix = "", ""
+		}
+
+		line := prefix + branch + name
+		if expanded[name] {
+			fmt.Fprintf(w, "%s (see above)\n", line)
+			return
+		}
+		expanded[name] = true
+
+		if node.Action != "" {
+			line += " [" + node.Action + "]"
+		}
+		if parent != "" && len(node.Predecessors) > 0 {
+			line += " " + describeWaits(node)
+		}
+		fmt.Fprintln(w, line)
+
+		kids := children[name]
+		for i, child := range kids {
+			walk(child, name, childPrefix, i == len(kids)-1, false)
+		}
+	}
+
+	for _, r := range roots {
+		walk(r, "", "", true, true)
+	}
+
+	// Tasks only reachable through a cycle have no root; list them so none
+	// are silently dropped.
+	for _, n := range nodes {
+		if !expanded[n.Name] {
+			walk(n.Name, "", "", true, true)
+		}
+	}
+}
+
+// describeWaits renders a task's predecessors and their required states, e.g.
+// "(after build: OK, test: ANY)".
+func describeWaits(n TaskNode) string {
+	parts := make([]string, 0, len(n.Predecessors))
+	for _, p := range n.Predecessors {
+		if state, ok := n.States[p]; ok && state != "" {
+			parts = append(parts, p+": "+state)
+		} else {
+			parts = append(parts, p)
+		}
+	}
+	return "(after " + strings.Join(parts, ", ") + ")"
+}
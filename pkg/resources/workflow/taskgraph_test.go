@@ -0,0 +1,98 @@
+package workflow
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+// sampleTasks is a diamond: fetch -> (enrich, validate) -> notify, plus an
+// independent cleanup task.
+const sampleTasks = `{
+  "fetch":    {"name": "fetch", "action": "dynatrace.automations:execute-dql-query"},
+  "enrich":   {"name": "enrich", "action": "dynatrace.automations:run-javascript",
+               "predecessors": ["fetch"], "conditions": {"states": {"fetch": "OK"}}},
+  "validate": {"name": "validate", "action": "dynatrace.automations:run-javascript",
+               "predecessors": ["fetch"], "conditions": {"states": {"fetch": "OK"}}},
+  "notify":   {"name": "notify", "action": "dynatrace.slack:slack-send-message",
+               "predecessors": ["enrich", "validate"],
+               "conditions": {"states": {"enrich": "OK", "validate": "ERROR"}}},
+  "cleanup":  {"name": "cleanup", "action": "dynatrace.automations:http-function"}
+}`
+
+func sampleTaskMap(t *testing.T) map[string]interface{} {
+	t.Helper()
+	var tasks map[string]interface{}
+	if err := json.Unmarshal([]byte(sampleTasks), &tasks); err != nil {
+		t.Fatalf("unmarshal sample tasks: %v", err)
+	}
+	return tasks
+}
+
+func TestTaskGraph(t *testing.T) {
+	nodes := TaskGraph(sampleTaskMap(t))
+	if len(nodes) != 5 {
+		t.Fatalf("got %d nodes, want 5", len(nodes))
+	}
+	if nodes[0].Name != "cleanup" {
+		t.Errorf("nodes not sorted by name: first = %q", nodes[0].Name)
+	}
+
+	var notify TaskNode
+	for _, n := range nodes {
+		if n.Name == "notify" {
+			notify = n
+		}
+	}
+	if len(notify.Predecessors) != 2 || notify.Predecessors[0] != "enrich" || notify.Predecessors[1] != "validate" {
+		t.Errorf("notify predecessors = %v", notify.Predecessors)
+	}
+	if notify.States["validate"] != "ERROR" {
+		t.Errorf("notify state for validate = %q, want ERROR", notify.States["validate"])
+	}
+}
+
+func TestTaskGraph_ConditionsOnly(t *testing.T) {
+	nodes := TaskGraph(map[string]interface{}{
+		"a": map[string]interface{}{},
+		"b": map[string]interface{}{"conditions": map[string]interface{}{"states": map[string]interface{}{"a": "ANY"}}},
+	})
+	if got := nodes[1].Predecessors; len(got) != 1 || got[0] != "a" {
+		t.Errorf("predecessors from conditions.states = %v, want [a]", got)
+	}
+}
+
+func TestRenderTaskTree(t *testing.T) {
+	var buf bytes.Buffer
+	RenderTaskTree(&buf, sampleTaskMap(t))
+
+	want := `cleanup [dynatrace.automations:http-function]
+fetch [dynatrace.automations:execute-dql-query]
+├── enrich [dynatrace.automations:run-javascript] (after fetch: OK)
+│   └── notify [dynatrace.slack:slack-send-message] (after enrich: OK, validate: ERROR)
+└── validate [dynatrace.automations:run-javascript] (after fetch: OK)
+    └── notify (see above)
+`
+	if got := buf.String(); got != want {
+		t.Errorf("RenderTaskTree() =\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestRenderTaskTree_CycleAndUnknownPredecessor(t *testing.T) {
+	tasks := map[string]interface{}{
+		"x":      map[string]interface{}{"predecessors": []interface{}{"y"}},
+		"y":      map[string]interface{}{"predecessors": []interface{}{"x"}},
+		"orphan": map[string]interface{}{"predecessors": []interface{}{"missing"}},
+	}
+	var buf bytes.Buffer
+	RenderTaskTree(&buf, tasks)
+
+	want := `orphan
+x
+└── y (after x)
+    └── x (see above)
+`
+	if got := buf.String(); got != want {
+		t.Errorf("RenderTaskTree() =\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,103 @@
+package workflow
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// UnusedWorkflow is a workflow reported by get workflows --unused, with the
+// reason it looks unused.
+type UnusedWorkflow struct {
+	ID          string `json:"id" yaml:"id" table:"ID"`
+	Title       string `json:"title" yaml:"title" table:"TITLE"`
+	Owner       string `json:"owner,omitempty" yaml:"owner,omitempty" table:"OWNER,wide"`
+	TriggerType string `json:"triggerType,omitempty" yaml:"triggerType,omitempty" table:"TRIGGER"`
+	Reason      string `json:"reason" yaml:"reason" table:"REASON"`
+}
+
+// Disabled reports whether a workflow cannot run on its own: it is not
+// deployed, or its schedule or event trigger is switched off.
+func Disabled(wf Workflow) bool {
+	if !wf.IsDeployed {
+		return true
+	}
+	for _, key := range []string{"schedule", "eventTrigger"} {
+		t, ok := wf.Trigger[key].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if active, ok := t["isActive"].(bool); ok && !active {
+			return true
+		}
+	}
+	return false
+}
+
+// FindUnused returns the workflows that are disabled or have not been
+// executed since the given time, in input order. Executions are looked up
+// per workflow with at most concurrency requests in flight; disabled
+// workflows are reported without a lookup.
+func (h *ExecutionHandler) FindUnused(workflows []Workflow, since time.Time, concurrency int) ([]UnusedWorkflow, error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	reasons := make([]string, len(workflows))
+	errs := make([]error, len(workflows))
+	sinceStr := since.UTC().Format(time.RFC3339)
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i, wf := range workflows {
+		if Disabled(wf) {
+			reasons[i] = "disabled"
+			continue
+		}
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			list, err := h.List(ExecutionFilters{WorkflowID: id, StartedSince: sinceStr}, 1)
+			if err != nil {
+				errs[i] = fmt.Errorf("workflow %s: %w", id, err)
+				return
+			}
+			if list.Count == 0 && len(list.Results) == 0 {
+				reasons[i] = "no runs since " + since.UTC().Format("2006-01-02")
+			}
+		}(i, wf.ID)
+	}
+	wg.Wait()
+
+	var firstErr error
+	failed := 0
+	for _, err := range errs {
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			failed++
+		}
+	}
+	if firstErr != nil {
+		return nil, fmt.Errorf("failed to look up executions of %d workflow(s): %w", failed, firstErr)
+	}
+
+	var unused []UnusedWorkflow
+	for i, wf := range workflows {
+		if reasons[i] == "" {
+			continue
+		}
+		unused = append(unused, UnusedWorkflow{
+			ID:          wf.ID,
+			Title:       wf.Title,
+			Owner:       wf.Owner,
+			TriggerType: wf.TriggerType,
+			Reason:      reasons[i],
+		})
+	}
+	return unused, nil
+}
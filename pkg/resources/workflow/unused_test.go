@@ -0,0 +1,72 @@
+package workflow
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDisabled(t *testing.T) {
+	tests := []struct {
+		name string
+		wf   Workflow
+		want bool
+	}{
+		{"deployed manual", Workflow{IsDeployed: true}, false},
+		{"not deployed", Workflow{IsDeployed: false}, true},
+		{"inactive schedule", Workflow{IsDeployed: true, Trigger: map[string]interface{}{
+			"schedule": map[string]interface{}{"isActive": false},
+		}}, true},
+		{"active event trigger", Workflow{IsDeployed: true, Trigger: map[string]interface{}{
+			"eventTrigger": map[string]interface{}{"isActive": true},
+		}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Disabled(tt.wf); got != tt.want {
+				t.Errorf("Disabled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindUnused(t *testing.T) {
+	var mu sync.Mutex
+	var queried []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/platform/automation/v1/executions", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		mu.Lock()
+		queried = append(queried, q.Get("workflow"))
+		mu.Unlock()
+		if q.Get("startedAt__gte") != "2026-07-01T00:00:00Z" || q.Get("limit") != "1" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if q.Get("workflow") == "active" {
+			_ = json.NewEncoder(w).Encode(map[string]any{"count": 3, "results": []any{map[string]any{"id": "e1", "workflow": "active"}}})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"count": 0, "results": []any{}})
+	})
+	h, cleanup := newExecTestHandler(t, mux)
+	defer cleanup()
+
+	workflows := []Workflow{
+		{ID: "active", Title: "Active", IsDeployed: true},
+		{ID: "idle", Title: "Idle", IsDeployed: true},
+		{ID: "draft", Title: "Draft", IsDeployed: false},
+	}
+	got, err := h.FindUnused(workflows, time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC), 2)
+	if err != nil {
+		t.Fatalf("FindUnused() error = %v", err)
+	}
+	if len(got) != 2 || got[0].ID != "idle" || got[0].Reason != "no runs since 2026-07-01" || got[1].ID != "draft" || got[1].Reason != "disabled" {
+		t.Errorf("FindUnused() = %+v, want idle (no runs) and draft (disabled)", got)
+	}
+	if len(queried) != 2 {
+		t.Errorf("queried executions of %v, want only the two deployed workflows", queried)
+	}
+}
@@ -32,6 +32,12 @@ type Workflow struct {
 	Tasks                map[string]interface{} `json:"tasks,omitempty" yaml:"tasks,omitempty" table:"-"`
 }
 
+// ManagedFields lists the server-managed fields that 'get -o json|yaml' omits
+// unless --show-managed-fields is set.
+func (w Workflow) ManagedFields() []string {
+	return []string{"owner", "ownerType"}
+}
+
 // WorkflowList represents a list of workflows.
 type WorkflowList struct {
 	Count   int        `json:"count"`
@@ -20,11 +20,12 @@ type (
 )
 
 const (
-	OperationRead         = session.OperationRead
-	OperationCreate       = session.OperationCreate
-	OperationUpdate       = session.OperationUpdate
-	OperationDelete       = session.OperationDelete
-	OperationDeleteBucket = session.OperationDeleteBucket
+	OperationRead          = session.OperationRead
+	OperationCreate        = session.OperationCreate
+	OperationUpdate        = session.OperationUpdate
+	OperationDelete        = session.OperationDelete
+	OperationDeleteBucket  = session.OperationDeleteBucket
+	OperationDeleteRecords = session.OperationDeleteRecords
 
 	OwnershipUnknown = session.OwnershipUnknown
 	OwnershipOwn     = session.OwnershipOwn
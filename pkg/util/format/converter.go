@@ -145,6 +145,54 @@ func ValidateAndConvert(data []byte) ([]byte, error) {
 	}
 }
 
+// ParseFormat parses an input format name (json or yaml, case-insensitive;
+// yml is accepted for yaml).
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "json":
+		return FormatJSON, nil
+	case "yaml", "yml":
+		return FormatYAML, nil
+	default:
+		return "", fmt.Errorf("unsupported input format %q (use json or yaml)", s)
+	}
+}
+
+// ConvertAs parses data as the given format and converts it to JSON, without
+// auto-detection. An empty format falls back to ValidateAndConvert. Parse
+// errors name the forced format.
+func ConvertAs(data []byte, f Format) ([]byte, error) {
+	if f == "" {
+		return ValidateAndConvert(data)
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, fmt.Errorf("empty data")
+	}
+
+	switch f {
+	case FormatJSON:
+		var js interface{}
+		if err := json.Unmarshal(data, &js); err != nil {
+			return nil, fmt.Errorf("failed to parse input as JSON (input format forced to json): %w", err)
+		}
+		return data, nil
+
+	case FormatYAML:
+		var y interface{}
+		if err := yaml.Unmarshal(data, &y); err != nil {
+			return nil, fmt.Errorf("failed to parse input as YAML (input format forced to yaml): %w", err)
+		}
+		jsonData, err := json.Marshal(y)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert to JSON: %w", err)
+		}
+		return jsonData, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", f)
+	}
+}
+
 // PrettyJSON formats JSON with indentation
 func PrettyJSON(jsonData []byte) ([]byte, error) {
 	var prettyJSON bytes.Buffer
@@ -471,3 +471,85 @@ func TestYAMLNodeFromJSON(t *testing.T) {
 }
 
 var rawByteSeqRE = regexp.MustCompile(`(?m)(?:^[ \t]*-[ \t]+\d+[ \t]*\n){8,}`)
+
+func TestParseFormat(t *testing.T) {
+	for in, want := range map[string]Format{"json": FormatJSON, "JSON": FormatJSON, "yaml": FormatYAML, "yml": FormatYAML} {
+		got, err := ParseFormat(in)
+		if err != nil || got != want {
+			t.Errorf("ParseFormat(%q) = %q, %v, want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseFormat("toml"); err == nil {
+		t.Error("ParseFormat(toml) error = nil")
+	}
+}
+
+func TestConvertAs(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		format  Format
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "json forced",
+			input:  `{"name": "test"}`,
+			format: FormatJSON,
+			want:   `{"name": "test"}`,
+		},
+		{
+			name:   "json content forced to yaml",
+			input:  `{"name": "test"}`,
+			format: FormatYAML,
+			want:   `{"name":"test"}`,
+		},
+		{
+			name:   "yaml forced",
+			input:  "name: test\n",
+			format: FormatYAML,
+			want:   `{"name":"test"}`,
+		},
+		{
+			name:    "yaml content forced to json",
+			input:   "name: test\n",
+			format:  FormatJSON,
+			wantErr: "failed to parse input as JSON (input format forced to json)",
+		},
+		{
+			name:    "invalid yaml",
+			input:   "name: [unclosed\n",
+			format:  FormatYAML,
+			wantErr: "failed to parse input as YAML (input format forced to yaml)",
+		},
+		{
+			name:    "empty",
+			input:   "  \n",
+			format:  FormatJSON,
+			wantErr: "empty data",
+		},
+		{
+			name:  "auto-detect",
+			input: "name: test\n",
+			want:  `{"name":"test"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ConvertAs([]byte(tt.input), tt.format)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("ConvertAs() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ConvertAs() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("ConvertAs() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
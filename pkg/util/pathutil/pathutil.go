@@ -0,0 +1,65 @@
+// Package pathutil normalizes file paths given on the command line so that
+// the shell shorthands interactive users expect also work when the shell did
+// not expand them, e.g. in quoted arguments or "--file=~/wf.yaml".
+//
+// Supported forms:
+//
+//	~, ~/configs/wf.yaml      the current user's home directory
+//	~alice/configs/wf.yaml    another user's home directory
+//	$HOME/wf.yaml, ${DIR}/x   environment variables
+//
+// "-" (stdin/stdout) and the empty string are returned unchanged.
+package pathutil
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+)
+
+// lookupUser is replaceable in tests.
+var lookupUser = user.Lookup
+
+// Expand expands environment variables and a leading ~ or ~user in path.
+func Expand(path string) (string, error) {
+	if path == "" || path == "-" {
+		return path, nil
+	}
+	if strings.Contains(path, "$") {
+		path = os.ExpandEnv(path)
+	}
+	if !strings.HasPrefix(path, "~") {
+		return path, nil
+	}
+
+	name, rest := path[1:], ""
+	if i := strings.IndexFunc(name, isSeparator); i >= 0 {
+		name, rest = name[:i], name[i+1:]
+	}
+
+	var home string
+	if name == "" {
+		dir, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("cannot expand %q: %w", path, err)
+		}
+		home = dir
+	} else {
+		u, err := lookupUser(name)
+		if err != nil {
+			return "", fmt.Errorf("cannot expand %q: %w", path, err)
+		}
+		home = u.HomeDir
+	}
+
+	if rest == "" {
+		return home, nil
+	}
+	return filepath.Join(home, rest), nil
+}
+
+func isSeparator(r rune) bool {
+	return r == '/' || r == filepath.Separator
+}
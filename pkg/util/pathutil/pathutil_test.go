@@ -0,0 +1,62 @@
+package pathutil
+
+import (
+	"errors"
+	"os/user"
+	"path/filepath"
+	"testing"
+)
+
+func TestExpand(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	t.Setenv("DTCTL_TEST_DIR", "/srv/configs")
+
+	orig := lookupUser
+	defer func() { lookupUser = orig }()
+	lookupUser = func(name string) (*user.User, error) {
+		if name == "alice" {
+			return &user.User{Username: "alice", HomeDir: "/home/alice"}, nil
+		}
+		return nil, user.UnknownUserError(name)
+	}
+
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{name: "empty", in: "", want: ""},
+		{name: "stdin", in: "-", want: "-"},
+		{name: "plain relative", in: "wf.yaml", want: "wf.yaml"},
+		{name: "plain absolute", in: "/tmp/wf.yaml", want: "/tmp/wf.yaml"},
+		{name: "tilde alone", in: "~", want: home},
+		{name: "tilde path", in: "~/configs/wf.yaml", want: filepath.Join(home, "configs", "wf.yaml")},
+		{name: "tilde user", in: "~alice/wf.yaml", want: filepath.Join("/home/alice", "wf.yaml")},
+		{name: "unknown user", in: "~nobody-here/wf.yaml", wantErr: true},
+		{name: "env var", in: "$DTCTL_TEST_DIR/wf.yaml", want: "/srv/configs/wf.yaml"},
+		{name: "braced env var", in: "${DTCTL_TEST_DIR}/wf.yaml", want: "/srv/configs/wf.yaml"},
+		{name: "home env var", in: "$HOME/wf.yaml", want: home + "/wf.yaml"},
+		{name: "tilde not leading", in: "configs/~/wf.yaml", want: "configs/~/wf.yaml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Expand(tt.in)
+			if tt.wantErr {
+				var unknown user.UnknownUserError
+				if !errors.As(err, &unknown) {
+					t.Fatalf("Expand(%q) error = %v, want unknown user", tt.in, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expand(%q) error = %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Errorf("Expand(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package template
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/dynatrace-oss/dtctl/pkg/util/pathutil"
+)
+
+// ParseSetFileFlags parses --set-file flags into a map. Each key=path entry
+// sets key to the contents of the file at path, as a string. The path gets
+// the same ~ and $VAR expansion as other path flags.
+func ParseSetFileFlags(setFileFlags []string) (map[string]interface{}, error) {
+	vars := make(map[string]interface{})
+	for _, flag := range setFileFlags {
+		key, path, err := splitVarFlag("--set-file", flag, "key=path")
+		if err != nil {
+			return nil, err
+		}
+		if path, err = pathutil.Expand(path); err != nil {
+			return nil, fmt.Errorf("--set-file %s: %w", key, err)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("--set-file %s: %w", key, err)
+		}
+		vars[key] = string(data)
+	}
+	return vars, nil
+}
+
+// ParseSetJSONFlags parses --set-json flags into a map. Each key=json entry
+// sets key to the decoded JSON value, so arrays, objects, numbers, and
+// booleans keep their type.
+func ParseSetJSONFlags(setJSONFlags []string) (map[string]interface{}, error) {
+	vars := make(map[string]interface{})
+	for _, flag := range setJSONFlags {
+		key, raw, err := splitVarFlag("--set-json", flag, "key=json")
+		if err != nil {
+			return nil, err
+		}
+		var value interface{}
+		if err := json.Unmarshal([]byte(raw), &value); err != nil {
+			return nil, fmt.Errorf("--set-json %s: invalid JSON: %w", key, err)
+		}
+		vars[key] = value
+	}
+	return vars, nil
+}
+
+// ParseVarFlags combines --set, --set-file, and --set-json flags into one
+// variable map. When a key is given more than once, --set-json wins over
+// --set-file, which wins over --set.
+func ParseVarFlags(setFlags, setFileFlags, setJSONFlags []string) (map[string]interface{}, error) {
+	vars, err := ParseSetFlags(setFlags)
+	if err != nil {
+		return nil, err
+	}
+	fileVars, err := ParseSetFileFlags(setFileFlags)
+	if err != nil {
+		return nil, err
+	}
+	jsonVars, err := ParseSetJSONFlags(setJSONFlags)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range fileVars {
+		vars[k] = v
+	}
+	for k, v := range jsonVars {
+		vars[k] = v
+	}
+	return vars, nil
+}
+
+func splitVarFlag(flagName, flag, format string) (string, string, error) {
+	parts := strings.SplitN(flag, "=", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid %s format: %q (expected %s)", flagName, flag, format)
+	}
+	key := strings.TrimSpace(parts[0])
+	if key == "" {
+		return "", "", fmt.Errorf("empty key in %s flag: %q", flagName, flag)
+	}
+	return key, parts[1], nil
+}
+
+// wholeValuePlaceholder matches a JSON string that consists of nothing but a
+// single variable reference, e.g. "{{ .hosts }}".
+var wholeValuePlaceholder = regexp.MustCompile(`"\{\{-?\s*\.([A-Za-z_][A-Za-z0-9_]*)\s*-?\}\}"`)
+
+// RenderJSONTemplate renders a JSON document template. A JSON string that
+// holds only a variable reference ("{{ .hosts }}") is replaced by the
+// variable's JSON encoding, so list and object values from --set-json become
+// real arrays and objects, and file contents from --set-file are escaped.
+// Everything else is rendered by RenderTemplate as plain text.
+//
+// Whole-value references are swapped for sentinels before rendering and
+// filled in afterwards, so variable values are never parsed as template
+// text themselves; a workflow script containing "{{ }}" passes through as is.
+func RenderJSONTemplate(jsonStr string, vars map[string]interface{}) (string, error) {
+	sentinel, err := newSentinel(jsonStr)
+	if err != nil {
+		return "", err
+	}
+
+	var replacements []string
+	var encodeErr error
+	protected := wholeValuePlaceholder.ReplaceAllStringFunc(jsonStr, func(match string) string {
+		key := wholeValuePlaceholder.FindStringSubmatch(match)[1]
+		value, ok := vars[key]
+		if !ok {
+			return match
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			encodeErr = fmt.Errorf("failed to encode variable %q: %w", key, err)
+			return match
+		}
+		placeholder := fmt.Sprintf(`"%s%d"`, sentinel, len(replacements)/2)
+		replacements = append(replacements, placeholder, string(data))
+		return placeholder
+	})
+	if encodeErr != nil {
+		return "", encodeErr
+	}
+
+	rendered, err := RenderTemplate(protected, vars)
+	if err != nil {
+		return "", err
+	}
+	if len(replacements) == 0 {
+		return rendered, nil
+	}
+	return strings.NewReplacer(replacements...).Replace(rendered), nil
+}
+
+// newSentinel returns a random marker that does not occur in s.
+func newSentinel(s string) (string, error) {
+	buf := make([]byte, 8)
+	for {
+		if _, err := rand.Read(buf); err != nil {
+			return "", fmt.Errorf("failed to generate template sentinel: %w", err)
+		}
+		sentinel := "dtctl-var-" + hex.EncodeToString(buf) + "-"
+		if !strings.Contains(s, sentinel) {
+			return sentinel, nil
+		}
+	}
+}
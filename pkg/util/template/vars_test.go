@@ -0,0 +1,164 @@
+package template
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseSetFileFlags(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "script.js")
+	if err := os.WriteFile(path, []byte("export default () => \"ok\";\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	vars, err := ParseSetFileFlags([]string{"script=" + path})
+	if err != nil {
+		t.Fatalf("ParseSetFileFlags() error = %v", err)
+	}
+	if got := vars["script"]; got != "export default () => \"ok\";\n" {
+		t.Errorf("script = %q, want file contents", got)
+	}
+
+	if _, err := ParseSetFileFlags([]string{"script=" + filepath.Join(dir, "missing.js")}); err == nil {
+		t.Error("expected error for missing file")
+	}
+	if _, err := ParseSetFileFlags([]string{"script"}); err == nil || !strings.Contains(err.Error(), "--set-file") {
+		t.Errorf("expected --set-file format error, got %v", err)
+	}
+}
+
+func TestParseSetFileFlags_ExpandsPath(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	if err := os.WriteFile(filepath.Join(home, "query.dql"), []byte("fetch logs"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	vars, err := ParseSetFileFlags([]string{"query=~/query.dql"})
+	if err != nil {
+		t.Fatalf("ParseSetFileFlags() error = %v", err)
+	}
+	if got := vars["query"]; got != "fetch logs" {
+		t.Errorf("query = %q, want file contents", got)
+	}
+}
+
+func TestParseSetJSONFlags(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		flag    string
+		want    interface{}
+		wantErr bool
+	}{
+		{name: "list", flag: `hosts=["a","b"]`, want: []interface{}{"a", "b"}},
+		{name: "object", flag: `owner={"team":"sre"}`, want: map[string]interface{}{"team": "sre"}},
+		{name: "number", flag: `limit=10`, want: float64(10)},
+		{name: "bool", flag: `enabled=true`, want: true},
+		{name: "invalid json", flag: `hosts=[a`, wantErr: true},
+		{name: "empty key", flag: `=[]`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			vars, err := ParseSetJSONFlags([]string{tt.flag})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseSetJSONFlags() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			for _, v := range vars {
+				if !reflect.DeepEqual(v, tt.want) {
+					t.Errorf("value = %#v, want %#v", v, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseVarFlags_Precedence(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "name.txt")
+	if err := os.WriteFile(path, []byte("from-file"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	vars, err := ParseVarFlags(
+		[]string{"a=from-set", "b=from-set", "c=from-set"},
+		[]string{"b=" + path, "c=" + path},
+		[]string{`c="from-json"`},
+	)
+	if err != nil {
+		t.Fatalf("ParseVarFlags() error = %v", err)
+	}
+	want := map[string]interface{}{"a": "from-set", "b": "from-file", "c": "from-json"}
+	if !reflect.DeepEqual(vars, want) {
+		t.Errorf("ParseVarFlags() = %v, want %v", vars, want)
+	}
+}
+
+func TestRenderJSONTemplate(t *testing.T) {
+	t.Parallel()
+	vars := map[string]interface{}{
+		"name":   "web",
+		"hosts":  []interface{}{"h-1", "h-2"},
+		"script": "line \"one\"\nline two\n",
+		"limit":  float64(5),
+	}
+	tmpl := `{"title":"Hosts of {{ .name }}","hosts":"{{ .hosts }}","script":"{{.script}}","limit":"{{ .limit }}"}`
+
+	rendered, err := RenderJSONTemplate(tmpl, vars)
+	if err != nil {
+		t.Fatalf("RenderJSONTemplate() error = %v", err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal([]byte(rendered), &got); err != nil {
+		t.Fatalf("rendered output is not valid JSON: %v\n%s", err, rendered)
+	}
+	want := map[string]interface{}{
+		"title":  "Hosts of web",
+		"hosts":  []interface{}{"h-1", "h-2"},
+		"script": "line \"one\"\nline two\n",
+		"limit":  float64(5),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RenderJSONTemplate() = %v, want %v", got, want)
+	}
+}
+
+func TestRenderJSONTemplate_ValueWithTemplateSyntax(t *testing.T) {
+	t.Parallel()
+	vars := map[string]interface{}{
+		"script": "export default () => `{{ event()['id'] }}`;\n",
+		"input":  map[string]interface{}{"query": "{{ .notAVariable }}"},
+		"name":   "web",
+	}
+	tmpl := `{"title":"{{ .name }}","script":"{{ .script }}","input":"{{ .input }}"}`
+
+	rendered, err := RenderJSONTemplate(tmpl, vars)
+	if err != nil {
+		t.Fatalf("RenderJSONTemplate() error = %v", err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal([]byte(rendered), &got); err != nil {
+		t.Fatalf("rendered output is not valid JSON: %v\n%s", err, rendered)
+	}
+	want := map[string]interface{}{
+		"title":  "web",
+		"script": "export default () => `{{ event()['id'] }}`;\n",
+		"input":  map[string]interface{}{"query": "{{ .notAVariable }}"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RenderJSONTemplate() = %v, want %v", got, want)
+	}
+}
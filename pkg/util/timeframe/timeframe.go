@@ -0,0 +1,136 @@
+// Package timeframe parses the time expressions accepted by flags such as
+// --since, --started-since, and --default-timeframe-start into the absolute
+// timestamps the APIs expect.
+//
+// Supported forms:
+//
+//	now                   the current time
+//	now-7d, now()-2h      relative to now (units: s, m, h, d, w)
+//	7d, -30m, 1h30m       shorthand for "that long ago"
+//	2026-01-02T15:04:05Z  RFC3339 / ISO 8601, with or without seconds and zone
+//	2026-01-02            a calendar day (start of day, or end of day for Until)
+//
+// Timestamps without a zone are interpreted as UTC. Results are normalized to
+// UTC so they can be passed to the APIs unchanged.
+package timeframe
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// relative matches "now", "now-7d", "now()-7d", "now+1h", "7d", and "-7d".
+var relative = regexp.MustCompile(`^(?:now(?:\(\))?)?\s*([+-])?\s*(\d+)([smhdw])$`)
+
+// Layouts of absolute timestamps, tried in order.
+var layouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+}
+
+const dateLayout = "2006-01-02"
+
+var units = map[string]time.Duration{
+	"s": time.Second,
+	"m": time.Minute,
+	"h": time.Hour,
+	"d": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour,
+}
+
+// Parse resolves expr relative to now. Date-only values resolve to the start
+// of the day.
+func Parse(expr string, now time.Time) (time.Time, error) {
+	return parse(expr, now, false)
+}
+
+// Until resolves expr like Parse, except that date-only values resolve to the
+// last second of the day, so "--until 2026-01-02" includes that whole day.
+func Until(expr string, now time.Time) (time.Time, error) {
+	return parse(expr, now, true)
+}
+
+// RFC3339 resolves expr against the current time and formats it as an RFC3339
+// UTC timestamp, keeping fractional seconds if present. An empty expr yields an
+// empty string. endOfDay selects Until semantics for date-only values.
+func RFC3339(expr string, endOfDay bool) (string, error) {
+	if strings.TrimSpace(expr) == "" {
+		return "", nil
+	}
+	t, err := parse(expr, time.Now().Truncate(time.Second), endOfDay)
+	if err != nil {
+		return "", err
+	}
+	return t.Format(time.RFC3339Nano), nil
+}
+
+// Range resolves a from/to pair and checks that from is not after to. An empty
+// to means now.
+func Range(from, to string, now time.Time) (time.Time, time.Time, error) {
+	start, err := Parse(from, now)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid start: %w", err)
+	}
+	end := now.UTC()
+	if strings.TrimSpace(to) != "" {
+		if end, err = Until(to, now); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid end: %w", err)
+		}
+	}
+	if start.After(end) {
+		return time.Time{}, time.Time{}, fmt.Errorf("start %s is after end %s", start.Format(time.RFC3339), end.Format(time.RFC3339))
+	}
+	return start, end, nil
+}
+
+func parse(expr string, now time.Time, endOfDay bool) (time.Time, error) {
+	s := strings.TrimSpace(expr)
+	if s == "" {
+		return time.Time{}, fmt.Errorf("empty time expression")
+	}
+
+	switch strings.ToLower(s) {
+	case "now", "now()":
+		return now.UTC(), nil
+	}
+
+	if m := relative.FindStringSubmatch(strings.ToLower(s)); m != nil {
+		n, err := strconv.Atoi(m[2])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid time expression %q: %w", expr, err)
+		}
+		d := time.Duration(n) * units[m[3]]
+		if m[1] == "+" {
+			return now.Add(d).UTC(), nil
+		}
+		return now.Add(-d).UTC(), nil
+	}
+
+	// Compound Go durations such as 1h30m, meaning "that long ago".
+	if d, err := time.ParseDuration(strings.TrimPrefix(s, "-")); err == nil {
+		return now.Add(-d).UTC(), nil
+	}
+
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC(), nil
+		}
+	}
+
+	if t, err := time.Parse(dateLayout, s); err == nil {
+		if endOfDay {
+			t = t.Add(24*time.Hour - time.Second)
+		}
+		return t, nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time expression %q: use now-7d, 24h, YYYY-MM-DD, or ISO 8601 (e.g. 2006-01-02T15:04:05Z)", expr)
+}
@@ -0,0 +1,139 @@
+package timeframe
+
+import (
+	"testing"
+	"time"
+)
+
+var now = time.Date(2026, 6, 10, 12, 0, 0, 0, time.UTC)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{name: "now", in: "now", want: "2026-06-10T12:00:00Z"},
+		{name: "now() DQL form", in: "now()", want: "2026-06-10T12:00:00Z"},
+		{name: "now minus days", in: "now-7d", want: "2026-06-03T12:00:00Z"},
+		{name: "now() minus hours", in: "now()-2h", want: "2026-06-10T10:00:00Z"},
+		{name: "now plus minutes", in: "now+30m", want: "2026-06-10T12:30:00Z"},
+		{name: "spaces around operator", in: "now - 1w", want: "2026-06-03T12:00:00Z"},
+		{name: "bare duration means ago", in: "24h", want: "2026-06-09T12:00:00Z"},
+		{name: "negative bare duration", in: "-30s", want: "2026-06-10T11:59:30Z"},
+		{name: "compound duration", in: "1h30m", want: "2026-06-10T10:30:00Z"},
+		{name: "uppercase unit", in: "NOW-1D", want: "2026-06-09T12:00:00Z"},
+		{name: "RFC3339 UTC", in: "2026-01-02T15:04:05Z", want: "2026-01-02T15:04:05Z"},
+		{name: "RFC3339 fractional seconds", in: "2026-01-02T15:04:05.123Z", want: "2026-01-02T15:04:05Z"},
+		{name: "positive offset normalized to UTC", in: "2026-01-02T17:04:05+02:00", want: "2026-01-02T15:04:05Z"},
+		{name: "negative offset crosses midnight", in: "2026-01-02T20:00:00-05:00", want: "2026-01-03T01:00:00Z"},
+		{name: "no seconds with zone", in: "2026-01-02T15:04+01:00", want: "2026-01-02T14:04:00Z"},
+		{name: "no zone treated as UTC", in: "2026-01-02T15:04:05", want: "2026-01-02T15:04:05Z"},
+		{name: "no seconds no zone", in: "2026-01-02T15:04", want: "2026-01-02T15:04:00Z"},
+		{name: "space separated", in: "2026-01-02 15:04:05", want: "2026-01-02T15:04:05Z"},
+		{name: "date only is start of day", in: "2026-01-02", want: "2026-01-02T00:00:00Z"},
+		{name: "empty", in: "", wantErr: true},
+		{name: "garbage", in: "yesterday", wantErr: true},
+		{name: "unknown unit", in: "now-7y", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.in, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if s := got.Format(time.RFC3339); s != tt.want {
+				t.Errorf("Parse(%q) = %s, want %s", tt.in, s, tt.want)
+			}
+			if got.Location() != time.UTC {
+				t.Errorf("Parse(%q) location = %v, want UTC", tt.in, got.Location())
+			}
+		})
+	}
+}
+
+func TestParse_NowInOtherZone(t *testing.T) {
+	berlin := time.FixedZone("CEST", 2*60*60)
+	got, err := Parse("now-1h", now.In(berlin))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if want := "2026-06-10T11:00:00Z"; got.Format(time.RFC3339) != want {
+		t.Errorf("Parse() = %s, want %s", got.Format(time.RFC3339), want)
+	}
+}
+
+func TestUntil(t *testing.T) {
+	got, err := Until("2026-01-02", now)
+	if err != nil {
+		t.Fatalf("Until() error = %v", err)
+	}
+	if want := "2026-01-02T23:59:59Z"; got.Format(time.RFC3339) != want {
+		t.Errorf("Until() = %s, want %s", got.Format(time.RFC3339), want)
+	}
+
+	got, err = Until("now-1d", now)
+	if err != nil {
+		t.Fatalf("Until() error = %v", err)
+	}
+	if want := "2026-06-09T12:00:00Z"; got.Format(time.RFC3339) != want {
+		t.Errorf("Until(relative) = %s, want %s", got.Format(time.RFC3339), want)
+	}
+}
+
+func TestRFC3339(t *testing.T) {
+	got, err := RFC3339("", false)
+	if err != nil || got != "" {
+		t.Errorf("RFC3339(\"\") = %q, %v; want empty", got, err)
+	}
+
+	got, err = RFC3339("2026-01-02", true)
+	if err != nil {
+		t.Fatalf("RFC3339() error = %v", err)
+	}
+	if got != "2026-01-02T23:59:59Z" {
+		t.Errorf("RFC3339() = %q", got)
+	}
+
+	got, err = RFC3339("2022-04-20T14:10:04.123+02:00", false)
+	if err != nil {
+		t.Fatalf("RFC3339() error = %v", err)
+	}
+	if got != "2022-04-20T12:10:04.123Z" {
+		t.Errorf("RFC3339(fractional) = %q", got)
+	}
+
+	if _, err := RFC3339("bogus", false); err == nil {
+		t.Error("RFC3339(bogus) expected error")
+	}
+}
+
+func TestRange(t *testing.T) {
+	start, end, err := Range("now-7d", "", now)
+	if err != nil {
+		t.Fatalf("Range() error = %v", err)
+	}
+	if !start.Equal(now.Add(-7*24*time.Hour)) || !end.Equal(now) {
+		t.Errorf("Range() = %s..%s", start, end)
+	}
+
+	start, end, err = Range("2026-01-01", "2026-01-01", now)
+	if err != nil {
+		t.Fatalf("Range() error = %v", err)
+	}
+	if end.Sub(start) != 24*time.Hour-time.Second {
+		t.Errorf("Range(same day) span = %s", end.Sub(start))
+	}
+
+	if _, _, err := Range("now-1h", "now-2h", now); err == nil {
+		t.Error("Range() expected error for inverted range")
+	}
+	if _, _, err := Range("bogus", "", now); err == nil {
+		t.Error("Range() expected error for invalid start")
+	}
+}
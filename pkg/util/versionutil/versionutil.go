@@ -0,0 +1,38 @@
+// Package versionutil compares the dotted numeric versions used by
+// extensions, settings schemas, and the app function executor SDK.
+package versionutil
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Compare compares dotted numeric versions ("1.2.10" > "1.2.9"). It returns
+// 1 when a is newer than b, -1 when it is older, and 0 when they are equal.
+// Missing parts count as 0 ("2.0" == "2.0.0"), as do non-numeric parts.
+func Compare(a, b string) int {
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+	maxLen := len(aParts)
+	if len(bParts) > maxLen {
+		maxLen = len(bParts)
+	}
+
+	for idx := 0; idx < maxLen; idx++ {
+		aVal := 0
+		if idx < len(aParts) {
+			aVal, _ = strconv.Atoi(aParts[idx])
+		}
+		bVal := 0
+		if idx < len(bParts) {
+			bVal, _ = strconv.Atoi(bParts[idx])
+		}
+		if aVal > bVal {
+			return 1
+		}
+		if aVal < bVal {
+			return -1
+		}
+	}
+	return 0
+}
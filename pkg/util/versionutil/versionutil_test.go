@@ -0,0 +1,25 @@
+package versionutil
+
+import "testing"
+
+func TestCompare(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"1.0.0", "1.0.0", 0},
+		{"1.0.0", "0.1.6", 1},
+		{"0.1.6", "1.0.0", -1},
+		{"1.10.0", "1.2.0", 1},
+		{"1.2.10", "1.2.3", 1},
+		{"2.0", "1.99.99", 1},
+		{"2.0", "2.0.0", 0},
+		{"1.0", "1.0.1", -1},
+		{"1.x", "1.0", 0},
+	}
+	for _, tc := range cases {
+		if got := Compare(tc.a, tc.b); got != tc.want {
+			t.Errorf("Compare(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
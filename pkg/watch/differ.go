@@ -10,6 +10,9 @@ import (
 
 type Differ struct {
 	previous map[string]interface{}
+	// order holds the keys of previous in the order they were fetched, so
+	// changes are reported in list order rather than map order.
+	order []string
 }
 
 func NewDiffer() *Differ {
@@ -22,6 +25,7 @@ func (d *Differ) Detect(current []interface{}) []Change {
 	changes := []Change{}
 
 	currentMap := make(map[string]interface{})
+	var order []string
 	for _, item := range current {
 		id := extractID(item)
 		if id == "" {
@@ -31,11 +35,15 @@ func (d *Differ) Detect(current []interface{}) []Change {
 			// indistinguishable to the user too.
 			id = "hash:" + contentHash(item)
 		}
+		if _, dup := currentMap[id]; !dup {
+			order = append(order, id)
+		}
 		currentMap[id] = item
 	}
 
 	// Detect additions and modifications - only return actual changes
-	for id, item := range currentMap {
+	for _, id := range order {
+		item := currentMap[id]
 		if prev, exists := d.previous[id]; !exists {
 			changes = append(changes, Change{
 				Type:     ChangeTypeAdded,
@@ -54,21 +62,23 @@ func (d *Differ) Detect(current []interface{}) []Change {
 	}
 
 	// Detect deletions
-	for id, item := range d.previous {
+	for _, id := range d.order {
 		if _, exists := currentMap[id]; !exists {
 			changes = append(changes, Change{
 				Type:     ChangeTypeDeleted,
-				Resource: item,
+				Resource: d.previous[id],
 			})
 		}
 	}
 
 	d.previous = currentMap
+	d.order = order
 	return changes
 }
 
 func (d *Differ) Reset() {
 	d.previous = make(map[string]interface{})
+	d.order = nil
 }
 
 func extractID(item interface{}) string {
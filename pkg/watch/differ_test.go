@@ -1,6 +1,7 @@
 package watch
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -262,3 +263,31 @@ func TestDetectChangedField(t *testing.T) {
 		t.Errorf("Expected new value 'FAILED', got %v", newVal)
 	}
 }
+
+func TestDiffer_ChangesFollowListOrder(t *testing.T) {
+	differ := NewDiffer()
+
+	var initial []interface{}
+	for _, id := range []string{"a", "b", "c", "d", "e"} {
+		initial = append(initial, map[string]interface{}{"id": id, "state": "RUNNING"})
+	}
+	differ.Detect(initial)
+
+	current := []interface{}{
+		map[string]interface{}{"id": "z", "state": "RUNNING"},
+		map[string]interface{}{"id": "e", "state": "SUCCESS"},
+		map[string]interface{}{"id": "c", "state": "RUNNING"},
+		map[string]interface{}{"id": "a", "state": "FAILED"},
+		map[string]interface{}{"id": "y", "state": "RUNNING"},
+	}
+	changes := differ.Detect(current)
+
+	var got []string
+	for _, c := range changes {
+		got = append(got, string(c.Type)+":"+extractID(c.Resource))
+	}
+	want := []string{"ADDED:z", "MODIFIED:e", "MODIFIED:a", "ADDED:y", "DELETED:b", "DELETED:d"}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("changes = %v, want %v", got, want)
+	}
+}
@@ -59,6 +59,9 @@ type DeferredExecutionResponse struct {
 type FunctionExecutorRequest struct {
 	SourceCode string `json:"sourceCode"`
 	Payload    string `json:"payload,omitempty"`
+	// SDKVersion pins the function executor SDK; empty lets the service
+	// choose its default.
+	SDKVersion string `json:"sdkVersion,omitempty"`
 }
 
 // FunctionExecutorResponse represents an ad-hoc function execution response
@@ -178,11 +181,15 @@ func (h *FunctionHandler) DeferExecution(ctx context.Context, req *DeferredExecu
 
 // ExecuteCode executes ad-hoc JavaScript code using the function executor
 func (h *FunctionHandler) ExecuteCode(ctx context.Context, sourceCode, payload string) (*FunctionExecutorResponse, error) {
-	req := FunctionExecutorRequest{
+	return h.ExecuteCodeWithOptions(ctx, &FunctionExecutorRequest{
 		SourceCode: sourceCode,
 		Payload:    payload,
-	}
+	})
+}
 
+// ExecuteCodeWithOptions executes ad-hoc JavaScript code using the function
+// executor, sending the full request (including an optional SDK version).
+func (h *FunctionHandler) ExecuteCodeWithOptions(ctx context.Context, req *FunctionExecutorRequest) (*FunctionExecutorResponse, error) {
 	resp, err := h.client.HTTP().R().SetContext(ctx).
 		SetBody(req).
 		Post("/platform/app-engine/function-executor/v1/executions")
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/dynatrace-oss/dtctl/sdk/httpclient"
 )
@@ -124,6 +126,33 @@ func (h *Handler) Update(ctx context.Context, bucketName string, version int, re
 	return nil
 }
 
+// StatusActive is the status of a bucket that is ready to store and query data.
+const StatusActive = "active"
+
+// WaitActive polls a bucket every interval until its status is active and
+// returns the final definition. It stops with ctx's error when ctx is done,
+// and fails early if the bucket enters the deleting state.
+func (h *Handler) WaitActive(ctx context.Context, bucketName string, interval time.Duration) (*Bucket, error) {
+	for {
+		b, err := h.Get(ctx, bucketName)
+		if err != nil {
+			return nil, err
+		}
+		switch strings.ToLower(b.Status) {
+		case StatusActive:
+			return b, nil
+		case "deleting":
+			return nil, fmt.Errorf("bucket %q is being deleted", bucketName)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for bucket %q to become active (last status: %s): %w", bucketName, b.Status, ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
+
 // Delete deletes a bucket by name.
 func (h *Handler) Delete(ctx context.Context, bucketName string) error {
 	resp, err := h.client.HTTP().R().SetContext(ctx).
@@ -149,3 +178,28 @@ func (h *Handler) Truncate(ctx context.Context, bucketName string) error {
 	}
 	return nil
 }
+
+// RecordDeletion is the handle of an asynchronous record deletion task.
+type RecordDeletion struct {
+	TaskID string `json:"taskId"`
+}
+
+// DeleteRecords starts deleting the records matched by a DQL query of the
+// form "fetch <table>, from:…, to:… | filter …". Deletion runs in the
+// background; the returned task ID identifies it.
+func (h *Handler) DeleteRecords(ctx context.Context, query string) (*RecordDeletion, error) {
+	resp, err := h.client.HTTP().R().SetContext(ctx).
+		SetBody(map[string]string{"query": query}).
+		Post("/platform/storage/record/v1/delete:execute")
+	if err != nil {
+		return nil, fmt.Errorf("delete records: %w", err)
+	}
+	if err := httpclient.CheckResponse(resp); err != nil {
+		return nil, fmt.Errorf("delete records: %w", err)
+	}
+	var result RecordDeletion
+	if err := json.Unmarshal(resp.Body(), &result); err != nil {
+		return nil, fmt.Errorf("delete records: parse response: %w", err)
+	}
+	return &result, nil
+}
@@ -3,10 +3,12 @@ package bucket
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/dynatrace-oss/dtctl/sdk/httpclient"
 )
@@ -149,3 +151,70 @@ func TestDelete_ServerError(t *testing.T) {
 		t.Fatal("Delete() expected error for 500")
 	}
 }
+
+func TestDeleteRecords(t *testing.T) {
+	var gotQuery string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/platform/storage/record/v1/delete:execute", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		gotQuery = body["query"]
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"taskId":"task-1"}`)
+	})
+
+	h := NewHandler(newTestClient(t, mux))
+	query := `fetch logs, from:now()-7d | filter host.name == "web-1"`
+	result, err := h.DeleteRecords(context.Background(), query)
+	if err != nil {
+		t.Fatalf("DeleteRecords() error: %v", err)
+	}
+	if result.TaskID != "task-1" {
+		t.Errorf("TaskID = %q, want %q", result.TaskID, "task-1")
+	}
+	if gotQuery != query {
+		t.Errorf("query = %q, want %q", gotQuery, query)
+	}
+}
+
+func TestWaitActive(t *testing.T) {
+	statuses := []string{"creating", "creating", "active"}
+	calls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/platform/storage/management/v1/bucket-definitions/my-logs", func(w http.ResponseWriter, r *http.Request) {
+		status := statuses[min(calls, len(statuses)-1)]
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Bucket{BucketName: "my-logs", Status: status})
+	})
+
+	h := NewHandler(newTestClient(t, mux))
+	b, err := h.WaitActive(context.Background(), "my-logs", time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitActive() error: %v", err)
+	}
+	if b.Status != StatusActive || calls != 3 {
+		t.Errorf("WaitActive() status = %q after %d calls, want active after 3", b.Status, calls)
+	}
+}
+
+func TestWaitActive_Timeout(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/platform/storage/management/v1/bucket-definitions/my-logs", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Bucket{BucketName: "my-logs", Status: "creating"})
+	})
+
+	h := NewHandler(newTestClient(t, mux))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := h.WaitActive(ctx, "my-logs", 10*time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("WaitActive() error = %v, want deadline exceeded", err)
+	}
+}
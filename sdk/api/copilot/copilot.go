@@ -107,6 +107,91 @@ func (h *Handler) ListSkills(ctx context.Context) (*SkillList, error) {
 	return &SkillList{Skills: skills}, nil
 }
 
+// SkillParameter describes one input field of a skill's request body.
+type SkillParameter struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Required    bool   `json:"required"`
+	Description string `json:"description"`
+}
+
+// SkillDetail describes a skill: what it does, the endpoint that invokes it,
+// and its input parameters. The skills API only returns skill names, so the
+// details come from the skills this package knows how to call; Known is false
+// for a skill the environment offers that this package has no details for.
+type SkillDetail struct {
+	Name        string           `json:"name"`
+	Description string           `json:"description,omitempty"`
+	Endpoint    string           `json:"endpoint,omitempty"`
+	Parameters  []SkillParameter `json:"parameters,omitempty"`
+	Known       bool             `json:"known"`
+}
+
+// knownSkills documents the skills the Handler methods call, keyed by
+// skillKey of the name the skills API returns.
+var knownSkills = map[string]SkillDetail{
+	"conversation": {
+		Description: "Chat with Davis CoPilot; answers questions about the environment and Dynatrace, optionally grounded in documentation.",
+		Endpoint:    "POST /platform/davis/copilot/v1/skills/conversations:message",
+		Parameters: []SkillParameter{
+			{Name: "text", Type: "string", Required: true, Description: "the message to send"},
+			{Name: "context", Type: "array", Description: "additional context items (type, value), e.g. supplementary text or formatting instructions"},
+			{Name: "state", Type: "object", Description: "conversation state returned by the previous message, for multi-turn conversations"},
+		},
+	},
+	"nl2dql": {
+		Description: "Translate a natural language question into a DQL query.",
+		Endpoint:    "POST /platform/davis/copilot/v1/skills/nl2dql:generate",
+		Parameters: []SkillParameter{
+			{Name: "text", Type: "string", Required: true, Description: "the question in natural language"},
+		},
+	},
+	"dql2nl": {
+		Description: "Explain a DQL query in natural language, as a summary and a detailed explanation.",
+		Endpoint:    "POST /platform/davis/copilot/v1/skills/dql2nl:explain",
+		Parameters: []SkillParameter{
+			{Name: "dql", Type: "string", Required: true, Description: "the DQL query to explain"},
+		},
+	},
+	"documentsearch": {
+		Description: "Find the notebooks and dashboards most relevant to a text, ranked by relevance score.",
+		Endpoint:    "POST /platform/davis/copilot/v1/skills/document-search:execute",
+		Parameters: []SkillParameter{
+			{Name: "texts", Type: "array of string", Required: true, Description: "the search texts"},
+			{Name: "collections", Type: "array of string", Required: true, Description: "document collections to search, e.g. notebooks, dashboards"},
+			{Name: "exclude", Type: "array of string", Description: "document IDs to leave out of the results"},
+		},
+	},
+}
+
+// skillKey normalizes a skill name so that spellings such as
+// "documentSearch" and "document-search" match.
+func skillKey(name string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", "_", "").Replace(name))
+}
+
+// GetSkill returns the details of one skill the environment offers. The name
+// is matched ignoring case, dashes, and underscores; a skill the environment does not list is an
+// error that names the available ones.
+func (h *Handler) GetSkill(ctx context.Context, name string) (*SkillDetail, error) {
+	list, err := h.ListSkills(ctx)
+	if err != nil {
+		return nil, err
+	}
+	available := make([]string, 0, len(list.Skills))
+	for _, sk := range list.Skills {
+		if skillKey(sk.Name) != skillKey(name) {
+			available = append(available, sk.Name)
+			continue
+		}
+		detail, ok := knownSkills[skillKey(sk.Name)]
+		detail.Name = sk.Name
+		detail.Known = ok
+		return &detail, nil
+	}
+	return nil, fmt.Errorf("skill %q not found (available: %s)", name, strings.Join(available, ", "))
+}
+
 // Chat sends a message to CoPilot and returns the response
 func (h *Handler) Chat(ctx context.Context, text string, state *ConversationState, convCtx []ConversationContext) (*ConversationResponse, error) {
 	req := ConversationRequest{
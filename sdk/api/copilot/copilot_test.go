@@ -121,3 +121,40 @@ func TestChat_Error(t *testing.T) {
 		t.Fatal("Chat() expected error for 500")
 	}
 }
+
+func TestGetSkill(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/platform/davis/copilot/v1/skills", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(SkillsResponse{Skills: []string{"nl2dql", "documentSearch", "futureSkill"}})
+	})
+	h := NewHandler(newTestClient(t, mux))
+
+	skill, err := h.GetSkill(context.Background(), "NL2DQL")
+	if err != nil {
+		t.Fatalf("GetSkill() error: %v", err)
+	}
+	if skill.Name != "nl2dql" || !skill.Known || len(skill.Parameters) != 1 || skill.Parameters[0].Name != "text" {
+		t.Errorf("unexpected nl2dql detail: %+v", skill)
+	}
+
+	skill, err = h.GetSkill(context.Background(), "document-search")
+	if err != nil {
+		t.Fatalf("GetSkill(document-search) error: %v", err)
+	}
+	if skill.Name != "documentSearch" || !skill.Known {
+		t.Errorf("document-search should match documentSearch, got %+v", skill)
+	}
+
+	skill, err = h.GetSkill(context.Background(), "futureSkill")
+	if err != nil {
+		t.Fatalf("GetSkill(futureSkill) error: %v", err)
+	}
+	if skill.Known || skill.Description != "" {
+		t.Errorf("an unknown skill should have no details, got %+v", skill)
+	}
+
+	if _, err := h.GetSkill(context.Background(), "missing"); err == nil {
+		t.Error("expected an error for a skill the environment does not offer")
+	}
+}
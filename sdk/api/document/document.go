@@ -151,8 +151,13 @@ type DocumentFilters struct {
 	Type        string   // e.g., "dashboard", "notebook"
 	Name        string   // Filter by name
 	Owner       string   // Filter by owner ID
-	Filter      string   // Raw filter string, sent verbatim (overrides Type/Name/Owner)
-	ChunkSize   int64    // Page size for pagination (0 = no chunking, use API default)
+	NotOwner    string   // Exclude documents owned by this ID (shared with the caller)
+	PublicOnly  bool     // Only documents that are not private
+	Filter      string   // Raw filter string, sent verbatim (overrides Type/Name/Owner/NotOwner/PublicOnly)
+	PageSize    int64    // Documents per request (0 = API default)
+	AllPages    bool     // Follow next-page keys; without it only the first page is fetched unless ChunkSize is set
+	ChunkSize   int64    // Deprecated: use PageSize and AllPages. A non-zero value also follows next-page keys.
+	Limit       int64    // Maximum number of documents to return (0 = unlimited)
 	Sort        string   // Sort fields, comma-separated, prefix with "-" for descending
 	AddFields   []string // Fields the API omits by default (e.g. "originExtensionId", "labels")
 	AdminAccess bool     // List as effective owner; requires document:documents:admin
@@ -179,6 +184,12 @@ func (h *Handler) List(ctx context.Context, filters DocumentFilters) (*DocumentL
 		if filters.Owner != "" {
 			conditions = append(conditions, fmt.Sprintf("owner=='%s'", escapeFilterValue(filters.Owner)))
 		}
+		if filters.NotOwner != "" {
+			conditions = append(conditions, fmt.Sprintf("owner!='%s'", escapeFilterValue(filters.NotOwner)))
+		}
+		if filters.PublicOnly {
+			conditions = append(conditions, "isPrivate==false")
+		}
 		if len(conditions) > 0 {
 			filterStr = strings.Join(conditions, " and ")
 		}
@@ -198,6 +209,12 @@ func (h *Handler) List(ctx context.Context, filters DocumentFilters) (*DocumentL
 		queryFilters["admin-access"] = "true"
 	}
 
+	pageSize := filters.PageSize
+	if pageSize == 0 {
+		pageSize = filters.ChunkSize
+	}
+	firstPageOnly := !filters.AllPages && filters.ChunkSize == 0
+
 	for {
 		var result DocumentList
 		req := h.client.HTTP().R().SetContext(ctx)
@@ -207,7 +224,7 @@ func (h *Handler) List(ctx context.Context, filters DocumentFilters) (*DocumentL
 			PageKeyParam:  "page-key",
 			PageSizeParam: "page-size",
 			NextPageKey:   nextPageKey,
-			PageSize:      int64(filters.ChunkSize),
+			PageSize:      httpclient.LimitPageSize(pageSize, filters.Limit, len(allDocuments)),
 			Filters:       queryFilters,
 		}.QueryParams())
 
@@ -227,11 +244,17 @@ func (h *Handler) List(ctx context.Context, filters DocumentFilters) (*DocumentL
 		allDocuments = append(allDocuments, result.Documents...)
 		totalCount = result.TotalCount
 
-		// If chunking is disabled (ChunkSize == 0), return first page only
-		if filters.ChunkSize == 0 {
+		// In first-page-only mode, return the first page as-is
+		if firstPageOnly {
+			result.Documents, _ = httpclient.LimitItems(result.Documents, filters.Limit)
 			return &result, nil
 		}
 
+		var done bool
+		if allDocuments, done = httpclient.LimitItems(allDocuments, filters.Limit); done {
+			break
+		}
+
 		// Check if there are more pages
 		if result.NextPageKey == "" {
 			break
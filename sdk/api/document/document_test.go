@@ -54,6 +54,26 @@ func TestList(t *testing.T) {
 	}
 }
 
+func TestList_AccessFilters(t *testing.T) {
+	var gotFilter string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/platform/document/v1/documents", func(w http.ResponseWriter, r *http.Request) {
+		gotFilter = r.URL.Query().Get("filter")
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(DocumentList{})
+	})
+
+	h := NewHandler(newTestClient(t, mux))
+	_, err := h.List(context.Background(), DocumentFilters{Type: "dashboard", NotOwner: "user-1", PublicOnly: true})
+	if err != nil {
+		t.Fatalf("List() error: %v", err)
+	}
+	want := "type=='dashboard' and owner!='user-1' and isPrivate==false"
+	if gotFilter != want {
+		t.Errorf("filter = %q, want %q", gotFilter, want)
+	}
+}
+
 func TestList_Paginated(t *testing.T) {
 	callCount := 0
 	mux := http.NewServeMux()
@@ -111,6 +131,50 @@ func TestList_Paginated(t *testing.T) {
 	}
 }
 
+func TestList_PageOptions(t *testing.T) {
+	tests := []struct {
+		name      string
+		filters   DocumentFilters
+		wantDocs  int
+		wantCalls int
+	}{
+		{name: "zero value fetches first page only", filters: DocumentFilters{}, wantDocs: 1, wantCalls: 1},
+		{name: "page size alone fetches first page only", filters: DocumentFilters{PageSize: 1}, wantDocs: 1, wantCalls: 1},
+		{name: "all pages", filters: DocumentFilters{AllPages: true}, wantDocs: 2, wantCalls: 2},
+		{name: "page size with all pages", filters: DocumentFilters{PageSize: 1, AllPages: true}, wantDocs: 2, wantCalls: 2},
+		{name: "legacy chunk size follows all pages", filters: DocumentFilters{ChunkSize: 1}, wantDocs: 2, wantCalls: 2},
+		{name: "limit caps total", filters: DocumentFilters{Limit: 1, AllPages: true}, wantDocs: 1, wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			callCount := 0
+			mux := http.NewServeMux()
+			mux.HandleFunc("/platform/document/v1/documents", func(w http.ResponseWriter, r *http.Request) {
+				callCount++
+				resp := DocumentList{Documents: []DocumentMetadata{{ID: "doc-2"}}, TotalCount: 2}
+				if r.URL.Query().Get("page-key") == "" {
+					resp = DocumentList{Documents: []DocumentMetadata{{ID: "doc-1"}}, TotalCount: 2, NextPageKey: "page2token"}
+				}
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(resp)
+			})
+
+			h := NewHandler(newTestClient(t, mux))
+			result, err := h.List(context.Background(), tt.filters)
+			if err != nil {
+				t.Fatalf("List() error: %v", err)
+			}
+			if len(result.Documents) != tt.wantDocs {
+				t.Errorf("got %d documents, want %d", len(result.Documents), tt.wantDocs)
+			}
+			if callCount != tt.wantCalls {
+				t.Errorf("API called %d times, want %d", callCount, tt.wantCalls)
+			}
+		})
+	}
+}
+
 func TestGetMetadata(t *testing.T) {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/platform/document/v1/documents/doc-123/metadata", func(w http.ResponseWriter, r *http.Request) {
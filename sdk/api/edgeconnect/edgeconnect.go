@@ -41,7 +41,15 @@ type ModificationInfo struct {
 
 // Metadata contains additional metadata.
 type Metadata struct {
-	Version string `json:"version,omitempty"`
+	Version   string     `json:"version,omitempty"`
+	Instances []Instance `json:"instances,omitempty" yaml:"instances,omitempty"`
+}
+
+// Instance is a running EdgeConnect host registered with the configuration.
+type Instance struct {
+	InstanceID string `json:"instanceId,omitempty"`
+	Version    string `json:"version,omitempty"`
+	LastSeen   string `json:"lastSeen,omitempty"`
 }
 
 // EdgeConnectList represents a list of EdgeConnects.
@@ -90,6 +98,27 @@ func (h *Handler) Get(ctx context.Context, edgeConnectID string) (*EdgeConnect,
 	return &result, nil
 }
 
+// GetWithMetadata gets an EdgeConnect including its metadata, which lists the
+// EdgeConnect instances currently connected to the configuration.
+func (h *Handler) GetWithMetadata(ctx context.Context, edgeConnectID string) (*EdgeConnect, error) {
+	resp, err := h.client.HTTP().R().SetContext(ctx).
+		SetQueryParam("add-fields", "modificationInfo,metadata").
+		Get(fmt.Sprintf("/platform/app-engine/edge-connect/v1/edge-connects/%s", edgeConnectID))
+	if err != nil {
+		return nil, fmt.Errorf("get edge connect: %w", err)
+	}
+	if err := httpclient.CheckResponse(resp); err != nil {
+		return nil, fmt.Errorf("get edge connect %q: %w", edgeConnectID, err)
+	}
+
+	var result EdgeConnect
+	if err := json.Unmarshal(resp.Body(), &result); err != nil {
+		return nil, fmt.Errorf("get edge connect: parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
 // Create creates a new EdgeConnect.
 func (h *Handler) Create(ctx context.Context, req EdgeConnect) (*EdgeConnect, error) {
 	resp, err := h.client.HTTP().R().SetContext(ctx).
@@ -125,6 +154,40 @@ func (h *Handler) Update(ctx context.Context, edgeConnectID string, req EdgeConn
 	return nil
 }
 
+// RotateSecret regenerates the OAuth client of an EdgeConnect. The
+// configuration is written back without its OAuth client, which makes the
+// service issue a new one; the new secret is only returned in this response.
+func (h *Handler) RotateSecret(ctx context.Context, edgeConnectID string) (*EdgeConnect, error) {
+	existing, err := h.Get(ctx, edgeConnectID)
+	if err != nil {
+		return nil, err
+	}
+
+	req := EdgeConnect{
+		Name:         existing.Name,
+		HostPatterns: existing.HostPatterns,
+	}
+	resp, err := h.client.HTTP().R().SetContext(ctx).
+		SetBody(req).
+		Put(fmt.Sprintf("/platform/app-engine/edge-connect/v1/edge-connects/%s", edgeConnectID))
+	if err != nil {
+		return nil, fmt.Errorf("rotate edge connect secret: %w", err)
+	}
+	if err := httpclient.CheckResponse(resp); err != nil {
+		return nil, fmt.Errorf("rotate edge connect secret %q: %w", edgeConnectID, err)
+	}
+
+	var result EdgeConnect
+	if err := json.Unmarshal(resp.Body(), &result); err != nil {
+		return nil, fmt.Errorf("rotate edge connect secret: parse response: %w", err)
+	}
+	if result.OAuthClientSecret == "" {
+		return nil, fmt.Errorf("rotate edge connect secret %q: no new OAuth credentials returned", edgeConnectID)
+	}
+
+	return &result, nil
+}
+
 // Delete deletes an EdgeConnect.
 func (h *Handler) Delete(ctx context.Context, edgeConnectID string) error {
 	resp, err := h.client.HTTP().R().SetContext(ctx).
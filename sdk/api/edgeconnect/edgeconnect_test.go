@@ -125,3 +125,38 @@ func TestDelete(t *testing.T) {
 		t.Fatalf("Delete() error: %v", err)
 	}
 }
+
+func TestRotateSecret(t *testing.T) {
+	var putBody EdgeConnect
+	mux := http.NewServeMux()
+	mux.HandleFunc("/platform/app-engine/edge-connect/v1/edge-connects/ec-1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			json.NewEncoder(w).Encode(EdgeConnect{ID: "ec-1", Name: "edge", HostPatterns: []string{"*.internal"}, OAuthClientID: "old-client"})
+		case http.MethodPut:
+			if err := json.NewDecoder(r.Body).Decode(&putBody); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			json.NewEncoder(w).Encode(EdgeConnect{ID: "ec-1", Name: "edge", OAuthClientID: "new-client", OAuthClientSecret: "new-secret"})
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+
+	h := NewHandler(newTestClient(t, mux))
+	result, err := h.RotateSecret(context.Background(), "ec-1")
+	if err != nil {
+		t.Fatalf("RotateSecret() error: %v", err)
+	}
+	if result.OAuthClientSecret != "new-secret" {
+		t.Errorf("OAuthClientSecret = %q, want new-secret", result.OAuthClientSecret)
+	}
+	if putBody.OAuthClientID != "" {
+		t.Errorf("PUT sent oauthClientId %q, want it cleared", putBody.OAuthClientID)
+	}
+	if len(putBody.HostPatterns) != 1 || putBody.HostPatterns[0] != "*.internal" {
+		t.Errorf("PUT host patterns = %v, want preserved", putBody.HostPatterns)
+	}
+}
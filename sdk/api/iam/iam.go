@@ -54,8 +54,15 @@ func extractEnvironmentID(baseURL string) (string, error) {
 	return httpclient.ExtractSubdomain(baseURL)
 }
 
-// ListUsers lists all users in the current environment with automatic pagination.
+// ListUsers lists all users in the current environment with automatic
+// pagination. A chunkSize of 0 fetches only the first page.
 func (h *Handler) ListUsers(ctx context.Context, partialString string, uuids []string, chunkSize int64) (*UserListResponse, error) {
+	return h.ListUsersWithOptions(ctx, partialString, uuids, httpclient.ChunkPageOptions(chunkSize, 0))
+}
+
+// ListUsersWithOptions lists users, paging through results as described by
+// opts and stopping once opts.Limit users are collected (0 = unlimited).
+func (h *Handler) ListUsersWithOptions(ctx context.Context, partialString string, uuids []string, opts httpclient.PageOptions) (*UserListResponse, error) {
 	envID, err := extractEnvironmentID(h.client.BaseURL())
 	if err != nil {
 		return nil, err
@@ -78,7 +85,7 @@ func (h *Handler) ListUsers(ctx context.Context, partialString string, uuids []s
 			PageKeyParam:  "page-key",
 			PageSizeParam: "page-size",
 			NextPageKey:   nextPageKey,
-			PageSize:      chunkSize,
+			PageSize:      httpclient.LimitPageSize(opts.PageSize, opts.Limit, len(allUsers)),
 			Filters:       map[string]string{"partialString": partialString, "uuid": uuidFilter},
 		}.QueryParams()
 
@@ -100,10 +107,16 @@ func (h *Handler) ListUsers(ctx context.Context, partialString string, uuids []s
 		allUsers = append(allUsers, result.Results...)
 		totalCount = result.TotalCount
 
-		if chunkSize == 0 {
+		if opts.FirstPageOnly {
+			result.Results, _ = httpclient.LimitItems(result.Results, opts.Limit)
 			return &result, nil
 		}
 
+		var done bool
+		if allUsers, done = httpclient.LimitItems(allUsers, opts.Limit); done {
+			break
+		}
+
 		if result.NextPageKey == "" {
 			break
 		}
@@ -140,8 +153,15 @@ func (h *Handler) GetUser(ctx context.Context, uuid string) (*User, error) {
 	return &result, nil
 }
 
-// ListGroups lists all groups in the current environment with automatic pagination.
+// ListGroups lists all groups in the current environment with automatic
+// pagination. A chunkSize of 0 fetches only the first page.
 func (h *Handler) ListGroups(ctx context.Context, partialGroupName string, uuids []string, chunkSize int64) (*GroupListResponse, error) {
+	return h.ListGroupsWithOptions(ctx, partialGroupName, uuids, httpclient.ChunkPageOptions(chunkSize, 0))
+}
+
+// ListGroupsWithOptions lists groups, paging through results as described by
+// opts and stopping once opts.Limit groups are collected (0 = unlimited).
+func (h *Handler) ListGroupsWithOptions(ctx context.Context, partialGroupName string, uuids []string, opts httpclient.PageOptions) (*GroupListResponse, error) {
 	envID, err := extractEnvironmentID(h.client.BaseURL())
 	if err != nil {
 		return nil, err
@@ -164,7 +184,7 @@ func (h *Handler) ListGroups(ctx context.Context, partialGroupName string, uuids
 			PageKeyParam:  "page-key",
 			PageSizeParam: "page-size",
 			NextPageKey:   nextPageKey,
-			PageSize:      chunkSize,
+			PageSize:      httpclient.LimitPageSize(opts.PageSize, opts.Limit, len(allGroups)),
 			Filters:       map[string]string{"partialGroupName": partialGroupName, "uuid": uuidFilter},
 		}.QueryParams()
 
@@ -186,10 +206,16 @@ func (h *Handler) ListGroups(ctx context.Context, partialGroupName string, uuids
 		allGroups = append(allGroups, result.Results...)
 		totalCount = result.TotalCount
 
-		if chunkSize == 0 {
+		if opts.FirstPageOnly {
+			result.Results, _ = httpclient.LimitItems(result.Results, opts.Limit)
 			return &result, nil
 		}
 
+		var done bool
+		if allGroups, done = httpclient.LimitItems(allGroups, opts.Limit); done {
+			break
+		}
+
 		if result.NextPageKey == "" {
 			break
 		}
@@ -201,3 +227,99 @@ func (h *Handler) ListGroups(ctx context.Context, partialGroupName string, uuids
 		TotalCount: totalCount,
 	}, nil
 }
+
+// GetUserGroups lists the groups a user is a member of.
+func (h *Handler) GetUserGroups(ctx context.Context, uuid string) (*GroupListResponse, error) {
+	envID, err := extractEnvironmentID(h.client.BaseURL())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.client.HTTP().R().SetContext(ctx).
+		Get(fmt.Sprintf("/platform/iam/v1/organizational-levels/environment/%s/users/%s/groups", envID, uuid))
+	if err != nil {
+		return nil, fmt.Errorf("get user groups: %w", err)
+	}
+	if err := httpclient.CheckResponse(resp); err != nil {
+		return nil, fmt.Errorf("get groups of user %q: %w", uuid, err)
+	}
+
+	var result GroupListResponse
+	if err := json.Unmarshal(resp.Body(), &result); err != nil {
+		return nil, fmt.Errorf("get user groups: parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// ListGroupUsers lists the members of a group.
+func (h *Handler) ListGroupUsers(ctx context.Context, groupUUID string) (*UserListResponse, error) {
+	envID, err := extractEnvironmentID(h.client.BaseURL())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.client.HTTP().R().SetContext(ctx).
+		Get(fmt.Sprintf("/platform/iam/v1/organizational-levels/environment/%s/groups/%s/users", envID, groupUUID))
+	if err != nil {
+		return nil, fmt.Errorf("list group users: %w", err)
+	}
+	if err := httpclient.CheckResponse(resp); err != nil {
+		return nil, fmt.Errorf("list users of group %q: %w", groupUUID, err)
+	}
+
+	var result UserListResponse
+	if err := json.Unmarshal(resp.Body(), &result); err != nil {
+		return nil, fmt.Errorf("list group users: parse response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// PolicyRef identifies a policy that grants a permission.
+type PolicyRef struct {
+	UUID string `json:"uuid"`
+	Name string `json:"name"`
+}
+
+// EffectivePermission is a permission resolved from the policies bound to a
+// user, directly or through their groups.
+type EffectivePermission struct {
+	Permission string      `json:"permission"`
+	Effect     string      `json:"effect"`
+	Conditions []string    `json:"conditions,omitempty"`
+	Policies   []PolicyRef `json:"policies,omitempty"`
+}
+
+// EffectivePermissionList represents the effective permissions of an entity.
+type EffectivePermissionList struct {
+	Results    []EffectivePermission `json:"results"`
+	TotalCount int64                 `json:"totalCount"`
+}
+
+// GetEffectivePermissions resolves the permissions granted to a user or
+// group (entityType "user" or "group") by the policies bound to it.
+func (h *Handler) GetEffectivePermissions(ctx context.Context, entityType, entityID string) (*EffectivePermissionList, error) {
+	envID, err := extractEnvironmentID(h.client.BaseURL())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.client.HTTP().R().SetContext(ctx).
+		SetQueryParam("entityType", entityType).
+		SetQueryParam("entityId", entityID).
+		Get(fmt.Sprintf("/platform/iam/v1/organizational-levels/environment/%s/effective-permissions", envID))
+	if err != nil {
+		return nil, fmt.Errorf("get effective permissions: %w", err)
+	}
+	if err := httpclient.CheckResponse(resp); err != nil {
+		return nil, fmt.Errorf("get effective permissions of %s %q: %w", entityType, entityID, err)
+	}
+
+	var result EffectivePermissionList
+	if err := json.Unmarshal(resp.Body(), &result); err != nil {
+		return nil, fmt.Errorf("get effective permissions: parse response: %w", err)
+	}
+
+	return &result, nil
+}
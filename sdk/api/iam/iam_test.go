@@ -104,3 +104,32 @@ func TestListGroups(t *testing.T) {
 		t.Errorf("first group = %q, want %q", result.Results[0].GroupName, "admins")
 	}
 }
+
+func TestListUsersWithOptions(t *testing.T) {
+	for _, firstPageOnly := range []bool{false, true} {
+		callCount := 0
+		mux := http.NewServeMux()
+		mux.HandleFunc("/platform/iam/v1/organizational-levels/environment/127/users", func(w http.ResponseWriter, r *http.Request) {
+			callCount++
+			resp := UserListResponse{Results: []User{{UID: "u-2"}}, TotalCount: 2}
+			if r.URL.Query().Get("page-key") == "" {
+				resp = UserListResponse{Results: []User{{UID: "u-1"}}, TotalCount: 2, NextPageKey: "page-2"}
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(resp)
+		})
+
+		h := NewHandler(newTestClient(t, mux))
+		result, err := h.ListUsersWithOptions(context.Background(), "", nil, httpclient.PageOptions{FirstPageOnly: firstPageOnly})
+		if err != nil {
+			t.Fatalf("ListUsersWithOptions() error: %v", err)
+		}
+		want := 2
+		if firstPageOnly {
+			want = 1
+		}
+		if len(result.Results) != want || callCount != want {
+			t.Errorf("FirstPageOnly=%v: got %d users in %d calls, want %d", firstPageOnly, len(result.Results), callCount, want)
+		}
+	}
+}
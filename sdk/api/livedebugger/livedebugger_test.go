@@ -293,3 +293,26 @@ func TestWorkspaceHasFilters(t *testing.T) {
 		}
 	})
 }
+
+func TestExtractCreatedRuleID(t *testing.T) {
+	resp := map[string]interface{}{
+		"data": map[string]interface{}{
+			"org": map[string]interface{}{
+				"workspace": map[string]interface{}{
+					"createRuleV2": map[string]interface{}{"id": "rule-1", "immutableId": "imm-1"},
+				},
+			},
+		},
+	}
+	got, err := ExtractCreatedRuleID(resp)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "rule-1" {
+		t.Errorf("ExtractCreatedRuleID() = %q, want rule-1", got)
+	}
+
+	if _, err := ExtractCreatedRuleID(map[string]interface{}{"bad": "response"}); err == nil {
+		t.Error("expected error for invalid response")
+	}
+}
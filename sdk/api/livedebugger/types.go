@@ -171,3 +171,29 @@ func ExtractDeletedRuleIDs(resp map[string]interface{}) ([]string, error) {
 	ids := decoded.Data.Org.Workspace.DeleteAllRulesFromWorkspaceV2
 	return ids, nil
 }
+
+// ExtractCreatedRuleID returns the ID of the breakpoint rule in a
+// CreateBreakpoint response.
+func ExtractCreatedRuleID(resp map[string]interface{}) (string, error) {
+	dataObj, ok := resp["data"].(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("graphql response missing data object")
+	}
+	orgObj, ok := dataObj["org"].(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("graphql response missing org object")
+	}
+	workspaceObj, ok := orgObj["workspace"].(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("graphql response missing workspace object")
+	}
+	created, ok := workspaceObj["createRuleV2"].(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("graphql response missing created rule")
+	}
+	id, _ := created["id"].(string)
+	if id == "" {
+		return "", fmt.Errorf("graphql response missing rule id")
+	}
+	return id, nil
+}
@@ -374,6 +374,10 @@ type ExecuteAndPollOptions struct {
 	// query is still RUNNING — once for the initial response and once per poll.
 	// It is never called for a query that completes synchronously.
 	OnUpdate func(PollUpdate)
+	// OnRequestToken, if set, is called once with the request token when the
+	// query continues asynchronously, so callers can persist it and Resume
+	// polling later (e.g. after a client-side timeout).
+	OnRequestToken func(requestToken string)
 }
 
 // ExecuteAndPoll executes a DQL query and, if it returns asynchronously, polls
@@ -447,10 +451,32 @@ func (h *Handler) ExecuteAndPollWithOptions(ctx context.Context, req ExecuteRequ
 		return nil, fmt.Errorf("query is running but no request token provided")
 	}
 
+	if opts.OnRequestToken != nil {
+		opts.OnRequestToken(result.RequestToken)
+	}
+
 	// Surface the initial RUNNING state (progress may already be non-zero).
 	emitUpdate(opts.OnUpdate, req.EnablePreview, result)
 
-	// Poll loop.
+	return h.pollUntilDone(ctx, result.RequestToken, req.EnrichMetricMetadata, req.EnablePreview, opts)
+}
+
+// Resume polls a query started earlier by its request token until it
+// completes, e.g. after the client that started it timed out or exited.
+// enrich must match the enrichment requested on the originating execute call.
+// If the context is cancelled, a best-effort cancel is sent to the backend.
+func (h *Handler) Resume(ctx context.Context, requestToken string, enrich bool, opts ExecuteAndPollOptions) (*Response, error) {
+	if requestToken == "" {
+		return nil, fmt.Errorf("request token is required")
+	}
+	return h.pollUntilDone(ctx, requestToken, enrich, false, opts)
+}
+
+// pollUntilDone polls requestToken until the query reaches a terminal state,
+// the client-side poll timeout elapses, or ctx is cancelled.
+func (h *Handler) pollUntilDone(ctx context.Context, requestToken string, enrich, enablePreview bool, opts ExecuteAndPollOptions) (*Response, error) {
+	onUnauthorized := opts.OnUnauthorized
+
 	pollCtx, pollCancel := context.WithTimeout(ctx, 5*time.Minute)
 	defer pollCancel()
 
@@ -460,13 +486,13 @@ func (h *Handler) ExecuteAndPollWithOptions(ctx context.Context, req ExecuteRequ
 		select {
 		case <-pollCtx.Done():
 			if ctx.Err() != nil {
-				_ = h.Cancel(context.Background(), result.RequestToken)
+				_ = h.Cancel(context.Background(), requestToken)
 			}
 			return nil, pollCtx.Err()
 		default:
 		}
 
-		pollResult, pollErr := h.Poll(pollCtx, result.RequestToken, pollRequestTimeoutMs, req.EnrichMetricMetadata)
+		pollResult, pollErr := h.Poll(pollCtx, requestToken, pollRequestTimeoutMs, enrich)
 		if pollErr != nil {
 			// On 401, try the onUnauthorized callback once per consecutive failure.
 			var apiErr *httpclient.APIError
@@ -483,7 +509,7 @@ func (h *Handler) ExecuteAndPollWithOptions(ctx context.Context, req ExecuteRequ
 			}
 
 			if ctx.Err() != nil {
-				_ = h.Cancel(context.Background(), result.RequestToken)
+				_ = h.Cancel(context.Background(), requestToken)
 				return nil, ctx.Err()
 			}
 			return nil, pollErr
@@ -497,7 +523,7 @@ func (h *Handler) ExecuteAndPollWithOptions(ctx context.Context, req ExecuteRequ
 		case "FAILED":
 			return pollResult, fmt.Errorf("query execution failed")
 		case "RUNNING", "NOT_STARTED":
-			emitUpdate(opts.OnUpdate, req.EnablePreview, pollResult)
+			emitUpdate(opts.OnUpdate, enablePreview, pollResult)
 			continue
 		default:
 			return pollResult, nil
@@ -170,6 +170,65 @@ func TestExecute_AsyncWithPoll(t *testing.T) {
 	}
 }
 
+func TestExecuteAndPollWithOptions_OnRequestToken(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/platform/storage/query/v1/query:execute", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(Response{State: "RUNNING", RequestToken: "tok-123"})
+	})
+	mux.HandleFunc("/platform/storage/query/v1/query:poll", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Response{State: "SUCCEEDED", Result: &Result{}})
+	})
+
+	var tokens []string
+	h := NewHandler(newTestClient(t, mux))
+	_, err := h.ExecuteAndPollWithOptions(context.Background(), ExecuteRequest{Query: "fetch logs"}, ExecuteAndPollOptions{
+		OnRequestToken: func(token string) { tokens = append(tokens, token) },
+	})
+	if err != nil {
+		t.Fatalf("ExecuteAndPollWithOptions() error: %v", err)
+	}
+	if len(tokens) != 1 || tokens[0] != "tok-123" {
+		t.Errorf("OnRequestToken calls = %v, want [tok-123]", tokens)
+	}
+}
+
+func TestResume(t *testing.T) {
+	var executed atomic.Bool
+	mux := http.NewServeMux()
+	mux.HandleFunc("/platform/storage/query/v1/query:execute", func(w http.ResponseWriter, r *http.Request) {
+		executed.Store(true)
+	})
+	mux.HandleFunc("/platform/storage/query/v1/query:poll", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("request-token"); got != "tok-old" {
+			t.Errorf("request-token = %q, want tok-old", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Response{
+			State:  "SUCCEEDED",
+			Result: &Result{Records: []map[string]interface{}{{"key": "value"}}},
+		})
+	})
+
+	h := NewHandler(newTestClient(t, mux))
+	result, err := h.Resume(context.Background(), "tok-old", false, ExecuteAndPollOptions{})
+	if err != nil {
+		t.Fatalf("Resume() error: %v", err)
+	}
+	if len(result.GetRecords()) != 1 {
+		t.Errorf("records = %v, want 1", result.GetRecords())
+	}
+	if executed.Load() {
+		t.Error("Resume must not re-execute the query")
+	}
+
+	if _, err := h.Resume(context.Background(), "", false, ExecuteAndPollOptions{}); err == nil {
+		t.Error("expected error for empty request token")
+	}
+}
+
 // TestExecute_NotStartedIsPolled locks the congested-tenant path: a queued
 // query answers execute (and early polls) with state NOT_STARTED, which is
 // in-flight, not terminal — returning it as success hands the caller the raw
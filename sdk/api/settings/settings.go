@@ -70,6 +70,10 @@ type SettingsObjectCreate struct {
 	Value         map[string]any `json:"value"`
 	SchemaVersion string         `json:"schemaVersion,omitempty"`
 	ExternalID    string         `json:"externalId,omitempty"`
+	// InsertAfter positions the object in schemas with ordered objects: nil
+	// appends it, an empty string puts it first, and an object ID places it
+	// directly after that object.
+	InsertAfter *string `json:"insertAfter,omitempty"`
 }
 
 // SettingsObjectResponse represents the response from creating/updating a settings object.
@@ -127,6 +131,19 @@ func (h *Handler) GetSchema(ctx context.Context, schemaID string) (map[string]an
 
 // ListObjects lists settings objects for a schema with automatic pagination.
 func (h *Handler) ListObjects(ctx context.Context, schemaID, scope string, chunkSize int64) (*SettingsObjectsList, error) {
+	return h.ListObjectsFiltered(ctx, schemaID, scope, "", chunkSize, 0)
+}
+
+// ListObjectsFiltered is ListObjects with a Settings 2.0 filter expression
+// (e.g. `value.enabled = true`) evaluated server-side. An empty filter
+// returns every object.
+func (h *Handler) ListObjectsFiltered(ctx context.Context, schemaID, scope, filter string, chunkSize, limit int64) (*SettingsObjectsList, error) {
+	return h.ListObjectsWithOptions(ctx, schemaID, scope, filter, httpclient.ChunkPageOptions(chunkSize, limit))
+}
+
+// ListObjectsWithOptions is ListObjectsFiltered, paging through results as
+// described by opts.
+func (h *Handler) ListObjectsWithOptions(ctx context.Context, schemaID, scope, filter string, opts httpclient.PageOptions) (*SettingsObjectsList, error) {
 	var allItems []SettingsObject
 	var totalCount int
 	nextPageKey := ""
@@ -139,8 +156,8 @@ func (h *Handler) ListObjects(ctx context.Context, schemaID, scope string, chunk
 			PageKeyParam:  "nextPageKey",
 			PageSizeParam: "pageSize",
 			NextPageKey:   nextPageKey,
-			PageSize:      chunkSize,
-			Filters:       map[string]string{"schemaIds": schemaID, "scopes": scope},
+			PageSize:      httpclient.LimitPageSize(opts.PageSize, opts.Limit, len(allItems)),
+			Filters:       map[string]string{"schemaIds": schemaID, "scopes": scope, "filter": filter},
 		}.QueryParams()
 
 		req.SetQueryParamsFromValues(params)
@@ -176,11 +193,17 @@ func (h *Handler) ListObjects(ctx context.Context, schemaID, scope string, chunk
 		allItems = append(allItems, result.Items...)
 		totalCount = result.TotalCount
 
-		// If chunking is disabled (chunkSize == 0), return first page only
-		if chunkSize == 0 {
+		// In first-page-only mode, return the first page as-is
+		if opts.FirstPageOnly {
+			result.Items, _ = httpclient.LimitItems(result.Items, opts.Limit)
 			return &result, nil
 		}
 
+		var done bool
+		if allItems, done = httpclient.LimitItems(allItems, opts.Limit); done {
+			break
+		}
+
 		// Check if there are more pages
 		if result.NextPageKey == "" {
 			break
@@ -188,3 +188,29 @@ func TestDelete(t *testing.T) {
 		t.Fatalf("Delete() error: %v", err)
 	}
 }
+
+func TestListObjectsWithOptions_DefaultPageSizeFollowsPages(t *testing.T) {
+	callCount := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/platform/classic/environment-api/v2/settings/objects", func(w http.ResponseWriter, r *http.Request) {
+		callCount++
+		if ps := r.URL.Query().Get("pageSize"); ps != "" {
+			t.Errorf("pageSize = %q, want unset (API default)", ps)
+		}
+		resp := SettingsObjectsList{Items: []SettingsObject{{ObjectID: "obj-2"}}, TotalCount: 2}
+		if r.URL.Query().Get("nextPageKey") == "" {
+			resp = SettingsObjectsList{Items: []SettingsObject{{ObjectID: "obj-1"}}, TotalCount: 2, NextPageKey: "page2token"}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+
+	h := NewHandler(newTestClient(t, mux))
+	result, err := h.ListObjectsWithOptions(context.Background(), "builtin:alerting.profile", "", "", httpclient.PageOptions{})
+	if err != nil {
+		t.Fatalf("ListObjectsWithOptions() error: %v", err)
+	}
+	if len(result.Items) != 2 || callCount != 2 {
+		t.Errorf("got %d items in %d calls, want 2 in 2", len(result.Items), callCount)
+	}
+}
@@ -87,8 +87,15 @@ type EvaluationResponse struct {
 	TTLSeconds        int64              `json:"ttlSeconds,omitempty"`
 }
 
-// List lists all SLOs with automatic pagination
+// List lists all SLOs with automatic pagination. A chunkSize of 0 fetches
+// only the first page.
 func (h *Handler) List(ctx context.Context, filter string, chunkSize int64) (*SLOList, error) {
+	return h.ListWithOptions(ctx, filter, httpclient.ChunkPageOptions(chunkSize, 0))
+}
+
+// ListWithOptions lists SLOs, paging through results as described by opts
+// and stopping once opts.Limit SLOs are collected (0 = unlimited).
+func (h *Handler) ListWithOptions(ctx context.Context, filter string, opts httpclient.PageOptions) (*SLOList, error) {
 	var allSLOs []SLO
 	var totalCount int
 	nextPageKey := ""
@@ -102,7 +109,7 @@ func (h *Handler) List(ctx context.Context, filter string, chunkSize int64) (*SL
 			PageKeyParam:  "page-key",
 			PageSizeParam: "page-size",
 			NextPageKey:   nextPageKey,
-			PageSize:      chunkSize,
+			PageSize:      httpclient.LimitPageSize(opts.PageSize, opts.Limit, len(allSLOs)),
 			Filters:       map[string]string{"filter": filter},
 		}.QueryParams()
 		req.SetQueryParamsFromValues(params)
@@ -123,11 +130,17 @@ func (h *Handler) List(ctx context.Context, filter string, chunkSize int64) (*SL
 		allSLOs = append(allSLOs, result.SLOs...)
 		totalCount = result.TotalCount
 
-		// If chunking is disabled (chunkSize == 0), return first page only
-		if chunkSize == 0 {
+		// In first-page-only mode, return the first page as-is
+		if opts.FirstPageOnly {
+			result.SLOs, _ = httpclient.LimitItems(result.SLOs, opts.Limit)
 			return &result, nil
 		}
 
+		var done bool
+		if allSLOs, done = httpclient.LimitItems(allSLOs, opts.Limit); done {
+			break
+		}
+
 		// Check if there are more pages
 		if result.NextPageKey == "" {
 			break
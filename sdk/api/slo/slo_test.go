@@ -99,6 +99,87 @@ func TestList_Paginated(t *testing.T) {
 	}
 }
 
+func TestList_LimitStopsPagination(t *testing.T) {
+	callCount := 0
+	var pageSize string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/platform/slo/v1/slos", func(w http.ResponseWriter, r *http.Request) {
+		callCount++
+		pageSize = r.URL.Query().Get("page-size")
+		resp := SLOList{
+			SLOs:        []SLO{{ID: "slo-1"}, {ID: "slo-2"}},
+			TotalCount:  10,
+			NextPageKey: "next",
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+
+	h := NewHandler(newTestClient(t, mux))
+	result, err := h.ListWithOptions(context.Background(), "", httpclient.PageOptions{PageSize: 500, Limit: 1})
+	if err != nil {
+		t.Fatalf("ListWithOptions() error: %v", err)
+	}
+	if len(result.SLOs) != 1 {
+		t.Errorf("got %d SLOs, want 1", len(result.SLOs))
+	}
+	if callCount != 1 {
+		t.Errorf("expected 1 API call, got %d", callCount)
+	}
+	if pageSize != "1" {
+		t.Errorf("page-size = %q, want narrowed to the limit", pageSize)
+	}
+}
+
+func TestListWithOptions(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         httpclient.PageOptions
+		wantSLOs     int
+		wantCalls    int
+		wantPageSize string
+	}{
+		{name: "page size, all pages", opts: httpclient.PageOptions{PageSize: 1}, wantSLOs: 2, wantCalls: 2, wantPageSize: "1"},
+		{name: "API default size, all pages", opts: httpclient.PageOptions{}, wantSLOs: 2, wantCalls: 2},
+		{name: "first page only", opts: httpclient.PageOptions{FirstPageOnly: true}, wantSLOs: 1, wantCalls: 1},
+		{name: "first page only with page size", opts: httpclient.PageOptions{PageSize: 5, FirstPageOnly: true}, wantSLOs: 1, wantCalls: 1, wantPageSize: "5"},
+		{name: "limit caps total", opts: httpclient.PageOptions{PageSize: 1, Limit: 1}, wantSLOs: 1, wantCalls: 1, wantPageSize: "1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			callCount := 0
+			firstPageSize := ""
+			mux := http.NewServeMux()
+			mux.HandleFunc("/platform/slo/v1/slos", func(w http.ResponseWriter, r *http.Request) {
+				callCount++
+				resp := SLOList{SLOs: []SLO{{ID: "slo-2"}}, TotalCount: 2}
+				if r.URL.Query().Get("page-key") == "" {
+					firstPageSize = r.URL.Query().Get("page-size")
+					resp = SLOList{SLOs: []SLO{{ID: "slo-1"}}, TotalCount: 2, NextPageKey: "page-2"}
+				}
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(resp)
+			})
+
+			h := NewHandler(newTestClient(t, mux))
+			result, err := h.ListWithOptions(context.Background(), "", tt.opts)
+			if err != nil {
+				t.Fatalf("ListWithOptions() error: %v", err)
+			}
+			if len(result.SLOs) != tt.wantSLOs {
+				t.Errorf("got %d SLOs, want %d", len(result.SLOs), tt.wantSLOs)
+			}
+			if callCount != tt.wantCalls {
+				t.Errorf("API called %d times, want %d", callCount, tt.wantCalls)
+			}
+			if firstPageSize != tt.wantPageSize {
+				t.Errorf("page-size = %q, want %q", firstPageSize, tt.wantPageSize)
+			}
+		})
+	}
+}
+
 func TestGet(t *testing.T) {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/platform/slo/v1/slos/slo-1", func(w http.ResponseWriter, r *http.Request) {
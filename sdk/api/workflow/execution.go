@@ -130,6 +130,21 @@ func (h *ExecutionHandler) Get(ctx context.Context, id string) (*Execution, erro
 	return &result, nil
 }
 
+// GetInput retrieves the payload an execution was started with: the triggering
+// event for event triggers, or the input passed to a manual run. Executions
+// started through the legacy API carry it in params instead of input. It
+// returns nil when the execution has no input.
+func (h *ExecutionHandler) GetInput(ctx context.Context, id string) (any, error) {
+	exec, err := h.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if exec.Input != nil {
+		return exec.Input, nil
+	}
+	return exec.Params, nil
+}
+
 // Cancel cancels an active execution.
 func (h *ExecutionHandler) Cancel(ctx context.Context, id string) error {
 	resp, err := h.client.HTTP().R().SetContext(ctx).
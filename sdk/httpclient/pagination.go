@@ -82,3 +82,43 @@ func (p PaginationParams) QueryParams() url.Values {
 
 	return params
 }
+
+// PageOptions controls how a list call pages through results. PageSize is
+// the per-request page size (0 = API default), FirstPageOnly stops after the
+// first page instead of following next-page keys, and Limit caps the total
+// number of items returned (0 = unlimited).
+type PageOptions struct {
+	PageSize      int64
+	FirstPageOnly bool
+	Limit         int64
+}
+
+// ChunkPageOptions converts the legacy chunkSize/limit pair, where a
+// chunkSize of 0 means "first page only", into PageOptions.
+func ChunkPageOptions(chunkSize, limit int64) PageOptions {
+	return PageOptions{PageSize: chunkSize, FirstPageOnly: chunkSize == 0, Limit: limit}
+}
+
+// LimitPageSize narrows pageSize to the number of items still needed to reach
+// limit after collected items have been fetched (limit 0 = unlimited). In
+// single-page mode (pageSize 0) the remaining budget becomes the page size so
+// one request fetches up to limit items.
+func LimitPageSize(pageSize, limit int64, collected int) int64 {
+	if limit <= 0 {
+		return pageSize
+	}
+	remaining := limit - int64(collected)
+	if pageSize == 0 || remaining < pageSize {
+		return remaining
+	}
+	return pageSize
+}
+
+// LimitItems truncates items to limit (limit 0 = unlimited) and reports
+// whether the limit has been reached, i.e. pagination should stop.
+func LimitItems[T any](items []T, limit int64) ([]T, bool) {
+	if limit <= 0 || int64(len(items)) < limit {
+		return items, false
+	}
+	return items[:limit], true
+}
@@ -90,3 +90,49 @@ func TestPaginationParams_SettingsAPI_NextPage(t *testing.T) {
 		t.Error("schemaIds should not be set with nextPageKey for SettingsAPI")
 	}
 }
+
+func TestLimitPageSize(t *testing.T) {
+	tests := []struct {
+		pageSize, limit int64
+		collected       int
+		want            int64
+	}{
+		{pageSize: 500, limit: 0, want: 500},
+		{pageSize: 500, limit: 20, want: 20},
+		{pageSize: 10, limit: 25, collected: 20, want: 5},
+		{pageSize: 10, limit: 25, want: 10},
+		{pageSize: 0, limit: 7, want: 7},
+		{pageSize: 0, limit: 0, want: 0},
+	}
+	for _, tt := range tests {
+		if got := LimitPageSize(tt.pageSize, tt.limit, tt.collected); got != tt.want {
+			t.Errorf("LimitPageSize(%d, %d, %d) = %d, want %d", tt.pageSize, tt.limit, tt.collected, got, tt.want)
+		}
+	}
+}
+
+func TestLimitItems(t *testing.T) {
+	items := []int{1, 2, 3, 4}
+
+	if got, done := LimitItems(items, 0); len(got) != 4 || done {
+		t.Errorf("LimitItems(0) = %v, %v; want all items, not done", got, done)
+	}
+	if got, done := LimitItems(items, 10); len(got) != 4 || done {
+		t.Errorf("LimitItems(10) = %v, %v; want all items, not done", got, done)
+	}
+	if got, done := LimitItems(items, 4); len(got) != 4 || !done {
+		t.Errorf("LimitItems(4) = %v, %v; want all items, done", got, done)
+	}
+	if got, done := LimitItems(items, 2); len(got) != 2 || !done {
+		t.Errorf("LimitItems(2) = %v, %v; want 2 items, done", got, done)
+	}
+}
+
+func TestChunkPageOptions(t *testing.T) {
+	if got := ChunkPageOptions(0, 5); got != (PageOptions{FirstPageOnly: true, Limit: 5}) {
+		t.Errorf("ChunkPageOptions(0, 5) = %+v, want first page only", got)
+	}
+	if got := ChunkPageOptions(500, 0); got != (PageOptions{PageSize: 500}) {
+		t.Errorf("ChunkPageOptions(500, 0) = %+v, want paginated with page size 500", got)
+	}
+}
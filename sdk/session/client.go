@@ -3,10 +3,12 @@ package session
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 	"sync"
@@ -246,10 +248,88 @@ func isSensitiveHeader(name string) bool {
 	return false
 }
 
+// isSensitiveField reports whether a JSON or form field holds a credential
+// that must be masked in debug body output.
+func isSensitiveField(name string) bool {
+	lower := strings.ToLower(name)
+	return strings.Contains(lower, "secret") ||
+		strings.Contains(lower, "password") ||
+		strings.HasSuffix(lower, "token") ||
+		lower == "code_verifier" ||
+		lower == "private_key" || lower == "privatekey"
+}
+
+// redactBody masks credential fields in a debug body. JSON bodies are masked
+// at any depth; form-encoded bodies (OAuth token requests) per parameter.
+// Anything else is returned unchanged.
+func redactBody(body string) string {
+	trimmed := strings.TrimSpace(body)
+	if trimmed == "" {
+		return body
+	}
+
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(trimmed), &parsed); err == nil {
+		if !redactJSON(parsed) {
+			return body
+		}
+		out, err := json.Marshal(parsed)
+		if err != nil {
+			return body
+		}
+		return string(out)
+	}
+
+	if strings.Contains(trimmed, "=") && !strings.ContainsAny(trimmed, " \n{") {
+		values, err := url.ParseQuery(trimmed)
+		if err != nil {
+			return body
+		}
+		redacted := false
+		for k := range values {
+			if isSensitiveField(k) {
+				values.Set(k, "[REDACTED]")
+				redacted = true
+			}
+		}
+		if redacted {
+			return values.Encode()
+		}
+	}
+	return body
+}
+
+// redactJSON masks sensitive string values in v in place and reports whether
+// anything was masked.
+func redactJSON(v interface{}) bool {
+	redacted := false
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, item := range val {
+			if _, isString := item.(string); isString && isSensitiveField(k) {
+				val[k] = "[REDACTED]"
+				redacted = true
+				continue
+			}
+			if redactJSON(item) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, item := range val {
+			if redactJSON(item) {
+				redacted = true
+			}
+		}
+	}
+	return redacted
+}
+
 // SetVerbosity sets the verbosity level for logging
 // Level 0: normal (no debug output)
 // Level 1: show request/response summary
-// Level 2+: show full request/response details (sensitive headers always redacted)
+// Level 2+: show full request/response details (sensitive headers and
+// credential fields in bodies are always redacted)
 func (c *Client) SetVerbosity(level int) {
 	if level <= 0 {
 		return
@@ -269,7 +349,7 @@ func (c *Client) SetVerbosity(level int) {
 				}
 			}
 			if bodyText := readRequestBodyForDebug(req); bodyText != "" {
-				sb.WriteString(fmt.Sprintf("BODY:\n%s\n", bodyText))
+				sb.WriteString(fmt.Sprintf("BODY:\n%s\n", redactBody(bodyText)))
 			}
 		}
 		fmt.Fprint(os.Stderr, sb.String())
@@ -279,6 +359,9 @@ func (c *Client) SetVerbosity(level int) {
 	c.http.OnAfterResponse(func(client *resty.Client, resp *resty.Response) error {
 		var sb strings.Builder
 		sb.WriteString("===> RESPONSE <===\n")
+		if resp.Request != nil && resp.Request.RawRequest != nil {
+			sb.WriteString(fmt.Sprintf("%s %s\n", resp.Request.Method, resp.Request.RawRequest.URL))
+		}
 		sb.WriteString(fmt.Sprintf("STATUS: %d %s\n", resp.StatusCode(), resp.Status()))
 		sb.WriteString(fmt.Sprintf("TIME: %s\n", resp.Time()))
 		if level >= 2 {
@@ -290,7 +373,7 @@ func (c *Client) SetVerbosity(level int) {
 					sb.WriteString(fmt.Sprintf("    %s: %s\n", k, strings.Join(v, ", ")))
 				}
 			}
-			sb.WriteString(fmt.Sprintf("BODY:\n%s\n", resp.String()))
+			sb.WriteString(fmt.Sprintf("BODY:\n%s\n", redactBody(resp.String())))
 		}
 		fmt.Fprint(os.Stderr, sb.String())
 		return nil
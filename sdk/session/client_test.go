@@ -532,6 +532,58 @@ func TestIsSensitiveHeader(t *testing.T) {
 	}
 }
 
+func TestRedactBody(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		body     string
+		contains []string
+		absent   []string
+	}{
+		{
+			name:     "json nested secret",
+			body:     `{"name":"ec","oauthClientSecret":"s3cr3t","auth":{"password":"hunter2"}}`,
+			contains: []string{`"name":"ec"`, `"oauthClientSecret":"[REDACTED]"`, `"password":"[REDACTED]"`},
+			absent:   []string{"s3cr3t", "hunter2"},
+		},
+		{
+			name:     "json token response",
+			body:     `{"access_token":"abc","refresh_token":"def","expires_in":300}`,
+			contains: []string{`"expires_in":300`},
+			absent:   []string{`"abc"`, `"def"`},
+		},
+		{
+			name:     "form encoded token request",
+			body:     "grant_type=refresh_token&refresh_token=def&client_id=dt",
+			contains: []string{"grant_type=refresh_token", "client_id=dt"},
+			absent:   []string{"refresh_token=def"},
+		},
+		{
+			name:     "plain text unchanged",
+			body:     "fetch logs | limit 10",
+			contains: []string{"fetch logs | limit 10"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := redactBody(tt.body)
+			for _, want := range tt.contains {
+				if !strings.Contains(got, want) {
+					t.Errorf("redactBody() = %q, want it to contain %q", got, want)
+				}
+			}
+			for _, bad := range tt.absent {
+				if strings.Contains(got, bad) {
+					t.Errorf("redactBody() = %q, must not contain %q", got, bad)
+				}
+			}
+		})
+	}
+}
+
 func TestClient_SetVerbosityLevels(t *testing.T) {
 	t.Parallel()
 
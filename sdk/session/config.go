@@ -22,6 +22,9 @@ type Config struct {
 	Tokens         []NamedToken      `yaml:"tokens"`
 	Preferences    Preferences       `yaml:"preferences"`
 	Aliases        map[string]string `yaml:"aliases,omitempty"`
+	// Views are saved 'dtctl get' invocations (resource, filter, columns,
+	// sort), used with 'dtctl get --view <name>'. See view.go.
+	Views map[string]View `yaml:"views,omitempty"`
 	// Spill holds the global result-spill settings (D15). Per-context overrides
 	// live on Context.Spill.
 	Spill SpillConfig `yaml:"spill,omitempty"`
@@ -40,6 +43,12 @@ type Config struct {
 	// are never honored at runtime — alias resolution and hook execution check
 	// IsLocal() and skip them. See markLocal, GetPreApplyHook, resolveAlias.
 	ignoredExecKeys bool
+	// fromEnv is true for the in-memory config built by FromEnv. It carries
+	// a plaintext token and must never be written to disk.
+	fromEnv bool
+	// keyringMode overrides Preferences.KeyringBackend for this config
+	// (--no-keyring, --keyring-backend); see SetKeyringMode.
+	keyringMode KeyringMode
 }
 
 // NamedContext holds a context with its name
@@ -98,6 +107,9 @@ func (s SafetyLevel) String() string {
 	return string(s)
 }
 
+// AuthTypeToken marks a context authenticated with a static token.
+const AuthTypeToken = "token"
+
 // Hooks holds hook commands for lifecycle events
 type Hooks struct {
 	PreApply  string `yaml:"pre-apply,omitempty"`
@@ -115,7 +127,18 @@ type Context struct {
 	// command surface when the context is active (unless overridden by
 	// DTCTL_PROFILE). Empty means the full command tree. See profile.go.
 	Profile string `yaml:"profile,omitempty" table:"PROFILE,wide"`
-	Hooks   Hooks  `yaml:"hooks,omitempty"`
+	// Output is the default output format (-o) while this context is active.
+	// The -o flag and DTCTL_OUTPUT take precedence.
+	Output string `yaml:"output,omitempty" table:"-"`
+	// Color and Prompt mark the context in confirmation prompts, e.g. a red
+	// "PROD" label so destructive operations in production stand out.
+	Color  string `yaml:"color,omitempty" table:"-"`
+	Prompt string `yaml:"prompt,omitempty" table:"-"`
+	// AuthType is AuthTypeToken for contexts that use a static platform or
+	// API token, which skips OAuth lookups and refresh. Empty means the
+	// token reference may be an OAuth session.
+	AuthType string `yaml:"auth-type,omitempty"`
+	Hooks    Hooks  `yaml:"hooks,omitempty"`
 	// Spill overrides the global spill settings for this context (D15). Nil
 	// fields inherit the global spill config.
 	Spill *SpillConfig `yaml:"spill,omitempty"`
@@ -170,7 +193,16 @@ type NamedToken struct {
 type Preferences struct {
 	Output string `yaml:"output,omitempty"`
 	Editor string `yaml:"editor,omitempty"`
-	Hooks  Hooks  `yaml:"hooks,omitempty"`
+	// CacheTTL is how long shell-completion candidates are reused, as a Go
+	// duration ("5m"); "0" disables the cache.
+	CacheTTL string `yaml:"cache-ttl,omitempty"`
+	// SDKVersion pins the function executor SDK used by ad-hoc
+	// `exec function` runs when --sdk is not given.
+	SDKVersion string `yaml:"sdk-version,omitempty"`
+	// KeyringBackend is the default token storage mode ("auto", "os" or
+	// "file") when --keyring-backend and --no-keyring are not given.
+	KeyringBackend string `yaml:"keyring-backend,omitempty"`
+	Hooks          Hooks  `yaml:"hooks,omitempty"`
 }
 
 // DefaultConfigPath returns the default config file path following XDG Base Directory spec
@@ -244,6 +276,54 @@ func findLocalConfigFrom(startDir string) string {
 // guards against: a stray .dtctl.yaml elsewhere on disk can never be picked up.
 const EnvConfig = "DTCTL_CONFIG"
 
+// Environment variables for running without any config file (see LoadFromEnv).
+// EnvToken takes precedence over EnvAPIToken when both are set.
+const (
+	EnvEnvironmentURL = "DT_ENVIRONMENT_URL"
+	EnvToken          = "DT_TOKEN"
+	EnvAPIToken       = "DT_API_TOKEN"
+)
+
+// EnvContextName is the name of the context built by LoadFromEnv.
+const EnvContextName = "env"
+
+// LoadFromEnv builds an in-memory config with a single context named
+// EnvContextName from DT_ENVIRONMENT_URL and DT_TOKEN (or DT_API_TOKEN), at
+// the default safety level. It returns false when the URL or token is unset.
+//
+// The token is held in the config's token list, not the keyring, and the
+// config refuses to be saved so the plaintext token never lands on disk.
+func LoadFromEnv() (*Config, bool) {
+	url := strings.TrimSpace(os.Getenv(EnvEnvironmentURL))
+	if url == "" {
+		return nil, false
+	}
+	tokenRef := EnvToken
+	token := os.Getenv(EnvToken)
+	if token == "" {
+		tokenRef = EnvAPIToken
+		token = os.Getenv(EnvAPIToken)
+	}
+	if token == "" {
+		return nil, false
+	}
+
+	cfg := NewConfig()
+	cfg.CurrentContext = EnvContextName
+	cfg.Contexts = append(cfg.Contexts, NamedContext{
+		Name: EnvContextName,
+		Context: Context{
+			Environment: strings.TrimRight(url, "/"),
+			TokenRef:    tokenRef,
+			SafetyLevel: DefaultSafetyLevel,
+			Description: "from " + EnvEnvironmentURL,
+		},
+	})
+	cfg.Tokens = append(cfg.Tokens, NamedToken{Name: tokenRef, Token: token})
+	cfg.fromEnv = true
+	return cfg, true
+}
+
 // Load loads the configuration with the following precedence:
 //  1. Explicit config from the DTCTL_CONFIG environment variable (trusted)
 //  2. Local config (.dtctl.yaml in current directory or parent directories)
@@ -324,6 +404,28 @@ func (c *Config) IsLocal() bool { return c.localPath != "" }
 // config was loaded from, or "" if it was not loaded from a local config.
 func (c *Config) LocalConfigPath() string { return c.localPath }
 
+// FromEnv reports whether the config was built from environment variables by
+// LoadFromEnv rather than loaded from a file.
+func (c *Config) FromEnv() bool { return c.fromEnv }
+
+// SetKeyringMode overrides preferences.keyring-backend with the token storage
+// mode used by this config's token operations. It is not saved.
+func (c *Config) SetKeyringMode(m KeyringMode) { c.keyringMode = m }
+
+// KeyringMode returns the token storage mode: the SetKeyringMode override,
+// else preferences.keyring-backend, else auto. An invalid preference falls
+// back to auto.
+func (c *Config) KeyringMode() KeyringMode {
+	if c.keyringMode != "" {
+		return c.keyringMode
+	}
+	mode, err := ParseKeyringMode(c.Preferences.KeyringBackend)
+	if err != nil {
+		return KeyringModeAuto
+	}
+	return mode
+}
+
 // IgnoredExecKeys reports whether code-execution keys (aliases, apply hooks)
 // are present in the auto-discovered local config and are therefore ignored at
 // runtime. See markLocal.
@@ -557,6 +659,9 @@ func (c *Config) Save() error {
 // written by a newer dtctl or another schema-v1 writer — are grafted back
 // from the file being overwritten, so an older writer never destroys them.
 func (c *Config) SaveTo(path string) error {
+	if c.fromEnv {
+		return fmt.Errorf("the %q context comes from %s and cannot be saved; run 'dtctl config set-context' to create a persistent context", EnvContextName, EnvEnvironmentURL)
+	}
 	dir := filepath.Dir(path)
 	if err := os.MkdirAll(dir, 0700); err != nil {
 		return fmt.Errorf("failed to create config directory: %w", err)
@@ -603,18 +708,42 @@ func (c *Config) GetContext(name string) (*NamedContext, error) {
 	return nil, fmt.Errorf("context %q not found", name)
 }
 
+// IsStaticToken reports whether tokenRef belongs to a context marked as
+// token-auth, so OAuth storage and refresh need not be consulted.
+func (c *Config) IsStaticToken(tokenRef string) bool {
+	for _, nc := range c.Contexts {
+		if nc.Context.TokenRef == tokenRef && nc.Context.AuthType == AuthTypeToken {
+			return true
+		}
+	}
+	return false
+}
+
+// SetContextAuthType sets the auth type of an existing context.
+func (c *Config) SetContextAuthType(name, authType string) error {
+	nc, err := c.GetContext(name)
+	if err != nil {
+		return err
+	}
+	nc.Context.AuthType = authType
+	return nil
+}
+
 // GetToken retrieves a token by reference name.
 // It first tries the OS keyring (checking both regular and OAuth tokens),
 // then file-based OAuth token storage, then falls back to the config file.
 func (c *Config) GetToken(tokenRef string) (string, error) {
-	// Try keyring first
-	if IsKeyringAvailable() {
-		ts := NewTokenStore()
+	// Try keyring first. A failed read other than "not found" is kept so the
+	// final error says why the keyring could not supply the token.
+	var keyringErr error
+	mode := c.KeyringMode()
+	if mode.KeyringAvailable() {
+		ts := NewTokenStoreWithMode(mode)
 
 		// First check for OAuth token.
 		// Current format: oauth:<env>:<tokenRef>
 		// Legacy format:  oauth:<tokenRef>
-		for _, keyringName := range c.oauthKeyringNames(tokenRef) {
+		for _, keyringName := range c.oauthKeyringNamesFor(tokenRef) {
 			oauthToken, err := ts.GetToken(keyringName)
 			if err != nil || oauthToken == "" {
 				continue
@@ -633,12 +762,15 @@ func (c *Config) GetToken(tokenRef string) (string, error) {
 		if err == nil && token != "" {
 			return token, nil
 		}
+		if err != nil && !strings.Contains(err.Error(), "not found in keyring") {
+			keyringErr = err
+		}
 	}
 
 	// Try file-based OAuth token storage (for headless/WSL environments)
-	if !IsKeyringAvailable() || IsFileTokenStorage() {
+	if !mode.KeyringAvailable() || mode.FileTokenStorage() {
 		fileStore := NewOAuthFileStore()
-		for _, keyringName := range c.oauthKeyringNames(tokenRef) {
+		for _, keyringName := range c.oauthKeyringNamesFor(tokenRef) {
 			oauthToken, err := fileStore.GetToken(keyringName)
 			if err != nil || oauthToken == "" {
 				continue
@@ -660,12 +792,27 @@ func (c *Config) GetToken(tokenRef string) (string, error) {
 				return nt.Token, nil
 			}
 			// Token reference exists but value is empty (migrated to keyring)
+			if keyringErr != nil {
+				return "", fmt.Errorf("token %q is stored in the keyring but could not be read: %w", tokenRef, keyringErr)
+			}
+			if probeErr := mode.CheckKeyring(); probeErr != nil {
+				return "", fmt.Errorf("token %q is stored in the keyring, which is unavailable (%v); unlock the keyring, or re-add the token with 'dtctl config set-credentials %s --token <token> --no-keyring' to keep it in the config file", tokenRef, probeErr, tokenRef)
+			}
 			return "", fmt.Errorf("token %q not found in keyring (may need to re-add credentials)", tokenRef)
 		}
 	}
 	return "", fmt.Errorf("token %q not found", tokenRef)
 }
 
+// oauthKeyringNamesFor is oauthKeyringNames, except static-token references
+// have no OAuth entries to look up.
+func (c *Config) oauthKeyringNamesFor(tokenRef string) []string {
+	if c.IsStaticToken(tokenRef) {
+		return nil
+	}
+	return c.oauthKeyringNames(tokenRef)
+}
+
 func (c *Config) oauthKeyringNames(tokenRef string) []string {
 	addCandidate := func(list []string, seen map[string]struct{}, key string) []string {
 		if key == "" {
@@ -727,6 +874,9 @@ type ContextOptions struct {
 	SafetyLevel SafetyLevel
 	Description string
 	Profile     string
+	Output      string
+	Color       string
+	Prompt      string
 }
 
 // SetContext creates or updates a context
@@ -754,6 +904,15 @@ func (c *Config) SetContextWithOptions(name, environment, tokenRef string, opts
 				if opts.Profile != "" {
 					c.Contexts[i].Context.Profile = opts.Profile
 				}
+				if opts.Output != "" {
+					c.Contexts[i].Context.Output = opts.Output
+				}
+				if opts.Color != "" {
+					c.Contexts[i].Context.Color = opts.Color
+				}
+				if opts.Prompt != "" {
+					c.Contexts[i].Context.Prompt = opts.Prompt
+				}
 			}
 			return
 		}
@@ -767,6 +926,9 @@ func (c *Config) SetContextWithOptions(name, environment, tokenRef string, opts
 		ctx.SafetyLevel = opts.SafetyLevel
 		ctx.Description = opts.Description
 		ctx.Profile = opts.Profile
+		ctx.Output = opts.Output
+		ctx.Color = opts.Color
+		ctx.Prompt = opts.Prompt
 	}
 
 	c.Contexts = append(c.Contexts, NamedContext{
@@ -862,14 +1024,18 @@ func (c *Config) SetToken(name, token string) error {
 // setTokenWithKeyring is the testable core of SetToken; accepts an explicit
 // keyringBackend and OAuthFileStore so tests avoid the OS keyring.
 func (c *Config) setTokenWithKeyring(name, token string, kr keyringBackend, fileStore *OAuthFileStore) error {
+	mode := c.KeyringMode()
 	if kr == nil {
-		kr = newOSKeyring()
+		kr = newOSKeyring(mode)
 	}
 	if fileStore == nil {
 		fileStore = NewOAuthFileStore()
 	}
 
 	keyringAvailable := kr.Available()
+	if !keyringAvailable && mode == KeyringModeOS {
+		return fmt.Errorf("keyring backend %q requires the OS keyring, but it is unavailable; run 'dtctl doctor' for details, or use --no-keyring to store the token in the config file", KeyringModeOS)
+	}
 	if keyringAvailable {
 		if err := kr.Set(name, token); err != nil {
 			return fmt.Errorf("failed to store token in keyring: %w", err)
@@ -589,6 +589,9 @@ func TestConfig_SetContextWithOptions(t *testing.T) {
 	opts := &ContextOptions{
 		SafetyLevel: SafetyLevelReadOnly,
 		Description: "Production read-only access",
+		Output:      "json",
+		Color:       "red",
+		Prompt:      "PROD",
 	}
 
 	cfg.SetContextWithOptions("prod", "https://prod.dt.com", "prod-token", opts)
@@ -623,6 +626,9 @@ func TestConfig_SetContextWithOptions(t *testing.T) {
 	if ctx.Description != "Production read-only access" {
 		t.Errorf("Description should remain unchanged, got %v", ctx.Description)
 	}
+	if ctx.Output != "json" || ctx.Color != "red" || ctx.Prompt != "PROD" {
+		t.Errorf("Output/Color/Prompt should remain unchanged, got %q/%q/%q", ctx.Output, ctx.Color, ctx.Prompt)
+	}
 }
 
 func TestConfig_SetContextWithOptions_NilOpts(t *testing.T) {
@@ -1406,7 +1412,7 @@ func TestConfig_GetToken_KeyringFallback(t *testing.T) {
 	if err == nil {
 		// Either keyring is available and returned token, or should have error
 		t.Log("Keyring available, token retrieved successfully")
-	} else if !strings.Contains(err.Error(), "not found in keyring") {
+	} else if !strings.Contains(err.Error(), "not found in keyring") && !strings.Contains(err.Error(), "which is unavailable") {
 		t.Errorf("GetToken() error = %v, want error about keyring", err)
 	}
 }
@@ -1589,6 +1595,56 @@ func TestConfig_SetToken_InvalidatesOAuthCache_DynamicEnv(t *testing.T) {
 	}
 }
 
+func TestConfig_SetToken_KeyringModeOS(t *testing.T) {
+	t.Parallel()
+
+	cfg := NewConfig()
+	cfg.SetKeyringMode(KeyringModeOS)
+	err := cfg.setTokenWithKeyring("ci-token", "secret", &unavailableKeyring{}, NewOAuthFileStoreWithDir(t.TempDir()))
+	if err == nil || !strings.Contains(err.Error(), "--no-keyring") {
+		t.Fatalf("setTokenWithKeyring() error = %v, want an error instead of a plaintext fallback", err)
+	}
+	if len(cfg.Tokens) != 0 {
+		t.Errorf("token must not be written to the config, got %+v", cfg.Tokens)
+	}
+}
+
+func TestConfig_GetToken_KeyringModeFile(t *testing.T) {
+	t.Parallel()
+
+	cfg := NewConfig()
+	cfg.SetKeyringMode(KeyringModeFile)
+	cfg.Tokens = []NamedToken{{Name: "in-file", Token: "plain"}, {Name: "in-keyring"}}
+
+	if got, err := cfg.GetToken("in-file"); err != nil || got != "plain" {
+		t.Errorf("GetToken(in-file) = %q, %v; want the config file token", got, err)
+	}
+	_, err := cfg.GetToken("in-keyring")
+	if err == nil || !strings.Contains(err.Error(), "which is unavailable") || !strings.Contains(err.Error(), "--no-keyring") {
+		t.Errorf("GetToken(in-keyring) error = %v, want a keyring-unavailable explanation", err)
+	}
+}
+
+func TestConfig_KeyringMode(t *testing.T) {
+	t.Parallel()
+
+	cfg := NewConfig()
+	if got := cfg.KeyringMode(); got != KeyringModeAuto {
+		t.Errorf("KeyringMode() = %q, want auto by default", got)
+	}
+	cfg.Preferences.KeyringBackend = "file"
+	if got := cfg.KeyringMode(); got != KeyringModeFile {
+		t.Errorf("KeyringMode() = %q, want the preference", got)
+	}
+	cfg.SetKeyringMode(KeyringModeOS)
+	if got := cfg.KeyringMode(); got != KeyringModeOS {
+		t.Errorf("KeyringMode() = %q, want the override to win over the preference", got)
+	}
+	if other := NewConfig(); other.KeyringMode() != KeyringModeAuto {
+		t.Errorf("another config's KeyringMode() = %q, want auto", other.KeyringMode())
+	}
+}
+
 func TestConfig_SetToken_InvalidatesOAuthFileCache(t *testing.T) {
 	t.Parallel()
 
@@ -2361,3 +2417,57 @@ func contextNames(contexts []NamedContext) []string {
 	}
 	return names
 }
+
+func TestLoadFromEnv(t *testing.T) {
+	t.Setenv(EnvEnvironmentURL, "")
+	t.Setenv(EnvToken, "")
+	t.Setenv(EnvAPIToken, "")
+
+	if _, ok := LoadFromEnv(); ok {
+		t.Fatal("LoadFromEnv() ok with no variables set")
+	}
+
+	t.Setenv(EnvEnvironmentURL, "https://abc12345.apps.dynatrace.com/")
+	if _, ok := LoadFromEnv(); ok {
+		t.Fatal("LoadFromEnv() ok without a token")
+	}
+
+	t.Setenv(EnvAPIToken, "api-token")
+	cfg, ok := LoadFromEnv()
+	if !ok {
+		t.Fatal("LoadFromEnv() not ok with DT_API_TOKEN set")
+	}
+	if got, _ := cfg.GetToken(EnvAPIToken); got != "api-token" {
+		t.Errorf("token = %q, want api-token", got)
+	}
+
+	t.Setenv(EnvToken, "dt-token")
+	cfg, _ = LoadFromEnv()
+	ctx, err := cfg.CurrentContextObj()
+	if err != nil {
+		t.Fatalf("CurrentContextObj() error = %v", err)
+	}
+	if cfg.CurrentContext != EnvContextName {
+		t.Errorf("CurrentContext = %q, want %q", cfg.CurrentContext, EnvContextName)
+	}
+	if ctx.Environment != "https://abc12345.apps.dynatrace.com" {
+		t.Errorf("Environment = %q", ctx.Environment)
+	}
+	if ctx.TokenRef != EnvToken {
+		t.Errorf("TokenRef = %q, want DT_TOKEN to win over DT_API_TOKEN", ctx.TokenRef)
+	}
+	if ctx.SafetyLevel != DefaultSafetyLevel {
+		t.Errorf("SafetyLevel = %q, want %q", ctx.SafetyLevel, DefaultSafetyLevel)
+	}
+	if !cfg.FromEnv() {
+		t.Error("FromEnv() = false")
+	}
+
+	path := filepath.Join(t.TempDir(), "config")
+	if err := cfg.SaveTo(path); err == nil {
+		t.Error("SaveTo() succeeded for an env config")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("env config was written to disk")
+	}
+}
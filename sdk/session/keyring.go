@@ -31,6 +31,34 @@ const (
 	ErrMsgCollectionUnlock = "failed to unlock correct collection"
 )
 
+// KeyringMode selects where static tokens are stored (--keyring-backend).
+type KeyringMode string
+
+const (
+	// KeyringModeAuto uses the OS keyring when it is reachable and the config
+	// file otherwise.
+	KeyringModeAuto KeyringMode = "auto"
+	// KeyringModeOS requires the OS keyring; storing a token fails instead of
+	// falling back to the config file.
+	KeyringModeOS KeyringMode = "os"
+	// KeyringModeFile never touches the OS keyring and keeps tokens in the
+	// config file (--no-keyring). Useful in CI, where a keyring daemon may be
+	// running but locked.
+	KeyringModeFile KeyringMode = "file"
+)
+
+// ParseKeyringMode validates a --keyring-backend value. An empty string
+// means auto.
+func ParseKeyringMode(s string) (KeyringMode, error) {
+	switch m := KeyringMode(strings.ToLower(s)); m {
+	case "":
+		return KeyringModeAuto, nil
+	case KeyringModeAuto, KeyringModeOS, KeyringModeFile:
+		return m, nil
+	}
+	return "", fmt.Errorf("invalid keyring backend %q (valid: auto, os, file)", s)
+}
+
 // keyringBackend abstracts secure credential storage so callers can be tested
 // without a live OS keyring.
 type keyringBackend interface {
@@ -43,8 +71,10 @@ type keyringBackend interface {
 // osKeyring is the production implementation backed by the OS keyring.
 type osKeyring struct{ store *TokenStore }
 
-func newOSKeyring() *osKeyring                       { return &osKeyring{store: NewTokenStore()} }
-func (k *osKeyring) Available() bool                 { return IsKeyringAvailable() }
+func newOSKeyring(mode KeyringMode) *osKeyring {
+	return &osKeyring{store: NewTokenStoreWithMode(mode)}
+}
+func (k *osKeyring) Available() bool                 { return k.store.mode.KeyringAvailable() }
 func (k *osKeyring) Get(name string) (string, error) { return k.store.GetToken(name) }
 func (k *osKeyring) Set(name, value string) error    { return k.store.SetToken(name, value) }
 func (k *osKeyring) Delete(name string) error        { return k.store.DeleteToken(name) }
@@ -54,12 +84,21 @@ type TokenStore struct {
 	// fallbackToFile indicates whether to fall back to file-based storage
 	// when keyring is unavailable
 	fallbackToFile bool
+	// mode is the token storage mode; KeyringModeFile never touches the
+	// OS keyring.
+	mode KeyringMode
 }
 
 // NewTokenStore creates a new token store
 func NewTokenStore() *TokenStore {
+	return NewTokenStoreWithMode(KeyringModeAuto)
+}
+
+// NewTokenStoreWithMode creates a token store that uses the given storage mode.
+func NewTokenStoreWithMode(mode KeyringMode) *TokenStore {
 	return &TokenStore{
 		fallbackToFile: true,
+		mode:           mode,
 	}
 }
 
@@ -72,9 +111,18 @@ func isKeyringDisabled() bool {
 // CheckKeyring probes the OS keyring and returns nil if it is usable,
 // or a descriptive error explaining why it is not.
 func CheckKeyring() error {
+	return KeyringModeAuto.CheckKeyring()
+}
+
+// CheckKeyring is the package-level CheckKeyring for this storage mode: the
+// file mode reports the keyring as disabled without probing it.
+func (m KeyringMode) CheckKeyring() error {
 	if isKeyringDisabled() {
 		return fmt.Errorf("keyring disabled via %s environment variable", EnvDisableKeyring)
 	}
+	if m == KeyringModeFile {
+		return fmt.Errorf("keyring disabled (--no-keyring or keyring backend %q)", KeyringModeFile)
+	}
 
 	_, err := keyring.Get(KeyringService, "__test__")
 	if err == nil || err == keyring.ErrNotFound {
@@ -85,12 +133,17 @@ func CheckKeyring() error {
 
 // IsKeyringAvailable checks if keyring storage is available on this system
 func IsKeyringAvailable() bool {
-	return CheckKeyring() == nil
+	return KeyringModeAuto.KeyringAvailable()
+}
+
+// KeyringAvailable is IsKeyringAvailable for this storage mode.
+func (m KeyringMode) KeyringAvailable() bool {
+	return m.CheckKeyring() == nil
 }
 
 // SetToken stores a token securely in the OS keyring
 func (ts *TokenStore) SetToken(name, token string) error {
-	if !IsKeyringAvailable() {
+	if !ts.mode.KeyringAvailable() {
 		if ts.fallbackToFile {
 			return nil // Will be handled by file-based storage
 		}
@@ -106,7 +159,7 @@ func (ts *TokenStore) SetToken(name, token string) error {
 
 // GetToken retrieves a token from the OS keyring
 func (ts *TokenStore) GetToken(name string) (string, error) {
-	if !IsKeyringAvailable() {
+	if !ts.mode.KeyringAvailable() {
 		return "", fmt.Errorf("keyring not available")
 	}
 
@@ -122,7 +175,7 @@ func (ts *TokenStore) GetToken(name string) (string, error) {
 
 // DeleteToken removes a token from the OS keyring
 func (ts *TokenStore) DeleteToken(name string) error {
-	if !IsKeyringAvailable() {
+	if !ts.mode.KeyringAvailable() {
 		return nil // Nothing to delete
 	}
 
@@ -139,11 +192,12 @@ func (ts *TokenStore) DeleteToken(name string) error {
 // MigrateTokensToKeyring migrates tokens from config file to keyring
 // Returns the number of tokens migrated and any error
 func MigrateTokensToKeyring(cfg *Config) (int, error) {
-	if !IsKeyringAvailable() {
+	mode := cfg.KeyringMode()
+	if !mode.KeyringAvailable() {
 		return 0, fmt.Errorf("keyring not available")
 	}
 
-	ts := NewTokenStore()
+	ts := NewTokenStoreWithMode(mode)
 	migrated := 0
 
 	for i, nt := range cfg.Tokens {
@@ -167,8 +221,8 @@ func MigrateTokensToKeyring(cfg *Config) (int, error) {
 // GetTokenWithFallback tries to get a token from keyring first, then falls back to config
 func GetTokenWithFallback(cfg *Config, tokenRef string) (string, error) {
 	// Try keyring first
-	if IsKeyringAvailable() {
-		ts := NewTokenStore()
+	if mode := cfg.KeyringMode(); mode.KeyringAvailable() {
+		ts := NewTokenStoreWithMode(mode)
 		token, err := ts.GetToken(tokenRef)
 		if err == nil && token != "" {
 			return token, nil
@@ -196,22 +250,39 @@ func KeyringBackend() string {
 // IsFileTokenStorage reports whether the user has explicitly opted into
 // file-based OAuth token storage via DTCTL_TOKEN_STORAGE=file.
 func IsFileTokenStorage() bool {
-	return strings.EqualFold(os.Getenv(EnvTokenStorage), "file")
+	return KeyringModeAuto.FileTokenStorage()
+}
+
+// FileTokenStorage is IsFileTokenStorage for this storage mode: the file
+// mode (--no-keyring) always stores OAuth tokens in files.
+func (m KeyringMode) FileTokenStorage() bool {
+	return strings.EqualFold(os.Getenv(EnvTokenStorage), "file") || m == KeyringModeFile
 }
 
 // IsOAuthStorageAvailable reports whether OAuth tokens can be stored
 // and retrieved — either via the OS keyring or file-based storage.
 func IsOAuthStorageAvailable() bool {
-	return IsKeyringAvailable() || IsFileTokenStorage()
+	return KeyringModeAuto.OAuthStorageAvailable()
+}
+
+// OAuthStorageAvailable is IsOAuthStorageAvailable for this storage mode.
+func (m KeyringMode) OAuthStorageAvailable() bool {
+	return m.KeyringAvailable() || m.FileTokenStorage()
 }
 
 // OAuthStorageBackend returns a human-readable label describing
 // where OAuth tokens are (or will be) stored.
 func OAuthStorageBackend() string {
-	if IsFileTokenStorage() {
+	return KeyringModeAuto.OAuthStorageBackend()
+}
+
+// OAuthStorageBackend is the package-level OAuthStorageBackend for this
+// storage mode.
+func (m KeyringMode) OAuthStorageBackend() string {
+	if m.FileTokenStorage() {
 		return fmt.Sprintf("file (%s)", oauthTokensDir())
 	}
-	if IsKeyringAvailable() {
+	if m.KeyringAvailable() {
 		return KeyringBackend()
 	}
 	// Fallback: file storage is used implicitly when keyring is unavailable
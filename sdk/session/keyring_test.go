@@ -53,6 +53,41 @@ func TestCheckKeyring_Disabled(t *testing.T) {
 	}
 }
 
+func TestCheckKeyring_FileMode(t *testing.T) {
+	if err := KeyringModeFile.CheckKeyring(); err == nil || !strings.Contains(err.Error(), "--no-keyring") {
+		t.Errorf("CheckKeyring() = %v, want the keyring to be disabled", err)
+	}
+	if KeyringModeFile.KeyringAvailable() {
+		t.Error("KeyringAvailable() should be false in file mode")
+	}
+	if !KeyringModeFile.FileTokenStorage() {
+		t.Error("FileTokenStorage() should be true in file mode")
+	}
+	if _, err := NewTokenStoreWithMode(KeyringModeFile).GetToken("any"); err == nil {
+		t.Error("a file-mode TokenStore must not read from the keyring")
+	}
+}
+
+func TestParseKeyringMode(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    KeyringMode
+		wantErr bool
+	}{
+		{"", KeyringModeAuto, false},
+		{"auto", KeyringModeAuto, false},
+		{"OS", KeyringModeOS, false},
+		{"file", KeyringModeFile, false},
+		{"pass", "", true},
+	}
+	for _, tt := range tests {
+		got, err := ParseKeyringMode(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseKeyringMode(%q) = %q, %v; want %q, err=%v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
 func TestCheckKeyring_ReturnsNilOrError(t *testing.T) {
 	// Smoke test: the function should not panic regardless of environment.
 	// In CI (no keyring) it returns an error; on a desktop it may return nil.
@@ -0,0 +1,175 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+	// defaultDevicePollInterval is used when the server does not send an
+	// interval (RFC 8628 section 3.2).
+	defaultDevicePollInterval = 5 * time.Second
+	// deviceSlowDownStep is added to the interval on a slow_down response.
+	deviceSlowDownStep = 5 * time.Second
+)
+
+// DeviceCode is the device authorization response (RFC 8628 section 3.2).
+type DeviceCode struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// deviceTokenError is the error body of a pending or failed device token poll.
+type deviceTokenError struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// StartDevice runs the OAuth device authorization grant: it requests a user
+// code, prints the verification URL and code to stderr, and polls the token
+// endpoint until the user approves the login on another device. Unlike Start
+// it needs neither a browser nor a localhost callback, so it works over SSH
+// and on headless hosts.
+func (f *OAuthFlow) StartDevice(ctx context.Context) (*TokenSet, error) {
+	dc, err := f.requestDeviceCode(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Fprintln(os.Stderr, "To authenticate, open the following URL on any device:")
+	fmt.Fprintln(os.Stderr, "  "+dc.VerificationURI)
+	fmt.Fprintf(os.Stderr, "and enter the code: %s\n", dc.UserCode)
+	if dc.VerificationURIComplete != "" {
+		fmt.Fprintln(os.Stderr, "Or open this URL, which includes the code:")
+		fmt.Fprintln(os.Stderr, "  "+dc.VerificationURIComplete)
+	}
+	fmt.Fprintln(os.Stderr, "Waiting for authorization...")
+
+	return f.pollDeviceToken(ctx, dc)
+}
+
+func (f *OAuthFlow) requestDeviceCode(ctx context.Context) (*DeviceCode, error) {
+	if f.config.DeviceAuthURL == "" {
+		return nil, fmt.Errorf("device authorization is not configured for this environment")
+	}
+
+	data := url.Values{
+		"client_id": {f.config.ClientID},
+		"scope":     {strings.Join(f.config.Scopes, " ")},
+	}
+	if f.config.EnvironmentURL != "" {
+		data.Set("resource", f.config.EnvironmentURL)
+	}
+
+	resp, err := f.postForm(ctx, f.config.DeviceAuthURL, data)
+	if err != nil {
+		return nil, fmt.Errorf("device authorization request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("device authorization failed: %s - %s", resp.Status, string(body))
+	}
+
+	var dc DeviceCode
+	if err := json.NewDecoder(resp.Body).Decode(&dc); err != nil {
+		return nil, fmt.Errorf("failed to decode device authorization response: %w", err)
+	}
+	if dc.DeviceCode == "" || dc.UserCode == "" || dc.VerificationURI == "" {
+		return nil, fmt.Errorf("device authorization response is missing device_code, user_code, or verification_uri")
+	}
+	return &dc, nil
+}
+
+func (f *OAuthFlow) pollDeviceToken(ctx context.Context, dc *DeviceCode) (*TokenSet, error) {
+	interval := time.Duration(dc.Interval) * time.Second
+	if interval <= 0 {
+		interval = defaultDevicePollInterval
+	}
+	if f.devicePollInterval > 0 {
+		interval = f.devicePollInterval
+	}
+
+	if dc.ExpiresIn > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(dc.ExpiresIn)*time.Second)
+		defer cancel()
+	}
+
+	data := url.Values{
+		"grant_type":  {deviceCodeGrantType},
+		"device_code": {dc.DeviceCode},
+		"client_id":   {f.config.ClientID},
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("authentication cancelled: %w", ctx.Err())
+		case <-time.After(interval):
+		}
+
+		resp, err := f.postForm(ctx, f.config.TokenURL, data)
+		if err != nil {
+			return nil, fmt.Errorf("token request failed: %w", err)
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read token response: %w", err)
+		}
+
+		if resp.StatusCode == http.StatusOK {
+			var tokens TokenSet
+			if err := json.Unmarshal(body, &tokens); err != nil {
+				return nil, fmt.Errorf("failed to decode token response: %w", err)
+			}
+			tokens.ExpiresAt = time.Now().Add(time.Duration(tokens.ExpiresIn) * time.Second)
+			return &tokens, nil
+		}
+
+		var tokenErr deviceTokenError
+		_ = json.Unmarshal(body, &tokenErr)
+		switch tokenErr.Error {
+		case "authorization_pending":
+			continue
+		case "slow_down":
+			interval += deviceSlowDownStep
+			continue
+		case "access_denied":
+			return nil, fmt.Errorf("authorization was denied")
+		case "expired_token":
+			return nil, fmt.Errorf("device code expired before authorization completed; run the login again")
+		default:
+			return nil, fmt.Errorf("token exchange failed: %s - %s", resp.Status, string(body))
+		}
+	}
+}
+
+func (f *OAuthFlow) postForm(ctx context.Context, endpoint string, data url.Values) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	httpDo := f.httpDo
+	if httpDo == nil {
+		httpDo = defaultOAuthHTTPDo
+	}
+	return httpDo(req)
+}
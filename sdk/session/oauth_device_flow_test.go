@@ -0,0 +1,130 @@
+package session
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func deviceFlowForTest(t *testing.T, tokenResponses []string) (*OAuthFlow, *int) {
+	t.Helper()
+	cfg := DefaultOAuthConfig()
+	cfg.Scopes = []string{"openid", "storage:logs:read"}
+	cfg.EnvironmentURL = "https://abc12345.apps.dynatrace.com"
+
+	flow, err := NewOAuthFlow(cfg)
+	if err != nil {
+		t.Fatalf("NewOAuthFlow failed: %v", err)
+	}
+	flow.devicePollInterval = time.Millisecond
+
+	polls := 0
+	flow.httpDo = func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		form, _ := url.ParseQuery(string(body))
+
+		switch req.URL.String() {
+		case cfg.DeviceAuthURL:
+			if form.Get("scope") != "openid storage:logs:read" || form.Get("resource") != cfg.EnvironmentURL {
+				t.Errorf("unexpected device authorization form: %v", form)
+			}
+			return jsonResponse(http.StatusOK, `{"device_code":"dev-1","user_code":"ABCD-EFGH",`+
+				`"verification_uri":"https://sso.dynatrace.com/device","expires_in":600,"interval":5}`), nil
+		case cfg.TokenURL:
+			if form.Get("grant_type") != deviceCodeGrantType || form.Get("device_code") != "dev-1" {
+				t.Errorf("unexpected token form: %v", form)
+			}
+			resp := tokenResponses[polls]
+			polls++
+			if strings.Contains(resp, "access_token") {
+				return jsonResponse(http.StatusOK, resp), nil
+			}
+			return jsonResponse(http.StatusBadRequest, resp), nil
+		}
+		t.Fatalf("unexpected request to %s", req.URL)
+		return nil, nil
+	}
+	return flow, &polls
+}
+
+func jsonResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Status:     http.StatusText(status),
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+func TestOAuthFlowStartDevice(t *testing.T) {
+	flow, polls := deviceFlowForTest(t, []string{
+		`{"error":"authorization_pending"}`,
+		`{"error":"slow_down"}`,
+		`{"access_token":"a","refresh_token":"r","expires_in":300}`,
+	})
+
+	tokens, err := flow.StartDevice(context.Background())
+	if err != nil {
+		t.Fatalf("StartDevice failed: %v", err)
+	}
+	if tokens.AccessToken != "a" || tokens.RefreshToken != "r" {
+		t.Errorf("unexpected tokens: %#v", tokens)
+	}
+	if tokens.ExpiresAt.IsZero() {
+		t.Error("expected ExpiresAt to be set")
+	}
+	if *polls != 3 {
+		t.Errorf("expected 3 token polls, got %d", *polls)
+	}
+}
+
+func TestOAuthFlowStartDeviceErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		resp string
+		want string
+	}{
+		{"denied", `{"error":"access_denied"}`, "denied"},
+		{"expired", `{"error":"expired_token"}`, "expired"},
+		{"other", `{"error":"invalid_client"}`, "invalid_client"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flow, _ := deviceFlowForTest(t, []string{tt.resp})
+			_, err := flow.StartDevice(context.Background())
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestOAuthFlowStartDeviceCancelled(t *testing.T) {
+	pending := make([]string, 1000)
+	for i := range pending {
+		pending[i] = `{"error":"authorization_pending"}`
+	}
+	flow, _ := deviceFlowForTest(t, pending)
+	flow.devicePollInterval = 10 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+
+	_, err := flow.StartDevice(ctx)
+	if err == nil || !strings.Contains(err.Error(), "authentication cancelled") {
+		t.Fatalf("expected cancelled error, got %v", err)
+	}
+}
+
+func TestOAuthFlowStartDeviceNotConfigured(t *testing.T) {
+	cfg := DefaultOAuthConfig()
+	cfg.DeviceAuthURL = ""
+	flow, _ := NewOAuthFlow(cfg)
+	if _, err := flow.StartDevice(context.Background()); err == nil {
+		t.Fatal("expected error when device authorization URL is missing")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"html"
 	"io"
@@ -15,6 +16,7 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/pkg/browser"
@@ -25,18 +27,21 @@ const (
 	prodAuthURL     = "https://sso.dynatrace.com/oauth2/authorize"
 	prodTokenURL    = "https://token.dynatrace.com/sso/oauth2/token"
 	prodUserInfoURL = "https://sso.dynatrace.com/sso/oauth2/userinfo"
+	prodDeviceURL   = "https://sso.dynatrace.com/oauth2/device_authorization"
 	prodClientID    = "dt0s12.dtctl-prod"
 
 	// Development environment
 	devAuthURL     = "https://sso-dev.dynatracelabs.com/oauth2/authorize"
 	devTokenURL    = "https://dev.token.dynatracelabs.com/sso/oauth2/token"
 	devUserInfoURL = "https://sso-dev.dynatracelabs.com/sso/oauth2/userinfo"
+	devDeviceURL   = "https://sso-dev.dynatracelabs.com/oauth2/device_authorization"
 	devClientID    = "dt0s12.dtctl-dev"
 
 	// Hardening/Sprint environment
 	hardAuthURL     = "https://sso-sprint.dynatracelabs.com/oauth2/authorize"
 	hardTokenURL    = "https://hard.token.dynatracelabs.com/sso/oauth2/token"
 	hardUserInfoURL = "https://sso-sprint.dynatracelabs.com/sso/oauth2/userinfo"
+	hardDeviceURL   = "https://sso-sprint.dynatracelabs.com/oauth2/device_authorization"
 	hardClientID    = "dt0s12.dtctl-sprint"
 
 	callbackPort = 3232
@@ -44,6 +49,14 @@ const (
 	callbackPath = "/auth/login"
 )
 
+// DefaultCallbackPort is the localhost port of the redirect URI registered for
+// dtctl's OAuth clients (http://localhost:3232/auth/login).
+const DefaultCallbackPort = callbackPort
+
+// ErrCallbackPortInUse is returned by Start when the localhost callback port
+// is held by another process.
+var ErrCallbackPortInUse = errors.New("OAuth callback port is already in use")
+
 // Environment represents a Dynatrace environment type
 type Environment string
 
@@ -66,17 +79,28 @@ type OAuthConfig struct {
 	AuthURL     string
 	TokenURL    string
 	UserInfoURL string
-	ClientID    string
+	// DeviceAuthURL is the device authorization endpoint (RFC 8628) used by
+	// StartDevice for logins without a local browser.
+	DeviceAuthURL string
+	ClientID      string
 	// Scopes are requested during the interactive login flow only; token
 	// refresh re-issues the original grant's scopes, so refresh-only
 	// consumers (the 401-retry path, TokenManager auto-refresh) may leave
 	// them empty. The scope-composition tables live in dtctl's pkg/auth —
 	// callers running a login flow pass the composed set in.
-	Scopes         []string
-	Port           int
+	Scopes []string
+	// Port is the localhost port of the login callback. 0 binds a free
+	// ephemeral port; the redirect URI follows the port actually bound.
+	Port int
+	// NoBrowser makes Start print the authorization URL for the user to open
+	// instead of launching a browser. The callback server still runs.
+	NoBrowser      bool
 	Environment    Environment
 	SafetyLevel    SafetyLevel
 	EnvironmentURL string
+	// KeyringMode is where a TokenManager built from this config stores
+	// tokens; the zero value behaves like KeyringModeAuto.
+	KeyringMode KeyringMode
 }
 
 // DetectEnvironment determines the environment type from a Dynatrace URL
@@ -105,7 +129,7 @@ func DefaultOAuthConfig() *OAuthConfig {
 // scope set composed for the safety level (dtctl's pkg/auth owns that
 // composition).
 func OAuthConfigForEnvironment(env Environment, safetyLevel SafetyLevel, scopes []string) *OAuthConfig {
-	var authURL, tokenURL, userInfoURL, clientID string
+	var authURL, tokenURL, userInfoURL, deviceURL, clientID string
 
 	// Normalize empty safety level to default
 	if safetyLevel == "" {
@@ -117,28 +141,32 @@ func OAuthConfigForEnvironment(env Environment, safetyLevel SafetyLevel, scopes
 		authURL = devAuthURL
 		tokenURL = devTokenURL
 		userInfoURL = devUserInfoURL
+		deviceURL = devDeviceURL
 		clientID = devClientID
 	case EnvironmentHard:
 		authURL = hardAuthURL
 		tokenURL = hardTokenURL
 		userInfoURL = hardUserInfoURL
+		deviceURL = hardDeviceURL
 		clientID = hardClientID
 	default: // EnvironmentProd
 		authURL = prodAuthURL
 		tokenURL = prodTokenURL
 		userInfoURL = prodUserInfoURL
+		deviceURL = prodDeviceURL
 		clientID = prodClientID
 	}
 
 	return &OAuthConfig{
-		AuthURL:     authURL,
-		TokenURL:    tokenURL,
-		UserInfoURL: userInfoURL,
-		ClientID:    clientID,
-		Scopes:      scopes,
-		Port:        callbackPort,
-		Environment: env,
-		SafetyLevel: safetyLevel,
+		AuthURL:       authURL,
+		TokenURL:      tokenURL,
+		UserInfoURL:   userInfoURL,
+		DeviceAuthURL: deviceURL,
+		ClientID:      clientID,
+		Scopes:        scopes,
+		Port:          callbackPort,
+		Environment:   env,
+		SafetyLevel:   safetyLevel,
 	}
 }
 
@@ -176,10 +204,16 @@ type OAuthFlow struct {
 	codeChallenge string
 	state         string
 	server        *http.Server
-	resultChan    chan *authResult
-	resultOnce    sync.Once
-	openURL       func(string) error
-	httpDo        func(*http.Request) (*http.Response, error)
+	// boundPort is the callback port actually bound by startCallbackServer
+	// (differs from config.Port when that is 0).
+	boundPort  int
+	resultChan chan *authResult
+	resultOnce sync.Once
+	openURL    func(string) error
+	httpDo     func(*http.Request) (*http.Response, error)
+	// devicePollInterval overrides the server-provided polling interval of
+	// the device flow (tests only).
+	devicePollInterval time.Duration
 }
 
 type authResult struct {
@@ -221,6 +255,13 @@ func (f *OAuthFlow) Start(ctx context.Context) (*TokenSet, error) {
 
 	authURL := f.buildAuthURL()
 
+	if f.config.NoBrowser {
+		fmt.Fprintln(os.Stderr, "Open the following URL in a browser to authenticate:")
+		fmt.Fprintln(os.Stderr, authURL)
+		fmt.Fprintln(os.Stderr, "Waiting for the login callback...")
+		return f.waitForResult(ctx)
+	}
+
 	fmt.Fprintln(os.Stderr, "Opening browser for authentication...")
 	fmt.Fprintln(os.Stderr, "If the browser doesn't open automatically, please visit:")
 	fmt.Fprintln(os.Stderr, authURL)
@@ -235,6 +276,12 @@ func (f *OAuthFlow) Start(ctx context.Context) (*TokenSet, error) {
 		fmt.Fprintln(os.Stderr, "Please open the URL above manually.")
 	}
 
+	return f.waitForResult(ctx)
+}
+
+// waitForResult blocks until the callback server delivers the login result
+// or ctx is done.
+func (f *OAuthFlow) waitForResult(ctx context.Context) (*TokenSet, error) {
 	select {
 	case result := <-f.resultChan:
 		if result.err != nil {
@@ -338,7 +385,11 @@ func (f *OAuthFlow) buildAuthURL() string {
 }
 
 func (f *OAuthFlow) getRedirectURI() string {
-	return fmt.Sprintf("http://localhost:%d%s", f.config.Port, callbackPath)
+	port := f.config.Port
+	if f.boundPort != 0 {
+		port = f.boundPort
+	}
+	return fmt.Sprintf("http://localhost:%d%s", port, callbackPath)
 }
 
 func (f *OAuthFlow) startCallbackServer() error {
@@ -348,8 +399,12 @@ func (f *OAuthFlow) startCallbackServer() error {
 	// Create a listener first so we can verify it's bound before proceeding
 	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", f.config.Port))
 	if err != nil {
+		if isAddrInUse(err) {
+			return fmt.Errorf("%w: port %d", ErrCallbackPortInUse, f.config.Port)
+		}
 		return fmt.Errorf("failed to bind to port %d: %w", f.config.Port, err)
 	}
+	f.boundPort = listener.Addr().(*net.TCPAddr).Port
 
 	f.server = &http.Server{
 		Handler: mux,
@@ -377,6 +432,17 @@ func (f *OAuthFlow) startCallbackServer() error {
 	return nil
 }
 
+// isAddrInUse reports whether a listen error means the port is taken. Windows
+// reports WSAEADDRINUSE, which does not match syscall.EADDRINUSE.
+func isAddrInUse(err error) bool {
+	if errors.Is(err, syscall.EADDRINUSE) {
+		return true
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "address already in use") ||
+		strings.Contains(msg, "Only one usage of each socket address")
+}
+
 func (f *OAuthFlow) stopCallbackServer() {
 	if f.server != nil {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -32,6 +32,32 @@ func TestOAuthFlowStartCancelled(t *testing.T) {
 	}
 }
 
+func TestOAuthFlowStartNoBrowser(t *testing.T) {
+	cfg := DefaultOAuthConfig()
+	cfg.Port = 0
+	cfg.NoBrowser = true
+	flow, err := NewOAuthFlow(cfg)
+	if err != nil {
+		t.Fatalf("NewOAuthFlow failed: %v", err)
+	}
+	opened := false
+	flow.openURL = func(url string) error {
+		opened = true
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+
+	_, err = flow.Start(ctx)
+	if err == nil || !strings.Contains(err.Error(), "authentication cancelled") {
+		t.Fatalf("expected cancelled error, got %v", err)
+	}
+	if opened {
+		t.Error("browser was opened despite NoBrowser")
+	}
+}
+
 func TestOAuthFlowRefreshTokenAndUserInfo(t *testing.T) {
 	t.Run("refresh token success", func(t *testing.T) {
 		flow, _ := NewOAuthFlow(DefaultOAuthConfig())
@@ -198,8 +224,29 @@ func TestOAuthFlowStartStopCallbackServer(t *testing.T) {
 		cfg.Port = port
 		flow, _ := NewOAuthFlow(cfg)
 		err = flow.startCallbackServer()
-		if err == nil {
-			t.Fatalf("expected bind error")
+		if !errors.Is(err, ErrCallbackPortInUse) {
+			t.Fatalf("expected ErrCallbackPortInUse, got %v", err)
+		}
+	})
+
+	t.Run("ephemeral port drives redirect URI", func(t *testing.T) {
+		cfg := DefaultOAuthConfig()
+		cfg.Port = 0
+		flow, _ := NewOAuthFlow(cfg)
+		if err := flow.startCallbackServer(); err != nil {
+			t.Fatalf("startCallbackServer failed: %v", err)
+		}
+		defer flow.stopCallbackServer()
+
+		if flow.boundPort == 0 {
+			t.Fatal("expected a bound port")
+		}
+		want := fmt.Sprintf("http://localhost:%d%s", flow.boundPort, callbackPath)
+		if got := flow.getRedirectURI(); got != want {
+			t.Errorf("getRedirectURI() = %q, want %q", got, want)
+		}
+		if !strings.Contains(flow.buildAuthURL(), url.QueryEscape(want)) {
+			t.Errorf("auth URL does not carry the bound redirect URI: %s", flow.buildAuthURL())
 		}
 	})
 }
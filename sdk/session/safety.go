@@ -19,6 +19,8 @@ const (
 	OperationDelete Operation = "delete"
 	// OperationDeleteBucket is a bucket deletion operation (data loss)
 	OperationDeleteBucket Operation = "delete-bucket"
+	// OperationDeleteRecords is a record-level deletion from a bucket (data loss)
+	OperationDeleteRecords Operation = "delete-records"
 )
 
 // ResourceOwnership indicates whether a resource is owned by the current user
@@ -125,31 +127,35 @@ func (c *Checker) checkReadWriteMine(op Operation, ownership ResourceOwnership)
 				"Switch to a 'readwrite-all' context",
 			},
 		}
-	case OperationDeleteBucket:
-		return CheckResult{
-			Allowed: false,
-			Reason:  fmt.Sprintf("Context '%s' (%s) does not allow bucket deletion", c.contextName, c.safetyLevel),
-			Suggestions: []string{
-				"Bucket operations require 'dangerously-unrestricted' safety level",
-			},
-		}
+	case OperationDeleteBucket, OperationDeleteRecords:
+		return c.dataDeletionBlocked(op)
 	}
 	return CheckResult{Allowed: true}
 }
 
 func (c *Checker) checkReadWriteAll(op Operation) CheckResult {
-	if op == OperationDeleteBucket {
-		return CheckResult{
-			Allowed: false,
-			Reason:  fmt.Sprintf("Context '%s' (%s) does not allow bucket deletion", c.contextName, c.safetyLevel),
-			Suggestions: []string{
-				"Bucket operations require 'dangerously-unrestricted' safety level",
-			},
-		}
+	if op == OperationDeleteBucket || op == OperationDeleteRecords {
+		return c.dataDeletionBlocked(op)
 	}
 	return CheckResult{Allowed: true}
 }
 
+// dataDeletionBlocked rejects bucket and record deletion below
+// dangerously-unrestricted.
+func (c *Checker) dataDeletionBlocked(op Operation) CheckResult {
+	what := "bucket deletion"
+	if op == OperationDeleteRecords {
+		what = "record deletion"
+	}
+	return CheckResult{
+		Allowed: false,
+		Reason:  fmt.Sprintf("Context '%s' (%s) does not allow %s", c.contextName, c.safetyLevel, what),
+		Suggestions: []string{
+			"Bucket operations require 'dangerously-unrestricted' safety level",
+		},
+	}
+}
+
 // FormatError formats a CheckResult as an error message
 func (c *Checker) FormatError(result CheckResult) string {
 	if result.Allowed {
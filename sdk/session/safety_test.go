@@ -59,6 +59,7 @@ func TestChecker_ReadOnly(t *testing.T) {
 		{"delete own blocked", OperationDelete, OwnershipOwn, false},
 		{"delete shared blocked", OperationDelete, OwnershipShared, false},
 		{"delete bucket blocked", OperationDeleteBucket, OwnershipUnknown, false},
+		{"delete records blocked", OperationDeleteRecords, OwnershipUnknown, false},
 	}
 
 	for _, tt := range tests {
@@ -96,6 +97,7 @@ func TestChecker_ReadWriteMine(t *testing.T) {
 		{"delete unknown blocked", OperationDelete, OwnershipUnknown, false}, // Unknown ownership is blocked (safer)
 		{"delete shared blocked", OperationDelete, OwnershipShared, false},
 		{"delete bucket blocked", OperationDeleteBucket, OwnershipUnknown, false},
+		{"delete records blocked", OperationDeleteRecords, OwnershipUnknown, false},
 	}
 
 	for _, tt := range tests {
@@ -125,6 +127,7 @@ func TestChecker_ReadWriteAll(t *testing.T) {
 		{"delete own allowed", OperationDelete, OwnershipOwn, true},
 		{"delete shared allowed", OperationDelete, OwnershipShared, true},
 		{"delete bucket blocked", OperationDeleteBucket, OwnershipUnknown, false},
+		{"delete records blocked", OperationDeleteRecords, OwnershipUnknown, false},
 	}
 
 	for _, tt := range tests {
@@ -154,6 +157,7 @@ func TestChecker_DangerouslyUnrestricted(t *testing.T) {
 		{"delete own allowed", OperationDelete, OwnershipOwn, true},
 		{"delete shared allowed", OperationDelete, OwnershipShared, true},
 		{"delete bucket allowed", OperationDeleteBucket, OwnershipUnknown, true},
+		{"delete records allowed", OperationDeleteRecords, OwnershipUnknown, true},
 	}
 
 	for _, tt := range tests {
@@ -73,20 +73,21 @@ func NewTokenManager(oauthConfig *OAuthConfig) (*TokenManager, error) {
 	}
 
 	fileStore := NewOAuthFileStore()
+	mode := oauthConfig.KeyringMode
 
 	return &TokenManager{
 		flow:        &OAuthFlow{config: oauthConfig, openURL: defaultOAuthOpenURL, httpDo: defaultOAuthHTTPDo},
-		tokenStore:  NewTokenStore(),
+		tokenStore:  NewTokenStoreWithMode(mode),
 		environment: oauthConfig.Environment,
 		warn: func(format string, args ...any) {
 			fmt.Fprintf(os.Stderr, "dtctl: warning: "+format+"\n", args...)
 		},
 		deps: tokenStoreDeps{
-			keyringAvailable:   IsKeyringAvailable,
+			keyringAvailable:   mode.KeyringAvailable,
 			getToken:           func(ts *TokenStore, name string) (string, error) { return ts.GetToken(name) },
 			setToken:           func(ts *TokenStore, name, token string) error { return ts.SetToken(name, token) },
 			deleteToken:        func(ts *TokenStore, name string) error { return ts.DeleteToken(name) },
-			fileStoreAvailable: func() bool { return !IsKeyringAvailable() && IsFileTokenStorage() },
+			fileStoreAvailable: func() bool { return !mode.KeyringAvailable() && mode.FileTokenStorage() },
 			fileGetToken:       func(name string) (string, error) { return fileStore.GetToken(name) },
 			fileSetToken:       func(name, token string) error { return fileStore.SetToken(name, token) },
 			fileDeleteToken:    func(name string) error { return fileStore.DeleteToken(name) },
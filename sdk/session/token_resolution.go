@@ -20,10 +20,12 @@ func GetTokenWithOAuthSupport(cfg *Config, tokenRef string) (string, error) {
 // token may belong to a context other than the current one (e.g. `dtctl ctx token <name>`),
 // since the OAuth environment determines both the refresh endpoint and the storage key.
 func GetTokenForContext(cfg *Config, environmentURL, tokenRef string) (string, error) {
-	// First, try to get it as an OAuth token (via keyring or file-based storage)
-	if IsOAuthStorageAvailable() && environmentURL != "" {
+	// First, try to get it as an OAuth token (via keyring or file-based storage).
+	// Token-auth contexts hold a static token and never go through OAuth.
+	if cfg.KeyringMode().OAuthStorageAvailable() && environmentURL != "" && !cfg.IsStaticToken(tokenRef) {
 		// Detect environment from the context's URL
 		oauthConfig := OAuthConfigFromEnvironmentURL(environmentURL, "", nil)
+		oauthConfig.KeyringMode = cfg.KeyringMode()
 		tokenManager, err := NewTokenManager(oauthConfig)
 		if err != nil {
 			return "", err
@@ -60,10 +62,12 @@ func RefreshedTokenForContext(cfg *Config, environmentURL, tokenRef, rejected st
 	if err != nil || token != rejected {
 		return token, err
 	}
-	if !IsOAuthStorageAvailable() || environmentURL == "" {
+	if !cfg.KeyringMode().OAuthStorageAvailable() || environmentURL == "" || cfg.IsStaticToken(tokenRef) {
 		return token, nil
 	}
-	tokenManager, err := NewTokenManager(OAuthConfigFromEnvironmentURL(environmentURL, "", nil))
+	oauthConfig := OAuthConfigFromEnvironmentURL(environmentURL, "", nil)
+	oauthConfig.KeyringMode = cfg.KeyringMode()
+	tokenManager, err := NewTokenManager(oauthConfig)
 	if err != nil {
 		return "", err
 	}
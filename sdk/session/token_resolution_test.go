@@ -61,6 +61,35 @@ func TestGetTokenWithOAuthSupport_FallsBackWithoutOAuthContext(t *testing.T) {
 	}
 }
 
+func TestGetTokenForContext_StaticTokenSkipsOAuth(t *testing.T) {
+	t.Setenv(EnvDisableKeyring, "1")
+	t.Setenv(EnvTokenStorage, "file")
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+
+	cfg := NewConfig()
+	cfg.SetContext("ci", "https://abc12345.apps.dynatrace.com", "ci-token")
+	if err := cfg.SetToken("ci-token", "dt0s16.static"); err != nil {
+		t.Fatalf("SetToken() error = %v", err)
+	}
+	if cfg.IsStaticToken("ci-token") {
+		t.Fatal("IsStaticToken() = true before the context is marked")
+	}
+	if err := cfg.SetContextAuthType("ci", AuthTypeToken); err != nil {
+		t.Fatalf("SetContextAuthType() error = %v", err)
+	}
+	if !cfg.IsStaticToken("ci-token") {
+		t.Fatal("IsStaticToken() = false for a token-auth context")
+	}
+
+	got, err := RefreshedTokenForContext(cfg, "https://abc12345.apps.dynatrace.com", "ci-token", "dt0s16.static")
+	if err != nil {
+		t.Fatalf("RefreshedTokenForContext() error = %v", err)
+	}
+	if got != "dt0s16.static" {
+		t.Fatalf("RefreshedTokenForContext() = %q, want the static token", got)
+	}
+}
+
 // A forced refresh that fails with invalid_grant must evict the revoked cache
 // entry (mirroring GetToken) while still returning the fallback token so the
 // caller surfaces the original 401.
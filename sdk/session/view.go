@@ -0,0 +1,79 @@
+package session
+
+import (
+	"fmt"
+	"sort"
+)
+
+// View is a saved 'dtctl get' invocation: a resource type plus the filter,
+// selector, sort and column flags that are repeatedly typed with it. Views are
+// expanded by 'dtctl get --view <name>'.
+type View struct {
+	Resource string   `yaml:"resource" table:"RESOURCE"`
+	Filter   string   `yaml:"filter,omitempty" table:"FILTER"`
+	Selector string   `yaml:"selector,omitempty" table:"SELECTOR"`
+	Sort     string   `yaml:"sort,omitempty" table:"SORT"`
+	Columns  []string `yaml:"columns,omitempty" table:"COLUMNS"`
+	Output   string   `yaml:"output,omitempty" table:"OUTPUT"`
+}
+
+// Args returns the 'dtctl get' arguments the view expands to.
+func (v View) Args() []string {
+	args := []string{"get", v.Resource}
+	if v.Filter != "" {
+		args = append(args, "--filter", v.Filter)
+	}
+	if v.Selector != "" {
+		args = append(args, "--selector", v.Selector)
+	}
+	if v.Sort != "" {
+		args = append(args, "--sort", v.Sort)
+	}
+	for _, c := range v.Columns {
+		args = append(args, "--columns", c)
+	}
+	if v.Output != "" {
+		args = append(args, "--output", v.Output)
+	}
+	return args
+}
+
+// SetView adds or updates a view. View names follow the alias naming rules.
+func (c *Config) SetView(name string, view View) error {
+	if err := ValidateAliasName(name); err != nil {
+		return fmt.Errorf("invalid view name: %w", err)
+	}
+	if view.Resource == "" {
+		return fmt.Errorf("view %q needs a resource type", name)
+	}
+	if c.Views == nil {
+		c.Views = make(map[string]View)
+	}
+	c.Views[name] = view
+	return nil
+}
+
+// DeleteView removes a view by name. Returns an error if it does not exist.
+func (c *Config) DeleteView(name string) error {
+	if _, ok := c.Views[name]; !ok {
+		return fmt.Errorf("view %q not found", name)
+	}
+	delete(c.Views, name)
+	return nil
+}
+
+// GetView returns the view with the given name.
+func (c *Config) GetView(name string) (View, bool) {
+	v, ok := c.Views[name]
+	return v, ok
+}
+
+// ViewNames returns the names of all views, sorted alphabetically.
+func (c *Config) ViewNames() []string {
+	names := make([]string, 0, len(c.Views))
+	for name := range c.Views {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
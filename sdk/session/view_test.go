@@ -0,0 +1,29 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestViews(t *testing.T) {
+	cfg := NewConfig()
+
+	require.Error(t, cfg.SetView("bad name", View{Resource: "workflows"}))
+	require.Error(t, cfg.SetView("no-resource", View{}))
+
+	view := View{Resource: "workflows", Filter: "deploy", Selector: "env=prod", Columns: []string{"id", "title"}, Output: "markdown"}
+	require.NoError(t, cfg.SetView("prod-wf", view))
+	require.NoError(t, cfg.SetView("all-dash", View{Resource: "dashboards"}))
+
+	got, ok := cfg.GetView("prod-wf")
+	require.True(t, ok)
+	require.Equal(t, []string{
+		"get", "workflows", "--filter", "deploy", "--selector", "env=prod",
+		"--columns", "id", "--columns", "title", "--output", "markdown",
+	}, got.Args())
+	require.Equal(t, []string{"all-dash", "prod-wf"}, cfg.ViewNames())
+
+	require.NoError(t, cfg.DeleteView("prod-wf"))
+	require.Error(t, cfg.DeleteView("prod-wf"))
+}
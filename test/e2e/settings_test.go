@@ -111,7 +111,7 @@ func TestSettingsListObjects(t *testing.T) {
 	schemaID := "builtin:openpipeline.logs.pipelines"
 	t.Logf("Listing settings objects for schema: %s", schemaID)
 
-	objectsList, err := handler.ListObjects(schemaID, "environment", 0)
+	objectsList, err := handler.ListObjects(schemaID, "environment", 0, 0)
 	if err != nil {
 		t.Fatalf("Failed to list objects: %v", err)
 	}
@@ -143,7 +143,7 @@ func TestSettingsListObjectsWithPagination(t *testing.T) {
 
 	t.Logf("Listing settings objects with pagination (chunkSize=%d)...", chunkSize)
 
-	objectsList, err := handler.ListObjects(schemaID, "", chunkSize)
+	objectsList, err := handler.ListObjects(schemaID, "", chunkSize, 0)
 	if err != nil {
 		t.Fatalf("Failed to list objects with pagination: %v", err)
 	}
@@ -183,7 +183,7 @@ func TestSettingsListObjectsScopePopulated(t *testing.T) {
 	schemaID := "builtin:anomaly-detection.services"
 	t.Logf("Listing objects for schema: %s", schemaID)
 
-	list, err := handler.ListObjects(schemaID, "", 0)
+	list, err := handler.ListObjects(schemaID, "", 0, 0)
 	if err != nil {
 		t.Fatalf("ListObjects() error = %v", err)
 	}
@@ -215,7 +215,7 @@ func TestSettingsListObjectsObjectIDShortPopulated(t *testing.T) {
 	handler := settings.NewHandler(env.Client)
 
 	schemaID := "builtin:anomaly-detection.services"
-	list, err := handler.ListObjects(schemaID, "", 0)
+	list, err := handler.ListObjects(schemaID, "", 0, 0)
 	if err != nil {
 		t.Fatalf("ListObjects() error = %v", err)
 	}
@@ -387,7 +387,7 @@ func TestSettingsListObjectsInvalidSchema(t *testing.T) {
 	schemaID := "builtin:nonexistent.schema.doesnotexist"
 	t.Logf("Attempting to list objects for non-existent schema: %s", schemaID)
 
-	_, err := handler.ListObjects(schemaID, "", 0)
+	_, err := handler.ListObjects(schemaID, "", 0, 0)
 	if err == nil {
 		t.Fatal("Expected error for non-existent schema, got nil")
 	}
@@ -63,7 +63,7 @@ func TestSLOLifecycle(t *testing.T) {
 
 			// Step 3: List SLOs (verify our SLO appears)
 			t.Log("Step 3: Listing SLOs...")
-			list, err := handler.List("", 0)
+			list, err := handler.List("", 0, 0)
 			if err != nil {
 				t.Fatalf("Failed to list SLOs: %v", err)
 			}